@@ -2199,6 +2199,29 @@ func (r *ProtocolIncus) GetInstanceState(name string) (*api.InstanceState, strin
 	return &state, etag, nil
 }
 
+// GetInstanceInterfaceOVN compares an instance NIC connected to an OVN network with its OVN records.
+func (r *ProtocolIncus) GetInstanceInterfaceOVN(name string, deviceName string) (*api.InstanceInterfaceOVN, error) {
+	err := r.CheckExtension("instance_interface_ovn")
+	if err != nil {
+		return nil, err
+	}
+
+	path, _, err := r.instanceTypeToPath(api.InstanceTypeAny)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := api.InstanceInterfaceOVN{}
+
+	// Fetch the raw value
+	_, err = r.queryStruct("GET", fmt.Sprintf("%s/%s/interfaces/%s/ovn", path, url.PathEscape(name), url.PathEscape(deviceName)), nil, "", &diff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diff, nil
+}
+
 // UpdateInstanceState updates the instance to match the requested state.
 func (r *ProtocolIncus) UpdateInstanceState(name string, state api.InstanceStatePut, ETag string) (Operation, error) {
 	path, _, err := r.instanceTypeToPath(api.InstanceTypeAny)
@@ -21,3 +21,19 @@ func (r *ProtocolIncus) GetMetadataConfiguration() (*api.MetadataConfiguration,
 
 	return &metadataConfiguration, nil
 }
+
+// GetMetadataNetworkConfiguration returns the configuration schema of a network type.
+func (r *ProtocolIncus) GetMetadataNetworkConfiguration(networkType string) (*api.MetadataNetworkConfiguration, error) {
+	networkConfiguration := api.MetadataNetworkConfiguration{}
+
+	if !r.HasExtension("metadata_configuration_network_schema") {
+		return nil, errors.New("The server is missing the required \"metadata_configuration_network_schema\" API extension")
+	}
+
+	_, err := r.queryStruct("GET", api.NewURL().Path("metadata", "configuration", "networks", networkType).String(), nil, "", &networkConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkConfiguration, nil
+}
@@ -0,0 +1,107 @@
+package incus
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// GetNetworkExternalPortNames returns a list of network external port names.
+func (r *ProtocolIncus) GetNetworkExternalPortNames(networkName string) ([]string, error) {
+	if !r.HasExtension("network_external_ports") {
+		return nil, errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	// Fetch the raw URL values.
+	urls := []string{}
+	baseURL := fmt.Sprintf("/networks/%s/external-ports", url.PathEscape(networkName))
+	_, err := r.queryStruct("GET", baseURL, nil, "", &urls)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse it.
+	return urlsToResourceNames(baseURL, urls...)
+}
+
+// GetNetworkExternalPorts returns a list of network external port structs.
+func (r *ProtocolIncus) GetNetworkExternalPorts(networkName string) ([]api.NetworkExternalPort, error) {
+	if !r.HasExtension("network_external_ports") {
+		return nil, errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	ports := []api.NetworkExternalPort{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/external-ports?recursion=1", url.PathEscape(networkName)), nil, "", &ports)
+	if err != nil {
+		return nil, err
+	}
+
+	return ports, nil
+}
+
+// GetNetworkExternalPort returns a network external port entry for the provided network and port name.
+func (r *ProtocolIncus) GetNetworkExternalPort(networkName string, portName string) (*api.NetworkExternalPort, string, error) {
+	if !r.HasExtension("network_external_ports") {
+		return nil, "", errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	port := api.NetworkExternalPort{}
+
+	// Fetch the raw value.
+	etag, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/external-ports/%s", url.PathEscape(networkName), url.PathEscape(portName)), nil, "", &port)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &port, etag, nil
+}
+
+// CreateNetworkExternalPort defines a new network external port using the provided struct.
+// The port is attached on the cluster member selected with UseTarget.
+func (r *ProtocolIncus) CreateNetworkExternalPort(networkName string, port api.NetworkExternalPortsPost) error {
+	if !r.HasExtension("network_external_ports") {
+		return errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/external-ports", url.PathEscape(networkName)), port, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateNetworkExternalPort updates the network external port to match the provided struct.
+func (r *ProtocolIncus) UpdateNetworkExternalPort(networkName string, portName string, port api.NetworkExternalPortPut, ETag string) error {
+	if !r.HasExtension("network_external_ports") {
+		return errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("PUT", fmt.Sprintf("/networks/%s/external-ports/%s", url.PathEscape(networkName), url.PathEscape(portName)), port, ETag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkExternalPort deletes an existing network external port.
+func (r *ProtocolIncus) DeleteNetworkExternalPort(networkName string, portName string) error {
+	if !r.HasExtension("network_external_ports") {
+		return errors.New(`The server is missing the required "network_external_ports" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("DELETE", fmt.Sprintf("/networks/%s/external-ports/%s", url.PathEscape(networkName), url.PathEscape(portName)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -90,6 +90,36 @@ func (r *ProtocolIncus) UpdateNetworkForward(networkName string, listenAddress s
 	return nil
 }
 
+// PatchNetworkForward partially updates the network forward, adding, modifying or removing individual ports.
+func (r *ProtocolIncus) PatchNetworkForward(networkName string, listenAddress string, forward api.NetworkForwardPatch, ETag string) error {
+	if !r.HasExtension("network_forward_ports_patch") {
+		return errors.New(`The server is missing the required "network_forward_ports_patch" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("PATCH", fmt.Sprintf("/networks/%s/forwards/%s", url.PathEscape(networkName), url.PathEscape(listenAddress)), forward, ETag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RenameNetworkForward moves an existing network forward to another listen address.
+func (r *ProtocolIncus) RenameNetworkForward(networkName string, listenAddress string, forward api.NetworkForwardPost) error {
+	if !r.HasExtension("network_listen_address_move") {
+		return errors.New(`The server is missing the required "network_listen_address_move" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/forwards/%s", url.PathEscape(networkName), url.PathEscape(listenAddress)), forward, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteNetworkForward deletes an existing network forward.
 func (r *ProtocolIncus) DeleteNetworkForward(networkName string, listenAddress string) error {
 	if !r.HasExtension("network_forward") {
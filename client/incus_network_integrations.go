@@ -60,6 +60,23 @@ func (r *ProtocolIncus) GetNetworkIntegration(name string) (*api.NetworkIntegrat
 	return &integration, etag, nil
 }
 
+// GetNetworkIntegrationState returns the state of the interconnection layer behind a network integration.
+func (r *ProtocolIncus) GetNetworkIntegrationState(name string) (*api.NetworkIntegrationState, error) {
+	if !r.HasExtension("network_integrations_state") {
+		return nil, errors.New(`The server is missing the required "network_integrations_state" API extension`)
+	}
+
+	state := api.NetworkIntegrationState{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/network-integrations/%s/state", url.PathEscape(name)), nil, "", &state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
 // CreateNetworkIntegration defines a new network integration using the provided struct.
 // Returns true if the integration connection has been mutually created. Returns false if integrationing has been only initiated.
 func (r *ProtocolIncus) CreateNetworkIntegration(integration api.NetworkIntegrationsPost) error {
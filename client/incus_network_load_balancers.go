@@ -95,6 +95,23 @@ func (r *ProtocolIncus) UpdateNetworkLoadBalancer(networkName string, listenAddr
 	return nil
 }
 
+// RenameNetworkLoadBalancer moves an existing network load balancer to another listen address.
+func (r *ProtocolIncus) RenameNetworkLoadBalancer(networkName string, listenAddress string, loadBalancer api.NetworkLoadBalancerPost) error {
+	err := r.CheckExtension("network_listen_address_move")
+	if err != nil {
+		return err
+	}
+
+	// Send the request.
+	u := api.NewURL().Path("networks", networkName, "load-balancers", listenAddress)
+	_, _, err = r.query("POST", u.String(), loadBalancer, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteNetworkLoadBalancer deletes an existing network load balancer.
 func (r *ProtocolIncus) DeleteNetworkLoadBalancer(networkName string, listenAddress string) error {
 	err := r.CheckExtension("network_load_balancer")
@@ -109,3 +109,35 @@ func (r *ProtocolIncus) DeleteNetworkPeer(networkName string, peerName string) e
 
 	return nil
 }
+
+// GetNetworkPeerRoutes returns the routes a remote network peer advertises to its integration.
+func (r *ProtocolIncus) GetNetworkPeerRoutes(networkName string, peerName string) (*api.NetworkPeerRoutes, error) {
+	if !r.HasExtension("network_peer_route_filters") {
+		return nil, errors.New(`The server is missing the required "network_peer_route_filters" API extension`)
+	}
+
+	routes := api.NetworkPeerRoutes{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/peers/%s/routes", url.PathEscape(networkName), url.PathEscape(peerName)), nil, "", &routes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &routes, nil
+}
+
+// UpdateNetworkPeerState approves or rejects a network peering awaiting approval.
+func (r *ProtocolIncus) UpdateNetworkPeerState(networkName string, peerName string, state api.NetworkPeerStatePost) error {
+	if !r.HasExtension("network_peer_approval") {
+		return errors.New(`The server is missing the required "network_peer_approval" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/peers/%s/state", url.PathEscape(networkName), url.PathEscape(peerName)), state, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package incus
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// GetNetworkRestorePointNames returns a list of network restore point names.
+func (r *ProtocolIncus) GetNetworkRestorePointNames(networkName string) ([]string, error) {
+	if !r.HasExtension("network_restore_points") {
+		return nil, errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	// Fetch the raw URL values.
+	urls := []string{}
+	baseURL := fmt.Sprintf("/networks/%s/restore-points", url.PathEscape(networkName))
+	_, err := r.queryStruct("GET", baseURL, nil, "", &urls)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse it.
+	return urlsToResourceNames(baseURL, urls...)
+}
+
+// GetNetworkRestorePoints returns a list of network restore point structs.
+func (r *ProtocolIncus) GetNetworkRestorePoints(networkName string) ([]api.NetworkRestorePoint, error) {
+	if !r.HasExtension("network_restore_points") {
+		return nil, errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	restorePoints := []api.NetworkRestorePoint{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/restore-points?recursion=1", url.PathEscape(networkName)), nil, "", &restorePoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return restorePoints, nil
+}
+
+// GetNetworkRestorePoint returns a network restore point entry for the provided network and restore point name.
+func (r *ProtocolIncus) GetNetworkRestorePoint(networkName string, restorePointName string) (*api.NetworkRestorePoint, error) {
+	if !r.HasExtension("network_restore_points") {
+		return nil, errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	restorePoint := api.NetworkRestorePoint{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/restore-points/%s", url.PathEscape(networkName), url.PathEscape(restorePointName)), nil, "", &restorePoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restorePoint, nil
+}
+
+// GetNetworkRestorePointDiff returns the differences between the network and the provided restore point.
+func (r *ProtocolIncus) GetNetworkRestorePointDiff(networkName string, restorePointName string) ([]api.NetworkRestorePointChange, error) {
+	if !r.HasExtension("network_restore_points") {
+		return nil, errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	changes := []api.NetworkRestorePointChange{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/restore-points/%s/diff", url.PathEscape(networkName), url.PathEscape(restorePointName)), nil, "", &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// CreateNetworkRestorePoint saves the current definition of the network in a new restore point.
+func (r *ProtocolIncus) CreateNetworkRestorePoint(networkName string, restorePoint api.NetworkRestorePointsPost) error {
+	if !r.HasExtension("network_restore_points") {
+		return errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/restore-points", url.PathEscape(networkName)), restorePoint, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreNetworkRestorePoint rolls the network back to the provided restore point.
+func (r *ProtocolIncus) RestoreNetworkRestorePoint(networkName string, restorePointName string) error {
+	if !r.HasExtension("network_restore_points") {
+		return errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/restore-points/%s/restore", url.PathEscape(networkName), url.PathEscape(restorePointName)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkRestorePoint deletes an existing network restore point.
+func (r *ProtocolIncus) DeleteNetworkRestorePoint(networkName string, restorePointName string) error {
+	if !r.HasExtension("network_restore_points") {
+		return errors.New(`The server is missing the required "network_restore_points" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("DELETE", fmt.Sprintf("/networks/%s/restore-points/%s", url.PathEscape(networkName), url.PathEscape(restorePointName)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
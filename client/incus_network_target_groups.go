@@ -0,0 +1,136 @@
+package incus
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// GetNetworkTargetGroupNames returns a list of network target group names.
+func (r *ProtocolIncus) GetNetworkTargetGroupNames() ([]string, error) {
+	if !r.HasExtension("network_target_groups") {
+		return nil, errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	// Fetch the raw URL values.
+	urls := []string{}
+	baseURL := "/network-target-groups"
+	_, err := r.queryStruct("GET", baseURL, nil, "", &urls)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse it.
+	return urlsToResourceNames(baseURL, urls...)
+}
+
+// GetNetworkTargetGroups returns a list of network target group structs.
+func (r *ProtocolIncus) GetNetworkTargetGroups() ([]api.NetworkTargetGroup, error) {
+	if !r.HasExtension("network_target_groups") {
+		return nil, errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	targetGroups := []api.NetworkTargetGroup{}
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", "/network-target-groups?recursion=1", nil, "", &targetGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return targetGroups, nil
+}
+
+// GetNetworkTargetGroupsAllProjects returns a list of network target group structs across all projects.
+func (r *ProtocolIncus) GetNetworkTargetGroupsAllProjects() ([]api.NetworkTargetGroup, error) {
+	if !r.HasExtension("network_target_groups") {
+		return nil, errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	targetGroups := []api.NetworkTargetGroup{}
+	_, err := r.queryStruct("GET", "/network-target-groups?recursion=1&all-projects=true", nil, "", &targetGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return targetGroups, nil
+}
+
+// GetNetworkTargetGroup returns a network target group entry for the provided name.
+func (r *ProtocolIncus) GetNetworkTargetGroup(name string) (*api.NetworkTargetGroup, string, error) {
+	if !r.HasExtension("network_target_groups") {
+		return nil, "", errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	targetGroup := api.NetworkTargetGroup{}
+
+	// Fetch the raw value.
+	etag, err := r.queryStruct("GET", fmt.Sprintf("/network-target-groups/%s", url.PathEscape(name)), nil, "", &targetGroup)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &targetGroup, etag, nil
+}
+
+// CreateNetworkTargetGroup defines a new network target group using the provided struct.
+func (r *ProtocolIncus) CreateNetworkTargetGroup(group api.NetworkTargetGroupsPost) error {
+	if !r.HasExtension("network_target_groups") {
+		return errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", "/network-target-groups", group, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateNetworkTargetGroup updates the network target group to match the provided struct.
+func (r *ProtocolIncus) UpdateNetworkTargetGroup(name string, group api.NetworkTargetGroupPut, ETag string) error {
+	if !r.HasExtension("network_target_groups") {
+		return errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("PUT", fmt.Sprintf("/network-target-groups/%s", url.PathEscape(name)), group, ETag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RenameNetworkTargetGroup renames an existing network target group entry.
+func (r *ProtocolIncus) RenameNetworkTargetGroup(name string, group api.NetworkTargetGroupPost) error {
+	if !r.HasExtension("network_target_groups") {
+		return errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("POST", fmt.Sprintf("/network-target-groups/%s", url.PathEscape(name)), group, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkTargetGroup deletes an existing network target group.
+func (r *ProtocolIncus) DeleteNetworkTargetGroup(name string) error {
+	if !r.HasExtension("network_target_groups") {
+		return errors.New(`The server is missing the required "network_target_groups" API extension`)
+	}
+
+	// Send the request.
+	_, _, err := r.query("DELETE", fmt.Sprintf("/network-target-groups/%s", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -134,6 +134,23 @@ func (r *ProtocolIncus) GetNetworkLeases(name string) ([]api.NetworkLease, error
 	return leases, nil
 }
 
+// GetNetworkLeasesAllProjects returns the leases of a network across all the projects using it.
+func (r *ProtocolIncus) GetNetworkLeasesAllProjects(name string) ([]api.NetworkLease, error) {
+	if !r.HasExtension("network_leases_all_projects") {
+		return nil, errors.New(`The server is missing the required "network_leases_all_projects" API extension`)
+	}
+
+	leases := []api.NetworkLease{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/leases?all-projects=true", url.PathEscape(name)), nil, "", &leases)
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
 // GetNetworkState returns metrics and information on the running network.
 func (r *ProtocolIncus) GetNetworkState(name string) (*api.NetworkState, error) {
 	if !r.HasExtension("network_state") {
@@ -151,6 +168,74 @@ func (r *ProtocolIncus) GetNetworkState(name string) (*api.NetworkState, error)
 	return &state, nil
 }
 
+// GetNetworkHealth returns the result of the health checks of a network.
+func (r *ProtocolIncus) GetNetworkHealth(name string) (*api.NetworkHealth, error) {
+	if !r.HasExtension("network_health") {
+		return nil, errors.New("The server is missing the required \"network_health\" API extension")
+	}
+
+	health := api.NetworkHealth{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/health", url.PathEscape(name)), nil, "", &health)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health, nil
+}
+
+// GetNetworkOVN returns the OVN northbound database objects backing a network.
+func (r *ProtocolIncus) GetNetworkOVN(name string) (*api.NetworkOVN, error) {
+	if !r.HasExtension("network_ovn_objects") {
+		return nil, errors.New("The server is missing the required \"network_ovn_objects\" API extension")
+	}
+
+	objects := api.NetworkOVN{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/ovn", url.PathEscape(name)), nil, "", &objects)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objects, nil
+}
+
+// GetNetworkRoutes returns the static routes and routing policies of a network's router along with their source.
+func (r *ProtocolIncus) GetNetworkRoutes(name string) (*api.NetworkRoutes, error) {
+	if !r.HasExtension("network_router_routes") {
+		return nil, errors.New("The server is missing the required \"network_router_routes\" API extension")
+	}
+
+	routes := api.NetworkRoutes{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/routes", url.PathEscape(name)), nil, "", &routes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &routes, nil
+}
+
+// GetNetworkTraffic returns the traffic accumulated by a network.
+func (r *ProtocolIncus) GetNetworkTraffic(name string) (*api.NetworkTraffic, error) {
+	if !r.HasExtension("network_traffic_accounting") {
+		return nil, errors.New("The server is missing the required \"network_traffic_accounting\" API extension")
+	}
+
+	traffic := api.NetworkTraffic{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/traffic", url.PathEscape(name)), nil, "", &traffic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &traffic, nil
+}
+
 // CreateNetwork defines a new network using the provided Network struct.
 func (r *ProtocolIncus) CreateNetwork(network api.NetworksPost) error {
 	if !r.HasExtension("network") {
@@ -181,6 +266,22 @@ func (r *ProtocolIncus) UpdateNetwork(name string, network api.NetworkPut, ETag
 	return nil
 }
 
+// UpdateNetworkRemapTargets updates the network to match the provided Network struct, moving the target
+// addresses of its forwards and load balancers along with any subnet change.
+func (r *ProtocolIncus) UpdateNetworkRemapTargets(name string, network api.NetworkPut, ETag string) error {
+	if !r.HasExtension("network_update_remap_targets") {
+		return errors.New("The server is missing the required \"network_update_remap_targets\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("PUT", fmt.Sprintf("/networks/%s?remap-targets=true", url.PathEscape(name)), network, ETag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RenameNetwork renames an existing network entry.
 func (r *ProtocolIncus) RenameNetwork(name string, network api.NetworkPost) error {
 	if !r.HasExtension("network") {
@@ -196,6 +297,21 @@ func (r *ProtocolIncus) RenameNetwork(name string, network api.NetworkPost) erro
 	return nil
 }
 
+// DetachNetworkUplink turns a network into an isolated network by detaching it from its uplink.
+func (r *ProtocolIncus) DetachNetworkUplink(name string) error {
+	if !r.HasExtension("network_detach_uplink") {
+		return errors.New("The server is missing the required \"network_detach_uplink\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/detach-uplink", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteNetwork deletes an existing network.
 func (r *ProtocolIncus) DeleteNetwork(name string) error {
 	if !r.HasExtension("network") {
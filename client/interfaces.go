@@ -155,6 +155,7 @@ type InstanceServer interface {
 
 	GetInstanceState(name string) (state *api.InstanceState, ETag string, err error)
 	UpdateInstanceState(name string, state api.InstanceStatePut, ETag string) (op Operation, err error)
+	GetInstanceInterfaceOVN(name string, deviceName string) (diff *api.InstanceInterfaceOVN, err error)
 
 	GetInstanceAccess(name string) (access api.Access, err error)
 
@@ -191,6 +192,7 @@ type InstanceServer interface {
 
 	// Configuration metadata functions
 	GetMetadataConfiguration() (meta *api.MetadataConfiguration, err error)
+	GetMetadataNetworkConfiguration(networkType string) (meta *api.MetadataNetworkConfiguration, err error)
 
 	// Network functions ("network" API extension)
 	GetNetworkNames() (names []string, err error)
@@ -200,10 +202,17 @@ type InstanceServer interface {
 	GetNetworksAllProjectsWithFilter(filters []string) (networks []api.Network, err error)
 	GetNetwork(name string) (network *api.Network, ETag string, err error)
 	GetNetworkLeases(name string) (leases []api.NetworkLease, err error)
+	GetNetworkLeasesAllProjects(name string) (leases []api.NetworkLease, err error)
 	GetNetworkState(name string) (state *api.NetworkState, err error)
+	GetNetworkHealth(name string) (health *api.NetworkHealth, err error)
+	GetNetworkOVN(name string) (objects *api.NetworkOVN, err error)
+	GetNetworkRoutes(name string) (routes *api.NetworkRoutes, err error)
+	GetNetworkTraffic(name string) (traffic *api.NetworkTraffic, err error)
 	CreateNetwork(network api.NetworksPost) (err error)
 	UpdateNetwork(name string, network api.NetworkPut, ETag string) (err error)
+	UpdateNetworkRemapTargets(name string, network api.NetworkPut, ETag string) (err error)
 	RenameNetwork(name string, network api.NetworkPost) (err error)
+	DetachNetworkUplink(name string) (err error)
 	DeleteNetwork(name string) (err error)
 
 	// Network forward functions ("network_forward" API extension)
@@ -212,6 +221,8 @@ type InstanceServer interface {
 	GetNetworkForward(networkName string, listenAddress string) (forward *api.NetworkForward, ETag string, err error)
 	CreateNetworkForward(networkName string, forward api.NetworkForwardsPost) error
 	UpdateNetworkForward(networkName string, listenAddress string, forward api.NetworkForwardPut, ETag string) (err error)
+	PatchNetworkForward(networkName string, listenAddress string, forward api.NetworkForwardPatch, ETag string) (err error)
+	RenameNetworkForward(networkName string, listenAddress string, forward api.NetworkForwardPost) (err error)
 	DeleteNetworkForward(networkName string, listenAddress string) (err error)
 
 	// Network load balancer functions ("network_load_balancer" API extension)
@@ -220,6 +231,7 @@ type InstanceServer interface {
 	GetNetworkLoadBalancer(networkName string, listenAddress string) (forward *api.NetworkLoadBalancer, ETag string, err error)
 	CreateNetworkLoadBalancer(networkName string, forward api.NetworkLoadBalancersPost) error
 	UpdateNetworkLoadBalancer(networkName string, listenAddress string, forward api.NetworkLoadBalancerPut, ETag string) (err error)
+	RenameNetworkLoadBalancer(networkName string, listenAddress string, loadBalancer api.NetworkLoadBalancerPost) (err error)
 	DeleteNetworkLoadBalancer(networkName string, listenAddress string) (err error)
 	GetNetworkLoadBalancerState(networkName string, listenAddress string) (lbState *api.NetworkLoadBalancerState, err error)
 
@@ -230,6 +242,25 @@ type InstanceServer interface {
 	CreateNetworkPeer(networkName string, peer api.NetworkPeersPost) error
 	UpdateNetworkPeer(networkName string, peerName string, peer api.NetworkPeerPut, ETag string) (err error)
 	DeleteNetworkPeer(networkName string, peerName string) (err error)
+	UpdateNetworkPeerState(networkName string, peerName string, state api.NetworkPeerStatePost) (err error)
+	GetNetworkPeerRoutes(networkName string, peerName string) (routes *api.NetworkPeerRoutes, err error)
+
+	// Network external port functions ("network_external_ports" API extension)
+	GetNetworkExternalPortNames(networkName string) ([]string, error)
+	GetNetworkExternalPorts(networkName string) ([]api.NetworkExternalPort, error)
+	GetNetworkExternalPort(networkName string, portName string) (port *api.NetworkExternalPort, ETag string, err error)
+	CreateNetworkExternalPort(networkName string, port api.NetworkExternalPortsPost) error
+	UpdateNetworkExternalPort(networkName string, portName string, port api.NetworkExternalPortPut, ETag string) (err error)
+	DeleteNetworkExternalPort(networkName string, portName string) (err error)
+
+	// Network restore point functions ("network_restore_points" API extension)
+	GetNetworkRestorePointNames(networkName string) ([]string, error)
+	GetNetworkRestorePoints(networkName string) ([]api.NetworkRestorePoint, error)
+	GetNetworkRestorePoint(networkName string, restorePointName string) (restorePoint *api.NetworkRestorePoint, err error)
+	GetNetworkRestorePointDiff(networkName string, restorePointName string) (changes []api.NetworkRestorePointChange, err error)
+	CreateNetworkRestorePoint(networkName string, restorePoint api.NetworkRestorePointsPost) (err error)
+	RestoreNetworkRestorePoint(networkName string, restorePointName string) (err error)
+	DeleteNetworkRestorePoint(networkName string, restorePointName string) (err error)
 
 	// Network ACL functions ("network_acl" API extension)
 	GetNetworkACLNames() (names []string, err error)
@@ -252,6 +283,16 @@ type InstanceServer interface {
 	RenameNetworkAddressSet(name string, AddressSet api.NetworkAddressSetPost) (err error)
 	DeleteNetworkAddressSet(name string) (err error)
 
+	// Network target group functions ("network_target_groups" API extension)
+	GetNetworkTargetGroupNames() (names []string, err error)
+	GetNetworkTargetGroups() (TargetGroups []api.NetworkTargetGroup, err error)
+	GetNetworkTargetGroupsAllProjects() (TargetGroups []api.NetworkTargetGroup, err error)
+	GetNetworkTargetGroup(name string) (TargetGroup *api.NetworkTargetGroup, ETag string, err error)
+	CreateNetworkTargetGroup(TargetGroup api.NetworkTargetGroupsPost) (err error)
+	UpdateNetworkTargetGroup(name string, TargetGroup api.NetworkTargetGroupPut, ETag string) (err error)
+	RenameNetworkTargetGroup(name string, TargetGroup api.NetworkTargetGroupPost) (err error)
+	DeleteNetworkTargetGroup(name string) (err error)
+
 	// Network allocations functions ("network_allocations" API extension)
 	GetNetworkAllocations() (allocations []api.NetworkAllocations, err error)
 	GetNetworkAllocationsAllProjects() (allocations []api.NetworkAllocations, err error)
@@ -276,6 +317,7 @@ type InstanceServer interface {
 	GetNetworkIntegrationNames() (names []string, err error)
 	GetNetworkIntegrations() (integrations []api.NetworkIntegration, err error)
 	GetNetworkIntegration(name string) (integration *api.NetworkIntegration, ETag string, err error)
+	GetNetworkIntegrationState(name string) (state *api.NetworkIntegrationState, err error)
 	CreateNetworkIntegration(integration api.NetworkIntegrationsPost) (err error)
 	UpdateNetworkIntegration(name string, integration api.NetworkIntegrationPut, ETag string) (err error)
 	RenameNetworkIntegration(name string, integration api.NetworkIntegrationPost) (err error)
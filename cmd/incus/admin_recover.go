@@ -213,6 +213,13 @@ func (c *cmdAdminRecover) Run(_ *cobra.Command, args []string) error {
 			}
 		}
 
+		if len(res.UnknownNetworks) > 0 {
+			fmt.Println(i18n.G("The following unknown networks have been found in OVN:"))
+			for _, unknownNet := range res.UnknownNetworks {
+				fmt.Printf(" - "+i18n.G("Network %q of type %q in project %q (includes %d forwards and %d load balancers)")+"\n", unknownNet.Name, unknownNet.Type, unknownNet.Project, unknownNet.Forwards, unknownNet.LoadBalancers)
+			}
+		}
+
 		if len(res.DependencyErrors) == 0 {
 			if len(unknownPools) == 0 && len(res.UnknownVolumes) == 0 {
 				fmt.Println(i18n.G("No unknown storage pools or volumes found. Nothing to do."))
@@ -7,6 +7,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
@@ -781,6 +782,54 @@ func (c *cmdInfo) instanceInfo(d incus.InstanceServer, name string, showLog bool
 						networkInfo += fmt.Sprintf("        %s: %s/%s (%s)\n", addr.Family, addr.Address, addr.Netmask, addr.Scope)
 					}
 				}
+
+				ovnState := network[netName].OVN
+				if ovnState != nil {
+					networkInfo += fmt.Sprintf("      %s:\n", i18n.G("OVN"))
+
+					if d.HasExtension("instance_state_network_ovn_binding") {
+						portState := i18n.G("down")
+						if ovnState.PortUp {
+							portState = i18n.G("up")
+						}
+
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("Port state"), portState)
+					}
+
+					if ovnState.ChassisHostname != "" {
+						networkInfo += fmt.Sprintf("        %s: %s (%s)\n", i18n.G("Chassis"), ovnState.ChassisHostname, ovnState.Chassis)
+					} else if ovnState.Chassis != "" {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("Chassis"), ovnState.Chassis)
+					}
+
+					if len(ovnState.PortSecurity) > 0 {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("Port security"), strings.Join(ovnState.PortSecurity, ", "))
+					}
+
+					if ovnState.DHCPv4OptionsUUID != "" {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("DHCPv4 options"), ovnState.DHCPv4OptionsUUID)
+					}
+
+					if ovnState.DHCPv6OptionsUUID != "" {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("DHCPv6 options"), ovnState.DHCPv6OptionsUUID)
+					}
+
+					if ovnState.LeaseTime != 0 {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("Lease time"), time.Duration(ovnState.LeaseTime)*time.Second)
+					}
+
+					if ovnState.MTU != 0 {
+						networkInfo += fmt.Sprintf("        %s: %d\n", i18n.G("Advertised MTU"), ovnState.MTU)
+					}
+
+					if len(ovnState.DNSServers) > 0 {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("DNS servers"), strings.Join(ovnState.DNSServers, ", "))
+					}
+
+					if len(ovnState.DNSSearch) > 0 {
+						networkInfo += fmt.Sprintf("        %s: %s\n", i18n.G("DNS search"), strings.Join(ovnState.DNSSearch, ", "))
+					}
+				}
 			}
 		}
 
@@ -65,6 +65,10 @@ func (c *cmdNetwork) Command() *cobra.Command {
 	networkDetachProfileCmd := cmdNetworkDetachProfile{global: c.global, network: c}
 	cmd.AddCommand(networkDetachProfileCmd.Command())
 
+	// Detach uplink
+	networkDetachUplinkCmd := cmdNetworkDetachUplink{global: c.global, network: c}
+	cmd.AddCommand(networkDetachUplinkCmd.Command())
+
 	// Edit
 	networkEditCmd := cmdNetworkEdit{global: c.global, network: c}
 	cmd.AddCommand(networkEditCmd.Command())
@@ -113,6 +117,10 @@ func (c *cmdNetwork) Command() *cobra.Command {
 	networkAddressSetCmd := cmdNetworkAddressSet{global: c.global}
 	cmd.AddCommand(networkAddressSetCmd.Command())
 
+	// External port
+	networkExternalPortCmd := cmdNetworkExternalPort{global: c.global}
+	cmd.AddCommand(networkExternalPortCmd.Command())
+
 	// Forward
 	networkForwardCmd := cmdNetworkForward{global: c.global}
 	cmd.AddCommand(networkForwardCmd.Command())
@@ -129,6 +137,14 @@ func (c *cmdNetwork) Command() *cobra.Command {
 	networkPeerCmd := cmdNetworkPeer{global: c.global}
 	cmd.AddCommand(networkPeerCmd.Command())
 
+	// Restore point
+	networkRestorePointCmd := cmdNetworkRestorePoint{global: c.global}
+	cmd.AddCommand(networkRestorePointCmd.Command())
+
+	// Target group
+	networkTargetGroupCmd := cmdNetworkTargetGroup{global: c.global}
+	cmd.AddCommand(networkTargetGroupCmd.Command())
+
 	// Zone
 	networkZoneCmd := cmdNetworkZone{global: c.global}
 	cmd.AddCommand(networkZoneCmd.Command())
@@ -716,6 +732,69 @@ func (c *cmdNetworkDetachProfile) Run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Detach uplink.
+type cmdNetworkDetachUplink struct {
+	global  *cmdGlobal
+	network *cmdNetwork
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkDetachUplink) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("detach-uplink", i18n.G("[<remote>:]<network>"))
+	cmd.Short = i18n.G("Detach networks from their uplink")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(
+		`Detach networks from their uplink
+
+The network becomes isolated while the instances using it keep running.
+Forwards and load balancers using external listen addresses are disabled.`))
+
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return c.global.cmpNetworks(toComplete)
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkDetachUplink) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	// Parse remote
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	// Detach the uplink
+	err = resource.server.DetachNetworkUplink(resource.name)
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network %s detached from its uplink")+"\n", resource.name)
+	}
+
+	return nil
+}
+
 // Edit.
 type cmdNetworkEdit struct {
 	global  *cmdGlobal
@@ -941,6 +1020,9 @@ func (c *cmdNetworkGet) Run(cmd *cobra.Command, args []string) error {
 type cmdNetworkInfo struct {
 	global  *cmdGlobal
 	network *cmdNetwork
+
+	flagOVN    bool
+	flagRoutes bool
 }
 
 // Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
@@ -952,6 +1034,8 @@ func (c *cmdNetworkInfo) Command() *cobra.Command {
 		`Get runtime information on networks`))
 
 	cmd.Flags().StringVar(&c.network.flagTarget, "target", "", i18n.G("Cluster member name")+"``")
+	cmd.Flags().BoolVar(&c.flagOVN, "ovn", false, i18n.G("Show the OVN objects backing the network"))
+	cmd.Flags().BoolVar(&c.flagRoutes, "routes", false, i18n.G("Show the routes and routing policies of the network's router"))
 	cmd.RunE = c.Run
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -995,6 +1079,40 @@ func (c *cmdNetworkInfo) Run(cmd *cobra.Command, args []string) error {
 		client = client.UseTarget(c.network.flagTarget)
 	}
 
+	// Dump the OVN objects.
+	if c.flagOVN {
+		objects, err := client.GetNetworkOVN(resource.name)
+		if err != nil {
+			return err
+		}
+
+		data, err := yaml.Marshal(objects)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s", data)
+
+		return nil
+	}
+
+	// Dump the routes.
+	if c.flagRoutes {
+		routes, err := client.GetNetworkRoutes(resource.name)
+		if err != nil {
+			return err
+		}
+
+		data, err := yaml.Marshal(routes)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s", data)
+
+		return nil
+	}
+
 	state, err := client.GetNetworkState(resource.name)
 	if err != nil {
 		return err
@@ -1080,6 +1198,10 @@ func (c *cmdNetworkInfo) Run(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  %s: %s\n", i18n.G("Logical switch"), state.OVN.LogicalSwitch)
 		}
 
+		if state.OVN.LogicalSwitchTunnelKey != 0 {
+			fmt.Printf("  %s: %d\n", i18n.G("Tunnel key"), state.OVN.LogicalSwitchTunnelKey)
+		}
+
 		if state.OVN.UplinkIPv4 != "" {
 			fmt.Printf("  %s: %s\n", i18n.G("IPv4 uplink address"), state.OVN.UplinkIPv4)
 		}
@@ -1087,6 +1209,123 @@ func (c *cmdNetworkInfo) Run(cmd *cobra.Command, args []string) error {
 		if state.OVN.UplinkIPv6 != "" {
 			fmt.Printf("  %s: %s\n", i18n.G("IPv6 uplink address"), state.OVN.UplinkIPv6)
 		}
+
+		if len(state.OVN.ChassisHealth) > 0 {
+			fmt.Printf("  %s: %d/%d/%d\n", i18n.G("Sequence (NB/SB/HV)"), state.OVN.NbCfg, state.OVN.SbCfg, state.OVN.HvCfg)
+			fmt.Printf("  %s:\n", i18n.G("Chassis health"))
+
+			for _, chassis := range state.OVN.ChassisHealth {
+				fmt.Printf("    %s: %s=%d, %s=%ds\n", chassis.Hostname, i18n.G("lag"), chassis.Lag, i18n.G("age"), chassis.HeartbeatAge)
+			}
+		}
+
+		if len(state.OVN.ChassisPriorities) > 0 {
+			fmt.Printf("  %s:\n", i18n.G("Chassis priorities"))
+
+			for _, chassis := range state.OVN.ChassisPriorities {
+				name := chassis.Hostname
+				if name == "" {
+					name = chassis.Name
+				}
+
+				fmt.Printf("    %s: %d\n", name, chassis.Priority)
+			}
+		}
+
+		if len(state.OVN.FailoverAddresses) > 0 {
+			fmt.Printf("  %s:\n", i18n.G("Failover addresses"))
+
+			for _, failover := range state.OVN.FailoverAddresses {
+				instanceName := failover.Instance
+				if instanceName == "" {
+					instanceName = i18n.G("none")
+				}
+
+				fmt.Printf("    %s (%s): %s\n", failover.Name, failover.Address, instanceName)
+			}
+		}
+
+		if state.OVN.NorthboundEndpoint != "" {
+			fmt.Printf("  %s: %s\n", i18n.G("Northbound database"), state.OVN.NorthboundEndpoint)
+		}
+
+		if state.OVN.SouthboundEndpoint != "" {
+			fmt.Printf("  %s: %s\n", i18n.G("Southbound database"), state.OVN.SouthboundEndpoint)
+		}
+
+		if len(state.OVN.ExternalInterfaces) > 0 {
+			fmt.Printf("  %s:\n", i18n.G("External interfaces"))
+
+			for _, iface := range state.OVN.ExternalInterfaces {
+				status := i18n.G("attached")
+				if !iface.Attached {
+					status = iface.Error
+				}
+
+				fmt.Printf("    %s: %s\n", iface.Name, status)
+			}
+		}
+
+		if len(state.OVN.DNSConflicts) > 0 {
+			fmt.Printf("  %s:\n", i18n.G("DNS conflicts"))
+
+			for _, conflict := range state.OVN.DNSConflicts {
+				fmt.Printf("    %s: %s\n", conflict.Name, strings.Join(conflict.UsedBy, ", "))
+			}
+		}
+
+		if client.HasExtension("network_health") {
+			health, err := client.GetNetworkHealth(resource.name)
+			if err != nil {
+				health = &api.NetworkHealth{}
+			}
+
+			healthStatus := i18n.G("healthy")
+			if err != nil {
+				healthStatus = fmt.Sprintf(i18n.G("unknown (%v)"), err)
+			} else if !health.Healthy {
+				healthStatus = i18n.G("unhealthy")
+			}
+
+			fmt.Println("")
+			fmt.Printf(i18n.G("Health: %s")+"\n", healthStatus)
+
+			for _, check := range health.Checks {
+				if check.Message != "" {
+					fmt.Printf("  %s: %s (%s)\n", check.Name, check.Status, check.Message)
+				} else {
+					fmt.Printf("  %s: %s\n", check.Name, check.Status)
+				}
+			}
+		}
+
+		if client.HasExtension("network_traffic_accounting") {
+			traffic, err := client.GetNetworkTraffic(resource.name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("")
+			fmt.Println(i18n.G("Accounted traffic:"))
+
+			for _, entry := range []struct {
+				name     string
+				counters api.NetworkTrafficCounters
+			}{
+				{name: i18n.G("Ports"), counters: traffic.Ports},
+				{name: i18n.G("Uplink"), counters: traffic.Uplink},
+			} {
+				fmt.Printf("  %s:\n", entry.name)
+				fmt.Printf("    %s: %s\n", i18n.G("Bytes received"), units.GetByteSizeString(entry.counters.IngressBytes, 2))
+				fmt.Printf("    %s: %s\n", i18n.G("Bytes sent"), units.GetByteSizeString(entry.counters.EgressBytes, 2))
+				fmt.Printf("    %s: %d\n", i18n.G("Packets received"), entry.counters.IngressPackets)
+				fmt.Printf("    %s: %d\n", i18n.G("Packets sent"), entry.counters.EgressPackets)
+			}
+
+			if !traffic.UpdatedAt.IsZero() {
+				fmt.Printf("  %s: %s\n", i18n.G("Last updated"), traffic.UpdatedAt.Local().Format(dateLayout))
+			}
+		}
 	}
 
 	return nil
@@ -1314,8 +1553,9 @@ type cmdNetworkListLeases struct {
 	global  *cmdGlobal
 	network *cmdNetwork
 
-	flagFormat  string
-	flagColumns string
+	flagFormat      string
+	flagColumns     string
+	flagAllProjects bool
 }
 
 type networkLeasesColumn struct {
@@ -1344,13 +1584,17 @@ or (extended) config keys.
 Commas between consecutive shorthand chars are optional.
 
 Pre-defined column shorthand chars:
+  p - Project name
   h - Hostname
   m - MAC Address
   i - IP Address
   t - Type
+  s - State (bound or configured)
+  e - Expiry
   L - Location of the DHCP Lease (e.g. its cluster member)`))
 	cmd.Flags().StringVarP(&c.flagFormat, "format", "f", c.global.defaultListFormat(), i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`)+"``")
 	cmd.Flags().StringVarP(&c.flagColumns, "columns", "c", defaultNetworkListLeasesColumns, i18n.G("Columns")+"``")
+	cmd.Flags().BoolVar(&c.flagAllProjects, "all-projects", false, i18n.G("List leases from all projects using the network"))
 
 	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
@@ -1373,15 +1617,22 @@ const defaultNetworkListLeasesColumns = "hmit"
 
 func (c *cmdNetworkListLeases) parseColumns(clustered bool) ([]networkLeasesColumn, error) {
 	columnsShorthandMap := map[rune]networkLeasesColumn{
+		'p': {i18n.G("PROJECT"), c.projectColumnData},
 		'h': {i18n.G("HOSTNAME"), c.hostnameColumnData},
 		'm': {i18n.G("MAC ADDRESS"), c.macAddressColumnData},
 		'i': {i18n.G("IP ADDRESS"), c.ipAddressColumnData},
 		't': {i18n.G("TYPE"), c.typeColumnData},
+		's': {i18n.G("STATE"), c.stateColumnData},
+		'e': {i18n.G("EXPIRES AT"), c.expiresAtColumnData},
 		'L': {i18n.G("LOCATION"), c.locationColumnData},
 	}
 
 	columnList := strings.Split(c.flagColumns, ",")
 	columns := []networkLeasesColumn{}
+	if c.flagColumns == defaultNetworkListLeasesColumns && c.flagAllProjects {
+		columnList = []string{"p" + defaultNetworkListLeasesColumns}
+	}
+
 	if c.flagColumns == defaultNetworkListLeasesColumns && clustered {
 		columnList = append(columnList, "L")
 	}
@@ -1404,6 +1655,10 @@ func (c *cmdNetworkListLeases) parseColumns(clustered bool) ([]networkLeasesColu
 	return columns, nil
 }
 
+func (c *cmdNetworkListLeases) projectColumnData(lease api.NetworkLease) string {
+	return lease.Project
+}
+
 func (c *cmdNetworkListLeases) hostnameColumnData(lease api.NetworkLease) string {
 	return lease.Hostname
 }
@@ -1420,6 +1675,18 @@ func (c *cmdNetworkListLeases) typeColumnData(lease api.NetworkLease) string {
 	return strings.ToUpper(lease.Type)
 }
 
+func (c *cmdNetworkListLeases) stateColumnData(lease api.NetworkLease) string {
+	return strings.ToUpper(lease.State)
+}
+
+func (c *cmdNetworkListLeases) expiresAtColumnData(lease api.NetworkLease) string {
+	if lease.ExpiresAt == nil {
+		return ""
+	}
+
+	return lease.ExpiresAt.Local().Format(dateLayout)
+}
+
 func (c *cmdNetworkListLeases) locationColumnData(lease api.NetworkLease) string {
 	return lease.Location
 }
@@ -1445,7 +1712,13 @@ func (c *cmdNetworkListLeases) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	// List DHCP leases
-	leases, err := resource.server.GetNetworkLeases(resource.name)
+	var leases []api.NetworkLease
+	if c.flagAllProjects {
+		leases, err = resource.server.GetNetworkLeasesAllProjects(resource.name)
+	} else {
+		leases, err = resource.server.GetNetworkLeases(resource.name)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -1542,7 +1815,8 @@ type cmdNetworkSet struct {
 	global  *cmdGlobal
 	network *cmdNetwork
 
-	flagIsProperty bool
+	flagIsProperty   bool
+	flagRemapTargets bool
 }
 
 // Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
@@ -1558,6 +1832,7 @@ For backward compatibility, a single configuration key may still be set with:
 
 	cmd.Flags().StringVar(&c.network.flagTarget, "target", "", i18n.G("Cluster member name")+"``")
 	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Set the key as a network property"))
+	cmd.Flags().BoolVar(&c.flagRemapTargets, "remap-targets", false, i18n.G("Move forward and load balancer target addresses along with the network subnets"))
 	cmd.RunE = c.Run
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1632,6 +1907,10 @@ func (c *cmdNetworkSet) Run(cmd *cobra.Command, args []string) error {
 		maps.Copy(writable.Config, keys)
 	}
 
+	if c.flagRemapTargets {
+		return client.UpdateNetworkRemapTargets(resource.name, writable, etag)
+	}
+
 	return client.UpdateNetwork(resource.name, writable, etag)
 }
 
@@ -0,0 +1,865 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	cli "github.com/lxc/incus/v6/internal/cmd"
+	"github.com/lxc/incus/v6/internal/i18n"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/termios"
+)
+
+type cmdNetworkExternalPort struct {
+	global *cmdGlobal
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPort) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("external-port")
+	cmd.Short = i18n.G("Manage network external ports")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Manage network external ports"))
+
+	// List.
+	networkExternalPortListCmd := cmdNetworkExternalPortList{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortListCmd.Command())
+
+	// Show.
+	networkExternalPortShowCmd := cmdNetworkExternalPortShow{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortShowCmd.Command())
+
+	// Create.
+	networkExternalPortCreateCmd := cmdNetworkExternalPortCreate{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortCreateCmd.Command())
+
+	// Get.
+	networkExternalPortGetCmd := cmdNetworkExternalPortGet{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortGetCmd.Command())
+
+	// Set.
+	networkExternalPortSetCmd := cmdNetworkExternalPortSet{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortSetCmd.Command())
+
+	// Unset.
+	networkExternalPortUnsetCmd := cmdNetworkExternalPortUnset{global: c.global, networkExternalPort: c, networkExternalPortSet: &networkExternalPortSetCmd}
+	cmd.AddCommand(networkExternalPortUnsetCmd.Command())
+
+	// Edit.
+	networkExternalPortEditCmd := cmdNetworkExternalPortEdit{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortEditCmd.Command())
+
+	// Delete.
+	networkExternalPortDeleteCmd := cmdNetworkExternalPortDelete{global: c.global, networkExternalPort: c}
+	cmd.AddCommand(networkExternalPortDeleteCmd.Command())
+
+	// Workaround for subcommand usage errors. See: https://github.com/spf13/cobra/issues/706
+	cmd.Args = cobra.NoArgs
+	cmd.Run = func(cmd *cobra.Command, _ []string) { _ = cmd.Usage() }
+	return cmd
+}
+
+// List.
+type cmdNetworkExternalPortList struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+
+	flagFormat  string
+	flagColumns string
+}
+
+type networkExternalPortColumn struct {
+	Name string
+	Data func(api.NetworkExternalPort) string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortList) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("list", i18n.G("[<remote>:]<network>"))
+	cmd.Aliases = []string{"ls"}
+	cmd.Short = i18n.G("List available network external ports")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(
+		`List available network external ports
+
+Default column layout: ndihL
+
+== Columns ==
+The -c option takes a comma separated list of arguments that control
+which network external port attributes to output when displaying in table
+or csv format.
+
+Commas between consecutive shorthand chars are optional.
+
+Pre-defined column shorthand chars:
+  n - Name
+  d - Description
+  i - Interface
+  h - MAC address
+  4 - IPv4 address
+  6 - IPv6 address
+  L - Location of the external port (e.g. its cluster member)`))
+
+	cmd.RunE = c.Run
+	cmd.Flags().StringVarP(&c.flagFormat, "format", "f", c.global.defaultListFormat(), i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`)+"``")
+	cmd.Flags().StringVarP(&c.flagColumns, "columns", "c", defaultNetworkExternalPortListColumns, i18n.G("Columns")+"``")
+
+	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
+	}
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+const defaultNetworkExternalPortListColumns = "ndihL"
+
+func (c *cmdNetworkExternalPortList) parseColumns() ([]networkExternalPortColumn, error) {
+	columnsShorthandMap := map[rune]networkExternalPortColumn{
+		'n': {i18n.G("NAME"), c.nameColumnData},
+		'd': {i18n.G("DESCRIPTION"), c.descriptionColumnData},
+		'i': {i18n.G("INTERFACE"), c.configColumnData("interface")},
+		'h': {i18n.G("MAC ADDRESS"), c.configColumnData("hwaddr")},
+		'4': {i18n.G("IPV4"), c.configColumnData("ipv4.address")},
+		'6': {i18n.G("IPV6"), c.configColumnData("ipv6.address")},
+		'L': {i18n.G("LOCATION"), c.locationColumnData},
+	}
+
+	columnList := strings.Split(c.flagColumns, ",")
+	columns := []networkExternalPortColumn{}
+
+	for _, columnEntry := range columnList {
+		if columnEntry == "" {
+			return nil, fmt.Errorf(i18n.G("Empty column entry (redundant, leading or trailing command) in '%s'"), c.flagColumns)
+		}
+
+		for _, columnRune := range columnEntry {
+			column, ok := columnsShorthandMap[columnRune]
+			if !ok {
+				return nil, fmt.Errorf(i18n.G("Unknown column shorthand char '%c' in '%s'"), columnRune, columnEntry)
+			}
+
+			columns = append(columns, column)
+		}
+	}
+
+	return columns, nil
+}
+
+func (c *cmdNetworkExternalPortList) nameColumnData(port api.NetworkExternalPort) string {
+	return port.Name
+}
+
+func (c *cmdNetworkExternalPortList) descriptionColumnData(port api.NetworkExternalPort) string {
+	return port.Description
+}
+
+func (c *cmdNetworkExternalPortList) configColumnData(key string) func(api.NetworkExternalPort) string {
+	return func(port api.NetworkExternalPort) string {
+		return port.Config[key]
+	}
+}
+
+func (c *cmdNetworkExternalPortList) locationColumnData(port api.NetworkExternalPort) string {
+	return port.Location
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortList) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	ports, err := resource.server.GetNetworkExternalPorts(resource.name)
+	if err != nil {
+		return err
+	}
+
+	// Parse column flags.
+	columns, err := c.parseColumns()
+	if err != nil {
+		return err
+	}
+
+	data := [][]string{}
+	for _, port := range ports {
+		line := []string{}
+		for _, column := range columns {
+			line = append(line, column.Data(port))
+		}
+
+		data = append(data, line)
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(data))
+
+	header := []string{}
+	for _, column := range columns {
+		header = append(header, column.Name)
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, ports)
+}
+
+// Show.
+type cmdNetworkExternalPortShow struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortShow) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("show", i18n.G("[<remote>:]<network> <port_name>"))
+	cmd.Short = i18n.G("Show network external port configurations")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Show network external port configurations"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortShow) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	client := resource.server
+
+	// Show the network external port config.
+	port, _, err := client.GetNetworkExternalPort(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(&port)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s", data)
+
+	return nil
+}
+
+// Create.
+type cmdNetworkExternalPortCreate struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+
+	flagTarget      string
+	flagDescription string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortCreate) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("create", i18n.G("[<remote>:]<network> <port_name> [key=value...]"))
+	cmd.Aliases = []string{"add"}
+	cmd.Short = i18n.G("Create new network external ports")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Create new network external ports"))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network external-port create ovn0 vrouter01 interface=vhu0 hwaddr=10:66:6a:05:2b:1f --target server01
+    Attach the OVS interface "vhu0" on cluster member "server01" to network "ovn0"
+
+incus network external-port create ovn0 vrouter01 < config.yaml
+    Create a new external port on network "ovn0" using the configuration in the file config.yaml`))
+
+	cmd.RunE = c.Run
+
+	cmd.Flags().StringVar(&c.flagTarget, "target", "", i18n.G("Cluster member name")+"``")
+	cmd.Flags().StringVar(&c.flagDescription, "description", "", i18n.G("External port description")+"``")
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortCreate) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, -1)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	// If stdin isn't a terminal, read yaml from it.
+	var portPut api.NetworkExternalPortPut
+	if !termios.IsTerminal(getStdinFd()) {
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		err = yaml.UnmarshalStrict(contents, &portPut)
+		if err != nil {
+			return err
+		}
+	}
+
+	if portPut.Config == nil {
+		portPut.Config = map[string]string{}
+	}
+
+	// Get config filters from arguments.
+	for i := 2; i < len(args); i++ {
+		entry := strings.SplitN(args[i], "=", 2)
+		if len(entry) < 2 {
+			return fmt.Errorf(i18n.G("Bad key/value pair: %s"), args[i])
+		}
+
+		portPut.Config[entry[0]] = entry[1]
+	}
+
+	// Create the network external port.
+	port := api.NetworkExternalPortsPost{
+		Name:                   args[1],
+		NetworkExternalPortPut: portPut,
+	}
+
+	if c.flagDescription != "" {
+		port.Description = c.flagDescription
+	}
+
+	client := resource.server
+
+	// If a target was specified, create the external port on the given member.
+	if c.flagTarget != "" {
+		client = client.UseTarget(c.flagTarget)
+	}
+
+	err = client.CreateNetworkExternalPort(resource.name, port)
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network external port %s created")+"\n", port.Name)
+	}
+
+	return nil
+}
+
+// Get.
+type cmdNetworkExternalPortGet struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+
+	flagIsProperty bool
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortGet) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("get", i18n.G("[<remote>:]<network> <port_name> <key>"))
+	cmd.Short = i18n.G("Get values for network external port configuration keys")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Get values for network external port configuration keys"))
+	cmd.RunE = c.Run
+
+	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Get the key as a network external port property"))
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		if len(args) == 2 {
+			return c.global.cmpNetworkExternalPortConfigs(args[0], args[1])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortGet) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 3, 3)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	client := resource.server
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	// Get the current config.
+	port, _, err := client.GetNetworkExternalPort(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	if c.flagIsProperty {
+		w := port.Writable()
+		res, err := getFieldByJSONTag(&w, args[2])
+		if err != nil {
+			return fmt.Errorf(i18n.G("The property %q does not exist on the network external port %q: %v"), args[2], args[1], err)
+		}
+
+		fmt.Printf("%v\n", res)
+	} else {
+		for k, v := range port.Config {
+			if k == args[2] {
+				fmt.Printf("%s\n", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Set.
+type cmdNetworkExternalPortSet struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+
+	flagIsProperty bool
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortSet) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("set", i18n.G("[<remote>:]<network> <port_name> <key>=<value>..."))
+	cmd.Short = i18n.G("Set network external port keys")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Set network external port keys"))
+	cmd.RunE = c.Run
+
+	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Set the key as a network external port property"))
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortSet) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 3, -1)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	client := resource.server
+
+	// Get the current config.
+	port, etag, err := client.GetNetworkExternalPort(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	if port.Config == nil {
+		port.Config = map[string]string{}
+	}
+
+	// Set the keys.
+	keys, err := getConfig(args[2:]...)
+	if err != nil {
+		return err
+	}
+
+	writable := port.Writable()
+	if c.flagIsProperty {
+		if cmd.Name() == "unset" {
+			for k := range keys {
+				err := unsetFieldByJSONTag(&writable, k)
+				if err != nil {
+					return fmt.Errorf(i18n.G("Error unsetting property: %v"), err)
+				}
+			}
+		} else {
+			err := unpackKVToWritable(&writable, keys)
+			if err != nil {
+				return fmt.Errorf(i18n.G("Error setting properties: %v"), err)
+			}
+		}
+	} else {
+		maps.Copy(writable.Config, keys)
+	}
+
+	return client.UpdateNetworkExternalPort(resource.name, port.Name, writable, etag)
+}
+
+// Unset.
+type cmdNetworkExternalPortUnset struct {
+	global                 *cmdGlobal
+	networkExternalPort    *cmdNetworkExternalPort
+	networkExternalPortSet *cmdNetworkExternalPortSet
+
+	flagIsProperty bool
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortUnset) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("unset", i18n.G("[<remote>:]<network> <port_name> <key>"))
+	cmd.Short = i18n.G("Unset network external port configuration keys")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Unset network external port configuration keys"))
+	cmd.RunE = c.Run
+
+	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Unset the key as a network external port property"))
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		if len(args) == 2 {
+			return c.global.cmpNetworkExternalPortConfigs(args[0], args[1])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortUnset) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 3, 3)
+	if exit {
+		return err
+	}
+
+	c.networkExternalPortSet.flagIsProperty = c.flagIsProperty
+
+	args = append(args, "")
+	return c.networkExternalPortSet.Run(cmd, args)
+}
+
+// Edit.
+type cmdNetworkExternalPortEdit struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortEdit) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("edit", i18n.G("[<remote>:]<network> <port_name>"))
+	cmd.Short = i18n.G("Edit network external port configurations as YAML")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Edit network external port configurations as YAML"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func (c *cmdNetworkExternalPortEdit) helpTemplate() string {
+	return i18n.G(
+		`### This is a YAML representation of the network external port.
+### Any line starting with a '# will be ignored.
+###
+### An example would look like:
+### description: DPDK vhost-user port for vrouter01
+### config:
+###   interface: vhu0
+###   hwaddr: 10:66:6a:05:2b:1f
+###   ipv4.address: 10.0.0.10
+### name: vrouter01
+### location: server01
+###
+### Note that the name and location fields cannot be changed.`)
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortEdit) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	client := resource.server
+
+	// If stdin isn't a terminal, read text from it
+	if !termios.IsTerminal(getStdinFd()) {
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		// Allow output of `incus network external-port show` command to be passed in here, but only take the
+		// contents of the NetworkExternalPortPut fields when updating. The other fields are silently discarded.
+		newData := api.NetworkExternalPort{}
+		err = yaml.UnmarshalStrict(contents, &newData)
+		if err != nil {
+			return err
+		}
+
+		return client.UpdateNetworkExternalPort(resource.name, args[1], newData.NetworkExternalPortPut, "")
+	}
+
+	// Get the current config.
+	port, etag, err := client.GetNetworkExternalPort(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(&port)
+	if err != nil {
+		return err
+	}
+
+	// Spawn the editor.
+	content, err := textEditor("", []byte(c.helpTemplate()+"\n\n"+string(data)))
+	if err != nil {
+		return err
+	}
+
+	for {
+		// Parse the text received from the editor.
+		newData := api.NetworkExternalPort{} // We show the full info, but only send the writable fields.
+		err = yaml.UnmarshalStrict(content, &newData)
+		if err == nil {
+			err = client.UpdateNetworkExternalPort(resource.name, args[1], newData.Writable(), etag)
+		}
+
+		// Respawn the editor.
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.G("Config parsing error: %s")+"\n", err)
+			fmt.Println(i18n.G("Press enter to open the editor again or ctrl+c to abort change"))
+
+			_, err := os.Stdin.Read(make([]byte, 1))
+			if err != nil {
+				return err
+			}
+
+			content, err = textEditor("", content)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// Delete.
+type cmdNetworkExternalPortDelete struct {
+	global              *cmdGlobal
+	networkExternalPort *cmdNetworkExternalPort
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkExternalPortDelete) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("delete", i18n.G("[<remote>:]<network> <port_name>"))
+	cmd.Aliases = []string{"rm", "remove"}
+	cmd.Short = i18n.G("Delete network external ports")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Delete network external ports"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkExternalPorts(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkExternalPortDelete) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing external port name"))
+	}
+
+	client := resource.server
+
+	// Delete the network external port.
+	err = client.DeleteNetworkExternalPort(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network external port %s deleted")+"\n", args[1])
+	}
+
+	return nil
+}
@@ -62,6 +62,10 @@ func (c *cmdNetworkForward) Command() *cobra.Command {
 	networkForwardDeleteCmd := cmdNetworkForwardDelete{global: c.global, networkForward: c}
 	cmd.AddCommand(networkForwardDeleteCmd.Command())
 
+	// Rename.
+	networkForwardRenameCmd := cmdNetworkForwardRename{global: c.global, networkForward: c}
+	cmd.AddCommand(networkForwardRenameCmd.Command())
+
 	// Port.
 	networkForwardPortCmd := cmdNetworkForwardPort{global: c.global, networkForward: c}
 	cmd.AddCommand(networkForwardPortCmd.Command())
@@ -177,7 +181,18 @@ func (c *cmdNetworkForwardList) descriptionColumnData(forward api.NetworkForward
 }
 
 func (c *cmdNetworkForwardList) defaultTargetAddressColumnData(forward api.NetworkForward) string {
-	return forward.Config["target_address"]
+	if forward.Config["target_address"] != "" {
+		return forward.Config["target_address"]
+	}
+
+	targetAddresses := []string{}
+	for _, key := range []string{"target_address.ipv4", "target_address.ipv6"} {
+		if forward.Config[key] != "" {
+			targetAddresses = append(targetAddresses, forward.Config[key])
+		}
+	}
+
+	return strings.Join(targetAddresses, "\n")
 }
 
 func (c *cmdNetworkForwardList) portsColumnData(forward api.NetworkForward) string {
@@ -912,12 +927,80 @@ func (c *cmdNetworkForwardDelete) Run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Rename.
+type cmdNetworkForwardRename struct {
+	global         *cmdGlobal
+	networkForward *cmdNetworkForward
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkForwardRename) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("rename", i18n.G("[<remote>:]<network> <listen_address> <new_listen_address>"))
+	cmd.Aliases = []string{"mv"}
+	cmd.Short = i18n.G("Move network forwards to another listen address")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Move network forwards to another listen address"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkForwards(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkForwardRename) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 3, 3)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing listen address"))
+	}
+
+	// Move the network forward.
+	err = resource.server.RenameNetworkForward(resource.name, args[1], api.NetworkForwardPost{ListenAddress: args[2]})
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network forward %s moved to %s")+"\n", args[1], args[2])
+	}
+
+	return nil
+}
+
 // Add/Remove Port.
 type cmdNetworkForwardPort struct {
 	global          *cmdGlobal
 	networkForward  *cmdNetworkForward
 	flagRemoveForce bool
 	flagDescription string
+	flagTargetGroup string
 }
 
 // Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
@@ -942,11 +1025,18 @@ func (c *cmdNetworkForwardPort) CommandAdd() *cobra.Command {
 	cmd.Use = usage("add", i18n.G("[<remote>:]<network> <listen_address> <protocol> <listen_port(s)> <target_address> [<target_port(s)>]"))
 	cmd.Aliases = []string{"create"}
 	cmd.Short = i18n.G("Add ports to a forward")
-	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Add ports to a forward"))
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Add ports to a forward
+
+When --target-group is used, the target address is omitted and the port forwards to the members of the network target group.`))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network forward port add ovn0 192.0.2.1 tcp 80 10.0.0.2 8080
+
+incus network forward port add ovn0 192.0.2.1 tcp 80 8080 --target-group web
+    Forward port 80 to port 8080 of the members of the "web" target group`))
 	cmd.RunE = c.RunAdd
 
 	cmd.Flags().StringVar(&c.networkForward.flagTarget, "target", "", i18n.G("Cluster member name")+"``")
 	cmd.Flags().StringVar(&c.flagDescription, "description", "", i18n.G("Port description")+"``")
+	cmd.Flags().StringVar(&c.flagTargetGroup, "target-group", "", i18n.G("Network target group to forward to")+"``")
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -958,7 +1048,7 @@ func (c *cmdNetworkForwardPort) CommandAdd() *cobra.Command {
 		}
 
 		if len(args) == 2 {
-			return []string{"tcp", "udp"}, cobra.ShellCompDirectiveNoFileComp
+			return []string{"tcp", "udp", "any"}, cobra.ShellCompDirectiveNoFileComp
 		}
 
 		return nil, cobra.ShellCompDirectiveNoFileComp
@@ -970,7 +1060,12 @@ func (c *cmdNetworkForwardPort) CommandAdd() *cobra.Command {
 // RunAdd runs the actual command logic.
 func (c *cmdNetworkForwardPort) RunAdd(cmd *cobra.Command, args []string) error {
 	// Quick checks.
-	exit, err := c.global.checkArgs(cmd, args, 5, 6)
+	minArgs := 5
+	if c.flagTargetGroup != "" {
+		minArgs = 4
+	}
+
+	exit, err := c.global.checkArgs(cmd, args, minArgs, minArgs+1)
 	if exit {
 		return err
 	}
@@ -1005,14 +1100,18 @@ func (c *cmdNetworkForwardPort) RunAdd(cmd *cobra.Command, args []string) error
 	}
 
 	port := api.NetworkForwardPort{
-		Protocol:      args[2],
-		ListenPort:    args[3],
-		TargetAddress: args[4],
-		Description:   c.flagDescription,
+		Protocol:    args[2],
+		ListenPort:  args[3],
+		TargetGroup: c.flagTargetGroup,
+		Description: c.flagDescription,
+	}
+
+	if port.TargetGroup == "" {
+		port.TargetAddress = args[4]
 	}
 
-	if len(args) > 5 {
-		port.TargetPort = args[5]
+	if len(args) > minArgs {
+		port.TargetPort = args[minArgs]
 	}
 
 	forward.Ports = append(forward.Ports, port)
@@ -1044,7 +1143,7 @@ func (c *cmdNetworkForwardPort) CommandRemove() *cobra.Command {
 		}
 
 		if len(args) == 2 {
-			return []string{"tcp", "udp"}, cobra.ShellCompDirectiveNoFileComp
+			return []string{"tcp", "udp", "any"}, cobra.ShellCompDirectiveNoFileComp
 		}
 
 		return nil, cobra.ShellCompDirectiveNoFileComp
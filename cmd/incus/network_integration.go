@@ -46,6 +46,10 @@ func (c *cmdNetworkIntegration) Command() *cobra.Command {
 	networkIntegrationGetCmd := cmdNetworkIntegrationGet{global: c.global, networkIntegration: c}
 	cmd.AddCommand(networkIntegrationGetCmd.Command())
 
+	// Info
+	networkIntegrationInfoCmd := cmdNetworkIntegrationInfo{global: c.global, networkIntegration: c}
+	cmd.AddCommand(networkIntegrationInfoCmd.Command())
+
 	// List
 	networkIntegrationListCmd := cmdNetworkIntegrationList{global: c.global, networkIntegration: c}
 	cmd.AddCommand(networkIntegrationListCmd.Command())
@@ -401,6 +405,103 @@ func (c *cmdNetworkIntegrationGet) Run(cmd *cobra.Command, args []string) error
 	return nil
 }
 
+// Info.
+type cmdNetworkIntegrationInfo struct {
+	global             *cmdGlobal
+	networkIntegration *cmdNetworkIntegration
+}
+
+// Command returns a cobra command for inclusion.
+func (c *cmdNetworkIntegrationInfo) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("info", i18n.G("[<remote>:]<network integration>"))
+	cmd.Short = i18n.G("Get runtime information on network integrations")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(
+		`Get runtime information on network integrations
+
+This reports the state of the interconnection databases, gateways and
+transit switches, along with the binding state of the network peers using
+the integration.`))
+
+	cmd.RunE = c.Run
+
+	return cmd
+}
+
+// Run actually performs the action.
+func (c *cmdNetworkIntegrationInfo) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	// Parse remote
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network integration name"))
+	}
+
+	state, err := resource.server.GetNetworkIntegrationState(resource.name)
+	if err != nil {
+		return err
+	}
+
+	databaseState := func(db api.NetworkIntegrationStateDatabase) string {
+		if db.Reachable {
+			return i18n.G("reachable")
+		}
+
+		return fmt.Sprintf(i18n.G("unreachable (%s)"), db.Error)
+	}
+
+	fmt.Printf(i18n.G("Name: %s")+"\n", resource.name)
+	fmt.Printf(i18n.G("Availability zone: %s")+"\n", state.AvailabilityZone)
+	fmt.Printf(i18n.G("Northbound database: %s")+"\n", databaseState(state.Northbound))
+	fmt.Printf(i18n.G("Southbound database: %s")+"\n", databaseState(state.Southbound))
+
+	if len(state.Gateways) > 0 {
+		fmt.Println("")
+		fmt.Println(i18n.G("Gateways:"))
+		for _, gateway := range state.Gateways {
+			fmt.Printf("  %s\n", gateway)
+		}
+	}
+
+	if len(state.TransitSwitches) > 0 {
+		fmt.Println("")
+		fmt.Println(i18n.G("Transit switches:"))
+		for _, transitSwitch := range state.TransitSwitches {
+			fmt.Printf("  %s (%s)\n", transitSwitch.Name, strings.Join(transitSwitch.AvailabilityZones, ", "))
+		}
+	}
+
+	if len(state.Peers) > 0 {
+		fmt.Println("")
+		fmt.Println(i18n.G("Peers:"))
+		for _, peer := range state.Peers {
+			fmt.Printf("  %s/%s/%s:\n", peer.Project, peer.Network, peer.Name)
+			fmt.Printf("    %s: %s\n", i18n.G("Transit switch"), peer.TransitSwitch)
+
+			if peer.Bound {
+				fmt.Printf("    %s: %s\n", i18n.G("Gateway"), peer.Gateway)
+			} else {
+				fmt.Printf("    %s: %s\n", i18n.G("Gateway"), i18n.G("unbound"))
+			}
+
+			fmt.Printf("    %s: %s\n", i18n.G("Remote availability zones"), strings.Join(peer.RemoteAvailabilityZones, ", "))
+		}
+	}
+
+	return nil
+}
+
 // List.
 type cmdNetworkIntegrationList struct {
 	global             *cmdGlobal
@@ -67,6 +67,10 @@ func (c *cmdNetworkLoadBalancer) Command() *cobra.Command {
 	networkLoadBalancerDeleteCmd := cmdNetworkLoadBalancerDelete{global: c.global, networkLoadBalancer: c}
 	cmd.AddCommand(networkLoadBalancerDeleteCmd.Command())
 
+	// Rename.
+	networkLoadBalancerRenameCmd := cmdNetworkLoadBalancerRename{global: c.global, networkLoadBalancer: c}
+	cmd.AddCommand(networkLoadBalancerRenameCmd.Command())
+
 	// Backend.
 	networkLoadBalancerBackendCmd := cmdNetworkLoadBalancerBackend{global: c.global, networkLoadBalancer: c}
 	cmd.AddCommand(networkLoadBalancerBackendCmd.Command())
@@ -892,11 +896,79 @@ func (c *cmdNetworkLoadBalancerDelete) Run(cmd *cobra.Command, args []string) er
 	return nil
 }
 
+// Rename.
+type cmdNetworkLoadBalancerRename struct {
+	global              *cmdGlobal
+	networkLoadBalancer *cmdNetworkLoadBalancer
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkLoadBalancerRename) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("rename", i18n.G("[<remote>:]<network> <listen_address> <new_listen_address>"))
+	cmd.Aliases = []string{"mv"}
+	cmd.Short = i18n.G("Move network load balancers to another listen address")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Move network load balancers to another listen address"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkLoadBalancers(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkLoadBalancerRename) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 3, 3)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing listen address"))
+	}
+
+	// Move the network load balancer.
+	err = resource.server.RenameNetworkLoadBalancer(resource.name, args[1], api.NetworkLoadBalancerPost{ListenAddress: args[2]})
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network load balancer %s moved to %s")+"\n", args[1], args[2])
+	}
+
+	return nil
+}
+
 // Add/Remove Backend.
 type cmdNetworkLoadBalancerBackend struct {
 	global              *cmdGlobal
 	networkLoadBalancer *cmdNetworkLoadBalancer
 	flagDescription     string
+	flagTargetGroup     string
 }
 
 // Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
@@ -921,11 +993,14 @@ func (c *cmdNetworkLoadBalancerBackend) CommandAdd() *cobra.Command {
 	cmd.Use = usage("add", i18n.G("[<remote>:]<network> <listen_address> <backend_name> <target_address> [<target_port(s)>]"))
 	cmd.Aliases = []string{"create"}
 	cmd.Short = i18n.G("Add backends to a load balancer")
-	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Add backend to a load balancer"))
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Add backend to a load balancer
+
+When --target-group is used, the target address is omitted and the backend balances across the members of the network target group.`))
 	cmd.RunE = c.RunAdd
 
 	cmd.Flags().StringVar(&c.networkLoadBalancer.flagTarget, "target", "", i18n.G("Cluster member name")+"``")
 	cmd.Flags().StringVar(&c.flagDescription, "description", "", i18n.G("Backend description")+"``")
+	cmd.Flags().StringVar(&c.flagTargetGroup, "target-group", "", i18n.G("Network target group to use as the backend")+"``")
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -945,7 +1020,12 @@ func (c *cmdNetworkLoadBalancerBackend) CommandAdd() *cobra.Command {
 // RunAdd runs the actual command logic.
 func (c *cmdNetworkLoadBalancerBackend) RunAdd(cmd *cobra.Command, args []string) error {
 	// Quick checks.
-	exit, err := c.global.checkArgs(cmd, args, 4, 5)
+	minArgs := 4
+	if c.flagTargetGroup != "" {
+		minArgs = 3
+	}
+
+	exit, err := c.global.checkArgs(cmd, args, minArgs, minArgs+1)
 	if exit {
 		return err
 	}
@@ -980,13 +1060,17 @@ func (c *cmdNetworkLoadBalancerBackend) RunAdd(cmd *cobra.Command, args []string
 	}
 
 	backend := api.NetworkLoadBalancerBackend{
-		Name:          args[2],
-		TargetAddress: args[3],
-		Description:   c.flagDescription,
+		Name:        args[2],
+		TargetGroup: c.flagTargetGroup,
+		Description: c.flagDescription,
+	}
+
+	if backend.TargetGroup == "" {
+		backend.TargetAddress = args[3]
 	}
 
-	if len(args) >= 5 {
-		backend.TargetPort = args[4]
+	if len(args) > minArgs {
+		backend.TargetPort = args[minArgs]
 	}
 
 	loadBalancer.Backends = append(loadBalancer.Backends, backend)
@@ -62,6 +62,18 @@ func (c *cmdNetworkPeer) Command() *cobra.Command {
 	networkPeerDeleteCmd := cmdNetworkPeerDelete{global: c.global, networkPeer: c}
 	cmd.AddCommand(networkPeerDeleteCmd.Command())
 
+	// Approve.
+	networkPeerApproveCmd := cmdNetworkPeerState{global: c.global, networkPeer: c, action: "approve"}
+	cmd.AddCommand(networkPeerApproveCmd.Command())
+
+	// Reject.
+	networkPeerRejectCmd := cmdNetworkPeerState{global: c.global, networkPeer: c, action: "reject"}
+	cmd.AddCommand(networkPeerRejectCmd.Command())
+
+	// Routes.
+	networkPeerRoutesCmd := cmdNetworkPeerRoutes{global: c.global, networkPeer: c}
+	cmd.AddCommand(networkPeerRoutesCmd.Command())
+
 	// Workaround for subcommand usage errors. See: https://github.com/spf13/cobra/issues/706
 	cmd.Args = cobra.NoArgs
 	cmd.Run = func(cmd *cobra.Command, _ []string) { _ = cmd.Usage() }
@@ -919,3 +931,167 @@ func (c *cmdNetworkPeerDelete) Run(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// Approve and reject.
+type cmdNetworkPeerState struct {
+	global      *cmdGlobal
+	networkPeer *cmdNetworkPeer
+
+	action string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkPeerState) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage(c.action, i18n.G("[<remote>:]<network> <peer_name>"))
+
+	if c.action == "approve" {
+		cmd.Short = i18n.G("Approve network peerings")
+		cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Approve network peerings
+
+Peerings between networks of different projects only become active once
+approved on the side of the network they were requested with.`))
+	} else {
+		cmd.Short = i18n.G("Reject network peerings")
+		cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Reject network peerings
+
+Rejected peerings stay inactive until they are deleted and created again.`))
+	}
+
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkPeers(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkPeerState) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing peer name"))
+	}
+
+	client := resource.server
+
+	// Update the network peer state.
+	err = client.UpdateNetworkPeerState(resource.name, args[1], api.NetworkPeerStatePost{Action: c.action})
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		if c.action == "approve" {
+			fmt.Printf(i18n.G("Network peer %s approved")+"\n", args[1])
+		} else {
+			fmt.Printf(i18n.G("Network peer %s rejected")+"\n", args[1])
+		}
+	}
+
+	return nil
+}
+
+// Routes.
+type cmdNetworkPeerRoutes struct {
+	global      *cmdGlobal
+	networkPeer *cmdNetworkPeer
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkPeerRoutes) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("routes", i18n.G("[<remote>:]<network> <peer_name>"))
+	cmd.Short = i18n.G("List the routes advertised by remote network peerings")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`List the routes advertised by remote network peerings
+
+This shows which routes of the network are advertised to the integration
+and which ones are held back by the routes.export filter of the peering.`))
+
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkPeers(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkPeerRoutes) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network name"))
+	}
+
+	if args[1] == "" {
+		return errors.New(i18n.G("Missing peer name"))
+	}
+
+	routes, err := resource.server.GetNetworkPeerRoutes(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(i18n.G("Exported routes:"))
+	for _, route := range routes.Exported {
+		fmt.Printf("  %s\n", route)
+	}
+
+	if len(routes.Filtered) > 0 {
+		fmt.Println("")
+		fmt.Println(i18n.G("Filtered routes:"))
+		for _, route := range routes.Filtered {
+			fmt.Printf("  %s\n", route)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,452 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	cli "github.com/lxc/incus/v6/internal/cmd"
+	"github.com/lxc/incus/v6/internal/i18n"
+	"github.com/lxc/incus/v6/internal/instance"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+type cmdNetworkRestorePoint struct {
+	global *cmdGlobal
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePoint) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("restore-point")
+	cmd.Short = i18n.G("Manage network restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Manage network restore points"))
+
+	// List.
+	networkRestorePointListCmd := cmdNetworkRestorePointList{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointListCmd.Command())
+
+	// Show.
+	networkRestorePointShowCmd := cmdNetworkRestorePointShow{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointShowCmd.Command())
+
+	// Create.
+	networkRestorePointCreateCmd := cmdNetworkRestorePointCreate{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointCreateCmd.Command())
+
+	// Diff.
+	networkRestorePointDiffCmd := cmdNetworkRestorePointDiff{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointDiffCmd.Command())
+
+	// Restore.
+	networkRestorePointRestoreCmd := cmdNetworkRestorePointRestore{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointRestoreCmd.Command())
+
+	// Delete.
+	networkRestorePointDeleteCmd := cmdNetworkRestorePointDelete{global: c.global, networkRestorePoint: c}
+	cmd.AddCommand(networkRestorePointDeleteCmd.Command())
+
+	// Workaround for subcommand usage errors. See: https://github.com/spf13/cobra/issues/706
+	cmd.Args = cobra.NoArgs
+	cmd.Run = func(cmd *cobra.Command, _ []string) { _ = cmd.Usage() }
+	return cmd
+}
+
+// parseArgs parses the network and restore point name arguments.
+func (c *cmdNetworkRestorePoint) parseArgs(args []string) (*remoteResource, error) {
+	// Parse remote.
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resource := resources[0]
+
+	if resource.name == "" {
+		return nil, errors.New(i18n.G("Missing network name"))
+	}
+
+	if len(args) > 1 && args[1] == "" {
+		return nil, errors.New(i18n.G("Missing restore point name"))
+	}
+
+	return &resource, nil
+}
+
+// validArgs returns the shell completion function for commands taking a network and a restore point.
+func (c *cmdNetworkRestorePoint) validArgs(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return c.global.cmpNetworks(toComplete)
+	}
+
+	if len(args) == 1 {
+		return c.global.cmpNetworkRestorePoints(args[0])
+	}
+
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// List.
+type cmdNetworkRestorePointList struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+
+	flagFormat string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointList) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("list", i18n.G("[<remote>:]<network>"))
+	cmd.Aliases = []string{"ls"}
+	cmd.Short = i18n.G("List available network restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("List available network restore points"))
+
+	cmd.RunE = c.Run
+	cmd.Flags().StringVarP(&c.flagFormat, "format", "f", c.global.defaultListFormat(), i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`)+"``")
+
+	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
+	}
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointList) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	restorePoints, err := resource.server.GetNetworkRestorePoints(resource.name)
+	if err != nil {
+		return err
+	}
+
+	data := [][]string{}
+	for _, restorePoint := range restorePoints {
+		expiresAt := ""
+		if restorePoint.ExpiresAt.Unix() > 0 {
+			expiresAt = restorePoint.ExpiresAt.Local().Format(dateLayout)
+		}
+
+		data = append(data, []string{
+			restorePoint.Name,
+			restorePoint.CreatedAt.Local().Format(dateLayout),
+			expiresAt,
+			fmt.Sprintf("%d", len(restorePoint.Definition.Forwards)),
+			fmt.Sprintf("%d", len(restorePoint.Definition.LoadBalancers)),
+		})
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(data))
+
+	header := []string{
+		i18n.G("NAME"),
+		i18n.G("TAKEN AT"),
+		i18n.G("EXPIRES AT"),
+		i18n.G("FORWARDS"),
+		i18n.G("LOAD BALANCERS"),
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, restorePoints)
+}
+
+// Show.
+type cmdNetworkRestorePointShow struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointShow) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("show", i18n.G("[<remote>:]<network> <restore_point>"))
+	cmd.Short = i18n.G("Show network restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Show network restore points"))
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = c.networkRestorePoint.validArgs
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointShow) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	restorePoint, err := resource.server.GetNetworkRestorePoint(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(&restorePoint)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s", data)
+
+	return nil
+}
+
+// Create.
+type cmdNetworkRestorePointCreate struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+
+	flagExpiry string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointCreate) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("create", i18n.G("[<remote>:]<network> <restore_point>"))
+	cmd.Aliases = []string{"add"}
+	cmd.Short = i18n.G("Create network restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Create network restore points
+
+The restore point saves the network configuration, forwards and load balancers.`))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network restore-point create ovn0 before-acl-change --expiry 1d
+    Save the current definition of network "ovn0" for one day`))
+
+	cmd.RunE = c.Run
+	cmd.Flags().StringVar(&c.flagExpiry, "expiry", "", i18n.G("Expiry date or time span for the new restore point")+"``")
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworks(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointCreate) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	req := api.NetworkRestorePointsPost{
+		Name: args[1],
+	}
+
+	if c.flagExpiry != "" {
+		// Try to parse as a duration.
+		expiry, err := instance.GetExpiry(time.Now(), c.flagExpiry)
+		if err != nil {
+			if !errors.Is(err, instance.ErrInvalidExpiry) {
+				return err
+			}
+
+			// Fallback to date parsing.
+			expiry, err = time.Parse(dateLayout, c.flagExpiry)
+			if err != nil {
+				return err
+			}
+		}
+
+		req.ExpiresAt = expiry
+	}
+
+	err = resource.server.CreateNetworkRestorePoint(resource.name, req)
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network restore point %s created")+"\n", args[1])
+	}
+
+	return nil
+}
+
+// Diff.
+type cmdNetworkRestorePointDiff struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+
+	flagFormat string
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointDiff) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("diff", i18n.G("[<remote>:]<network> <restore_point>"))
+	cmd.Short = i18n.G("Compare a network with one of its restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Compare a network with one of its restore points
+
+Lists the changes that restoring the restore point would make.`))
+
+	cmd.RunE = c.Run
+	cmd.Flags().StringVarP(&c.flagFormat, "format", "f", c.global.defaultListFormat(), i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`)+"``")
+
+	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
+	}
+
+	cmd.ValidArgsFunction = c.networkRestorePoint.validArgs
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointDiff) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	changes, err := resource.server.GetNetworkRestorePointDiff(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	data := [][]string{}
+	for _, change := range changes {
+		data = append(data, []string{change.Entity, change.Name, change.Field, change.Current, change.RestorePoint})
+	}
+
+	header := []string{
+		i18n.G("TYPE"),
+		i18n.G("NAME"),
+		i18n.G("FIELD"),
+		i18n.G("CURRENT"),
+		strings.ToUpper(args[1]),
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, changes)
+}
+
+// Restore.
+type cmdNetworkRestorePointRestore struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointRestore) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("restore", i18n.G("[<remote>:]<network> <restore_point>"))
+	cmd.Short = i18n.G("Restore networks from restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Restore networks from restore points
+
+The network configuration, forwards and load balancers are rolled back to the restore point.`))
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = c.networkRestorePoint.validArgs
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointRestore) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	err = resource.server.RestoreNetworkRestorePoint(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network %s restored from %s")+"\n", resource.name, args[1])
+	}
+
+	return nil
+}
+
+// Delete.
+type cmdNetworkRestorePointDelete struct {
+	global              *cmdGlobal
+	networkRestorePoint *cmdNetworkRestorePoint
+}
+
+// Command returns a cobra.Command for use with (*cobra.Command).AddCommand.
+func (c *cmdNetworkRestorePointDelete) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("delete", i18n.G("[<remote>:]<network> <restore_point>"))
+	cmd.Aliases = []string{"rm", "remove"}
+	cmd.Short = i18n.G("Delete network restore points")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Delete network restore points"))
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = c.networkRestorePoint.validArgs
+
+	return cmd
+}
+
+// Run runs the actual command logic.
+func (c *cmdNetworkRestorePointDelete) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resource, err := c.networkRestorePoint.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	err = resource.server.DeleteNetworkRestorePoint(resource.name, args[1])
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network restore point %s deleted")+"\n", args[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,842 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	cli "github.com/lxc/incus/v6/internal/cmd"
+	"github.com/lxc/incus/v6/internal/i18n"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/termios"
+)
+
+// cmdNetworkTargetGroup represents the global network target group command.
+type cmdNetworkTargetGroup struct {
+	global *cmdGlobal
+}
+
+// Command initializes the base network target group command and its subcommands.
+func (c *cmdNetworkTargetGroup) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("target-group")
+	cmd.Short = i18n.G("Manage network target groups")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Manage network target groups"))
+
+	// List
+	networkTargetGroupListCmd := cmdNetworkTargetGroupList{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupListCmd.Command())
+
+	// Show
+	networkTargetGroupShowCmd := cmdNetworkTargetGroupShow{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupShowCmd.Command())
+
+	// Create
+	networkTargetGroupCreateCmd := cmdNetworkTargetGroupCreate{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupCreateCmd.Command())
+
+	// Set
+	networkTargetGroupSetCmd := cmdNetworkTargetGroupSet{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupSetCmd.Command())
+
+	// Unset
+	networkTargetGroupUnsetCmd := cmdNetworkTargetGroupUnset{global: c.global, networkTargetGroup: c, networkTargetGroupSet: &networkTargetGroupSetCmd}
+	cmd.AddCommand(networkTargetGroupUnsetCmd.Command())
+
+	// Edit
+	networkTargetGroupEditCmd := cmdNetworkTargetGroupEdit{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupEditCmd.Command())
+
+	// Rename
+	networkTargetGroupRenameCmd := cmdNetworkTargetGroupRename{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupRenameCmd.Command())
+
+	// Delete
+	networkTargetGroupDeleteCmd := cmdNetworkTargetGroupDelete{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupDeleteCmd.Command())
+
+	// Add
+	networkTargetGroupAddCmd := cmdNetworkTargetGroupAdd{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupAddCmd.Command())
+
+	// Remove
+	networkTargetGroupRemoveCmd := cmdNetworkTargetGroupRemove{global: c.global, networkTargetGroup: c}
+	cmd.AddCommand(networkTargetGroupRemoveCmd.Command())
+
+	// Workaround for subcommand usage errors
+	cmd.Args = cobra.NoArgs
+	cmd.Run = func(cmd *cobra.Command, args []string) { _ = cmd.Usage() }
+	return cmd
+}
+
+// cmdNetworkTargetGroupList defines the structure for listing network target groups.
+type cmdNetworkTargetGroupList struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+
+	flagFormat      string
+	flagAllProjects bool
+}
+
+// Command initializes the list subcommand.
+func (c *cmdNetworkTargetGroupList) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("list", i18n.G("[<remote>:]"))
+	cmd.Aliases = []string{"ls"}
+	cmd.Short = i18n.G("List available network target groups")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("List available network target groups"))
+
+	cmd.RunE = c.Run
+	cmd.Flags().StringVarP(&c.flagFormat, "format", "f", c.global.defaultListFormat(), i18n.G("Format (csv|json|table|yaml|compact|markdown)")+"``")
+	cmd.Flags().BoolVar(&c.flagAllProjects, "all-projects", false, i18n.G("List target groups across all projects"))
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpRemotes(toComplete, false)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the list command logic.
+func (c *cmdNetworkTargetGroupList) Run(cmd *cobra.Command, args []string) error {
+	// Quick checks.
+	exit, err := c.global.checkArgs(cmd, args, 0, 1)
+	if exit {
+		return err
+	}
+
+	// Parse remote.
+	remote := ""
+	if len(args) > 0 {
+		remote = args[0]
+	}
+
+	resources, err := c.global.parseServers(remote)
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+
+	var groups []api.NetworkTargetGroup
+	if c.flagAllProjects {
+		groups, err = resource.server.GetNetworkTargetGroupsAllProjects()
+		if err != nil {
+			return err
+		}
+	} else {
+		groups, err = resource.server.GetNetworkTargetGroups()
+		if err != nil {
+			return err
+		}
+	}
+	data := [][]string{}
+	for _, group := range groups {
+		strUsedBy := fmt.Sprintf("%d", len(group.UsedBy))
+		details := []string{
+			group.Name,
+			group.Description,
+			strings.Join(group.Addresses, "\n"),
+			strings.Join(group.Instances, "\n"),
+			strUsedBy,
+		}
+
+		if c.flagAllProjects {
+			details = append([]string{group.Project}, details...)
+		}
+
+		data = append(data, details)
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(data))
+
+	header := []string{
+		i18n.G("NAME"),
+		i18n.G("DESCRIPTION"),
+		i18n.G("ADDRESSES"),
+		i18n.G("INSTANCES"),
+		i18n.G("USED BY"),
+	}
+
+	if c.flagAllProjects {
+		header = append([]string{i18n.G("PROJECT")}, header...)
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, groups)
+}
+
+// cmdNetworkTargetGroupShow defines the structure for showing a network target group.
+type cmdNetworkTargetGroupShow struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the show subcommand.
+func (c *cmdNetworkTargetGroupShow) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("show", i18n.G("[<remote>:]<target-group>"))
+	cmd.Short = i18n.G("Show network target group configuration")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Show network target group configuration"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the show command logic.
+func (c *cmdNetworkTargetGroupShow) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	group, _, err := resource.server.GetNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(group.UsedBy)
+
+	data, err := yaml.Marshal(&group)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s", data)
+	return nil
+}
+
+// cmdNetworkTargetGroupCreate defines the structure for creating a network target group.
+type cmdNetworkTargetGroupCreate struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+
+	flagDescription string
+}
+
+// Command initializes the create subcommand.
+func (c *cmdNetworkTargetGroupCreate) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("create", i18n.G("[<remote>:]<target-group> [key=value...]"))
+	cmd.Short = i18n.G("Create new network target groups")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Create new network target groups"))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network target-group create web addresses=192.0.2.10,192.0.2.11 instances=web01,web02
+
+incus network target-group create web < config.yaml
+    Create network target group with configuration from config.yaml`))
+
+	cmd.Flags().StringVar(&c.flagDescription, "description", "", i18n.G("Network target group description")+"``")
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the create command logic.
+func (c *cmdNetworkTargetGroupCreate) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 1, -1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	var groupPut api.NetworkTargetGroupPut
+	if !termios.IsTerminal(getStdinFd()) {
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		err = yaml.UnmarshalStrict(contents, &groupPut)
+		if err != nil {
+			return err
+		}
+	}
+
+	group := api.NetworkTargetGroupsPost{
+		NetworkTargetGroupPost: api.NetworkTargetGroupPost{
+			Name: resource.name,
+		},
+		NetworkTargetGroupPut: groupPut,
+	}
+
+	if c.flagDescription != "" {
+		group.Description = c.flagDescription
+	}
+
+	if group.Config == nil {
+		group.Config = map[string]string{}
+	}
+
+	for i := 1; i < len(args); i++ {
+		entry := strings.SplitN(args[i], "=", 2)
+		if len(entry) < 2 {
+			return fmt.Errorf(i18n.G("Bad key/value pair: %s"), args[i])
+		}
+
+		if entry[0] == "addresses" {
+			addresses := strings.Split(entry[1], ",") // Split the comma-separated IPs
+			group.Addresses = append(group.Addresses, addresses...)
+			continue
+		}
+
+		if entry[0] == "instances" {
+			instances := strings.Split(entry[1], ",") // Split the comma-separated instance names
+			group.Instances = append(group.Instances, instances...)
+			continue
+		}
+
+		group.Config[entry[0]] = entry[1]
+	}
+
+	err = resource.server.CreateNetworkTargetGroup(group)
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network target group %s created")+"\n", resource.name)
+	}
+
+	return nil
+}
+
+// cmdNetworkTargetGroupSet defines the structure for setting network target group configuration.
+type cmdNetworkTargetGroupSet struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+
+	flagIsProperty bool
+}
+
+// Command initializes the set subcommand.
+func (c *cmdNetworkTargetGroupSet) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("set", i18n.G("[<remote>:]<target-group> <key>=<value>..."))
+	cmd.Short = i18n.G("Set network target group configuration keys")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Set network target group configuration keys`))
+
+	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Set the key as a network target group property"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the set command logic.
+func (c *cmdNetworkTargetGroupSet) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 2, -1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	// Get current target group
+	group, etag, err := resource.server.GetNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	keys, err := getConfig(args[1:]...)
+	if err != nil {
+		return err
+	}
+
+	writable := group.Writable()
+	if writable.Config == nil {
+		writable.Config = make(map[string]string)
+	}
+
+	if c.flagIsProperty {
+		// handle as properties
+		err = unpackKVToWritable(&writable, keys)
+		if err != nil {
+			return fmt.Errorf(i18n.G("Error setting properties: %v"), err)
+		}
+	} else {
+		maps.Copy(writable.Config, keys)
+	}
+
+	return resource.server.UpdateNetworkTargetGroup(resource.name, writable, etag)
+}
+
+// cmdNetworkTargetGroupUnset defines the structure for unsetting network target group configuration keys.
+type cmdNetworkTargetGroupUnset struct {
+	global                *cmdGlobal
+	networkTargetGroup    *cmdNetworkTargetGroup
+	networkTargetGroupSet *cmdNetworkTargetGroupSet
+
+	flagIsProperty bool
+}
+
+// Command initializes the unset subcommand.
+func (c *cmdNetworkTargetGroupUnset) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("unset", i18n.G("[<remote>:]<target-group> <key>"))
+	cmd.Short = i18n.G("Unset network target group configuration keys")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Unset network target group configuration keys"))
+	cmd.RunE = c.Run
+
+	cmd.Flags().BoolVarP(&c.flagIsProperty, "property", "p", false, i18n.G("Unset the key as a network target group property"))
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkTargetGroupConfigs(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the unset command logic.
+func (c *cmdNetworkTargetGroupUnset) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	c.networkTargetGroupSet.flagIsProperty = c.flagIsProperty
+	args = append(args, "")
+	return c.networkTargetGroupSet.Run(cmd, args)
+}
+
+// cmdNetworkTargetGroupEdit defines the structure for editing a network target group.
+type cmdNetworkTargetGroupEdit struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the edit subcommand.
+func (c *cmdNetworkTargetGroupEdit) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("edit", i18n.G("[<remote>:]<target-group>"))
+	cmd.Short = i18n.G("Edit network target group configurations as YAML")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Edit network target group configurations as YAML"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// helpTemplate provides a YAML template for editing target groups.
+func (c *cmdNetworkTargetGroupEdit) helpTemplate() string {
+	return i18n.G(
+		`### This is a YAML representation of the network target group.
+### Any line starting with '#' will be ignored.
+###
+### For example:
+### name: web
+### description: "Web servers"
+### addresses:
+###  - 192.0.2.10
+###  - 2001:db8::10
+### instances:
+###  - web01
+###  - web02
+### config:
+###  user.foo: bar
+`)
+}
+
+// Run executes the edit command logic.
+func (c *cmdNetworkTargetGroupEdit) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	// If stdin isn't terminal, read yaml from it
+	if !termios.IsTerminal(getStdinFd()) {
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		newdata := api.NetworkTargetGroup{}
+		err = yaml.UnmarshalStrict(contents, &newdata)
+		if err != nil {
+			return err
+		}
+
+		return resource.server.UpdateNetworkTargetGroup(resource.name, newdata.Writable(), "")
+	}
+
+	// Get current config
+	group, etag, err := resource.server.GetNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(&group)
+	if err != nil {
+		return err
+	}
+
+	content, err := textEditor("", []byte(c.helpTemplate()+"\n\n"+string(data)))
+	if err != nil {
+		return err
+	}
+
+	for {
+		newdata := api.NetworkTargetGroup{}
+		err = yaml.UnmarshalStrict(content, &newdata)
+		if err == nil {
+			err = resource.server.UpdateNetworkTargetGroup(resource.name, newdata.Writable(), etag)
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.G("Config parsing error: %s")+"\n", err)
+			fmt.Println(i18n.G("Press enter to open the editor again or ctrl+c to abort change"))
+
+			_, err2 := os.Stdin.Read(make([]byte, 1))
+			if err2 != nil {
+				return err2
+			}
+
+			content, err2 = textEditor("", content)
+			if err2 != nil {
+				return err2
+			}
+
+			continue
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// cmdNetworkTargetGroupRename defines the structure for renaming a network target group.
+type cmdNetworkTargetGroupRename struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the rename subcommand.
+func (c *cmdNetworkTargetGroupRename) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("rename", i18n.G("[<remote>:]<target-group> <new-name>"))
+	cmd.Aliases = []string{"mv"}
+	cmd.Short = i18n.G("Rename network target groups")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Rename network target groups"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the rename command logic.
+func (c *cmdNetworkTargetGroupRename) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 2, 2)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	err = resource.server.RenameNetworkTargetGroup(resource.name, api.NetworkTargetGroupPost{Name: args[1]})
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network target group %s renamed to %s")+"\n", resource.name, args[1])
+	}
+
+	return nil
+}
+
+// cmdNetworkTargetGroupDelete defines the structure for deleting a network target group.
+type cmdNetworkTargetGroupDelete struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the delete subcommand.
+func (c *cmdNetworkTargetGroupDelete) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("delete", i18n.G("[<remote>:]<target-group>"))
+	cmd.Aliases = []string{"rm"}
+	cmd.Short = i18n.G("Delete network target groups")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Delete network target groups"))
+	cmd.RunE = c.Run
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the delete command logic.
+func (c *cmdNetworkTargetGroupDelete) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 1, 1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	err = resource.server.DeleteNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network target group %s deleted")+"\n", resource.name)
+	}
+
+	return nil
+}
+
+// cmdNetworkTargetGroupAdd defines the structure for adding addresses and instances to a network target group.
+type cmdNetworkTargetGroupAdd struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the add subcommand.
+func (c *cmdNetworkTargetGroupAdd) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("add", i18n.G("[<remote>:]<target-group> <address|instance>..."))
+	cmd.Short = i18n.G("Add addresses or instances to a network target group")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G(`Add addresses or instances to a network target group
+
+Arguments which are valid IP addresses are added as addresses, all others as instance names.`))
+
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the add command logic.
+func (c *cmdNetworkTargetGroupAdd) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 2, -1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	group, etag, err := resource.server.GetNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	// Add addresses and instances
+	for _, member := range args[1:] {
+		if net.ParseIP(member) != nil {
+			group.Addresses = append(group.Addresses, member)
+		} else {
+			group.Instances = append(group.Instances, member)
+		}
+	}
+
+	return resource.server.UpdateNetworkTargetGroup(resource.name, group.Writable(), etag)
+}
+
+// cmdNetworkTargetGroupRemove defines the structure for removing addresses and instances from a network target group.
+type cmdNetworkTargetGroupRemove struct {
+	global             *cmdGlobal
+	networkTargetGroup *cmdNetworkTargetGroup
+}
+
+// Command initializes the remove subcommand.
+func (c *cmdNetworkTargetGroupRemove) Command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = usage("remove", i18n.G("[<remote>:]<target-group> <address|instance>..."))
+	cmd.Short = i18n.G("Remove addresses or instances from a network target group")
+	cmd.Long = cli.FormatSection(i18n.G("Description"), i18n.G("Remove addresses or instances from a network target group"))
+
+	cmd.RunE = c.Run
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkTargetGroups(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// Run executes the remove command logic.
+func (c *cmdNetworkTargetGroupRemove) Run(cmd *cobra.Command, args []string) error {
+	exit, err := c.global.checkArgs(cmd, args, 2, -1)
+	if exit {
+		return err
+	}
+
+	resources, err := c.global.parseServers(args[0])
+	if err != nil {
+		return err
+	}
+
+	resource := resources[0]
+	if resource.name == "" {
+		return errors.New(i18n.G("Missing network target group name"))
+	}
+
+	group, etag, err := resource.server.GetNetworkTargetGroup(resource.name)
+	if err != nil {
+		return err
+	}
+
+	toRemove := args[1:]
+	newAddrs := make([]string, 0, len(group.Addresses))
+	newInstances := make([]string, 0, len(group.Instances))
+	removedCount := 0
+
+	for _, addr := range group.Addresses {
+		if slices.Contains(toRemove, addr) {
+			removedCount++
+			continue
+		}
+
+		newAddrs = append(newAddrs, addr)
+	}
+
+	for _, instance := range group.Instances {
+		if slices.Contains(toRemove, instance) {
+			removedCount++
+			continue
+		}
+
+		newInstances = append(newInstances, instance)
+	}
+
+	if removedCount != len(toRemove) {
+		return errors.New(i18n.G("One or more provided members aren't currently in the group"))
+	}
+
+	group.Addresses = newAddrs
+	group.Instances = newInstances
+	return resource.server.UpdateNetworkTargetGroup(resource.name, group.Writable(), etag)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/db"
 	instanceDrivers "github.com/lxc/incus/v6/internal/server/instance/drivers"
 	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network/ovn"
 	"github.com/lxc/incus/v6/internal/server/node"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/response"
@@ -72,6 +73,7 @@ var api10 = []APIEndpoint{
 	instanceStateCmd,
 	instanceAccessCmd,
 	instanceDebugMemoryCmd,
+	instanceInterfaceOVNCmd,
 	eventsCmd,
 	imageAliasCmd,
 	imageAliasesCmd,
@@ -81,25 +83,42 @@ var api10 = []APIEndpoint{
 	imagesCmd,
 	imageSecretCmd,
 	metadataConfigurationCmd,
+	metadataConfigurationNetworkCmd,
 	networkCmd,
 	networkLeasesCmd,
 	networksCmd,
 	networkStateCmd,
+	networkHealthCmd,
+	networkRoutesCmd,
+	networkOVNCmd,
+	networkDetachUplinkCmd,
 	networkACLCmd,
 	networkACLsCmd,
 	networkACLLogCmd,
 	networkAddressSetCmd,
 	networkAddressSetsCmd,
 	networkAllocationsCmd,
+	networkExternalPortCmd,
+	networkExternalPortsCmd,
 	networkForwardCmd,
 	networkForwardsCmd,
 	networkIntegrationCmd,
+	networkIntegrationStateCmd,
 	networkIntegrationsCmd,
 	networkLoadBalancerCmd,
 	networkLoadBalancerStateCmd,
 	networkLoadBalancersCmd,
 	networkPeerCmd,
+	networkPeerRoutesCmd,
+	networkPeerStateCmd,
 	networkPeersCmd,
+	networkRestorePointCmd,
+	networkRestorePointDiffCmd,
+	networkRestorePointRestoreCmd,
+	networkRestorePointsCmd,
+	networkTargetGroupCmd,
+	networkTargetGroupsCmd,
+	networkTrafficCmd,
 	networkZoneCmd,
 	networkZonesCmd,
 	networkZoneRecordCmd,
@@ -835,9 +854,12 @@ func doApi10UpdateTriggers(d *Daemon, nodeChanged, clusterChanged map[string]str
 			// Notify the logging mechanism about changes to the deprecated keys for backward compatibility.
 			loggingChanges["loki"] = struct{}{}
 
-		case "network.ovn.northbound_connection", "network.ovn.ca_cert", "network.ovn.client_cert", "network.ovn.client_key":
+		case "network.ovn.northbound_connection", "network.ovn.southbound_connection", "network.ovn.ca_cert", "network.ovn.client_cert", "network.ovn.client_key":
 			ovnChanged = true
 
+		case "network.ovn.transaction_retries", "network.ovn.transaction_retry_delay":
+			ovn.SetTransactRetryPolicy(clusterConfig.NetworkOVNTransactionRetry())
+
 		case "oidc.issuer", "oidc.client.id", "oidc.audience", "oidc.claim":
 			oidcChanged = true
 
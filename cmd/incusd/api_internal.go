@@ -32,6 +32,7 @@ import (
 	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
 	"github.com/lxc/incus/v6/internal/server/instance"
 	"github.com/lxc/incus/v6/internal/server/instance/instancetype"
+	"github.com/lxc/incus/v6/internal/server/network"
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/response"
@@ -62,6 +63,8 @@ var apiInternal = []APIEndpoint{
 	internalGarbageCollectorCmd,
 	internalImageOptimizeCmd,
 	internalImageRefreshCmd,
+	internalOVNUnderlayCmd,
+	internalOVNUplinkProbeCmd,
 	internalRAFTSnapshotCmd,
 	internalRebalanceLoadCmd,
 	internalReadyCmd,
@@ -90,6 +93,18 @@ var internalImageOptimizeCmd = APIEndpoint{
 	Post: APIEndpointAction{Handler: internalOptimizeImage, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
 }
 
+var internalOVNUnderlayCmd = APIEndpoint{
+	Path: "ovn/underlay",
+
+	Get: APIEndpointAction{Handler: internalOVNUnderlay, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
+}
+
+var internalOVNUplinkProbeCmd = APIEndpoint{
+	Path: "ovn/uplink-probe",
+
+	Post: APIEndpointAction{Handler: internalOVNUplinkProbe, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
+}
+
 var internalRebalanceLoadCmd = APIEndpoint{
 	Path: "rebalance",
 
@@ -1101,6 +1116,34 @@ func internalBGPState(d *Daemon, _ *http.Request) response.Response {
 	return response.SyncResponse(true, s.BGP.Debug())
 }
 
+// internalOVNUnderlay returns the OVN tunnel underlay of the local member (nil if it doesn't run the OVN dataplane).
+func internalOVNUnderlay(d *Daemon, r *http.Request) response.Response {
+	underlay, err := network.OVNLocalUnderlay(r.Context(), d.State())
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, underlay)
+}
+
+// internalOVNUplinkProbe returns which of the requested addresses are already in use on an OVN uplink network, as
+// seen from the local member.
+func internalOVNUplinkProbe(d *Daemon, r *http.Request) response.Response {
+	req := network.OVNUplinkProbe{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	inUse, err := network.OVNLocalUplinkProbe(r.Context(), d.State(), req)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, inUse)
+}
+
 func internalRebalanceLoad(d *Daemon, _ *http.Request) response.Response {
 	err := autoRebalanceCluster(context.TODO(), d)
 	if err != nil {
@@ -13,11 +13,13 @@ import (
 	"github.com/lxc/incus/v6/internal/server/auth"
 	"github.com/lxc/incus/v6/internal/server/backup"
 	backupConfig "github.com/lxc/incus/v6/internal/server/backup/config"
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
 	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
 	"github.com/lxc/incus/v6/internal/server/instance"
 	"github.com/lxc/incus/v6/internal/server/instance/instancetype"
+	"github.com/lxc/incus/v6/internal/server/network"
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/response"
 	"github.com/lxc/incus/v6/internal/server/state"
@@ -137,6 +139,62 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 	// Used to store a handle to each pool containing user supplied config.
 	pools := make(map[string]storagePools.Pool)
 
+	// Used to store the OVN networks found in the OVN northbound database for each project.
+	projectRecoverNetworks := make(map[string]map[string]*network.OVNRecoverNetwork)
+
+	// recoverNetwork looks for a missing network used by an instance NIC in the OVN northbound database.
+	// Returns true if the network can be recovered.
+	recoverNetwork := func(networkProjectName string, networkName string, instanceUUID string, deviceName string) bool {
+		if projectRecoverNetworks[networkProjectName][networkName] != nil {
+			return true
+		}
+
+		// Network recovery isn't supported when clustered.
+		if s.ServerClustered {
+			return false
+		}
+
+		rec, err := network.OVNRecoverNetworkScan(ctx, s, networkName, instanceUUID, deviceName)
+		if err != nil {
+			logger.Debug("Failed looking up network in OVN", logger.Ctx{"project": networkProjectName, "network": networkName, "err": err})
+			return false
+		}
+
+		if rec == nil {
+			return false
+		}
+
+		// The OVN records can't be told apart from those of an existing network with the same ID.
+		for _, networks := range projectNetworks {
+			_, found := networks[rec.ID]
+			if found {
+				logger.Warn("Skipping OVN network recovery as its ID is used by another network", logger.Ctx{"project": networkProjectName, "network": networkName, "id": rec.ID})
+				return false
+			}
+		}
+
+		// Check that the uplink network exists.
+		foundUplink := false
+		for _, n := range projectNetworks[api.ProjectDefaultName] {
+			if n.Name == rec.Network.Config["network"] {
+				foundUplink = true
+				break
+			}
+		}
+
+		if !foundUplink {
+			addDependencyError(fmt.Errorf("Network %q in project %q", rec.Network.Config["network"], api.ProjectDefaultName))
+		}
+
+		if projectRecoverNetworks[networkProjectName] == nil {
+			projectRecoverNetworks[networkProjectName] = make(map[string]*network.OVNRecoverNetwork)
+		}
+
+		projectRecoverNetworks[networkProjectName][networkName] = rec
+
+		return true
+	}
+
 	// Iterate the pools finding unknown volumes and perform validation.
 	for _, p := range userPools {
 		pool, err := storagePools.LoadByName(s, p.Name)
@@ -252,7 +310,7 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 				}
 
 				// Check that the instance's NIC network dependencies are met.
-				for _, devConfig := range poolVol.Container.ExpandedDevices {
+				for devName, devConfig := range poolVol.Container.ExpandedDevices {
 					if devConfig["type"] != "nic" {
 						continue
 					}
@@ -269,7 +327,7 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 						}
 					}
 
-					if !foundNetwork {
+					if !foundNetwork && !recoverNetwork(networkProjectName, devConfig["network"], poolVol.Container.Config["volatile.uuid"], devName) {
 						addDependencyError(fmt.Errorf("Network %q in project %q", devConfig["network"], projectName))
 					}
 				}
@@ -312,6 +370,18 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 			}
 		}
 
+		for projectName, recoverNetworks := range projectRecoverNetworks {
+			for _, rec := range recoverNetworks {
+				res.UnknownNetworks = append(res.UnknownNetworks, internalRecover.ValidateNetwork{
+					Name:          rec.Network.Name,
+					Type:          rec.Network.Type,
+					Project:       projectName,
+					Forwards:      len(rec.Forwards),
+					LoadBalancers: len(rec.LoadBalancers),
+				})
+			}
+		}
+
 		return response.SyncResponse(true, &res)
 	}
 
@@ -383,6 +453,25 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 		}
 	}
 
+	// Recover the OVN networks used by the instances.
+	var recoveredNetworkIDs []int64
+	for _, recoverNetworks := range projectRecoverNetworks {
+		for _, rec := range recoverNetworks {
+			recoveredNetworkIDs = append(recoveredNetworkIDs, rec.ID)
+		}
+	}
+
+	for projectName, recoverNetworks := range projectRecoverNetworks {
+		for _, rec := range recoverNetworks {
+			cleanup, err := internalRecoverImportNetwork(ctx, s, projectName, rec, recoveredNetworkIDs)
+			if err != nil {
+				return response.SmartError(fmt.Errorf("Failed importing network %q in project %q: %w", rec.Network.Name, projectName, err))
+			}
+
+			reverter.Add(cleanup)
+		}
+	}
+
 	// Recover the storage volumes and buckets.
 	for _, pool := range pools {
 		for projectName, poolVols := range poolsProjectVols[pool.Name()] {
@@ -507,9 +596,111 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 	}
 
 	reverter.Success()
+
+	// Only remove the previous OVN records of the recovered networks once everything else succeeded, as they're
+	// the only copy of the recovered state until then.
+	for projectName, recoverNetworks := range projectRecoverNetworks {
+		for _, rec := range recoverNetworks {
+			err := network.OVNRecoverNetworkCleanup(ctx, s, rec)
+			if err != nil {
+				logger.Warn("Failed deleting previous OVN records of recovered network", logger.Ctx{"project": projectName, "network": rec.Network.Name, "err": err})
+			}
+		}
+	}
+
 	return response.EmptySyncResponse
 }
 
+// internalRecoverImportNetwork recreates an OVN network found in the OVN northbound database, along with its forwards
+// and load balancers. The network's previous OVN records are left in place, the new network being given an ID that
+// none of the recovered networks had, and must be removed with network.OVNRecoverNetworkCleanup once the recovery
+// succeeded.
+// Returns a revert fail function that can be used to undo this function if a subsequent step fails.
+func internalRecoverImportNetwork(ctx context.Context, s *state.State, projectName string, rec *network.OVNRecoverNetwork, recoveredNetworkIDs []int64) (revert.Hook, error) {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	netType, err := network.LoadByType(rec.Network.Type, projectName, rec.Network.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netType.FillConfig(ctx, rec.Network.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Creating network DB record from OVN", logger.Ctx{"project": projectName, "name": rec.Network.Name, "config": rec.Network.Config})
+
+	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID, err := tx.CreateNetwork(ctx, projectName, rec.Network.Name, rec.Network.Description, netType.DBType(), rec.Network.Config)
+		if err != nil {
+			return err
+		}
+
+		// The OVN records are named after the network ID, so don't reuse the ID of a recovered network while its
+		// previous records still exist. Network IDs are never reused, so creating the record again gives a new one.
+		for slices.Contains(recoveredNetworkIDs, networkID) {
+			err = tx.DeleteNetwork(ctx, projectName, rec.Network.Name)
+			if err != nil {
+				return err
+			}
+
+			networkID, err = tx.CreateNetwork(ctx, projectName, rec.Network.Name, rec.Network.Description, netType.DBType(), rec.Network.Config)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed creating network database entry: %w", err)
+	}
+
+	reverter.Add(func() {
+		_ = s.DB.Cluster.Transaction(context.Background(), func(ctx context.Context, tx *db.ClusterTx) error {
+			return tx.DeleteNetwork(ctx, projectName, rec.Network.Name)
+		})
+	})
+
+	n, err := network.LoadByName(s, projectName, rec.Network.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	err = doNetworksCreate(ctx, s, n, clusterRequest.ClientTypeNormal)
+	if err != nil {
+		return nil, err
+	}
+
+	reverter.Add(func() { _ = n.Delete(clusterRequest.ClientTypeNormal) })
+
+	err = s.Authorizer.AddNetwork(ctx, projectName, rec.Network.Name)
+	if err != nil {
+		logger.Error("Failed to add network to authorizer", logger.Ctx{"name": rec.Network.Name, "project": projectName, "error": err})
+	}
+
+	for _, forward := range rec.Forwards {
+		_, err = n.ForwardCreate(ctx, forward, clusterRequest.ClientTypeNormal)
+		if err != nil {
+			return nil, fmt.Errorf("Failed creating network forward %q: %w", forward.ListenAddress, err)
+		}
+	}
+
+	for _, loadBalancer := range rec.LoadBalancers {
+		_, err = n.LoadBalancerCreate(ctx, loadBalancer, clusterRequest.ClientTypeNormal)
+		if err != nil {
+			return nil, fmt.Errorf("Failed creating network load balancer %q: %w", loadBalancer.ListenAddress, err)
+		}
+	}
+
+	cleanup := reverter.Clone().Fail
+	reverter.Success()
+
+	return cleanup, nil
+}
+
 // internalRecoverImportInstance recreates the database records for an instance and returns the new instance.
 // Returns a revert fail function that can be used to undo this function if a subsequent step fails.
 func internalRecoverImportInstance(s *state.State, pool storagePools.Pool, projectName string, poolVol *backupConfig.Config, profiles []api.Profile) (instance.Instance, revert.Hook, error) {
@@ -38,6 +38,15 @@ var (
 	metricsCacheLock sync.Mutex
 )
 
+// metricsCacheDuration is how long gathered metrics are served from the cache.
+const metricsCacheDuration = 8 * time.Second
+
+// ovnMetricsCache holds the last gathered OVN metrics, shared by all projects.
+var (
+	ovnMetricsCache     metricsCacheEntry
+	ovnMetricsCacheLock sync.Mutex
+)
+
 var metricsCmd = APIEndpoint{
 	Path: "metrics",
 
@@ -130,6 +139,11 @@ func metricsGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	// Add OVN metrics if the OVN clients were set up by an OVN network.
+	if d.ovnConnected() {
+		metricSet.Merge(ovnMetricsCached(r.Context(), s))
+	}
+
 	// invalidProjectFilters returns project filters which are either not in cache or have expired.
 	invalidProjectFilters := func(projectNames []string) []dbCluster.InstanceFilter {
 		metricsCacheLock.Lock()
@@ -165,7 +179,7 @@ func metricsGet(d *Daemon, r *http.Request) response.Response {
 		return getFilteredMetrics(s, r, compress, metricSet)
 	}
 
-	cacheDuration := time.Duration(8) * time.Second
+	cacheDuration := metricsCacheDuration
 
 	// Acquire update lock.
 	lockCtx, lockCtxCancel := context.WithTimeout(r.Context(), cacheDuration)
@@ -394,3 +408,73 @@ func internalMetrics(ctx context.Context, daemonStartTime time.Time, tx *db.Clus
 
 	return out
 }
+
+// ovnMetricsCached returns the OVN metrics, only gathering them again once the cached ones have expired.
+func ovnMetricsCached(ctx context.Context, s *state.State) *metrics.MetricSet {
+	ovnMetricsCacheLock.Lock()
+	defer ovnMetricsCacheLock.Unlock()
+
+	if ovnMetricsCache.metrics == nil || ovnMetricsCache.expiry.Before(time.Now()) {
+		ovnMetricsCache = metricsCacheEntry{
+			metrics: ovnMetrics(ctx, s),
+			expiry:  time.Now().Add(metricsCacheDuration),
+		}
+	}
+
+	return ovnMetricsCache.metrics
+}
+
+// ovnMetrics gathers the OVN configuration sequence numbers and the state of the local chassis.
+// These are read from the monitored copies of the databases kept by the clients, so the OVN central databases
+// aren't queried.
+func ovnMetrics(ctx context.Context, s *state.State) *metrics.MetricSet {
+	out := metrics.NewMetricSet(nil)
+
+	ovnnb, ovnsb, err := s.OVN()
+	if err != nil {
+		logger.Warn("Failed to connect to OVN", logger.Ctx{"err": err})
+		return out
+	}
+
+	seqNumbers, err := ovnnb.GetSequenceNumbers(ctx)
+	if err != nil {
+		logger.Warn("Failed to get OVN sequence numbers", logger.Ctx{"err": err})
+		return out
+	}
+
+	// Configuration sequence numbers
+	out.AddSamples(metrics.OVNNorthboundSequence, metrics.Sample{Value: float64(seqNumbers.NbCfg)})
+	out.AddSamples(metrics.OVNSouthboundSequence, metrics.Sample{Value: float64(seqNumbers.SbCfg)})
+	out.AddSamples(metrics.OVNHypervisorSequence, metrics.Sample{Value: float64(seqNumbers.HvCfg)})
+
+	// Only report on the local chassis, each member reports its own.
+	vswitch, err := s.OVS()
+	if err != nil {
+		logger.Warn("Failed to connect to OVS", logger.Ctx{"err": err})
+		return out
+	}
+
+	chassisID, err := vswitch.GetChassisID(ctx)
+	if err != nil {
+		logger.Warn("Failed to get OVN chassis ID", logger.Ctx{"err": err})
+		return out
+	}
+
+	chassisStatus, err := ovnsb.GetChassisStatus(ctx)
+	if err != nil {
+		logger.Warn("Failed to get OVN chassis status", logger.Ctx{"err": err})
+		return out
+	}
+
+	for _, chassis := range chassisStatus {
+		if chassis.Name != chassisID {
+			continue
+		}
+
+		labels := map[string]string{"chassis": chassis.Name, "hostname": chassis.Hostname}
+		out.AddSamples(metrics.OVNChassisSequenceLag, metrics.Sample{Value: float64(max(seqNumbers.NbCfg-chassis.NbCfg, 0)), Labels: labels})
+		out.AddSamples(metrics.OVNChassisHeartbeatAgeSeconds, metrics.Sample{Value: time.Since(chassis.NbCfgTimestamp).Seconds(), Labels: labels})
+	}
+
+	return out
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/db/operationtype"
 	"github.com/lxc/incus/v6/internal/server/lifecycle"
 	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/network/acl"
 	"github.com/lxc/incus/v6/internal/server/operations"
 	projecthelpers "github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
@@ -285,6 +286,15 @@ func projectUsedBy(ctx context.Context, tx *db.ClusterTx, project *cluster.Proje
 
 	usedBy = append(usedBy, networkZones...)
 
+	targetGroups, err := cluster.GetNetworkTargetGroups(ctx, tx.Tx(), cluster.NetworkTargetGroupFilter{Project: &project.Name})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get URIs for network target groups: %w", err)
+	}
+
+	for _, targetGroup := range targetGroups {
+		usedBy = append(usedBy, api.NewURL().Path(version.APIVersion, "network-target-groups", targetGroup.Name).Project(project.Name).String())
+	}
+
 	profiles, err := cluster.GetProfiles(ctx, tx.Tx(), cluster.ProfileFilter{Project: &project.Name})
 	if err != nil {
 		return nil, err
@@ -374,6 +384,11 @@ func projectsPost(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(err)
 	}
 
+	err = projectValidateNetworkACLs(s, project.Name, project.Config)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	var id int64
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
 		id, err = cluster.CreateProject(ctx, tx.Tx(), cluster.Project{Description: project.Description, Name: project.Name})
@@ -765,6 +780,13 @@ func projectChange(ctx context.Context, s *state.State, project *api.Project, re
 		return response.BadRequest(err)
 	}
 
+	if slices.Contains(configChanged, "networks.default.security.acls") {
+		err = projectValidateNetworkACLs(s, project.Name, req.Config)
+		if err != nil {
+			return response.BadRequest(err)
+		}
+	}
+
 	// Update the database entry.
 	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		err := projecthelpers.AllowProjectUpdate(tx, project.Name, req.Config, configChanged)
@@ -772,6 +794,14 @@ func projectChange(ctx context.Context, s *state.State, project *api.Project, re
 			return err
 		}
 
+		// Check the existing networks still comply with the DNS domain restrictions.
+		if slices.Contains(configChanged, "restricted") || slices.Contains(configChanged, "restricted.networks.domains") {
+			err = projectValidateNetworkDomains(ctx, tx, project.Name, req.Config)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = cluster.UpdateProject(ctx, tx.Tx(), project.Name, req)
 		if err != nil {
 			return fmt.Errorf("Persist profile changes: %w", err)
@@ -805,9 +835,47 @@ func projectChange(ctx context.Context, s *state.State, project *api.Project, re
 		return response.SmartError(err)
 	}
 
+	// Apply the new default network ACLs to the existing networks of the project.
+	if slices.Contains(configChanged, "networks.default.security.acls") {
+		err = projectNetworksConfigUpdated(ctx, s, project.Name, project.Config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
 	return response.EmptySyncResponse
 }
 
+// projectNetworksConfigUpdated lets the networks of the project apply a change of the project configuration.
+func projectNetworksConfigUpdated(ctx context.Context, s *state.State, projectName string, oldConfig map[string]string) error {
+	var networkNames []string
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		networkNames, err = tx.GetCreatedNetworkNamesByProject(ctx, projectName)
+
+		return err
+	})
+	if err != nil && !response.IsNotFoundError(err) {
+		return fmt.Errorf("Failed loading networks of project %q: %w", projectName, err)
+	}
+
+	for _, networkName := range networkNames {
+		n, err := network.LoadByName(s, projectName, networkName)
+		if err != nil {
+			return fmt.Errorf("Failed loading network %q: %w", networkName, err)
+		}
+
+		err = n.ProjectConfigUpdated(oldConfig)
+		if err != nil {
+			return fmt.Errorf("Failed applying project configuration to network %q: %w", networkName, err)
+		}
+	}
+
+	return nil
+}
+
 // swagger:operation POST /1.0/projects/{name} projects project_post
 //
 //	Rename the project
@@ -1188,6 +1256,17 @@ func projectDelete(d *Daemon, r *http.Request) response.Response {
 			count--
 		}
 
+		// Delete network target groups.
+		for _, networkTargetGroupName := range entries["network-target-groups"] {
+			err := target.DeleteNetworkTargetGroup(networkTargetGroupName)
+			if err != nil {
+				return response.InternalError(err)
+			}
+
+			// Done deleting the network target group.
+			count--
+		}
+
 		// Delete network zones.
 		for _, networkZoneName := range entries["network-zones"] {
 			err := target.DeleteNetworkZone(networkZoneName)
@@ -1503,6 +1582,25 @@ func projectValidateConfig(s *state.State, config map[string]string) error {
 		//  shortdesc: Maximum number of networks that the project can have
 		"limits.networks": validate.Optional(validate.IsUint32),
 
+		// gendoc:generate(entity=project, group=specific, key=network.ovn.integration_bridge)
+		// Overrides the server-wide `network.ovn.integration_bridge` for the OVN networks of this project and the instance NICs connected to them.
+		// Instance NICs of projects without `features.networks` use the networks of the `default` project and so its setting.
+		// The bridge must exist on all cluster members and be handled by an `ovn-controller`.
+		// ---
+		//  type: string
+		//  defaultdesc: value of the server `network.ovn.integration_bridge` option
+		//  shortdesc: OVS integration bridge to use for OVN networks in the project
+		"network.ovn.integration_bridge": validate.Optional(validate.IsInterfaceName),
+
+		// gendoc:generate(entity=project, group=specific, key=networks.default.security.acls)
+		// Specify a comma-separated list of network ACLs from the project to apply to all of its OVN networks, in addition to their own `security.acls`.
+		// The ACLs must exist in the project, which requires `features.networks` unless it's the default project.
+		// Changes are applied to the existing networks and their running instance NICs.
+		// ---
+		//  type: string
+		//  shortdesc: Network ACLs to apply to all OVN networks in the project
+		"networks.default.security.acls": validate.Optional(validate.IsListOf(acl.ValidName)),
+
 		// gendoc:generate(entity=project, group=restricted, key=restricted)
 		// This option must be enabled to allow the `restricted.*` keys to take effect.
 		// To temporarily remove the restrictions, you can disable this option instead of clearing the related keys.
@@ -1739,6 +1837,36 @@ func projectValidateConfig(s *state.State, config map[string]string) error {
 		//  shortdesc: Which network names are allowed for use in this project
 		"restricted.networks.access": validate.Optional(validate.IsListOf(validate.IsAny)),
 
+		// gendoc:generate(entity=project, group=restricted, key=restricted.networks.acls.exclusive)
+		// Possible values are `allow` or `block`.
+		// When set to `allow`, network devices can set `security.acls.exclusive` to ignore the ACLs of their network.
+		// ---
+		//  type: string
+		//  defaultdesc: `block`
+		//  shortdesc: Whether network devices can ignore the ACLs of their network
+		"restricted.networks.acls.exclusive": isEitherAllowOrBlock,
+
+		// gendoc:generate(entity=project, group=restricted, key=restricted.networks.domains)
+		// Specify a comma-delimited list of DNS domains that OVN networks in this project can use for `dns.domain` and `dns.search`.
+		// Sub-domains of the listed domains are also allowed. Networks without a `dns.domain` use the `incus` domain, which must then be allowed too.
+		// ---
+		//  type: string
+		//  defaultdesc: all domains allowed
+		//  shortdesc: Which DNS domains can be used by networks in this project
+		"restricted.networks.domains": validate.Optional(validate.IsListOf(validate.IsAny)),
+
+		// gendoc:generate(entity=project, group=restricted, key=restricted.networks.external_ips)
+		// Specify a comma-delimited list of external subnets from the uplink networks that network forwards and load balancers in this project can use as listen addresses.
+		// Use the form `<uplink>:<subnet>`.
+		// These listen addresses must also be allowed by {config:option}`project-restricted:restricted.networks.subnets` (if set).
+		// ---
+		//  type: string
+		//  defaultdesc: all addresses allowed by `restricted.networks.subnets`
+		//  shortdesc: Which external addresses can be used by network forwards and load balancers in this project
+		"restricted.networks.external_ips": validate.Optional(func(value string) error {
+			return projectValidateRestrictedSubnets(s, value)
+		}),
+
 		// gendoc:generate(entity=project, group=restricted, key=restricted.networks.integrations)
 		// Specify a comma-delimited list of network integrations that can be used by networks in this project.
 		// ---
@@ -1877,6 +2005,52 @@ func projectValidateName(name string) error {
 	return nil
 }
 
+// projectValidateNetworkDomains checks that the DNS domains of the project's OVN networks are allowed by its config.
+func projectValidateNetworkDomains(ctx context.Context, tx *db.ClusterTx, projectName string, config map[string]string) error {
+	networkNames, err := tx.GetNetworks(ctx, projectName)
+	if err != nil {
+		return fmt.Errorf("Failed loading networks of project %q: %w", projectName, err)
+	}
+
+	for _, networkName := range networkNames {
+		_, netInfo, _, err := tx.GetNetworkInAnyState(ctx, projectName, networkName)
+		if err != nil {
+			return fmt.Errorf("Failed loading network %q: %w", networkName, err)
+		}
+
+		if netInfo.Type != "ovn" {
+			continue
+		}
+
+		err = network.DNSDomainsAllowed(config, netInfo.Config)
+		if err != nil {
+			return fmt.Errorf("Conflict detected with network %q: %w", networkName, err)
+		}
+	}
+
+	return nil
+}
+
+// projectValidateNetworkACLs checks that the project's default network ACLs exist in the project.
+func projectValidateNetworkACLs(s *state.State, projectName string, config map[string]string) error {
+	aclNames := util.SplitNTrimSpace(config["networks.default.security.acls"], ",", -1, true)
+	if len(aclNames) == 0 {
+		return nil
+	}
+
+	// Projects without their own networks use those of the default project, along with its configuration.
+	if projectName != api.ProjectDefaultName && !util.IsTrue(config["features.networks"]) {
+		return errors.New(`Default network ACLs can only be set on projects with "features.networks" enabled`)
+	}
+
+	err := acl.Exists(s, projectName, aclNames...)
+	if err != nil {
+		return fmt.Errorf("Invalid project configuration key %q value: %w", "networks.default.security.acls", err)
+	}
+
+	return nil
+}
+
 // projectValidateRestrictedSubnets checks that the project's restricted.networks.subnets are properly formatted
 // and are within the specified uplink network's routes.
 func projectValidateRestrictedSubnets(s *state.State, value string) error {
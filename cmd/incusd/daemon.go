@@ -1695,6 +1695,27 @@ func (d *Daemon) init() error {
 
 		// Remove expired tokens (hourly)
 		d.tasks.Add(autoRemoveExpiredTokensTask(d))
+
+		// Replicate OVN networks to their standby clusters (minutely)
+		d.tasks.Add(autoReplicateNetworksTask(d))
+
+		// Remove stale OVN DNS records and DHCP reservations (hourly)
+		d.tasks.Add(autoPruneNetworkStaleRecordsTask(d))
+
+		// Take scheduled network restore points and remove expired ones (minutely check of configurable cron expression)
+		d.tasks.Add(autoCreateNetworkRestorePointsTask(d))
+
+		// Record the traffic of the networks on this member (minutely)
+		d.tasks.Add(autoCollectNetworkTrafficTask(d))
+
+		// Probe the UDP backends of network load balancers from this member (every 5s)
+		d.tasks.Add(autoProbeNetworkLoadBalancersTask(d))
+
+		// Look for network addresses claimed by other hosts on the uplinks (minutely)
+		d.tasks.Add(autoCheckNetworkAddressConflictsTask(d))
+
+		// Move remote network peers to their backup integrations when needed (every 30s)
+		d.tasks.Add(autoFailoverNetworkPeersTask(d))
 	}
 
 	// Start all background tasks
@@ -2131,6 +2152,21 @@ func (d *Daemon) setupOpenFGA(apiURL string, apiToken string, storeID string) er
 				return err
 			}
 
+			err = query.Scan(ctx, tx.Tx(), "SELECT networks_target_groups.name, projects.name FROM networks_target_groups JOIN projects ON projects.id=networks_target_groups.project_id", func(scan func(dest ...any) error) error {
+				var networkTargetGroupName string
+				var projectName string
+				err := scan(&networkTargetGroupName, &projectName)
+				if err != nil {
+					return err
+				}
+
+				resources.NetworkTargetGroupObjects = append(resources.NetworkTargetGroupObjects, auth.ObjectNetworkTargetGroup(projectName, networkTargetGroupName))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
 			err = query.Scan(ctx, tx.Tx(), "SELECT networks_zones.name, projects.name FROM networks_zones JOIN projects ON projects.id=networks_zones.project_id", func(scan func(dest ...any) error) error {
 				var networkZoneName string
 				var projectName string
@@ -2567,16 +2603,32 @@ func (d *Daemon) setupOVN() error {
 	d.ovnnb = nil
 	d.ovnsb = nil
 
-	// Connect to OpenVswitch.
-	vswitch, err := d.getOVS()
-	if err != nil {
-		return fmt.Errorf("Failed to connect to OVS: %w", err)
+	// Use an in-memory OVN database in mock mode.
+	if d.os.MockMode {
+		fake, err := ovn.NewFake()
+		if err != nil {
+			return err
+		}
+
+		d.ovnnb = fake.NB
+		d.ovnsb = fake.SB
+
+		return nil
 	}
 
-	// Get the OVN southbound address.
-	ovnSBAddr, err := vswitch.GetOVNSouthboundDBRemoteAddress(d.shutdownCtx)
-	if err != nil {
-		return fmt.Errorf("Failed to get OVN southbound connection string: %w", err)
+	// Get the OVN southbound address, falling back to the one configured in OpenVswitch.
+	ovnSBAddr := d.globalConfig.NetworkOVNSouthboundConnection()
+	if ovnSBAddr == "" {
+		// Connect to OpenVswitch.
+		vswitch, err := d.getOVS()
+		if err != nil {
+			return fmt.Errorf("Failed to connect to OVS: %w", err)
+		}
+
+		ovnSBAddr, err = vswitch.GetOVNSouthboundDBRemoteAddress(d.shutdownCtx)
+		if err != nil {
+			return fmt.Errorf("Failed to get OVN southbound connection string: %w", err)
+		}
 	}
 
 	// Get the OVN northbound address.
@@ -2607,6 +2659,9 @@ func (d *Daemon) setupOVN() error {
 		}
 	}
 
+	// Apply the retry policy for transient northbound errors.
+	ovn.SetTransactRetryPolicy(d.globalConfig.NetworkOVNTransactionRetry())
+
 	// Get OVN northbound client.
 	ovnnb, err := ovn.NewNB(ovnNBAddr, sslCACert, sslClientCert, sslClientKey)
 	if err != nil {
@@ -2637,6 +2692,14 @@ func (d *Daemon) getOVN() (*ovn.NB, *ovn.SB, error) {
 	return d.ovnnb, d.ovnsb, nil
 }
 
+// ovnConnected returns whether the OVN clients have been set up.
+func (d *Daemon) ovnConnected() bool {
+	d.ovnMu.Lock()
+	defer d.ovnMu.Unlock()
+
+	return d.ovnnb != nil && d.ovnsb != nil
+}
+
 func (d *Daemon) setupOVS() error {
 	d.ovsMu.Lock()
 	defer d.ovsMu.Unlock()
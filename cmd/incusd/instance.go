@@ -263,6 +263,12 @@ func instanceCreateFromImage(ctx context.Context, s *state.State, img *api.Image
 			args.Config["oci.gid"] = fmt.Sprintf("%d", config.Process.User.GID)
 		}
 
+		// Record the ports declared by the image so they can be published.
+		exposedPorts := config.Annotations["org.opencontainers.image.exposedPorts"]
+		if exposedPorts != "" {
+			args.Config["volatile.container.oci.ports"] = exposedPorts
+		}
+
 		err = inst.Update(args, false)
 		if err != nil {
 			return err
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+
+	internalInstance "github.com/lxc/incus/v6/internal/instance"
+	"github.com/lxc/incus/v6/internal/server/instance"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// swagger:operation GET /1.0/instances/{name}/interfaces/{interface}/ovn instances instance_interface_ovn_get
+//
+//	Compare an instance NIC with its OVN records
+//
+//	Compares the expected OVN configuration of an instance NIC connected to an OVN network (addresses,
+//	DHCP options, port groups and routes) with its records in the OVN databases, highlighting mismatches.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: OVN comparison
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/InstanceInterfaceOVN"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func instanceInterfaceOVNGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName := request.ProjectParam(r)
+	name, err := url.PathUnescape(mux.Vars(r)["name"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if internalInstance.IsSnapshot(name) {
+		return response.BadRequest(errors.New("Invalid instance name"))
+	}
+
+	deviceName, err := url.PathUnescape(mux.Vars(r)["interface"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Handle requests targeted to an instance on a different member.
+	resp, err := forwardedResponseIfInstanceIsRemote(s, r, projectName, name)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if resp != nil {
+		return resp
+	}
+
+	inst, err := instance.LoadByProjectAndName(s, projectName, name)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	devConfig, found := inst.ExpandedDevices()[deviceName]
+	if !found || devConfig["type"] != "nic" {
+		return response.NotFound(fmt.Errorf("Network interface %q not found", deviceName))
+	}
+
+	if devConfig["network"] == "" {
+		return response.BadRequest(fmt.Errorf("Network interface %q isn't connected to a managed network", deviceName))
+	}
+
+	networkProjectName, reqProject, err := project.NetworkProject(s.DB.Cluster, inst.Project().Name)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, networkProjectName, devConfig["network"])
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, devConfig["network"], n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	// The MAC address is only stored in the volatile config unless set on the device.
+	config := devConfig.Clone()
+	if config["hwaddr"] == "" {
+		config["hwaddr"] = inst.LocalConfig()[fmt.Sprintf("volatile.%s.hwaddr", deviceName)]
+	}
+
+	diff, err := n.InstanceDevicePortDiff(r.Context(), inst.LocalConfig()["volatile.uuid"], deviceName, config, inst.IsRunning())
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Network interface %q isn't connected to an OVN network", deviceName))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, diff)
+}
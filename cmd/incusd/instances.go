@@ -172,6 +172,13 @@ var instanceDebugMemoryCmd = APIEndpoint{
 	Get: APIEndpointAction{Handler: instanceDebugMemoryGet, AccessHandler: allowPermission(auth.ObjectTypeInstance, auth.EntitlementCanEdit, "name")},
 }
 
+var instanceInterfaceOVNCmd = APIEndpoint{
+	Name: "instanceInterfaceOVN",
+	Path: "instances/{name}/interfaces/{interface}/ovn",
+
+	Get: APIEndpointAction{Handler: instanceInterfaceOVNGet, AccessHandler: allowPermission(auth.ObjectTypeInstance, auth.EntitlementCanView, "name")},
+}
+
 type instanceAutostartList []instance.Instance
 
 func (slice instanceAutostartList) Len() int {
@@ -1427,15 +1427,33 @@ func clusterCopyContainerInternal(ctx context.Context, s *state.State, r *http.R
 }
 
 func instanceCreateFinish(s *state.State, req *api.InstancesPost, args db.InstanceArgs, op *operations.Operation) error {
+	inst, err := instance.LoadByProjectAndName(s, args.Project, args.Name)
+	if err != nil {
+		return fmt.Errorf("Failed to load the instance: %w", err)
+	}
+
+	// Return the addresses of the pre-created NICs.
+	addresses := map[string][]string{}
+	for devName, dev := range inst.ExpandedDevices() {
+		if dev["type"] != "nic" || util.IsFalseOrEmpty(dev["precreate"]) {
+			continue
+		}
+
+		addresses[devName] = util.SplitNTrimSpace(inst.LocalConfig()[fmt.Sprintf("volatile.%s.last_state.ip_addresses", devName)], ",", -1, true)
+	}
+
+	if len(addresses) > 0 && op != nil {
+		err = op.ExtendMetadata(map[string]any{"addresses": addresses})
+		if err != nil {
+			return err
+		}
+	}
+
 	if req == nil || !req.Start {
 		return nil
 	}
 
 	// Start the instance.
-	inst, err := instance.LoadByProjectAndName(s, args.Project, args.Name)
-	if err != nil {
-		return fmt.Errorf("Failed to load the instance: %w", err)
-	}
 
 	inst.SetOperation(op)
 
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
 
 	"github.com/lxc/incus/v6/internal/server/metadata"
+	"github.com/lxc/incus/v6/internal/server/network"
 	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/shared/api"
 )
 
 var metadataConfigurationCmd = APIEndpoint{
@@ -13,6 +20,12 @@ var metadataConfigurationCmd = APIEndpoint{
 	Get: APIEndpointAction{Handler: metadataConfigurationGet, AllowUntrusted: true},
 }
 
+var metadataConfigurationNetworkCmd = APIEndpoint{
+	Path: "metadata/configuration/networks/{networkType}",
+
+	Get: APIEndpointAction{Handler: metadataConfigurationNetworkGet, AllowUntrusted: true},
+}
+
 // swagger:operation GET /1.0/metadata/configuration metadata_configuration_get
 //
 //	Get the metadata configuration
@@ -51,3 +64,63 @@ var metadataConfigurationCmd = APIEndpoint{
 func metadataConfigurationGet(_ *Daemon, _ *http.Request) response.Response {
 	return response.SyncResponse(true, metadata.Data)
 }
+
+// swagger:operation GET /1.0/metadata/configuration/networks/{networkType} metadata_configuration_network_get
+//
+//	Get the configuration schema of a network type
+//
+//	Returns the configuration keys accepted by the network type along with their type, default value and conditions.
+//
+//	---
+//	produces:
+//	  - application/json
+//	responses:
+//	  "200":
+//	    description: Network configuration schema
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/MetadataNetworkConfiguration"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func metadataConfigurationNetworkGet(_ *Daemon, r *http.Request) response.Response {
+	networkType, err := url.PathUnescape(mux.Vars(r)["networkType"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Only expose the schema of the network types known to the server.
+	_, err = network.LoadByType(networkType, api.ProjectDefaultName, "")
+	if err != nil {
+		if errors.Is(err, network.ErrUnknownDriver) {
+			return response.NotFound(fmt.Errorf("Unknown network type %q", networkType))
+		}
+
+		return response.SmartError(err)
+	}
+
+	keys, err := metadata.NetworkConfigKeys(networkType)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, api.MetadataNetworkConfiguration{Type: networkType, Keys: keys})
+}
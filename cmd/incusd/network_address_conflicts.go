@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/db/warningtype"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/internal/server/warnings"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// autoCheckNetworkAddressConflictsTask looks for addresses owned by the networks on their uplink (router addresses,
+// forwards, load balancers and SNAT addresses) which are claimed by other hosts, and raises a warning for them.
+func autoCheckNetworkAddressConflictsTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		var projectNetworks map[string]map[int64]api.Network
+
+		err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			var err error
+
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for address conflict detection", logger.Ctx{"err": err})
+			return
+		}
+
+		for projectName, networks := range projectNetworks {
+			for _, info := range networks {
+				if !network.IsAvailable(projectName, info.Name) {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for address conflict detection", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				conflicts, err := n.AddressConflicts()
+				if err != nil {
+					if !errors.Is(err, network.ErrNotImplemented) {
+						logger.Warn("Failed checking network address conflicts", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					}
+
+					continue
+				}
+
+				if len(conflicts) == 0 {
+					_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, projectName, warningtype.NetworkAddressConflict, dbCluster.TypeNetwork, int(n.ID()))
+					continue
+				}
+
+				messages := make([]string, 0, len(conflicts))
+				for _, conflict := range conflicts {
+					logger.Warn("Network address claimed by another host on the uplink", logger.Ctx{"project": projectName, "network": info.Name, "address": conflict.Address.String(), "owner": conflict.Owner, "hwaddr": conflict.MAC.String(), "source": conflict.Source})
+					messages = append(messages, fmt.Sprintf("Address %q (%s) is claimed by %q in the %s", conflict.Address.String(), conflict.Owner, conflict.MAC.String(), conflict.Source))
+				}
+
+				_ = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+					return tx.UpsertWarningLocalNode(ctx, projectName, dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkAddressConflict, strings.Join(messages, ", "))
+				})
+			}
+		}
+	}
+
+	return f, task.Every(time.Minute)
+}
@@ -166,7 +166,7 @@ func networkAllocationsGet(d *Daemon, r *http.Request) response.Response {
 				})
 			}
 
-			leases, err := n.Leases(projectName, clusterRequest.ClientTypeNormal)
+			leases, err := n.Leases(r.Context(), projectName, clusterRequest.ClientTypeNormal)
 			if err != nil && !errors.Is(network.ErrNotImplemented, err) {
 				return response.SmartError(fmt.Errorf("Failed getting leases for network %q in project %q: %w", networkName, projectName, err))
 			}
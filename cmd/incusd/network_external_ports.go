@@ -0,0 +1,600 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/filter"
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	localUtil "github.com/lxc/incus/v6/internal/server/util"
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/util"
+)
+
+var networkExternalPortsCmd = APIEndpoint{
+	Path: "networks/{networkName}/external-ports",
+
+	Get:  APIEndpointAction{Handler: networkExternalPortsGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+	Post: APIEndpointAction{Handler: networkExternalPortsPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkExternalPortCmd = APIEndpoint{
+	Path: "networks/{networkName}/external-ports/{portName}",
+
+	Delete: APIEndpointAction{Handler: networkExternalPortDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Get:    APIEndpointAction{Handler: networkExternalPortGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+	Put:    APIEndpointAction{Handler: networkExternalPortPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Patch:  APIEndpointAction{Handler: networkExternalPortPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+// networkExternalPortsLoadNetwork loads the network from the request and checks it supports external ports.
+// When manage is true, it also checks the caller is allowed to attach host interfaces to the network.
+func networkExternalPortsLoadNetwork(s *state.State, r *http.Request, manage bool) (network.Network, error) {
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return nil, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	if !n.Info().ExternalPorts {
+		return nil, api.StatusErrorf(http.StatusBadRequest, "Network driver %q does not support external ports", n.Type())
+	}
+
+	// External ports give access to the interfaces of the cluster members, so only server administrators
+	// and users of unrestricted projects can manage them.
+	if manage && util.IsTrue(reqProject.Config["restricted"]) {
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectServer(), auth.EntitlementCanEdit)
+		if err != nil {
+			if api.StatusErrorCheck(err, http.StatusForbidden) {
+				return nil, api.StatusErrorf(http.StatusForbidden, "External ports can't be managed in restricted projects")
+			}
+
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+// networkExternalPortLoad returns the external port from the request.
+func networkExternalPortLoad(s *state.State, r *http.Request, n network.Network) (*api.NetworkExternalPort, error) {
+	portName, err := url.PathUnescape(mux.Vars(r)["portName"])
+	if err != nil {
+		return nil, err
+	}
+
+	var port *api.NetworkExternalPort
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbRecord, err := dbCluster.GetNetworkExternalPort(ctx, tx.Tx(), n.ID(), portName)
+		if err != nil {
+			return err
+		}
+
+		port, err = dbRecord.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return port, nil
+}
+
+// API endpoints
+
+// swagger:operation GET /1.0/networks/{networkName}/external-ports network-external-ports network_external_ports_get
+//
+//  Get the network external ports
+//
+//  Returns a list of network external ports (URLs).
+//
+//  ---
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: query
+//      name: filter
+//      description: Collection filter
+//      type: string
+//      example: default
+//  responses:
+//    "200":
+//      description: API endpoints
+//      schema:
+//        type: object
+//        description: Sync response
+//        properties:
+//          type:
+//            type: string
+//            description: Response type
+//            example: sync
+//          status:
+//            type: string
+//            description: Status description
+//            example: Success
+//          status_code:
+//            type: integer
+//            description: Status code
+//            example: 200
+//          metadata:
+//            type: array
+//            description: List of endpoints
+//            items:
+//              type: string
+//            example: |-
+//              [
+//                "/1.0/networks/ovn0/external-ports/dpdk0",
+//                "/1.0/networks/ovn0/external-ports/dpdk1"
+//              ]
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+// swagger:operation GET /1.0/networks/{networkName}/external-ports?recursion=1 network-external-ports network_external_ports_get_recursion1
+//
+//  Get the network external ports
+//
+//  Returns a list of network external ports (structs).
+//
+//  ---
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: query
+//      name: filter
+//      description: Collection filter
+//      type: string
+//      example: default
+//  responses:
+//    "200":
+//      description: API endpoints
+//      schema:
+//        type: object
+//        description: Sync response
+//        properties:
+//          type:
+//            type: string
+//            description: Response type
+//            example: sync
+//          status:
+//            type: string
+//            description: Status description
+//            example: Success
+//          status_code:
+//            type: integer
+//            description: Status code
+//            example: 200
+//          metadata:
+//            type: array
+//            description: List of network external ports
+//            items:
+//              $ref: "#/definitions/NetworkExternalPort"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkExternalPortsGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkExternalPortsLoadNetwork(s, r, false)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	recursion := localUtil.IsRecursionRequest(r)
+
+	// Parse filter value.
+	filterStr := r.FormValue("filter")
+	clauses, err := filter.Parse(filterStr, filter.QueryOperatorSet())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid filter: %w", err))
+	}
+
+	mustLoadObjects := recursion || (clauses != nil && len(clauses.Clauses) > 0)
+
+	var records []*api.NetworkExternalPort
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+		dbRecords, err := dbCluster.GetNetworkExternalPorts(ctx, tx.Tx(), dbCluster.NetworkExternalPortFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		records = make([]*api.NetworkExternalPort, 0, len(dbRecords))
+		for _, dbRecord := range dbRecords {
+			if !mustLoadObjects {
+				records = append(records, &api.NetworkExternalPort{Name: dbRecord.Name})
+				continue
+			}
+
+			port, err := dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			records = append(records, port)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network external ports: %w", err))
+	}
+
+	linkResults := make([]string, 0, len(records))
+	fullResults := make([]api.NetworkExternalPort, 0, len(records))
+
+	for _, record := range records {
+		if clauses != nil && len(clauses.Clauses) > 0 {
+			match, err := filter.Match(*record, *clauses)
+			if err != nil {
+				return response.SmartError(err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		fullResults = append(fullResults, *record)
+		linkResults = append(linkResults, api.NewURL().Path(version.APIVersion, "networks", n.Name(), "external-ports", record.Name).String())
+	}
+
+	if recursion {
+		return response.SyncResponse(true, fullResults)
+	}
+
+	return response.SyncResponse(true, linkResults)
+}
+
+// swagger:operation POST /1.0/networks/{networkName}/external-ports network-external-ports network_external_ports_post
+//
+//	Add a network external port
+//
+//	Attaches an interface of the target cluster member to the network.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: body
+//	    name: port
+//	    description: External port
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkExternalPortsPost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkExternalPortsPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	n, err := networkExternalPortsLoadNetwork(s, r, true)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Parse the request into a record.
+	req := api.NetworkExternalPortsPost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	req.Normalise() // So we handle the request in normalised/canonical form.
+
+	err = n.ExternalPortCreate(req)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed creating external port: %w", err))
+	}
+
+	lc := lifecycle.NetworkExternalPortCreated.Event(n, req.Name, request.CreateRequestor(r), nil)
+	s.Events.SendLifecycle(n.Project(), lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation DELETE /1.0/networks/{networkName}/external-ports/{portName} network-external-ports network_external_port_delete
+//
+//	Delete the network external port
+//
+//	Detaches the interface from the network and removes the external port.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkExternalPortDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkExternalPortsLoadNetwork(s, r, true)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	port, err := networkExternalPortLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// External ports are managed by the member their interface is on.
+	resp := forwardedResponseToNode(s, r, port.Location)
+	if resp != nil {
+		return resp
+	}
+
+	err = n.ExternalPortDelete(port.Name)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed deleting external port: %w", err))
+	}
+
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkExternalPortDeleted.Event(n, port.Name, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation GET /1.0/networks/{networkName}/external-ports/{portName} network-external-ports network_external_port_get
+//
+//	Get the network external port
+//
+//	Gets a specific network external port.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: External port
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkExternalPort"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkExternalPortGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkExternalPortsLoadNetwork(s, r, false)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	port, err := networkExternalPortLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponseETag(true, port, port.Etag())
+}
+
+// swagger:operation PATCH /1.0/networks/{networkName}/external-ports/{portName} network-external-ports network_external_port_patch
+//
+//  Partially update the network external port
+//
+//  Updates a subset of the network external port configuration.
+//
+//  ---
+//  consumes:
+//    - application/json
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: body
+//      name: port
+//      description: External port configuration
+//      required: true
+//      schema:
+//        $ref: "#/definitions/NetworkExternalPortPut"
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "412":
+//      $ref: "#/responses/PreconditionFailed"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+// swagger:operation PUT /1.0/networks/{networkName}/external-ports/{portName} network-external-ports network_external_port_put
+//
+//	Update the network external port
+//
+//	Updates the entire network external port configuration.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: port
+//	    description: External port configuration
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkExternalPortPut"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "412":
+//	    $ref: "#/responses/PreconditionFailed"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkExternalPortPut(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkExternalPortsLoadNetwork(s, r, true)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	port, err := networkExternalPortLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// External ports are managed by the member their interface is on.
+	resp := forwardedResponseToNode(s, r, port.Location)
+	if resp != nil {
+		return resp
+	}
+
+	// Validate the ETag.
+	err = localUtil.EtagCheck(r, port.Etag())
+	if err != nil {
+		return response.PreconditionFailed(err)
+	}
+
+	// Decode the request.
+	req := api.NetworkExternalPortPut{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// If config being updated via "patch" method, then merge all existing config with the keys that
+	// are present in the request config.
+	if r.Method == http.MethodPatch {
+		if req.Config == nil {
+			req.Config = map[string]string{}
+		}
+
+		for k, v := range port.Config {
+			_, ok := req.Config[k]
+			if !ok {
+				req.Config[k] = v
+			}
+		}
+	}
+
+	req.Normalise() // So we handle the request in normalised/canonical form.
+
+	err = n.ExternalPortUpdate(port.Name, req)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed updating external port: %w", err))
+	}
+
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkExternalPortUpdated.Event(n, port.Name, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
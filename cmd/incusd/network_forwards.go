@@ -3,9 +3,12 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/gorilla/mux"
 
@@ -22,22 +25,24 @@ import (
 	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	"github.com/lxc/incus/v6/internal/version"
 	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
 )
 
 var networkForwardsCmd = APIEndpoint{
 	Path: "networks/{networkName}/forwards",
 
 	Get:  APIEndpointAction{Handler: networkForwardsGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Post: APIEndpointAction{Handler: networkForwardsPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Post: APIEndpointAction{Handler: networkForwardsPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageForwards, "networkName")},
 }
 
 var networkForwardCmd = APIEndpoint{
 	Path: "networks/{networkName}/forwards/{listenAddress}",
 
-	Delete: APIEndpointAction{Handler: networkForwardDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Delete: APIEndpointAction{Handler: networkForwardDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageForwards, "networkName")},
 	Get:    APIEndpointAction{Handler: networkForwardGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Put:    APIEndpointAction{Handler: networkForwardPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
-	Patch:  APIEndpointAction{Handler: networkForwardPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Post:   APIEndpointAction{Handler: networkForwardPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageForwards, "networkName")},
+	Put:    APIEndpointAction{Handler: networkForwardPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageForwards, "networkName")},
+	Patch:  APIEndpointAction{Handler: networkForwardPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageForwards, "networkName")},
 }
 
 // API endpoints
@@ -214,6 +219,8 @@ func networkForwardsGet(d *Daemon, r *http.Request) response.Response {
 			return response.SmartError(fmt.Errorf("Failed loading network forwards: %w", err))
 		}
 
+		networkForwardsFillStatus(r.Context(), n, slices.Collect(maps.Values(records))...)
+
 		for _, record := range records {
 
 			if clauses != nil && len(clauses.Clauses) > 0 {
@@ -340,12 +347,111 @@ func networkForwardsPost(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.ForwardCreate(req, clientType)
+	listenAddress, err := n.ForwardCreate(r.Context(), req, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed creating forward: %w", err))
 	}
 
-	lc := lifecycle.NetworkForwardCreated.Event(n, req.ListenAddress, request.CreateRequestor(r), nil)
+	lc := lifecycle.NetworkForwardCreated.Event(n, listenAddress.String(), request.CreateRequestor(r), nil)
+	s.Events.SendLifecycle(projectName, lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation POST /1.0/networks/{networkName}/forwards/{listenAddress} network-forwards network_forward_post
+//
+//	Move the network address forward
+//
+//	Moves the network address forward to another listen address, keeping its configuration.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: forward
+//	    description: New listen address
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkForwardPost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkForwardPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	if !n.Info().AddressForwards {
+		return response.BadRequest(fmt.Errorf("Network driver %q does not support forwards", n.Type()))
+	}
+
+	listenAddress, err := url.PathUnescape(mux.Vars(r)["listenAddress"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Decode the request.
+	req := api.NetworkForwardPost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	req.Normalise() // So we handle the request in normalised/canonical form.
+
+	if req.ListenAddress == "" {
+		return response.BadRequest(errors.New("New listen address not provided"))
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	err = n.ForwardRename(r.Context(), listenAddress, req.ListenAddress, clientType)
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Network driver %q does not support moving forwards", n.Type()))
+		}
+
+		return response.SmartError(fmt.Errorf("Failed moving forward: %w", err))
+	}
+
+	lc := lifecycle.NetworkForwardRenamed.Event(n, req.ListenAddress, request.CreateRequestor(r), map[string]any{"old_listen_address": listenAddress})
 	s.Events.SendLifecycle(projectName, lc)
 
 	return response.SyncResponseLocation(true, nil, lc.Source)
@@ -414,7 +520,7 @@ func networkForwardDelete(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.ForwardDelete(listenAddress, clientType)
+	err = n.ForwardDelete(r.Context(), listenAddress, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed deleting forward: %w", err))
 	}
@@ -545,6 +651,8 @@ func networkForwardGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	networkForwardsFillStatus(r.Context(), n, forward)
+
 	return response.SyncResponseETag(true, forward, forward.Etag())
 }
 
@@ -553,6 +661,7 @@ func networkForwardGet(d *Daemon, r *http.Request) response.Response {
 //  Partially update the network address forward
 //
 //  Updates a subset of the network address forward configuration.
+//  Individual ports can be added, modified or removed, matched on their listen port and protocol.
 //
 //  ---
 //  consumes:
@@ -567,10 +676,10 @@ func networkForwardGet(d *Daemon, r *http.Request) response.Response {
 //      example: default
 //    - in: body
 //      name: forward
-//      description: Address forward configuration
+//      description: Address forward changes
 //      required: true
 //      schema:
-//        $ref: "#/definitions/NetworkForwardPut"
+//        $ref: "#/definitions/NetworkForwardPatch"
 //  responses:
 //    "200":
 //      $ref: "#/responses/EmptySyncResponse"
@@ -654,17 +763,18 @@ func networkForwardPut(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	// Decode the request.
-	req := api.NetworkForwardPut{}
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return response.BadRequest(err)
-	}
-
 	targetMember := request.QueryParam(r, "target")
 	memberSpecific := targetMember != ""
 
+	// Decode the request.
+	req := api.NetworkForwardPut{}
 	if r.Method == http.MethodPatch {
+		patch := api.NetworkForwardPatch{}
+		err = json.NewDecoder(r.Body).Decode(&patch)
+		if err != nil {
+			return response.BadRequest(err)
+		}
+
 		var forward *api.NetworkForward
 
 		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
@@ -706,19 +816,15 @@ func networkForwardPut(d *Daemon, r *http.Request) response.Response {
 			return response.SmartError(err)
 		}
 
-		// If config being updated via "patch" method, then merge all existing config with the keys that
-		// are present in the request config.
-		for k, v := range forward.Config {
-			_, ok := req.Config[k]
-			if !ok {
-				req.Config[k] = v
-			}
+		// Apply the changes to the current forward, so the resulting ports are applied in a single update.
+		req, err = networkForwardPatch(forward, patch)
+		if err != nil {
+			return response.SmartError(err)
 		}
-
-		// If forward being updated via "patch" method and ports not specified, then merge existing ports
-		// into forward.
-		if req.Ports == nil {
-			req.Ports = forward.Ports
+	} else {
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			return response.BadRequest(err)
 		}
 	}
 
@@ -726,7 +832,7 @@ func networkForwardPut(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.ForwardUpdate(listenAddress, req, clientType)
+	err = n.ForwardUpdate(r.Context(), listenAddress, req, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed updating forward: %w", err))
 	}
@@ -735,3 +841,98 @@ func networkForwardPut(d *Daemon, r *http.Request) response.Response {
 
 	return response.EmptySyncResponse
 }
+
+// networkForwardsFillStatus sets the status of the forwards and resolves their target addresses to the instances
+// serving them.
+func networkForwardsFillStatus(ctx context.Context, n network.Network, forwards ...*api.NetworkForward) {
+	for _, forward := range forwards {
+		forward.Status = n.ListenAddressStatus(forward.Config)
+	}
+
+	instanceAddresses, err := n.InstanceAddresses(ctx)
+	if err != nil {
+		if !errors.Is(err, network.ErrNotImplemented) {
+			logger.Warn("Failed resolving network forward targets to instances", logger.Ctx{"project": n.Project(), "network": n.Name(), "err": err})
+		}
+
+		return
+	}
+
+	for _, forward := range forwards {
+		targetAddresses := []string{forward.Config["target_address"], forward.Config["target_address.ipv4"], forward.Config["target_address.ipv6"]}
+		for _, port := range forward.Ports {
+			targetAddresses = append(targetAddresses, port.TargetAddress)
+		}
+
+		forward.UsedBy, forward.UnmatchedTargetAddresses = network.TargetAddressesUsedBy(instanceAddresses, targetAddresses)
+	}
+}
+
+// networkForwardPatch applies a partial update to a network forward and returns the resulting forward definition.
+// Ports are matched on their listen port and protocol.
+func networkForwardPatch(forward *api.NetworkForward, patch api.NetworkForwardPatch) (api.NetworkForwardPut, error) {
+	patch.Normalise()
+
+	req := api.NetworkForwardPut{
+		Description: forward.Description,
+		Config:      map[string]string{},
+		Ports:       slices.Clone(forward.Ports),
+	}
+
+	if patch.Description != nil {
+		req.Description = *patch.Description
+	}
+
+	// Merge the keys present in the request into the existing config.
+	maps.Copy(req.Config, forward.Config)
+	maps.Copy(req.Config, patch.Config)
+
+	if patch.Ports != nil {
+		if len(patch.PortsAdd) > 0 || len(patch.PortsModify) > 0 || len(patch.PortsRemove) > 0 {
+			return req, api.StatusErrorf(http.StatusBadRequest, "Ports can't be replaced and modified in the same request")
+		}
+
+		req.Ports = patch.Ports
+
+		return req, nil
+	}
+
+	portIndex := func(port api.NetworkForwardPort) int {
+		return slices.IndexFunc(req.Ports, func(p api.NetworkForwardPort) bool {
+			return p.ListenPort == port.ListenPort && p.Protocol == port.Protocol
+		})
+	}
+
+	// Removing a port which doesn't exist is a no-op.
+	for _, port := range patch.PortsRemove {
+		i := portIndex(port)
+		if i >= 0 {
+			req.Ports = slices.Delete(req.Ports, i, i+1)
+		}
+	}
+
+	for _, port := range patch.PortsModify {
+		i := portIndex(port)
+		if i < 0 {
+			return req, api.StatusErrorf(http.StatusBadRequest, "No %s port forward found for listen port %q", port.Protocol, port.ListenPort)
+		}
+
+		req.Ports[i] = port
+	}
+
+	// Adding a port which already exists with the same settings is a no-op.
+	for _, port := range patch.PortsAdd {
+		i := portIndex(port)
+		if i >= 0 {
+			if req.Ports[i] != port {
+				return req, api.StatusErrorf(http.StatusConflict, "A different %s port forward already exists for listen port %q", port.Protocol, port.ListenPort)
+			}
+
+			continue
+		}
+
+		req.Ports = append(req.Ports, port)
+	}
+
+	return req, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network"
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/response"
@@ -43,6 +44,12 @@ var networkIntegrationCmd = APIEndpoint{
 	Post:   APIEndpointAction{Handler: networkIntegrationPost, AccessHandler: allowPermission(auth.ObjectTypeNetworkIntegration, auth.EntitlementCanEdit, "integration")},
 }
 
+var networkIntegrationStateCmd = APIEndpoint{
+	Path: "network-integrations/{integration}/state",
+
+	Get: APIEndpointAction{Handler: networkIntegrationStateGet, AccessHandler: allowPermission(auth.ObjectTypeNetworkIntegration, auth.EntitlementCanView, "integration")},
+}
+
 // API endpoints.
 
 // swagger:operation GET /1.0/network-integrations network-integrations network_integrations_get
@@ -841,6 +848,95 @@ func networkIntegrationPost(d *Daemon, r *http.Request) response.Response {
 	return response.EmptySyncResponse
 }
 
+// swagger:operation GET /1.0/network-integrations/{integration}/state network-integrations network_integration_state_get
+//
+//	Get the network integration state
+//
+//	Gets the state of the interconnection layer behind a specific network integration.
+//	This includes the reachability of its databases, the gateways, the transit switches and the binding
+//	state of the network peers using it.
+//
+//	---
+//	produces:
+//	  - application/json
+//	responses:
+//	  "200":
+//	    description: integration state
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkIntegrationState"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkIntegrationStateGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// Get the integration name.
+	integrationName, err := url.PathUnescape(mux.Vars(r)["integration"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Network integrations aren't project aware, we only load the per-project data to apply name restrictions.
+	projectName := request.ProjectParam(r)
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbProject, err := dbCluster.GetProject(ctx, tx.Tx(), projectName)
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", projectName, err)
+		}
+
+		p, err := dbProject.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", projectName, err)
+		}
+
+		if !project.NetworkIntegrationAllowed(p.Config, integrationName) {
+			return api.StatusErrorf(http.StatusNotFound, "Network integration not found")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	integrationState, err := network.IntegrationState(r.Context(), s, integrationName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, integrationState)
+}
+
+// networkIntegrationIsGatewayWeight validates a `<chassis>=<weight>` gateway weight entry.
+func networkIntegrationIsGatewayWeight(value string) error {
+	name, weight, found := strings.Cut(value, "=")
+	if !found || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("Invalid gateway weight %q, expected <chassis>=<weight>", value)
+	}
+
+	return validate.IsInRange(1, 100)(strings.TrimSpace(weight))
+}
+
 // networkIntegrationValidate validates the configuration keys/values for network integration.
 func networkIntegrationValidate(integrationType string, inUse bool, oldConfig map[string]string, config map[string]string) error {
 	if integrationType != "ovn" {
@@ -899,6 +995,34 @@ func networkIntegrationValidate(integrationType string, inUse bool, oldConfig ma
 		//  defaultdesc: `ts-incus-{{ integrationName }}-{{ projectName }}-{{ networkName }}`
 		//  shortdesc: Template for the transit switch name
 		"ovn.transit.pattern": validate.IsAny,
+
+		// gendoc:generate(entity=network_integration, group=ovn, key=ovn.gateways.exclude)
+		// Specify a comma-separated list of interconnection gateway chassis that mustn't be used for the transit switches.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Interconnection gateways to exclude
+		"ovn.gateways.exclude": validate.Optional(validate.IsListOf(validate.IsNotEmpty)),
+
+		// gendoc:generate(entity=network_integration, group=ovn, key=ovn.gateways.prefer_local)
+		// When enabled, the interconnection gateways which are also gateway chassis of the peered network
+		// are given the highest priorities, keeping the traffic on the chassis already handling it.
+		//
+		// ---
+		//  type: bool
+		//  defaultdesc: `false`
+		//  shortdesc: Whether to prefer the network's own gateway chassis
+		"ovn.gateways.prefer_local": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_integration, group=ovn, key=ovn.gateways.weights)
+		// Specify a comma-separated list of `<chassis>=<weight>` entries, with weights between 1 and 100.
+		// Gateways with a higher weight are more likely to be picked as the active gateway of a transit switch.
+		// Gateways which aren't listed have a weight of 1.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Weights of the interconnection gateways
+		"ovn.gateways.weights": validate.Optional(validate.IsListOf(networkIntegrationIsGatewayWeight)),
 	}
 
 	for k, v := range config {
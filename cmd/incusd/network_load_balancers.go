@@ -3,9 +3,13 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -19,25 +23,28 @@ import (
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/task"
 	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	"github.com/lxc/incus/v6/internal/version"
 	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
 )
 
 var networkLoadBalancersCmd = APIEndpoint{
 	Path: "networks/{networkName}/load-balancers",
 
 	Get:  APIEndpointAction{Handler: networkLoadBalancersGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Post: APIEndpointAction{Handler: networkLoadBalancersPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Post: APIEndpointAction{Handler: networkLoadBalancersPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageLoadBalancers, "networkName")},
 }
 
 var networkLoadBalancerCmd = APIEndpoint{
 	Path: "networks/{networkName}/load-balancers/{listenAddress}",
 
-	Delete: APIEndpointAction{Handler: networkLoadBalancerDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Delete: APIEndpointAction{Handler: networkLoadBalancerDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageLoadBalancers, "networkName")},
 	Get:    APIEndpointAction{Handler: networkLoadBalancerGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Put:    APIEndpointAction{Handler: networkLoadBalancerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
-	Patch:  APIEndpointAction{Handler: networkLoadBalancerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Post:   APIEndpointAction{Handler: networkLoadBalancerPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageLoadBalancers, "networkName")},
+	Put:    APIEndpointAction{Handler: networkLoadBalancerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageLoadBalancers, "networkName")},
+	Patch:  APIEndpointAction{Handler: networkLoadBalancerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManageLoadBalancers, "networkName")},
 }
 
 var networkLoadBalancerStateCmd = APIEndpoint{
@@ -222,6 +229,8 @@ func networkLoadBalancersGet(d *Daemon, r *http.Request) response.Response {
 			return response.SmartError(fmt.Errorf("Failed loading network load balancers: %w", err))
 		}
 
+		networkLoadBalancersFillStatus(r.Context(), n, slices.Collect(maps.Values(records))...)
+
 		for _, record := range records {
 			if clauses != nil && len(clauses.Clauses) > 0 {
 				match, err := filter.Match(*record, *clauses)
@@ -350,12 +359,111 @@ func networkLoadBalancersPost(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.LoadBalancerCreate(req, clientType)
+	listenAddress, err := n.LoadBalancerCreate(r.Context(), req, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed creating load balancer: %w", err))
 	}
 
-	lc := lifecycle.NetworkLoadBalancerCreated.Event(n, req.ListenAddress, request.CreateRequestor(r), nil)
+	lc := lifecycle.NetworkLoadBalancerCreated.Event(n, listenAddress.String(), request.CreateRequestor(r), nil)
+	s.Events.SendLifecycle(projectName, lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation POST /1.0/networks/{networkName}/load-balancers/{listenAddress} network-load-balancers network_load_balancer_post
+//
+//	Move the network load balancer
+//
+//	Moves the network load balancer to another listen address, keeping its configuration.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: load-balancer
+//	    description: New listen address
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkLoadBalancerPost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkLoadBalancerPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	if !n.Info().LoadBalancers {
+		return response.BadRequest(fmt.Errorf("Network driver %q does not support load balancers", n.Type()))
+	}
+
+	listenAddress, err := url.PathUnescape(mux.Vars(r)["listenAddress"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Decode the request.
+	req := api.NetworkLoadBalancerPost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	req.Normalise() // So we handle the request in normalised/canonical form.
+
+	if req.ListenAddress == "" {
+		return response.BadRequest(errors.New("New listen address not provided"))
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	err = n.LoadBalancerRename(r.Context(), listenAddress, req.ListenAddress, clientType)
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Network driver %q does not support moving load balancers", n.Type()))
+		}
+
+		return response.SmartError(fmt.Errorf("Failed moving load balancer: %w", err))
+	}
+
+	lc := lifecycle.NetworkLoadBalancerRenamed.Event(n, req.ListenAddress, request.CreateRequestor(r), map[string]any{"old_listen_address": listenAddress})
 	s.Events.SendLifecycle(projectName, lc)
 
 	return response.SyncResponseLocation(true, nil, lc.Source)
@@ -424,7 +532,7 @@ func networkLoadBalancerDelete(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.LoadBalancerDelete(listenAddress, clientType)
+	err = n.LoadBalancerDelete(r.Context(), listenAddress, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed deleting load balancer: %w", err))
 	}
@@ -540,6 +648,8 @@ func networkLoadBalancerGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	networkLoadBalancersFillStatus(r.Context(), n, loadBalancer)
+
 	return response.SyncResponseETag(true, loadBalancer, loadBalancer.Etag())
 }
 
@@ -713,7 +823,7 @@ func networkLoadBalancerPut(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	err = n.LoadBalancerUpdate(listenAddress, req, clientType)
+	err = n.LoadBalancerUpdate(r.Context(), listenAddress, req, clientType)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed updating load balancer: %w", err))
 	}
@@ -829,10 +939,119 @@ func networkLoadBalancerStateGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	lbState, err := n.LoadBalancerState(*loadBalancer)
+	lbState, err := n.LoadBalancerState(r.Context(), *loadBalancer)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed fetching load balancer state: %w", err))
 	}
 
 	return response.SyncResponse(true, lbState)
 }
+
+// autoProbeNetworkLoadBalancersTask runs the UDP probes of the network load balancers from this member.
+// Each member probes the backends itself as it advertises the load balancers it considers healthy. The task only
+// sets the pace, each load balancer is probed according to its own interval.
+func autoProbeNetworkLoadBalancersTask(d *Daemon) (task.Func, task.Schedule) {
+	// Networks probed on the previous run, so they can release their probe port once their probes are removed.
+	probedNetworks := map[int64]bool{}
+
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// As this runs every few seconds, only load the networks with load balancers using UDP probes.
+		probeNetworks := map[int64]bool{}
+		var projectNetworks map[string]map[int64]api.Network
+
+		err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			probeKey := "healthcheck.udp.probe"
+
+			probeConfigs, err := dbCluster.GetConfig(ctx, tx.Tx(), "networks_load_balancers", "network_load_balancer", dbCluster.ConfigFilter{Key: &probeKey})
+			if err != nil {
+				return err
+			}
+
+			if len(probeConfigs) > 0 {
+				loadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx())
+				if err != nil {
+					return err
+				}
+
+				for _, loadBalancer := range loadBalancers {
+					_, found := probeConfigs[int(loadBalancer.ID)]
+					if found {
+						probeNetworks[loadBalancer.NetworkID] = true
+					}
+				}
+			}
+
+			if len(probeNetworks) == 0 && len(probedNetworks) == 0 {
+				return nil
+			}
+
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for load balancer probes", logger.Ctx{"err": err})
+			return
+		}
+
+		previouslyProbed := probedNetworks
+		probedNetworks = map[int64]bool{}
+
+		for projectName, networks := range projectNetworks {
+			for networkID, info := range networks {
+				if !probeNetworks[networkID] && !previouslyProbed[networkID] {
+					continue
+				}
+
+				if !network.IsAvailable(projectName, info.Name) {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for load balancer probes", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				err = n.LoadBalancerProbe()
+				if err != nil && !errors.Is(err, network.ErrNotImplemented) {
+					logger.Warn("Failed probing network load balancers", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+				}
+
+				if probeNetworks[networkID] {
+					probedNetworks[networkID] = true
+				}
+			}
+		}
+	}
+
+	return f, task.Every(5 * time.Second)
+}
+
+// networkLoadBalancersFillStatus sets the status of the load balancers and resolves their backend addresses to the
+// instances serving them.
+func networkLoadBalancersFillStatus(ctx context.Context, n network.Network, loadBalancers ...*api.NetworkLoadBalancer) {
+	for _, loadBalancer := range loadBalancers {
+		loadBalancer.Status = n.ListenAddressStatus(loadBalancer.Config)
+	}
+
+	instanceAddresses, err := n.InstanceAddresses(ctx)
+	if err != nil {
+		if !errors.Is(err, network.ErrNotImplemented) {
+			logger.Warn("Failed resolving network load balancer backends to instances", logger.Ctx{"project": n.Project(), "network": n.Name(), "err": err})
+		}
+
+		return
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		targetAddresses := make([]string, 0, len(loadBalancer.Backends))
+		for _, backend := range loadBalancer.Backends {
+			targetAddresses = append(targetAddresses, backend.TargetAddress)
+		}
+
+		loadBalancer.UsedBy, loadBalancer.UnmatchedTargetAddresses = network.TargetAddressesUsedBy(instanceAddresses, targetAddresses)
+	}
+}
@@ -3,14 +3,17 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/lxc/incus/v6/internal/filter"
 	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/cluster"
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/lifecycle"
@@ -18,25 +21,39 @@ import (
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/task"
 	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	"github.com/lxc/incus/v6/internal/version"
 	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
 )
 
 var networkPeersCmd = APIEndpoint{
 	Path: "networks/{networkName}/peers",
 
 	Get:  APIEndpointAction{Handler: networkPeersGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Post: APIEndpointAction{Handler: networkPeersPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Post: APIEndpointAction{Handler: networkPeersPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManagePeers, "networkName")},
 }
 
 var networkPeerCmd = APIEndpoint{
 	Path: "networks/{networkName}/peers/{peerName}",
 
-	Delete: APIEndpointAction{Handler: networkPeerDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Delete: APIEndpointAction{Handler: networkPeerDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManagePeers, "networkName")},
 	Get:    APIEndpointAction{Handler: networkPeerGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
-	Put:    APIEndpointAction{Handler: networkPeerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
-	Patch:  APIEndpointAction{Handler: networkPeerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Put:    APIEndpointAction{Handler: networkPeerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManagePeers, "networkName")},
+	Patch:  APIEndpointAction{Handler: networkPeerPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManagePeers, "networkName")},
+}
+
+var networkPeerRoutesCmd = APIEndpoint{
+	Path: "networks/{networkName}/peers/{peerName}/routes",
+
+	Get: APIEndpointAction{Handler: networkPeerRoutesGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkPeerStateCmd = APIEndpoint{
+	Path: "networks/{networkName}/peers/{peerName}/state",
+
+	Post: APIEndpointAction{Handler: networkPeerStatePost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanManagePeers, "networkName")},
 }
 
 // API endpoints
@@ -340,7 +357,7 @@ func networkPeersPost(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(fmt.Errorf("Network driver %q does not support peering", n.Type()))
 	}
 
-	err = n.PeerCreate(req)
+	err = n.PeerCreate(r.Context(), req)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed creating peer: %w", err))
 	}
@@ -412,7 +429,7 @@ func networkPeerDelete(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	err = n.PeerDelete(peerName)
+	err = n.PeerDelete(r.Context(), peerName)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed deleting peer: %w", err))
 	}
@@ -637,7 +654,7 @@ func networkPeerPut(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(err)
 	}
 
-	err = n.PeerUpdate(peerName, req)
+	err = n.PeerUpdate(r.Context(), peerName, req)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed updating peer: %w", err))
 	}
@@ -646,3 +663,237 @@ func networkPeerPut(d *Daemon, r *http.Request) response.Response {
 
 	return response.EmptySyncResponse
 }
+
+// swagger:operation POST /1.0/networks/{networkName}/peers/{peerName}/state network-peers network_peer_state_post
+//
+//	Approve or reject the network peer
+//
+//	Approves or rejects a peering between networks of different projects, on the side whose approval is awaited.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: state
+//	    description: Requested action
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkPeerStatePost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkPeerStatePost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	if !n.Info().Peering {
+		return response.BadRequest(fmt.Errorf("Network driver %q does not support peering", n.Type()))
+	}
+
+	peerName, err := url.PathUnescape(mux.Vars(r)["peerName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Decode the request.
+	req := api.NetworkPeerStatePost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	var action lifecycle.NetworkPeerAction
+	switch req.Action {
+	case "approve":
+		action = lifecycle.NetworkPeerApproved
+	case "reject":
+		action = lifecycle.NetworkPeerRejected
+	default:
+		return response.BadRequest(fmt.Errorf("Unknown action %q", req.Action))
+	}
+
+	err = n.PeerApprove(r.Context(), peerName, req.Action == "approve")
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed updating peer state: %w", err))
+	}
+
+	s.Events.SendLifecycle(projectName, action.Event(n, peerName, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation GET /1.0/networks/{networkName}/peers/{peerName}/routes network-peers network_peer_routes_get
+//
+//	Get the routes of the network peer
+//
+//	Lists the routes a remote network peer advertises to its integration, along with the ones its export
+//	filter holds back.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Peer routes
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkPeerRoutes"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkPeerRoutesGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	if !n.Info().Peering {
+		return response.BadRequest(fmt.Errorf("Network driver %q does not support peering", n.Type()))
+	}
+
+	peerName, err := url.PathUnescape(mux.Vars(r)["peerName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	routes, err := n.PeerRoutes(r.Context(), peerName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed getting peer routes: %w", err))
+	}
+
+	return response.SyncResponse(true, routes)
+}
+
+// autoFailoverNetworkPeersTask moves the remote network peers with backup integrations to the most preferred
+// integration which can be reached.
+func autoFailoverNetworkPeersTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// Only run on the leader when clustered so that each peer is only moved once.
+		leader, err := s.Cluster.LeaderAddress()
+		if err != nil && !errors.Is(err, cluster.ErrNodeIsNotClustered) {
+			logger.Error("Failed to get leader cluster member address", logger.Ctx{"err": err})
+			return
+		}
+
+		if err == nil && s.LocalConfig.ClusterAddress() != leader {
+			return // Skip failover if not cluster leader.
+		}
+
+		var projectNetworks map[string]map[int64]api.Network
+		err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for peer failover", logger.Ctx{"err": err})
+			return
+		}
+
+		for projectName, networks := range projectNetworks {
+			for _, info := range networks {
+				if info.Type != "ovn" {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for peer failover", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				err = n.PeerFailover(ctx)
+				if err != nil {
+					logger.Warn("Failed checking network peer integrations", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+				}
+			}
+		}
+	}
+
+	return f, task.Every(30 * time.Second)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lxc/incus/v6/internal/server/cluster"
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// autoReplicateNetworksTask pushes the configuration of OVN networks that have a replication target to
+// their standby copy on the remote cluster.
+func autoReplicateNetworksTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// Only run on the leader when clustered so that each network is only pushed once.
+		leader, err := s.Cluster.LeaderAddress()
+		if err != nil && !errors.Is(err, cluster.ErrNodeIsNotClustered) {
+			logger.Error("Failed to get leader cluster member address", logger.Ctx{"err": err})
+			return
+		}
+
+		if err == nil && s.LocalConfig.ClusterAddress() != leader {
+			return // Skip replication if not cluster leader.
+		}
+
+		var projectNetworks map[string]map[int64]api.Network
+		err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for replication", logger.Ctx{"err": err})
+			return
+		}
+
+		for projectName, networks := range projectNetworks {
+			for _, info := range networks {
+				if info.Type != "ovn" || info.Config["replication.target.address"] == "" {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for replication", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				err = n.Replicate()
+				if err != nil {
+					logger.Warn("Failed replicating network", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+				}
+			}
+		}
+	}
+
+	return f, task.Every(time.Minute)
+}
@@ -0,0 +1,1114 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	internalInstance "github.com/lxc/incus/v6/internal/instance"
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/cluster"
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/internal/server/task"
+	localUtil "github.com/lxc/incus/v6/internal/server/util"
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+	"github.com/lxc/incus/v6/shared/revert"
+)
+
+// networkRestorePointRestoreTimeout is how long restoring a network from a restore point may take.
+const networkRestorePointRestoreTimeout = 5 * time.Minute
+
+var networkRestorePointsCmd = APIEndpoint{
+	Path: "networks/{networkName}/restore-points",
+
+	Get:  APIEndpointAction{Handler: networkRestorePointsGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+	Post: APIEndpointAction{Handler: networkRestorePointsPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkRestorePointCmd = APIEndpoint{
+	Path: "networks/{networkName}/restore-points/{restorePointName}",
+
+	Delete: APIEndpointAction{Handler: networkRestorePointDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Get:    APIEndpointAction{Handler: networkRestorePointGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkRestorePointDiffCmd = APIEndpoint{
+	Path: "networks/{networkName}/restore-points/{restorePointName}/diff",
+
+	Get: APIEndpointAction{Handler: networkRestorePointDiffGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkRestorePointRestoreCmd = APIEndpoint{
+	Path: "networks/{networkName}/restore-points/{restorePointName}/restore",
+
+	Post: APIEndpointAction{Handler: networkRestorePointRestorePost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+// networkRestorePointsLoadNetwork loads the network from the request and checks it supports restore points.
+func networkRestorePointsLoadNetwork(s *state.State, r *http.Request) (network.Network, error) {
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return nil, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	if !n.Info().RestorePoints {
+		return nil, api.StatusErrorf(http.StatusBadRequest, "Network driver %q does not support restore points", n.Type())
+	}
+
+	return n, nil
+}
+
+// networkRestorePointLoad returns the restore point from the request.
+func networkRestorePointLoad(s *state.State, r *http.Request, n network.Network) (*api.NetworkRestorePoint, error) {
+	restorePointName, err := url.PathUnescape(mux.Vars(r)["restorePointName"])
+	if err != nil {
+		return nil, err
+	}
+
+	var dbRecord *db.NetworkRestorePoint
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbRecord, err = tx.GetNetworkRestorePoint(ctx, n.ID(), restorePointName)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return networkRestorePointToAPI(*dbRecord)
+}
+
+// networkRestorePointToAPI converts a restore point DB record into the API type.
+func networkRestorePointToAPI(dbRecord db.NetworkRestorePoint) (*api.NetworkRestorePoint, error) {
+	restorePoint := api.NetworkRestorePoint{
+		Name:      dbRecord.Name,
+		CreatedAt: dbRecord.CreationDate,
+		ExpiresAt: dbRecord.ExpiryDate,
+	}
+
+	err := json.Unmarshal([]byte(dbRecord.Definition), &restorePoint.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing restore point %q: %w", dbRecord.Name, err)
+	}
+
+	networkRestorePointDefinitionNormalise(&restorePoint.Definition)
+
+	return &restorePoint, nil
+}
+
+// networkRestorePointDefinitionNormalise puts the definition in canonical form so that definitions can be compared.
+func networkRestorePointDefinitionNormalise(def *api.NetworkRestorePointDefinition) {
+	if def.Config == nil {
+		def.Config = map[string]string{}
+	}
+
+	if def.Forwards == nil {
+		def.Forwards = []api.NetworkForwardsPost{}
+	}
+
+	for i := range def.Forwards {
+		if def.Forwards[i].Config == nil {
+			def.Forwards[i].Config = map[string]string{}
+		}
+
+		if def.Forwards[i].Ports == nil {
+			def.Forwards[i].Ports = []api.NetworkForwardPort{}
+		}
+
+		def.Forwards[i].Normalise()
+	}
+
+	if def.LoadBalancers == nil {
+		def.LoadBalancers = []api.NetworkLoadBalancersPost{}
+	}
+
+	for i := range def.LoadBalancers {
+		if def.LoadBalancers[i].Config == nil {
+			def.LoadBalancers[i].Config = map[string]string{}
+		}
+
+		if def.LoadBalancers[i].Backends == nil {
+			def.LoadBalancers[i].Backends = []api.NetworkLoadBalancerBackend{}
+		}
+
+		if def.LoadBalancers[i].Ports == nil {
+			def.LoadBalancers[i].Ports = []api.NetworkLoadBalancerPort{}
+		}
+
+		def.LoadBalancers[i].Normalise()
+	}
+
+	slices.SortFunc(def.Forwards, func(a api.NetworkForwardsPost, b api.NetworkForwardsPost) int {
+		return strings.Compare(a.ListenAddress, b.ListenAddress)
+	})
+
+	slices.SortFunc(def.LoadBalancers, func(a api.NetworkLoadBalancersPost, b api.NetworkLoadBalancersPost) int {
+		return strings.Compare(a.ListenAddress, b.ListenAddress)
+	})
+}
+
+// networkRestorePointDefinition returns the current definition of the network as saved in a restore point.
+func networkRestorePointDefinition(ctx context.Context, s *state.State, n network.Network) (*api.NetworkRestorePointDefinition, error) {
+	def := api.NetworkRestorePointDefinition{
+		Description: n.Description(),
+		Config:      map[string]string{},
+	}
+
+	// Volatile keys hold runtime state rather than user configuration.
+	for k, v := range n.Config() {
+		if strings.HasPrefix(k, internalInstance.ConfigVolatilePrefix) {
+			continue
+		}
+
+		def.Config[k] = v
+	}
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		forwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed loading network forwards: %w", err)
+		}
+
+		for _, dbRecord := range forwards {
+			forward, err := dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			def.Forwards = append(def.Forwards, api.NetworkForwardsPost{
+				NetworkForwardPut: forward.Writable(),
+				ListenAddress:     forward.ListenAddress,
+			})
+		}
+
+		loadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancers: %w", err)
+		}
+
+		for _, dbRecord := range loadBalancers {
+			loadBalancer, err := dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			def.LoadBalancers = append(def.LoadBalancers, api.NetworkLoadBalancersPost{
+				NetworkLoadBalancerPut: loadBalancer.Writable(),
+				ListenAddress:          loadBalancer.ListenAddress,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	networkRestorePointDefinitionNormalise(&def)
+
+	return &def, nil
+}
+
+// networkRestorePointCreate saves the current definition of the network in a new restore point.
+// If onlyIfChanged is true, no restore point is created when the definition matches the most recent restore point.
+func networkRestorePointCreate(ctx context.Context, s *state.State, n network.Network, name string, expiry time.Time, onlyIfChanged bool) (bool, error) {
+	def, err := networkRestorePointDefinition(ctx, s, n)
+	if err != nil {
+		return false, err
+	}
+
+	defJSON, err := json.Marshal(def)
+	if err != nil {
+		return false, err
+	}
+
+	created := false
+	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		if onlyIfChanged {
+			restorePoints, err := tx.GetNetworkRestorePoints(ctx, n.ID())
+			if err != nil {
+				return err
+			}
+
+			if len(restorePoints) > 0 && restorePoints[len(restorePoints)-1].Definition == string(defJSON) {
+				return nil
+			}
+		}
+
+		err = tx.CreateNetworkRestorePoint(ctx, db.NetworkRestorePoint{
+			NetworkID:    n.ID(),
+			Name:         name,
+			CreationDate: time.Now().UTC(),
+			ExpiryDate:   expiry,
+			Definition:   string(defJSON),
+		})
+		if err != nil {
+			return err
+		}
+
+		created = true
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+// networkRestorePointDiff returns the changes needed to go from the current network definition to the one saved
+// in a restore point.
+func networkRestorePointDiff(networkName string, current *api.NetworkRestorePointDefinition, target *api.NetworkRestorePointDefinition) []api.NetworkRestorePointChange {
+	changes := []api.NetworkRestorePointChange{}
+
+	// diffConfig appends a change for each config key that differs between the two maps.
+	diffConfig := func(entity string, name string, current map[string]string, target map[string]string) {
+		keys := slices.Sorted(maps.Keys(current))
+		for k := range target {
+			_, ok := current[k]
+			if !ok {
+				keys = append(keys, k)
+			}
+		}
+
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			if current[k] == target[k] {
+				continue
+			}
+
+			changes = append(changes, api.NetworkRestorePointChange{
+				Entity:       entity,
+				Name:         name,
+				Field:        "config." + k,
+				Current:      current[k],
+				RestorePoint: target[k],
+			})
+		}
+	}
+
+	// diffField appends a change if the JSON encoding of the two values differs.
+	diffField := func(entity string, name string, field string, current any, target any) {
+		currentJSON, _ := json.Marshal(current)
+		targetJSON, _ := json.Marshal(target)
+
+		if string(currentJSON) == string(targetJSON) {
+			return
+		}
+
+		changes = append(changes, api.NetworkRestorePointChange{
+			Entity:       entity,
+			Name:         name,
+			Field:        field,
+			Current:      string(currentJSON),
+			RestorePoint: string(targetJSON),
+		})
+	}
+
+	if current.Description != target.Description {
+		changes = append(changes, api.NetworkRestorePointChange{
+			Entity:       "network",
+			Name:         networkName,
+			Field:        "description",
+			Current:      current.Description,
+			RestorePoint: target.Description,
+		})
+	}
+
+	diffConfig("network", networkName, current.Config, target.Config)
+
+	// Forwards.
+	currentForwards := make(map[string]api.NetworkForwardsPost, len(current.Forwards))
+	for _, forward := range current.Forwards {
+		currentForwards[forward.ListenAddress] = forward
+	}
+
+	targetForwards := make(map[string]api.NetworkForwardsPost, len(target.Forwards))
+	for _, forward := range target.Forwards {
+		targetForwards[forward.ListenAddress] = forward
+	}
+
+	for _, listenAddress := range slices.Sorted(maps.Keys(currentForwards)) {
+		currentForward := currentForwards[listenAddress]
+
+		targetForward, ok := targetForwards[listenAddress]
+		if !ok {
+			diffField("forward", listenAddress, "", currentForward.NetworkForwardPut, nil)
+			continue
+		}
+
+		if currentForward.Description != targetForward.Description {
+			changes = append(changes, api.NetworkRestorePointChange{
+				Entity:       "forward",
+				Name:         listenAddress,
+				Field:        "description",
+				Current:      currentForward.Description,
+				RestorePoint: targetForward.Description,
+			})
+		}
+
+		diffConfig("forward", listenAddress, currentForward.Config, targetForward.Config)
+		diffField("forward", listenAddress, "ports", currentForward.Ports, targetForward.Ports)
+	}
+
+	for _, listenAddress := range slices.Sorted(maps.Keys(targetForwards)) {
+		_, ok := currentForwards[listenAddress]
+		if !ok {
+			diffField("forward", listenAddress, "", nil, targetForwards[listenAddress].NetworkForwardPut)
+		}
+	}
+
+	// Load balancers.
+	currentLoadBalancers := make(map[string]api.NetworkLoadBalancersPost, len(current.LoadBalancers))
+	for _, loadBalancer := range current.LoadBalancers {
+		currentLoadBalancers[loadBalancer.ListenAddress] = loadBalancer
+	}
+
+	targetLoadBalancers := make(map[string]api.NetworkLoadBalancersPost, len(target.LoadBalancers))
+	for _, loadBalancer := range target.LoadBalancers {
+		targetLoadBalancers[loadBalancer.ListenAddress] = loadBalancer
+	}
+
+	for _, listenAddress := range slices.Sorted(maps.Keys(currentLoadBalancers)) {
+		currentLoadBalancer := currentLoadBalancers[listenAddress]
+
+		targetLoadBalancer, ok := targetLoadBalancers[listenAddress]
+		if !ok {
+			diffField("load-balancer", listenAddress, "", currentLoadBalancer.NetworkLoadBalancerPut, nil)
+			continue
+		}
+
+		if currentLoadBalancer.Description != targetLoadBalancer.Description {
+			changes = append(changes, api.NetworkRestorePointChange{
+				Entity:       "load-balancer",
+				Name:         listenAddress,
+				Field:        "description",
+				Current:      currentLoadBalancer.Description,
+				RestorePoint: targetLoadBalancer.Description,
+			})
+		}
+
+		diffConfig("load-balancer", listenAddress, currentLoadBalancer.Config, targetLoadBalancer.Config)
+		diffField("load-balancer", listenAddress, "backends", currentLoadBalancer.Backends, targetLoadBalancer.Backends)
+		diffField("load-balancer", listenAddress, "ports", currentLoadBalancer.Ports, targetLoadBalancer.Ports)
+	}
+
+	for _, listenAddress := range slices.Sorted(maps.Keys(targetLoadBalancers)) {
+		_, ok := currentLoadBalancers[listenAddress]
+		if !ok {
+			diffField("load-balancer", listenAddress, "", nil, targetLoadBalancers[listenAddress].NetworkLoadBalancerPut)
+		}
+	}
+
+	return changes
+}
+
+// networkRestorePointApply rolls the network back to the definition saved in a restore point.
+func networkRestorePointApply(ctx context.Context, s *state.State, n network.Network, target *api.NetworkRestorePointDefinition, clientType clusterRequest.ClientType) error {
+	current, err := networkRestorePointDefinition(ctx, s, n)
+	if err != nil {
+		return err
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Restore the network configuration, keeping the current volatile and cluster member specific keys.
+	oldNetwork := api.NetworkPut{
+		Description: n.Description(),
+		Config:      localUtil.CopyConfig(n.Config()),
+	}
+
+	newNetwork := api.NetworkPut{
+		Description: target.Description,
+		Config:      localUtil.CopyConfig(target.Config),
+	}
+
+	for k, v := range oldNetwork.Config {
+		if strings.HasPrefix(k, internalInstance.ConfigVolatilePrefix) || db.IsNodeSpecificNetworkConfig(k) {
+			newNetwork.Config[k] = v
+		}
+	}
+
+	err = n.Validate(ctx, newNetwork.Config)
+	if err != nil {
+		return fmt.Errorf("Restore point configuration is no longer valid: %w", err)
+	}
+
+	err = n.Update(newNetwork, "", clientType)
+	if err != nil {
+		return fmt.Errorf("Failed restoring network configuration: %w", err)
+	}
+
+	reverter.Add(func() { _ = n.Update(oldNetwork, "", clientType) })
+
+	// Restore the forwards.
+	targetForwards := make(map[string]api.NetworkForwardsPost, len(target.Forwards))
+	for _, forward := range target.Forwards {
+		targetForwards[forward.ListenAddress] = forward
+	}
+
+	currentForwards := make(map[string]api.NetworkForwardsPost, len(current.Forwards))
+	for _, forward := range current.Forwards {
+		currentForwards[forward.ListenAddress] = forward
+
+		targetForward, ok := targetForwards[forward.ListenAddress]
+		if !ok {
+			err = n.ForwardDelete(ctx, forward.ListenAddress, clientType)
+			if err != nil {
+				return fmt.Errorf("Failed deleting network forward %q: %w", forward.ListenAddress, err)
+			}
+
+			reverter.Add(func() { _, _ = n.ForwardCreate(ctx, forward, clientType) })
+			continue
+		}
+
+		if networkRestorePointDiffEmpty(n.Name(), &api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{forward}}, &api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{targetForward}}) {
+			continue
+		}
+
+		err = n.ForwardUpdate(ctx, forward.ListenAddress, targetForward.NetworkForwardPut, clientType)
+		if err != nil {
+			return fmt.Errorf("Failed updating network forward %q: %w", forward.ListenAddress, err)
+		}
+
+		reverter.Add(func() { _ = n.ForwardUpdate(ctx, forward.ListenAddress, forward.NetworkForwardPut, clientType) })
+	}
+
+	for _, forward := range target.Forwards {
+		_, ok := currentForwards[forward.ListenAddress]
+		if ok {
+			continue
+		}
+
+		_, err = n.ForwardCreate(ctx, forward, clientType)
+		if err != nil {
+			return fmt.Errorf("Failed creating network forward %q: %w", forward.ListenAddress, err)
+		}
+
+		reverter.Add(func() { _ = n.ForwardDelete(ctx, forward.ListenAddress, clientType) })
+	}
+
+	// Restore the load balancers.
+	targetLoadBalancers := make(map[string]api.NetworkLoadBalancersPost, len(target.LoadBalancers))
+	for _, loadBalancer := range target.LoadBalancers {
+		targetLoadBalancers[loadBalancer.ListenAddress] = loadBalancer
+	}
+
+	currentLoadBalancers := make(map[string]api.NetworkLoadBalancersPost, len(current.LoadBalancers))
+	for _, loadBalancer := range current.LoadBalancers {
+		currentLoadBalancers[loadBalancer.ListenAddress] = loadBalancer
+
+		targetLoadBalancer, ok := targetLoadBalancers[loadBalancer.ListenAddress]
+		if !ok {
+			err = n.LoadBalancerDelete(ctx, loadBalancer.ListenAddress, clientType)
+			if err != nil {
+				return fmt.Errorf("Failed deleting network load balancer %q: %w", loadBalancer.ListenAddress, err)
+			}
+
+			reverter.Add(func() { _, _ = n.LoadBalancerCreate(ctx, loadBalancer, clientType) })
+			continue
+		}
+
+		if networkRestorePointDiffEmpty(n.Name(), &api.NetworkRestorePointDefinition{LoadBalancers: []api.NetworkLoadBalancersPost{loadBalancer}}, &api.NetworkRestorePointDefinition{LoadBalancers: []api.NetworkLoadBalancersPost{targetLoadBalancer}}) {
+			continue
+		}
+
+		err = n.LoadBalancerUpdate(ctx, loadBalancer.ListenAddress, targetLoadBalancer.NetworkLoadBalancerPut, clientType)
+		if err != nil {
+			return fmt.Errorf("Failed updating network load balancer %q: %w", loadBalancer.ListenAddress, err)
+		}
+
+		reverter.Add(func() {
+			_ = n.LoadBalancerUpdate(ctx, loadBalancer.ListenAddress, loadBalancer.NetworkLoadBalancerPut, clientType)
+		})
+	}
+
+	for _, loadBalancer := range target.LoadBalancers {
+		_, ok := currentLoadBalancers[loadBalancer.ListenAddress]
+		if ok {
+			continue
+		}
+
+		_, err = n.LoadBalancerCreate(ctx, loadBalancer, clientType)
+		if err != nil {
+			return fmt.Errorf("Failed creating network load balancer %q: %w", loadBalancer.ListenAddress, err)
+		}
+
+		reverter.Add(func() { _ = n.LoadBalancerDelete(ctx, loadBalancer.ListenAddress, clientType) })
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// networkRestorePointDiffEmpty returns true if the two definitions are identical.
+func networkRestorePointDiffEmpty(networkName string, current *api.NetworkRestorePointDefinition, target *api.NetworkRestorePointDefinition) bool {
+	return len(networkRestorePointDiff(networkName, current, target)) == 0
+}
+
+// API endpoints
+
+// swagger:operation GET /1.0/networks/{networkName}/restore-points network-restore-points network_restore_points_get
+//
+//	Get the network restore points
+//
+//	Returns a list of network restore points (URLs).
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of endpoints
+//	          items:
+//	            type: string
+//	          example: |-
+//	            [
+//	              "/1.0/networks/ovn0/restore-points/auto-20210323-173837",
+//	              "/1.0/networks/ovn0/restore-points/before-acl-change"
+//	            ]
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+
+// swagger:operation GET /1.0/networks/{networkName}/restore-points?recursion=1 network-restore-points network_restore_points_get_recursion1
+//
+//	Get the network restore points
+//
+//	Returns a list of network restore points (structs).
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of network restore points
+//	          items:
+//	            $ref: "#/definitions/NetworkRestorePoint"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointsGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	recursion := localUtil.IsRecursionRequest(r)
+
+	var dbRecords []db.NetworkRestorePoint
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbRecords, err = tx.GetNetworkRestorePoints(ctx, n.ID())
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network restore points: %w", err))
+	}
+
+	linkResults := make([]string, 0, len(dbRecords))
+	fullResults := make([]api.NetworkRestorePoint, 0, len(dbRecords))
+
+	for _, dbRecord := range dbRecords {
+		if recursion {
+			restorePoint, err := networkRestorePointToAPI(dbRecord)
+			if err != nil {
+				return response.SmartError(err)
+			}
+
+			fullResults = append(fullResults, *restorePoint)
+		}
+
+		linkResults = append(linkResults, api.NewURL().Path(version.APIVersion, "networks", n.Name(), "restore-points", dbRecord.Name).String())
+	}
+
+	if recursion {
+		return response.SyncResponse(true, fullResults)
+	}
+
+	return response.SyncResponse(true, linkResults)
+}
+
+// swagger:operation POST /1.0/networks/{networkName}/restore-points network-restore-points network_restore_points_post
+//
+//	Add a network restore point
+//
+//	Saves the current definition of the network in a new restore point.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: restorePoint
+//	    description: Restore point
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkRestorePointsPost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointsPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Parse the request.
+	req := api.NetworkRestorePointsPost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return response.BadRequest(errors.New("Restore point name is required"))
+	}
+
+	if strings.Contains(req.Name, "/") {
+		return response.BadRequest(errors.New("Restore point name cannot contain \"/\""))
+	}
+
+	_, err = networkRestorePointCreate(r.Context(), s, n, req.Name, req.ExpiresAt, false)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed creating restore point: %w", err))
+	}
+
+	lc := lifecycle.NetworkRestorePointCreated.Event(n, req.Name, request.CreateRequestor(r), nil)
+	s.Events.SendLifecycle(n.Project(), lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation DELETE /1.0/networks/{networkName}/restore-points/{restorePointName} network-restore-points network_restore_point_delete
+//
+//	Delete the network restore point
+//
+//	Removes the network restore point.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	restorePointName, err := url.PathUnescape(mux.Vars(r)["restorePointName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.DeleteNetworkRestorePoint(ctx, n.ID(), restorePointName)
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkRestorePointDeleted.Event(n, restorePointName, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation GET /1.0/networks/{networkName}/restore-points/{restorePointName} network-restore-points network_restore_point_get
+//
+//	Get the network restore point
+//
+//	Gets a specific network restore point.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Restore point
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkRestorePoint"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	restorePoint, err := networkRestorePointLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, restorePoint)
+}
+
+// swagger:operation GET /1.0/networks/{networkName}/restore-points/{restorePointName}/diff network-restore-points network_restore_point_diff_get
+//
+//	Compare the network with a restore point
+//
+//	Returns the differences between the current network and the restore point.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Differences
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of differences
+//	          items:
+//	            $ref: "#/definitions/NetworkRestorePointChange"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointDiffGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	restorePoint, err := networkRestorePointLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	current, err := networkRestorePointDefinition(r.Context(), s, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, networkRestorePointDiff(n.Name(), current, &restorePoint.Definition))
+}
+
+// swagger:operation POST /1.0/networks/{networkName}/restore-points/{restorePointName}/restore network-restore-points network_restore_point_restore_post
+//
+//	Restore the network from a restore point
+//
+//	Rolls the network configuration, forwards and load balancers back to the restore point.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestorePointRestorePost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkRestorePointsLoadNetwork(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot restore network when not in created state"))
+	}
+
+	restorePoint, err := networkRestorePointLoad(s, r, n)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	// Don't let the client going away abort the restore half-way through.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), networkRestorePointRestoreTimeout)
+	defer cancel()
+
+	err = networkRestorePointApply(ctx, s, n, &restorePoint.Definition, clientType)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkRestorePointRestored.Event(n, restorePoint.Name, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// autoCreateNetworkRestorePointsTask takes scheduled restore points of networks and removes expired ones.
+func autoCreateNetworkRestorePointsTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// Only run on the leader when clustered so that each restore point is only taken once.
+		leader, err := s.Cluster.LeaderAddress()
+		if err != nil && !errors.Is(err, cluster.ErrNodeIsNotClustered) {
+			logger.Error("Failed to get leader cluster member address", logger.Ctx{"err": err})
+			return
+		}
+
+		if err == nil && s.LocalConfig.ClusterAddress() != leader {
+			return // Skip restore points if not cluster leader.
+		}
+
+		var expired []db.NetworkRestorePoint
+		var projectNetworks map[string]map[int64]api.Network
+
+		err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			expired, err = tx.GetExpiredNetworkRestorePoints(ctx)
+			if err != nil {
+				return fmt.Errorf("Failed getting expired network restore points: %w", err)
+			}
+
+			for _, rp := range expired {
+				err = tx.DeleteNetworkRestorePoint(ctx, rp.NetworkID, rp.Name)
+				if err != nil {
+					return fmt.Errorf("Failed deleting expired network restore point %q: %w", rp.Name, err)
+				}
+			}
+
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+			if err != nil {
+				return fmt.Errorf("Failed loading networks: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			logger.Error("Failed processing network restore points", logger.Ctx{"err": err})
+			return
+		}
+
+		now := time.Now()
+
+		for projectName, networks := range projectNetworks {
+			for networkID, info := range networks {
+				schedule := info.Config["restore_points.schedule"]
+				if schedule == "" {
+					schedule = "@daily"
+				}
+
+				if !snapshotIsScheduledNow(schedule, networkID) {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for restore point", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				if !n.Info().RestorePoints {
+					continue
+				}
+
+				expiry := info.Config["restore_points.expiry"]
+				if expiry == "" {
+					expiry = "1w"
+				}
+
+				expiryDate, err := internalInstance.GetExpiry(now, expiry)
+				if err != nil {
+					logger.Error("Invalid network restore point expiry", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				name := fmt.Sprintf("auto-%s", now.UTC().Format("20060102-150405"))
+
+				_, err = networkRestorePointCreate(ctx, s, n, name, expiryDate, true)
+				if err != nil {
+					logger.Error("Failed creating network restore point", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+				}
+			}
+		}
+	}
+
+	return f, task.Every(time.Minute)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// Test that the restore point diff reports the network, forward and load balancer changes in a stable order.
+func TestNetworkRestorePointDiff(t *testing.T) {
+	forward := func(listenAddress string, description string, config map[string]string, ports ...api.NetworkForwardPort) api.NetworkForwardsPost {
+		return api.NetworkForwardsPost{
+			ListenAddress:     listenAddress,
+			NetworkForwardPut: api.NetworkForwardPut{Description: description, Config: config, Ports: ports},
+		}
+	}
+
+	loadBalancer := func(listenAddress string, backends ...api.NetworkLoadBalancerBackend) api.NetworkLoadBalancersPost {
+		return api.NetworkLoadBalancersPost{
+			ListenAddress:          listenAddress,
+			NetworkLoadBalancerPut: api.NetworkLoadBalancerPut{Backends: backends},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		current api.NetworkRestorePointDefinition
+		target  api.NetworkRestorePointDefinition
+		want    []api.NetworkRestorePointChange
+	}{
+		{
+			name:    "Identical",
+			current: api.NetworkRestorePointDefinition{Description: "foo", Config: map[string]string{"ipv4.nat": "true"}, Forwards: []api.NetworkForwardsPost{forward("192.0.2.1", "", nil)}},
+			target:  api.NetworkRestorePointDefinition{Description: "foo", Config: map[string]string{"ipv4.nat": "true"}, Forwards: []api.NetworkForwardsPost{forward("192.0.2.1", "", nil)}},
+			want:    []api.NetworkRestorePointChange{},
+		},
+		{
+			name:    "Network description and config",
+			current: api.NetworkRestorePointDefinition{Description: "foo", Config: map[string]string{"ipv4.nat": "true", "dns.domain": "old"}},
+			target:  api.NetworkRestorePointDefinition{Description: "bar", Config: map[string]string{"ipv4.nat": "true", "ipv6.nat": "false"}},
+			want: []api.NetworkRestorePointChange{
+				{Entity: "network", Name: "ovn0", Field: "description", Current: "foo", RestorePoint: "bar"},
+				{Entity: "network", Name: "ovn0", Field: "config.dns.domain", Current: "old", RestorePoint: ""},
+				{Entity: "network", Name: "ovn0", Field: "config.ipv6.nat", Current: "", RestorePoint: "false"},
+			},
+		},
+		{
+			name:    "Forward changed",
+			current: api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{forward("192.0.2.1", "web", map[string]string{"target_address": "10.0.0.2"})}},
+			target: api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{forward("192.0.2.1", "web", map[string]string{"target_address": "10.0.0.3"}, api.NetworkForwardPort{
+				Protocol: "tcp", ListenPort: "80", TargetAddress: "10.0.0.4",
+			})}},
+			want: []api.NetworkRestorePointChange{
+				{Entity: "forward", Name: "192.0.2.1", Field: "config.target_address", Current: "10.0.0.2", RestorePoint: "10.0.0.3"},
+				{Entity: "forward", Name: "192.0.2.1", Field: "ports", Current: "null", RestorePoint: `[{"description":"","protocol":"tcp","listen_port":"80","target_port":"","target_address":"10.0.0.4","snat":false}]`},
+			},
+		},
+		{
+			name:    "Forwards added and removed",
+			current: api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{forward("192.0.2.2", "", nil)}},
+			target:  api.NetworkRestorePointDefinition{Forwards: []api.NetworkForwardsPost{forward("192.0.2.1", "", nil)}},
+			want: []api.NetworkRestorePointChange{
+				{Entity: "forward", Name: "192.0.2.2", Field: "", Current: `{"description":"","config":null,"ports":null}`, RestorePoint: "null"},
+				{Entity: "forward", Name: "192.0.2.1", Field: "", Current: "null", RestorePoint: `{"description":"","config":null,"ports":null}`},
+			},
+		},
+		{
+			name:    "Load balancer backends",
+			current: api.NetworkRestorePointDefinition{LoadBalancers: []api.NetworkLoadBalancersPost{loadBalancer("192.0.2.10", api.NetworkLoadBalancerBackend{Name: "b0", TargetAddress: "10.0.0.2"})}},
+			target:  api.NetworkRestorePointDefinition{LoadBalancers: []api.NetworkLoadBalancersPost{loadBalancer("192.0.2.10")}},
+			want: []api.NetworkRestorePointChange{
+				{Entity: "load-balancer", Name: "192.0.2.10", Field: "backends", Current: `[{"name":"b0","description":"","target_port":"","target_address":"10.0.0.2"}]`, RestorePoint: "null"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, networkRestorePointDiff("ovn0", &tt.current, &tt.target))
+			assert.Equal(t, len(tt.want) == 0, networkRestorePointDiffEmpty("ovn0", &tt.current, &tt.target))
+		})
+	}
+}
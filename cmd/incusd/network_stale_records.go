@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lxc/incus/v6/internal/server/cluster"
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// autoPruneNetworkStaleRecordsTask removes the DNS records and DHCP reservations of OVN networks which were left
+// behind by instance NICs or external ports that no longer exist.
+func autoPruneNetworkStaleRecordsTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		mode := s.GlobalConfig.NetworkOVNStaleRecordsPruning()
+		if mode == "disabled" {
+			return
+		}
+
+		// Only run on the leader when clustered as the OVN database is shared by all members.
+		leader, err := s.Cluster.LeaderAddress()
+		if err != nil && !errors.Is(err, cluster.ErrNodeIsNotClustered) {
+			logger.Error("Failed to get leader cluster member address", logger.Ctx{"err": err})
+			return
+		}
+
+		if err == nil && s.LocalConfig.ClusterAddress() != leader {
+			return // Skip pruning if not cluster leader.
+		}
+
+		var projectNetworks map[string]map[int64]api.Network
+		err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for stale records pruning", logger.Ctx{"err": err})
+			return
+		}
+
+		dryRun := mode == "dry-run"
+
+		for projectName, networks := range projectNetworks {
+			for _, info := range networks {
+				if info.Type != "ovn" {
+					continue
+				}
+
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for stale records pruning", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				stale, err := n.PruneStaleRecords(dryRun)
+				if err != nil {
+					logger.Warn("Failed pruning stale network records", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				for _, record := range stale {
+					if dryRun {
+						logger.Warn("Found stale network record", logger.Ctx{"project": projectName, "network": info.Name, "record": record})
+					} else {
+						logger.Info("Pruned stale network record", logger.Ctx{"project": projectName, "network": info.Name, "record": record})
+					}
+				}
+			}
+		}
+	}
+
+	return f, task.Hourly()
+}
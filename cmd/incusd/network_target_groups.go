@@ -0,0 +1,807 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/filter"
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/locking"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	localUtil "github.com/lxc/incus/v6/internal/server/util"
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+	"github.com/lxc/incus/v6/shared/revert"
+	"github.com/lxc/incus/v6/shared/util"
+)
+
+var networkTargetGroupsCmd = APIEndpoint{
+	Path: "network-target-groups",
+
+	Get:  APIEndpointAction{Handler: networkTargetGroupsGet, AccessHandler: allowAuthenticated},
+	Post: APIEndpointAction{Handler: networkTargetGroupsPost, AccessHandler: allowPermission(auth.ObjectTypeProject, auth.EntitlementCanCreateNetworkTargetGroups)},
+}
+
+var networkTargetGroupCmd = APIEndpoint{
+	Path: "network-target-groups/{name}",
+
+	Delete: APIEndpointAction{Handler: networkTargetGroupDelete, AccessHandler: allowPermission(auth.ObjectTypeNetworkTargetGroup, auth.EntitlementCanEdit, "name")},
+	Get:    APIEndpointAction{Handler: networkTargetGroupGet, AccessHandler: allowPermission(auth.ObjectTypeNetworkTargetGroup, auth.EntitlementCanView, "name")},
+	Put:    APIEndpointAction{Handler: networkTargetGroupPut, AccessHandler: allowPermission(auth.ObjectTypeNetworkTargetGroup, auth.EntitlementCanEdit, "name")},
+	Patch:  APIEndpointAction{Handler: networkTargetGroupPut, AccessHandler: allowPermission(auth.ObjectTypeNetworkTargetGroup, auth.EntitlementCanEdit, "name")},
+	Post:   APIEndpointAction{Handler: networkTargetGroupPost, AccessHandler: allowPermission(auth.ObjectTypeNetworkTargetGroup, auth.EntitlementCanEdit, "name")},
+}
+
+// networkTargetGroupLoad loads a target group along with the forwards and load balancers using it.
+func networkTargetGroupLoad(ctx context.Context, s *state.State, projectName string, groupName string) (*api.NetworkTargetGroup, error) {
+	var group *api.NetworkTargetGroup
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		dbGroup, err := dbCluster.GetNetworkTargetGroup(ctx, tx.Tx(), projectName, groupName)
+		if err != nil {
+			return err
+		}
+
+		group, err = dbGroup.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group.UsedBy, _, err = network.TargetGroupUsedBy(ctx, s, projectName, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// networkTargetGroupRefresh re-applies the forwards and load balancers of the networks using a target group.
+func networkTargetGroupRefresh(ctx context.Context, s *state.State, projectName string, networkNames []string) error {
+	for _, networkName := range networkNames {
+		n, err := network.LoadByName(s, projectName, networkName)
+		if err != nil {
+			return fmt.Errorf("Failed loading network %q: %w", networkName, err)
+		}
+
+		err = n.TargetGroupsRefresh(ctx)
+		if err != nil && !errors.Is(err, network.ErrNotImplemented) {
+			return fmt.Errorf("Failed refreshing network %q: %w", networkName, err)
+		}
+	}
+
+	return nil
+}
+
+// API endpoints.
+
+// swagger:operation GET /1.0/network-target-groups network-target-groups network_target_groups_get
+//
+// Get the network target groups
+//
+// Returns a list of network target groups (URLs).
+//
+// ---
+// produces:
+//   - application/json
+// parameters:
+//   - in: query
+//     name: project
+//     description: Project name
+//     type: string
+//     example: default
+//   - in: query
+//     name: all-projects
+//     description: Retrieve network target groups from all projects
+//     type: boolean
+//     example: true
+//   - in: query
+//     name: filter
+//     description: Collection filter
+//     type: string
+//     example: default
+// responses:
+//   "200":
+//     description: API endpoints
+//     schema:
+//       type: object
+//       description: Sync response
+//       properties:
+//         type:
+//           type: string
+//           description: Response type
+//           example: sync
+//         status:
+//           type: string
+//           description: Status description
+//           example: Success
+//         status_code:
+//           type: integer
+//           description: Status code
+//           example: 200
+//         metadata:
+//           type: array
+//           description: List of endpoints
+//           items:
+//             type: string
+//           example: |-
+//             [
+//               "/1.0/network-target-groups/foo",
+//               "/1.0/network-target-groups/bar"
+//             ]
+//   "403":
+//     $ref: "#/responses/Forbidden"
+//   "500":
+//     $ref: "#/responses/InternalServerError"
+
+// swagger:operation GET /1.0/network-target-groups?recursion=1 network-target-groups network_target_groups_get_recursion1
+//
+// # Get the network target groups
+//
+// Returns a list of network target groups (structs).
+//
+// ---
+// produces:
+//   - application/json
+//
+// parameters:
+//   - in: query
+//     name: project
+//     description: Project name
+//     type: string
+//     example: default
+//   - in: query
+//     name: all-projects
+//     description: Retrieve network target groups from all projects
+//     type: boolean
+//     example: true
+//   - in: query
+//     name: filter
+//     description: Collection filter
+//     type: string
+//     example: default
+//
+// responses:
+//
+//  "200":
+//    description: API endpoints
+//    schema:
+//      type: object
+//      description: Sync response
+//      properties:
+//        type:
+//          type: string
+//          description: Response type
+//          example: sync
+//        status:
+//          type: string
+//          description: Status description
+//          example: Success
+//        status_code:
+//          type: integer
+//          description: Status code
+//          example: 200
+//        metadata:
+//          type: array
+//          description: List of network target groups
+//          items:
+//            $ref: "#/definitions/NetworkTargetGroup"
+//  "403":
+//    $ref: "#/responses/Forbidden"
+//  "500":
+//    $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupsGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	recursion := localUtil.IsRecursionRequest(r)
+	allProjects := util.IsTrue(r.FormValue("all-projects"))
+
+	filterStr := r.FormValue("filter")
+	clauses, err := filter.Parse(filterStr, filter.QueryOperatorSet())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid filter: %w", err))
+	}
+
+	mustLoadObjects := recursion || (clauses != nil && len(clauses.Clauses) > 0)
+
+	var groups []dbCluster.NetworkTargetGroup
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		if allProjects {
+			groups, err = dbCluster.GetNetworkTargetGroups(ctx, tx.Tx())
+		} else {
+			groups, err = dbCluster.GetNetworkTargetGroups(ctx, tx.Tx(), dbCluster.NetworkTargetGroupFilter{Project: &projectName})
+		}
+
+		return err
+	})
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanView, auth.ObjectTypeNetworkTargetGroup)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	linkResults := make([]string, 0)
+	fullResults := make([]api.NetworkTargetGroup, 0)
+
+	for _, group := range groups {
+		if !userHasPermission(auth.ObjectNetworkTargetGroup(group.Project, group.Name)) {
+			continue
+		}
+
+		if mustLoadObjects {
+			groupInfo, err := networkTargetGroupLoad(r.Context(), s, group.Project, group.Name)
+			if err != nil {
+				continue
+			}
+
+			if clauses != nil && len(clauses.Clauses) > 0 {
+				match, err := filter.Match(*groupInfo, *clauses)
+				if err != nil {
+					return response.SmartError(err)
+				}
+
+				if !match {
+					continue
+				}
+			}
+
+			fullResults = append(fullResults, *groupInfo)
+		}
+
+		linkResults = append(linkResults, fmt.Sprintf("/%s/network-target-groups/%s", version.APIVersion, group.Name))
+	}
+
+	if !recursion {
+		return response.SyncResponse(true, linkResults)
+	}
+
+	return response.SyncResponse(true, fullResults)
+}
+
+// swagger:operation POST /1.0/network-target-groups network-target-groups network_target_groups_post
+//
+//  Add a network target group
+//
+//  Creates a new network target group.
+//
+//  ---
+//  consumes:
+//    - application/json
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: body
+//      name: target group
+//      description: target group
+//      required: true
+//      schema:
+//        $ref: "#/definitions/NetworkTargetGroupsPost"
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupsPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	req := api.NetworkTargetGroupsPost{}
+
+	// Parse the request into a record.
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	req.Normalise()
+
+	err = network.TargetGroupValidateName(req.Name)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = network.TargetGroupValidate(&req.NetworkTargetGroupPut)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		exists, err := dbCluster.NetworkTargetGroupExists(ctx, tx.Tx(), projectName, req.Name)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return api.StatusErrorf(http.StatusConflict, "The network target group already exists")
+		}
+
+		group := dbCluster.NetworkTargetGroup{
+			Project:     projectName,
+			Name:        req.Name,
+			Description: req.Description,
+			Addresses:   req.Addresses,
+			Instances:   req.Instances,
+		}
+
+		id, err := dbCluster.CreateNetworkTargetGroup(ctx, tx.Tx(), group)
+		if err != nil {
+			return err
+		}
+
+		return dbCluster.CreateNetworkTargetGroupConfig(ctx, tx.Tx(), id, req.Config)
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.Authorizer.AddNetworkTargetGroup(r.Context(), projectName, req.Name)
+	if err != nil {
+		logger.Error("Failed to add network target group to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
+	}
+
+	lc := lifecycle.NetworkTargetGroupCreated.Event(projectName, req.Name, request.CreateRequestor(r), nil)
+	s.Events.SendLifecycle(projectName, lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation DELETE /1.0/network-target-groups/{name} network-target-groups network_target_group_delete
+//
+//  Delete the network target group
+//
+//  Removes the network target group.
+//
+//  ---
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	groupName, err := url.PathUnescape(mux.Vars(r)["name"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Prevent forwards and load balancers from starting to use the group while it's being deleted.
+	unlock, err := locking.Lock(r.Context(), network.TargetGroupLockName(projectName))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	defer unlock()
+
+	group, err := networkTargetGroupLoad(r.Context(), s, projectName, groupName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if len(group.UsedBy) > 0 {
+		return response.BadRequest(errors.New("Cannot delete a network target group that is in use"))
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		return dbCluster.DeleteNetworkTargetGroup(ctx, tx.Tx(), projectName, groupName)
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.Authorizer.DeleteNetworkTargetGroup(r.Context(), projectName, groupName)
+	if err != nil {
+		logger.Error("Failed to remove network target group from authorizer", logger.Ctx{"name": groupName, "project": projectName, "error": err})
+	}
+
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkTargetGroupDeleted.Event(projectName, groupName, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation GET /1.0/network-target-groups/{name} network-target-groups network_target_group_get
+//
+//  Get the network target group
+//
+//  Gets a specific network target group.
+//
+//  ---
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//  responses:
+//    "200":
+//      description: target group
+//      schema:
+//        type: object
+//        description: Sync response
+//        properties:
+//          type:
+//            type: string
+//            description: Response type
+//            example: sync
+//          status:
+//            type: string
+//            description: Status description
+//            example: Success
+//          status_code:
+//            type: integer
+//            description: Status code
+//            example: 200
+//          metadata:
+//            $ref: "#/definitions/NetworkTargetGroup"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	groupName, err := url.PathUnescape(mux.Vars(r)["name"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	group, err := networkTargetGroupLoad(r.Context(), s, projectName, groupName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponseETag(true, group, group.Etag())
+}
+
+// swagger:operation PATCH /1.0/network-target-groups/{name} network-target-groups network_target_group_patch
+//
+//  Partially update the network target group
+//
+//  Updates a subset of the network target group configuration.
+//
+//  ---
+//  consumes:
+//    - application/json
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: body
+//      name: target group
+//      description: Target group configuration
+//      required: true
+//      schema:
+//        $ref: "#/definitions/NetworkTargetGroupPut"
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "412":
+//      $ref: "#/responses/PreconditionFailed"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+// swagger:operation PUT /1.0/network-target-groups/{name} network-target-groups network_target_group_put
+//
+//  Update the network target group
+//
+//  Updates the entire network target group configuration.
+//
+//  ---
+//  consumes:
+//    - application/json
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: body
+//      name: target group
+//      description: Target group configuration
+//      required: true
+//      schema:
+//        $ref: "#/definitions/NetworkTargetGroupPut"
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "412":
+//      $ref: "#/responses/PreconditionFailed"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupPut(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	groupName, err := url.PathUnescape(mux.Vars(r)["name"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Prevent forwards and load balancers from starting to use the group while it's being updated.
+	unlock, err := locking.Lock(r.Context(), network.TargetGroupLockName(projectName))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	defer unlock()
+
+	// Get the existing network target group.
+	group, err := networkTargetGroupLoad(r.Context(), s, projectName, groupName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Validate ETag.
+	err = localUtil.EtagCheck(r, group.Etag())
+	if err != nil {
+		return response.PreconditionFailed(err)
+	}
+
+	req := api.NetworkTargetGroupPut{}
+
+	// Decode the request.
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	if r.Method == http.MethodPatch {
+		if req.Config == nil {
+			req.Config = map[string]string{}
+		}
+
+		for k, v := range group.Config {
+			_, ok := req.Config[k]
+			if !ok {
+				req.Config[k] = v
+			}
+		}
+	}
+
+	req.Normalise()
+
+	err = network.TargetGroupValidate(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	updateGroup := func(put api.NetworkTargetGroupPut) error {
+		return s.DB.Cluster.Transaction(context.Background(), func(ctx context.Context, tx *db.ClusterTx) error {
+			dbGroup, err := dbCluster.GetNetworkTargetGroup(ctx, tx.Tx(), projectName, groupName)
+			if err != nil {
+				return err
+			}
+
+			dbGroup.Description = put.Description
+			dbGroup.Addresses = put.Addresses
+			dbGroup.Instances = put.Instances
+
+			err = dbCluster.UpdateNetworkTargetGroup(ctx, tx.Tx(), projectName, groupName, *dbGroup)
+			if err != nil {
+				return err
+			}
+
+			return dbCluster.UpdateNetworkTargetGroupConfig(ctx, tx.Tx(), int64(dbGroup.ID), put.Config)
+		})
+	}
+
+	_, networkNames, err := network.TargetGroupUsedBy(r.Context(), s, projectName, groupName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = updateGroup(req)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	reverter.Add(func() {
+		_ = updateGroup(group.Writable())
+		_ = networkTargetGroupRefresh(context.Background(), s, projectName, networkNames)
+	})
+
+	// Apply the new members to the forwards and load balancers using the group.
+	err = networkTargetGroupRefresh(r.Context(), s, projectName, networkNames)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	reverter.Success()
+
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkTargetGroupUpdated.Event(projectName, groupName, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation POST /1.0/network-target-groups/{name} network-target-groups network_target_group_post
+//
+//  Rename the network target group
+//
+//  Renames an existing network target group.
+//
+//  ---
+//  consumes:
+//    - application/json
+//  produces:
+//    - application/json
+//  parameters:
+//    - in: query
+//      name: project
+//      description: Project name
+//      type: string
+//      example: default
+//    - in: body
+//      name: target group
+//      description: Target group rename request
+//      required: true
+//      schema:
+//        $ref: "#/definitions/NetworkTargetGroupPost"
+//  responses:
+//    "200":
+//      $ref: "#/responses/EmptySyncResponse"
+//    "400":
+//      $ref: "#/responses/BadRequest"
+//    "403":
+//      $ref: "#/responses/Forbidden"
+//    "500":
+//      $ref: "#/responses/InternalServerError"
+
+func networkTargetGroupPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	groupName, err := url.PathUnescape(mux.Vars(r)["name"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Parse the request.
+	req := api.NetworkTargetGroupPost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = network.TargetGroupValidateName(req.Name)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// Prevent forwards and load balancers from starting to use the group while it's being renamed.
+	unlock, err := locking.Lock(r.Context(), network.TargetGroupLockName(projectName))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	defer unlock()
+
+	// Get the existing network target group.
+	group, err := networkTargetGroupLoad(r.Context(), s, projectName, groupName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Forwards and load balancers reference the group by name.
+	if len(group.UsedBy) > 0 {
+		return response.BadRequest(errors.New("Cannot rename a network target group that is in use"))
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		exists, err := dbCluster.NetworkTargetGroupExists(ctx, tx.Tx(), projectName, req.Name)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return api.StatusErrorf(http.StatusConflict, "A network target group by that name already exists")
+		}
+
+		return dbCluster.RenameNetworkTargetGroup(ctx, tx.Tx(), projectName, groupName, req.Name)
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.Authorizer.RenameNetworkTargetGroup(r.Context(), projectName, groupName, req.Name)
+	if err != nil {
+		logger.Error("Failed to rename network target group in authorizer", logger.Ctx{"old_name": groupName, "new_name": req.Name, "project": projectName, "error": err})
+	}
+
+	lc := lifecycle.NetworkTargetGroupRenamed.Event(projectName, req.Name, request.CreateRequestor(r), logger.Ctx{"old_name": groupName})
+	s.Events.SendLifecycle(projectName, lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+var networkTrafficCmd = APIEndpoint{
+	Path: "networks/{networkName}/traffic",
+
+	Get: APIEndpointAction{Handler: networkTrafficGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+// swagger:operation GET /1.0/networks/{networkName}/traffic networks network_traffic_get
+//
+//	Get the network traffic
+//
+//	Returns the traffic accumulated by the network across all cluster members.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Traffic
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkTraffic"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkTrafficGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	if !n.Info().TrafficAccounting {
+		return response.BadRequest(fmt.Errorf("Network driver %q does not support traffic accounting", n.Type()))
+	}
+
+	var traffic *api.NetworkTraffic
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		traffic, err = tx.GetNetworkTraffic(ctx, n.ID())
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, traffic)
+}
+
+// networkTrafficDelta returns the traffic seen by a port since the previous reading of its counters.
+func networkTrafficDelta(previous api.NetworkTrafficCounters, current api.NetworkTrafficCounters) api.NetworkTrafficCounters {
+	// Counters go backwards when the interface got recreated, in which case all of its traffic is new.
+	if current.IngressBytes < previous.IngressBytes || current.IngressPackets < previous.IngressPackets || current.EgressBytes < previous.EgressBytes || current.EgressPackets < previous.EgressPackets {
+		return current
+	}
+
+	return api.NetworkTrafficCounters{
+		IngressBytes:   current.IngressBytes - previous.IngressBytes,
+		IngressPackets: current.IngressPackets - previous.IngressPackets,
+		EgressBytes:    current.EgressBytes - previous.EgressBytes,
+		EgressPackets:  current.EgressPackets - previous.EgressPackets,
+	}
+}
+
+// networkTrafficAdd adds the counters in delta to total.
+func networkTrafficAdd(total *api.NetworkTrafficCounters, delta api.NetworkTrafficCounters) {
+	total.IngressBytes += delta.IngressBytes
+	total.IngressPackets += delta.IngressPackets
+	total.EgressBytes += delta.EgressBytes
+	total.EgressPackets += delta.EgressPackets
+}
+
+func autoCollectNetworkTrafficTask(d *Daemon) (task.Func, task.Schedule) {
+	// Last counters seen for each port, keyed by network ID and port name.
+	// Ports seen for the first time after startup only establish a baseline as their earlier traffic may
+	// already have been accounted for by a previous run of the daemon.
+	var lastCounters map[string]api.NetworkTrafficCounters
+
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		var projectNetworks map[string]map[int64]api.Network
+
+		err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			var err error
+
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed loading networks for traffic accounting", logger.Ctx{"err": err})
+			return
+		}
+
+		seenCounters := map[string]api.NetworkTrafficCounters{}
+
+		for projectName, networks := range projectNetworks {
+			for networkID, info := range networks {
+				n, err := network.LoadByName(s, projectName, info.Name)
+				if err != nil {
+					logger.Error("Failed loading network for traffic accounting", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					continue
+				}
+
+				if !n.Info().TrafficAccounting {
+					continue
+				}
+
+				counters, err := n.LocalTraffic(ctx)
+				if err != nil {
+					if !errors.Is(err, network.ErrNotImplemented) {
+						logger.Warn("Failed getting network traffic", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+					}
+
+					continue
+				}
+
+				var ports, uplink api.NetworkTrafficCounters
+
+				for _, counter := range counters {
+					key := fmt.Sprintf("%d/%s", networkID, counter.Name)
+					seenCounters[key] = counter.Counters
+
+					delta := networkTrafficDelta(lastCounters[key], counter.Counters)
+					if counter.Uplink {
+						networkTrafficAdd(&uplink, delta)
+					} else {
+						networkTrafficAdd(&ports, delta)
+					}
+				}
+
+				if lastCounters == nil {
+					continue // Only record a baseline on the first run.
+				}
+
+				err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+					return tx.AddNetworkTraffic(ctx, networkID, s.DB.Cluster.GetNodeID(), ports, uplink)
+				})
+				if err != nil {
+					logger.Error("Failed recording network traffic", logger.Ctx{"project": projectName, "network": info.Name, "err": err})
+
+					// Keep the previous counters so the traffic gets recorded on the next run.
+					for _, counter := range counters {
+						key := fmt.Sprintf("%d/%s", networkID, counter.Name)
+
+						previous, ok := lastCounters[key]
+						if ok {
+							seenCounters[key] = previous
+						} else {
+							delete(seenCounters, key)
+						}
+					}
+				}
+			}
+		}
+
+		lastCounters = seenCounters
+	}
+
+	return f, task.Every(time.Minute)
+}
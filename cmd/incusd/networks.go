@@ -75,6 +75,30 @@ var networkStateCmd = APIEndpoint{
 	Get: APIEndpointAction{Handler: networkStateGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
 }
 
+var networkHealthCmd = APIEndpoint{
+	Path: "networks/{networkName}/health",
+
+	Get: APIEndpointAction{Handler: networkHealthGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkRoutesCmd = APIEndpoint{
+	Path: "networks/{networkName}/routes",
+
+	Get: APIEndpointAction{Handler: networkRoutesGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkDetachUplinkCmd = APIEndpoint{
+	Path: "networks/{networkName}/detach-uplink",
+
+	Post: APIEndpointAction{Handler: networkDetachUplinkPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkOVNCmd = APIEndpoint{
+	Path: "networks/{networkName}/ovn",
+
+	Get: APIEndpointAction{Handler: networkOVNGet, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
+}
+
 // API endpoints
 
 // swagger:operation GET /1.0/networks networks networks_get
@@ -388,7 +412,7 @@ func networksPost(d *Daemon, r *http.Request) response.Response {
 		req.Config = map[string]string{}
 	}
 
-	netType, err := network.LoadByType(req.Type)
+	netType, err := network.LoadByType(req.Type, projectName, req.Name)
 	if err != nil {
 		return response.BadRequest(err)
 	}
@@ -435,6 +459,14 @@ func networksPost(d *Daemon, r *http.Request) response.Response {
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
+	// Render the config templates supplied by the user, the stored values are never rendered again.
+	if clientType == clusterRequest.ClientTypeNormal {
+		err = netType.RenderConfigTemplates(req.Config)
+		if err != nil {
+			return response.BadRequest(err)
+		}
+	}
+
 	if isClusterNotification(r) {
 		n, err := network.LoadByName(s, projectName, req.Name)
 		if err != nil {
@@ -578,7 +610,7 @@ func networksPost(d *Daemon, r *http.Request) response.Response {
 
 	// Populate default config.
 	if clientType != clusterRequest.ClientTypeJoiner {
-		err = netType.FillConfig(req.Config)
+		err = netType.FillConfig(r.Context(), req.Config)
 		if err != nil {
 			return response.SmartError(err)
 		}
@@ -692,7 +724,7 @@ func networksPostCluster(ctx context.Context, s *state.State, projectName string
 		}
 
 		// Add default values if we are inserting global config for first time.
-		err = netType.FillConfig(req.Config)
+		err = netType.FillConfig(ctx, req.Config)
 		if err != nil {
 			return err
 		}
@@ -810,7 +842,7 @@ func doNetworksCreate(ctx context.Context, s *state.State, n network.Network, cl
 	}
 
 	// Validate so that when run on a cluster node the full config (including node specific config) is checked.
-	err := n.Validate(validateConfig)
+	err := n.Validate(ctx, validateConfig)
 	if err != nil {
 		return err
 	}
@@ -1306,6 +1338,10 @@ func networkPost(d *Daemon, r *http.Request) response.Response {
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: remap-targets
+//	    description: Move the target addresses of forwards and load balancers along with the network subnets
+//	    type: boolean
 //	  - in: body
 //	    name: network
 //	    description: Network configuration
@@ -1413,9 +1449,14 @@ func networkPut(d *Daemon, r *http.Request) response.Response {
 		}
 	}
 
+	remapTargets := util.IsTrue(request.QueryParam(r, "remap-targets"))
+	if remapTargets && targetNode != "" {
+		return response.BadRequest(errors.New("Target addresses can only be remapped when updating the network global config"))
+	}
+
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
 
-	resp = doNetworkUpdate(n, req, targetNode, clientType, r.Method, s.ServerClustered)
+	resp = doNetworkUpdate(r.Context(), n, req, targetNode, clientType, r.Method, s.ServerClustered, remapTargets)
 
 	requestor := request.CreateRequestor(r)
 	s.Events.SendLifecycle(projectName, lifecycle.NetworkUpdated.Event(n, requestor, nil))
@@ -1445,6 +1486,10 @@ func networkPut(d *Daemon, r *http.Request) response.Response {
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: remap-targets
+//	    description: Move the target addresses of forwards and load balancers along with the network subnets
+//	    type: boolean
 //	  - in: body
 //	    name: network
 //	    description: Network configuration
@@ -1468,7 +1513,9 @@ func networkPatch(d *Daemon, r *http.Request) response.Response {
 
 // doNetworkUpdate loads the current local network config, merges with the requested network config, validates
 // and applies the changes. Will also notify other cluster nodes of non-node specific config if needed.
-func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, clientType clusterRequest.ClientType, httpMethod string, clustered bool) response.Response {
+// If remapTargets is true, the target addresses of the network's forwards and load balancers are moved along
+// with any subnet change in the same update.
+func doNetworkUpdate(ctx context.Context, n network.Network, req api.NetworkPut, targetNode string, clientType clusterRequest.ClientType, httpMethod string, clustered bool, remapTargets bool) response.Response {
 	if req.Config == nil {
 		req.Config = map[string]string{}
 	}
@@ -1495,8 +1542,25 @@ func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, c
 		}
 	}
 
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Move the forward and load balancer targets into the new subnets so they pass validation.
+	if remapTargets {
+		cleanup, err := n.RemapTargets(ctx, req.Config)
+		if err != nil {
+			if errors.Is(err, network.ErrNotImplemented) {
+				return response.BadRequest(fmt.Errorf("Network driver %q does not support remapping target addresses", n.Type()))
+			}
+
+			return response.SmartError(err)
+		}
+
+		reverter.Add(cleanup)
+	}
+
 	// Validate the merged configuration.
-	err := n.Validate(req.Config)
+	err := n.Validate(ctx, req.Config)
 	if err != nil {
 		return response.BadRequest(err)
 	}
@@ -1507,6 +1571,8 @@ func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, c
 		return response.SmartError(err)
 	}
 
+	reverter.Success()
+
 	return response.EmptySyncResponse
 }
 
@@ -1530,6 +1596,10 @@ func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, c
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: all-projects
+//	    description: Retrieve leases from all projects using the network
+//	    type: boolean
 //	responses:
 //	  "200":
 //	    description: API endpoints
@@ -1583,11 +1653,76 @@ func networkLeasesGet(d *Daemon, r *http.Request) response.Response {
 	}
 
 	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
-	leases, err := n.Leases(reqProject.Name, clientType)
+
+	allProjects := util.IsTrue(request.QueryParam(r, "all-projects"))
+	if !allProjects || clientType != clusterRequest.ClientTypeNormal {
+		leases, err := n.Leases(r.Context(), reqProject.Name, clientType)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return response.SyncResponse(true, leases)
+	}
+
+	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanView, auth.ObjectTypeProject)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	// Find the projects which can use the network, that is those sharing the network's project and
+	// allowed access to it, skipping those the user can't see.
+	var projects []api.Project
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbProjects, err := dbCluster.GetProjects(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed loading projects: %w", err)
+		}
+
+		for _, dbProject := range dbProjects {
+			if !userHasPermission(auth.ObjectProject(dbProject.Name)) {
+				continue
+			}
+
+			p, err := dbProject.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			if project.NetworkProjectFromRecord(p) != projectName || !project.NetworkAllowed(p.Config, networkName, n.IsManaged()) {
+				continue
+			}
+
+			projects = append(projects, *p)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return response.SmartError(err)
 	}
 
+	// Aggregate the leases of each project, recording which project they came from.
+	// Leases which can't be attributed to a project (such as DHCPv6 ones) are returned once.
+	leases := []api.NetworkLease{}
+	seen := map[string]bool{}
+	for _, p := range projects {
+		projectLeases, err := n.Leases(r.Context(), p.Name, clientType)
+		if err != nil {
+			return response.SmartError(fmt.Errorf("Failed getting leases for project %q: %w", p.Name, err))
+		}
+
+		for _, lease := range projectLeases {
+			key := strings.Join([]string{lease.Type, lease.Address, lease.Hwaddr, lease.Location}, "/")
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			lease.Project = p.Name
+			leases = append(leases, lease)
+		}
+	}
+
 	return response.SyncResponse(true, leases)
 }
 
@@ -1691,7 +1826,7 @@ func networkStartup(s *state.State) error {
 		}
 
 		netConfig := n.Config()
-		err = n.Validate(netConfig)
+		err = n.Validate(s.ShutdownCtx, netConfig)
 		if err != nil {
 			return fmt.Errorf("Failed validating: %w", err)
 		}
@@ -1975,7 +2110,7 @@ func networkStateGet(d *Daemon, r *http.Request) response.Response {
 
 	var state *api.NetworkState
 	if n != nil {
-		state, err = n.State()
+		state, err = n.State(r.Context())
 		if err != nil {
 			return response.SmartError(err)
 		}
@@ -1988,3 +2123,313 @@ func networkStateGet(d *Daemon, r *http.Request) response.Response {
 
 	return response.SyncResponse(true, state)
 }
+
+// swagger:operation GET /1.0/networks/{name}/health networks networks_health_get
+//
+//	Get the network health
+//
+//	Runs health checks against the network and returns their results.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkHealth"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkHealthGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	health, err := n.Health(r.Context())
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Network health isn't supported for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, health)
+}
+
+// swagger:operation GET /1.0/networks/{name}/routes networks networks_routes_get
+//
+//	Get the network routes
+//
+//	Returns the static routes and routing policies of the network's router, along with the
+//	configuration, instance NIC, forward, load balancer or peering each of them comes from.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkRoutes"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRoutesGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	}
+
+	routes, err := n.Routes()
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Network routes aren't supported for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, routes)
+}
+
+// swagger:operation GET /1.0/networks/{name}/ovn networks networks_ovn_get
+//
+//	Get the network OVN objects
+//
+//	Returns the OVN northbound database objects backing the network.
+//	This is restricted to server administrators.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkOVN"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkOVNGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	objects, err := n.OVN()
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("OVN objects aren't available for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, objects)
+}
+
+// swagger:operation POST /1.0/networks/{name}/detach-uplink networks networks_detach_uplink_post
+//
+//	Detach the network uplink
+//
+//	Turns the network into an isolated network without stopping the instances using it.
+//	The uplink addresses are released and the forwards and load balancers using external listen addresses are disabled.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkDetachUplinkPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot detach the uplink of a network that isn't in created state"))
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	err = n.DetachUplink(r.Context(), clientType)
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Detaching the uplink isn't supported for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkUpdated.Event(n, request.CreateRequestor(r), nil))
+
+	return response.EmptySyncResponse
+}
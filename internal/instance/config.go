@@ -626,6 +626,18 @@ var InstanceConfigKeysContainer = map[string]func(value string) error{
 	//  shortdesc: Percentage of memory to have in sync before stopping the instance
 	"migration.incremental.memory.goal": validate.Optional(validate.IsUint32),
 
+	// gendoc:generate(entity=instance, group=oci, key=network.publish_ports)
+	// When enabled, the ports declared by the OCI image are exposed through a network forward on the network of each
+	// `ovn` NIC of the instance, using a listen address allocated from the uplink. The forward is removed when the
+	// instance stops.
+	// ---
+	//  type: bool
+	//  defaultdesc: `false`
+	//  liveupdate: no
+	//  condition: OCI container
+	//  shortdesc: Whether to publish the ports declared by the OCI image
+	"network.publish_ports": validate.Optional(validate.IsBool),
+
 	// gendoc:generate(entity=instance, group=nvidia, key=nvidia.runtime)
 	//
 	// ---
@@ -942,6 +954,13 @@ var InstanceConfigKeysContainer = map[string]func(value string) error{
 	//  shortdesc: Whether the container is an OCI application container
 	"volatile.container.oci": validate.IsBool,
 
+	// gendoc:generate(entity=instance, group=volatile, key=volatile.container.oci.ports)
+	// Comma-separated list of the ports declared by the OCI image, like `80/tcp,53/udp`.
+	// ---
+	//  type: string
+	//  shortdesc: Ports declared by the OCI image
+	"volatile.container.oci.ports": validate.IsAny,
+
 	// gendoc:generate(entity=instance, group=volatile, key=volatile.last_state.idmap)
 	//
 	// ---
@@ -1259,6 +1278,15 @@ func ConfigKeyChecker(key string, instanceType api.InstanceType) (func(value str
 			return validate.IsAny, nil
 		}
 
+		// gendoc:generate(entity=instance, group=volatile, key=volatile.<name>.publish_address)
+		// The listen address of the network forward publishing the ports of an OCI container.
+		// ---
+		//  type: string
+		//  shortdesc: Listen address of the published ports
+		if strings.HasSuffix(key, ".publish_address") {
+			return validate.Optional(validate.IsNetworkAddress), nil
+		}
+
 		// gendoc:generate(entity=instance, group=volatile, key=volatile.<name>.vgpu.uuid)
 		// The NVIDIA virtual GPU instance UUID.
 		// ---
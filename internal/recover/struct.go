@@ -18,10 +18,20 @@ type ValidateVolume struct {
 	Pool          string `json:"pool" yaml:"pool"`                   // Pool the volume belongs to.
 }
 
+// ValidateNetwork provides info about a missing network that the recovery validation scan found.
+type ValidateNetwork struct {
+	Name          string `json:"name" yaml:"name"`                   // Name of network.
+	Type          string `json:"type" yaml:"type"`                   // Network type (ovn).
+	Project       string `json:"project" yaml:"project"`             // Project the network belongs to.
+	Forwards      int    `json:"forwards" yaml:"forwards"`           // Count of network forwards found for network.
+	LoadBalancers int    `json:"loadBalancers" yaml:"loadBalancers"` // Count of network load balancers found for network.
+}
+
 // ValidateResult returns the result of the validation scan.
 type ValidateResult struct {
-	UnknownVolumes   []ValidateVolume // Volumes that could be imported.
-	DependencyErrors []string         // Errors that are preventing import from proceeding.
+	UnknownVolumes   []ValidateVolume  // Volumes that could be imported.
+	UnknownNetworks  []ValidateNetwork // Networks that could be imported.
+	DependencyErrors []string          // Errors that are preventing import from proceeding.
 }
 
 // ImportPost is used to initiate a recovert import.
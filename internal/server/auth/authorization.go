@@ -91,6 +91,10 @@ type Authorizer interface {
 	DeleteNetworkAddressSet(ctx context.Context, projectName string, networkAddressSetName string) error
 	RenameNetworkAddressSet(ctx context.Context, projectName string, oldNetworkAddressSetName string, newNetworkAddressSetName string) error
 
+	AddNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error
+	DeleteNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error
+	RenameNetworkTargetGroup(ctx context.Context, projectName string, oldNetworkTargetGroupName string, newNetworkTargetGroupName string) error
+
 	AddProfile(ctx context.Context, projectName string, profileName string) error
 	DeleteProfile(ctx context.Context, projectName string, profileName string) error
 	RenameProfile(ctx context.Context, projectName string, oldProfileName string, newProfileName string) error
@@ -126,6 +130,7 @@ type Resources struct {
 	NetworkACLObjects         []Object
 	NetworkAddressSetObjects  []Object
 	NetworkIntegrationObjects []Object
+	NetworkTargetGroupObjects []Object
 	NetworkZoneObjects        []Object
 	ProfileObjects            []Object
 	StoragePoolVolumeObjects  []Object
@@ -119,6 +119,7 @@ var objectValidators = map[ObjectType]objectValidator{
 	ObjectTypeNetworkACL:         {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: true},
 	ObjectTypeNetworkAddressSet:  {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: true},
 	ObjectTypeNetworkIntegration: {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: false},
+	ObjectTypeNetworkTargetGroup: {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: true},
 	ObjectTypeNetworkZone:        {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: true},
 	ObjectTypeProfile:            {minIdentifierElements: 1, maxIdentifierElements: 1, requireProject: true},
 	ObjectTypeStorageBucket:      {minIdentifierElements: 2, maxIdentifierElements: 3, requireProject: true},
@@ -321,6 +322,12 @@ func ObjectNetworkIntegration(networkIntegrationName string) Object {
 	return object
 }
 
+// ObjectNetworkTargetGroup represents a network target group.
+func ObjectNetworkTargetGroup(projectName string, networkTargetGroupName string) Object {
+	object, _ := NewObject(ObjectTypeNetworkTargetGroup, projectName, networkTargetGroupName)
+	return object
+}
+
 // ObjectNetworkZone represents a network zone.
 func ObjectNetworkZone(projectName string, networkZoneName string) Object {
 	object, _ := NewObject(ObjectTypeNetworkZone, projectName, networkZoneName)
@@ -20,18 +20,19 @@ const (
 	EntitlementCanViewSensitive                    Entitlement = "can_view_sensitive"
 
 	// Project entitlements.
-	EntitlementCanCreateImageAliases       Entitlement = "can_create_image_aliases"
-	EntitlementCanCreateImages             Entitlement = "can_create_images"
-	EntitlementCanCreateInstances          Entitlement = "can_create_instances"
-	EntitlementCanCreateNetworkACLs        Entitlement = "can_create_network_acls"
-	EntitlementCanCreateNetworkAddressSets Entitlement = "can_create_network_address_sets"
-	EntitlementCanCreateNetworks           Entitlement = "can_create_networks"
-	EntitlementCanCreateNetworkZones       Entitlement = "can_create_network_zones"
-	EntitlementCanCreateProfiles           Entitlement = "can_create_profiles"
-	EntitlementCanCreateStorageBuckets     Entitlement = "can_create_storage_buckets"
-	EntitlementCanCreateStorageVolumes     Entitlement = "can_create_storage_volumes"
-	EntitlementCanViewEvents               Entitlement = "can_view_events"
-	EntitlementCanViewOperations           Entitlement = "can_view_operations"
+	EntitlementCanCreateImageAliases        Entitlement = "can_create_image_aliases"
+	EntitlementCanCreateImages              Entitlement = "can_create_images"
+	EntitlementCanCreateInstances           Entitlement = "can_create_instances"
+	EntitlementCanCreateNetworkACLs         Entitlement = "can_create_network_acls"
+	EntitlementCanCreateNetworkAddressSets  Entitlement = "can_create_network_address_sets"
+	EntitlementCanCreateNetworks            Entitlement = "can_create_networks"
+	EntitlementCanCreateNetworkTargetGroups Entitlement = "can_create_network_target_groups"
+	EntitlementCanCreateNetworkZones        Entitlement = "can_create_network_zones"
+	EntitlementCanCreateProfiles            Entitlement = "can_create_profiles"
+	EntitlementCanCreateStorageBuckets      Entitlement = "can_create_storage_buckets"
+	EntitlementCanCreateStorageVolumes      Entitlement = "can_create_storage_volumes"
+	EntitlementCanViewEvents                Entitlement = "can_view_events"
+	EntitlementCanViewOperations            Entitlement = "can_view_operations"
 
 	// Instance entitlements.
 	EntitlementCanAccessConsole Entitlement = "can_access_console"
@@ -40,6 +41,11 @@ const (
 	EntitlementCanExec          Entitlement = "can_exec"
 	EntitlementCanUpdateState   Entitlement = "can_update_state"
 
+	// Network entitlements.
+	EntitlementCanManageForwards      Entitlement = "can_manage_forwards"
+	EntitlementCanManageLoadBalancers Entitlement = "can_manage_load_balancers"
+	EntitlementCanManagePeers         Entitlement = "can_manage_peers"
+
 	// Instance and storage volume entitlements.
 	EntitlementCanManageBackups   Entitlement = "can_manage_backups"
 	EntitlementCanManageSnapshots Entitlement = "can_manage_snapshots"
@@ -85,6 +91,9 @@ const (
 	// ObjectTypeNetworkIntegration represents a network integration.
 	ObjectTypeNetworkIntegration ObjectType = "network_integration"
 
+	// ObjectTypeNetworkTargetGroup represents a network target group.
+	ObjectTypeNetworkTargetGroup ObjectType = "network_target_group"
+
 	// ObjectTypeNetworkZone represents a network zone.
 	ObjectTypeNetworkZone ObjectType = "network_zone"
 
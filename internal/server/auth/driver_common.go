@@ -296,6 +296,21 @@ func (c *commonAuthorizer) RenameNetworkAddressSet(ctx context.Context, projectN
 	return nil
 }
 
+// AddNetworkTargetGroup is a no-op.
+func (c *commonAuthorizer) AddNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error {
+	return nil
+}
+
+// DeleteNetworkTargetGroup is a no-op.
+func (c *commonAuthorizer) DeleteNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error {
+	return nil
+}
+
+// RenameNetworkTargetGroup is a no-op.
+func (c *commonAuthorizer) RenameNetworkTargetGroup(ctx context.Context, projectName string, oldNetworkTargetGroupName string, newNetworkTargetGroupName string) error {
+	return nil
+}
+
 // AddProfile is a no-op.
 func (c *commonAuthorizer) AddProfile(ctx context.Context, projectName string, profileName string) error {
 	return nil
@@ -814,6 +814,53 @@ func (f *FGA) RenameNetworkAddressSet(ctx context.Context, projectName string, o
 	return f.updateTuples(ctx, writes, deletions)
 }
 
+// AddNetworkTargetGroup adds a network target group to the authorization model.
+func (f *FGA) AddNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error {
+	writes := []client.ClientTupleKey{
+		{
+			User:     ObjectProject(projectName).String(),
+			Relation: relationProject,
+			Object:   ObjectNetworkTargetGroup(projectName, networkTargetGroupName).String(),
+		},
+	}
+
+	return f.updateTuples(ctx, writes, nil)
+}
+
+// DeleteNetworkTargetGroup removes a network target group from the authorization model.
+func (f *FGA) DeleteNetworkTargetGroup(ctx context.Context, projectName string, networkTargetGroupName string) error {
+	deletions := []client.ClientTupleKeyWithoutCondition{
+		{
+			User:     ObjectProject(projectName).String(),
+			Relation: relationProject,
+			Object:   ObjectNetworkTargetGroup(projectName, networkTargetGroupName).String(),
+		},
+	}
+
+	return f.updateTuples(ctx, nil, deletions)
+}
+
+// RenameNetworkTargetGroup renames an existing network target group in the authorization model.
+func (f *FGA) RenameNetworkTargetGroup(ctx context.Context, projectName string, oldNetworkTargetGroupName string, newNetworkTargetGroupName string) error {
+	writes := []client.ClientTupleKey{
+		{
+			User:     ObjectProject(projectName).String(),
+			Relation: relationProject,
+			Object:   ObjectNetworkTargetGroup(projectName, newNetworkTargetGroupName).String(),
+		},
+	}
+
+	deletions := []client.ClientTupleKeyWithoutCondition{
+		{
+			User:     ObjectProject(projectName).String(),
+			Relation: relationProject,
+			Object:   ObjectNetworkTargetGroup(projectName, oldNetworkTargetGroupName).String(),
+		},
+	}
+
+	return f.updateTuples(ctx, writes, deletions)
+}
+
 // AddProfile is a no-op.
 func (f *FGA) AddProfile(ctx context.Context, projectName string, profileName string) error {
 	writes := []client.ClientTupleKey{
@@ -1005,6 +1052,7 @@ func (f *FGA) projectObjects(ctx context.Context, projectName string) ([]string,
 		ObjectTypeNetwork,
 		ObjectTypeNetworkACL,
 		ObjectTypeNetworkAddressSet,
+		ObjectTypeNetworkTargetGroup,
 		ObjectTypeNetworkZone,
 		ObjectTypeProfile,
 		ObjectTypeStorageVolume,
@@ -1164,6 +1212,7 @@ func (f *FGA) syncResources(ctx context.Context, resources Resources) error {
 	localProjectObjects = append(localProjectObjects, resources.NetworkZoneObjects...)
 	localProjectObjects = append(localProjectObjects, resources.NetworkACLObjects...)
 	localProjectObjects = append(localProjectObjects, resources.NetworkAddressSetObjects...)
+	localProjectObjects = append(localProjectObjects, resources.NetworkTargetGroupObjects...)
 	localProjectObjects = append(localProjectObjects, resources.ProfileObjects...)
 	localProjectObjects = append(localProjectObjects, resources.StoragePoolVolumeObjects...)
 	localProjectObjects = append(localProjectObjects, resources.StorageBucketObjects...)
@@ -7,6 +7,7 @@ import (
 	"maps"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -497,6 +498,35 @@ func (s *Server) addPeer(address net.IP, asn uint32, password string, holdTime u
 	return nil
 }
 
+// PeerState returns the current session state of a peer (e.g. "established").
+func (s *Server) PeerState(address net.IP) (string, error) {
+	// Locking.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, bgpPeerExists := s.peers[address.String()]
+	if !bgpPeerExists {
+		return "", ErrPeerNotFound
+	}
+
+	// The peer isn't connected if the server isn't running.
+	if s.bgp == nil {
+		return "idle", nil
+	}
+
+	state := "unknown"
+	err := s.bgp.ListPeer(context.Background(), &bgpAPI.ListPeerRequest{Address: address.String()}, func(p *bgpAPI.Peer) {
+		if p.State != nil {
+			state = strings.ToLower(p.State.SessionState.String())
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return state, nil
+}
+
 // RemovePeer removes a prefix from the BGP server.
 func (s *Server) RemovePeer(address net.IP) error {
 	// Locking.
@@ -15,6 +15,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/config"
 	"github.com/lxc/incus/v6/internal/server/db"
 	scriptletLoad "github.com/lxc/incus/v6/internal/server/scriptlet/load"
+	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	"github.com/lxc/incus/v6/shared/validate"
 )
 
@@ -148,6 +149,11 @@ func (c *Config) ClusterRebalanceThreshold() int64 {
 	return c.m.GetInt64("cluster.rebalance.threshold")
 }
 
+// NetworkHwaddrPrefix returns the prefix of the MAC addresses generated for instance NICs and networks.
+func (c *Config) NetworkHwaddrPrefix() string {
+	return c.m.GetString("network.hwaddr_prefix")
+}
+
 // NetworkOVNIntegrationBridge returns the integration OVS bridge to use for OVN networks.
 func (c *Config) NetworkOVNIntegrationBridge() string {
 	return c.m.GetString("network.ovn.integration_bridge")
@@ -158,11 +164,36 @@ func (c *Config) NetworkOVNNorthboundConnection() string {
 	return c.m.GetString("network.ovn.northbound_connection")
 }
 
+// NetworkOVNSouthboundConnection returns the OVN southbound database connection string for OVN networks.
+func (c *Config) NetworkOVNSouthboundConnection() string {
+	return c.m.GetString("network.ovn.southbound_connection")
+}
+
 // NetworkOVNSSL returns all three SSL configuration keys needed for a connection.
 func (c *Config) NetworkOVNSSL() (string, string, string) {
 	return c.m.GetString("network.ovn.ca_cert"), c.m.GetString("network.ovn.client_cert"), c.m.GetString("network.ovn.client_key")
 }
 
+// NetworkReplicationTLS returns the client certificate and key used to replicate networks to standby clusters.
+func (c *Config) NetworkReplicationTLS() (string, string) {
+	return c.m.GetString("network.replication.client_cert"), c.m.GetString("network.replication.client_key")
+}
+
+// NetworkOVNTransactionRetry returns how many times OVN database transactions failing with transient errors are
+// retried and the initial delay between attempts.
+func (c *Config) NetworkOVNTransactionRetry() (int, time.Duration) {
+	retries := c.m.GetInt64("network.ovn.transaction_retries")
+	delay := c.m.GetInt64("network.ovn.transaction_retry_delay")
+
+	return int(retries), time.Duration(delay) * time.Millisecond
+}
+
+// NetworkOVNStaleRecordsPruning returns whether stale OVN DNS records and DHCP reservations are pruned
+// (enabled), only reported (dry-run) or left alone (disabled).
+func (c *Config) NetworkOVNStaleRecordsPruning() string {
+	return c.m.GetString("network.ovn.stale_records_pruning")
+}
+
 // LinstorControllerConnection returns the Linstor controller connection string.
 func (c *Config) LinstorControllerConnection() string {
 	return c.m.GetString("storage.linstor.controller_connection")
@@ -968,6 +999,18 @@ var ConfigSchema = config.Schema{
 	//  shortdesc: OpenID Connect claim to use as the username
 	"oidc.claim": {},
 
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.hwaddr_prefix)
+	// Use a prefix of more than three octets to give each cluster sharing a layer 2 network its own pool of addresses.
+	// Generated addresses skip those already used by instance NICs and networks of the cluster.
+	// Changing it only affects the addresses generated afterwards, except for bridge networks without `bridge.hwaddr` which pick it up when they're next started.
+	//
+	// ---
+	//  type: string
+	//  scope: global
+	//  defaultdesc: `10:66:6a`
+	//  shortdesc: Prefix (one to five octets) of the MAC addresses generated for instance NICs and networks
+	"network.hwaddr_prefix": {Default: localUtil.DefaultHwaddrPrefix, Validator: validate.Optional(localUtil.IsHwaddrPrefix)},
+
 	// OVN networking global keys.
 
 	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.integration_bridge)
@@ -980,6 +1023,8 @@ var ConfigSchema = config.Schema{
 	"network.ovn.integration_bridge": {Default: "br-int"},
 
 	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.northbound_connection)
+	// Multiple comma-separated endpoints can be listed, in which case they're tried in order and the connection
+	// automatically fails over to the next reachable one when the current endpoint goes away or stops responding.
 	//
 	// ---
 	//  type: string
@@ -988,6 +1033,17 @@ var ConfigSchema = config.Schema{
 	//  shortdesc: OVN northbound database connection string
 	"network.ovn.northbound_connection": {Default: "unix:/run/ovn/ovnnb_db.sock"},
 
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.southbound_connection)
+	// Like `network.ovn.northbound_connection`, multiple comma-separated endpoints can be listed to fail over between.
+	// When unset, the `ovn-remote` configured in the local Open vSwitch database is used.
+	//
+	// ---
+	//  type: string
+	//  scope: global
+	//  defaultdesc: `ovn-remote` of the local Open vSwitch
+	//  shortdesc: OVN southbound database connection string
+	"network.ovn.southbound_connection": {},
+
 	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.ca_cert)
 	//
 	// ---
@@ -1015,6 +1071,54 @@ var ConfigSchema = config.Schema{
 	//  shortdesc: OVN SSL client key
 	"network.ovn.client_key": {Default: ""},
 
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.replication.client_cert)
+	//
+	// ---
+	//  type: string
+	//  scope: global
+	//  shortdesc: Client certificate used to replicate OVN networks to standby clusters
+	"network.replication.client_cert": {Default: ""},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.replication.client_key)
+	//
+	// ---
+	//  type: string
+	//  scope: global
+	//  shortdesc: Client key used to replicate OVN networks to standby clusters
+	"network.replication.client_key": {Default: ""},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.transaction_retries)
+	// Transient errors include the database connection being reset, for example during a leader election.
+	// Transactions which may already have been committed are only retried if applying them again is harmless.
+	//
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `3`
+	//  shortdesc: Number of retries for OVN northbound transactions failing with transient errors
+	"network.ovn.transaction_retries": {Type: config.Int64, Default: "3", Validator: validate.Optional(validate.IsInRange(0, 10))},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.transaction_retry_delay)
+	// The delay doubles on each retry (up to 5 seconds) and is randomized to avoid cluster members retrying at the same time.
+	//
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `250`
+	//  shortdesc: Initial delay (in milliseconds) between retries of OVN northbound transactions
+	"network.ovn.transaction_retry_delay": {Type: config.Int64, Default: "250", Validator: validate.Optional(validate.IsInRange(1, 5000))},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.stale_records_pruning)
+	// Every hour, the DNS records and DHCP reservations of OVN networks are checked against the instance NICs and external ports that still exist.
+	// Records left behind, for example because the cluster member hosting an instance was lost, are removed when set to `enabled` and only logged when set to `dry-run`.
+	//
+	// ---
+	//  type: string
+	//  scope: global
+	//  defaultdesc: `enabled`
+	//  shortdesc: Whether to prune stale OVN DNS records and DHCP reservations (`enabled`, `dry-run` or `disabled`)
+	"network.ovn.stale_records_pruning": {Default: "enabled", Validator: validate.Optional(validate.IsOneOf("enabled", "dry-run", "disabled"))},
+
 	// gendoc:generate(entity=server, group=miscellaneous, key=storage.linstor.controller_connection)
 	//
 	// ---
@@ -0,0 +1,74 @@
+//go:build linux && cgo && !agent
+
+package cluster
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// Code generation directives.
+//
+//generate-database:mapper target networks_external_ports.mapper.go
+//generate-database:mapper reset -i -b "//go:build linux && cgo && !agent"
+//
+//generate-database:mapper stmt -e network_external_port objects table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port objects-by-NetworkID table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port objects-by-NetworkID-and-Name table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port objects-by-NodeID table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port id table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port create table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port update table=networks_external_ports
+//generate-database:mapper stmt -e network_external_port delete-by-NetworkID-and-Name table=networks_external_ports
+//
+//generate-database:mapper method -i -e network_external_port GetMany references=Config table=networks_external_ports
+//generate-database:mapper method -i -e network_external_port GetOne table=networks_external_ports
+//generate-database:mapper method -i -e network_external_port ID table=networks_external_ports
+//generate-database:mapper method -i -e network_external_port Create references=Config table=networks_external_ports
+//generate-database:mapper method -i -e network_external_port Update references=Config table=networks_external_ports
+//generate-database:mapper method -i -e network_external_port DeleteOne-by-NetworkID-and-Name table=networks_external_ports
+
+// NetworkExternalPort is the generated entity backing the networks_external_ports table.
+type NetworkExternalPort struct {
+	ID          int64
+	NetworkID   int64   `db:"primary=yes&column=network_id"`
+	Name        string  `db:"primary=yes"`
+	NodeID      int64   `db:"column=node_id"`
+	Location    *string `db:"leftjoin=nodes.name&omit=create,update"`
+	Description string
+}
+
+// NetworkExternalPortFilter defines the optional WHERE-clause fields.
+type NetworkExternalPortFilter struct {
+	ID        *int64
+	NetworkID *int64
+	NodeID    *int64
+	Name      *string
+}
+
+// ToAPI converts the DB record into the external API type.
+func (n *NetworkExternalPort) ToAPI(ctx context.Context, tx *sql.Tx) (*api.NetworkExternalPort, error) {
+	// Get the config.
+	cfg, err := GetNetworkExternalPortConfig(ctx, tx, int(n.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill in the struct.
+	out := api.NetworkExternalPort{
+		NetworkExternalPortPut: api.NetworkExternalPortPut{
+			Description: n.Description,
+			Config:      cfg,
+		},
+
+		Name: n.Name,
+	}
+
+	if n.Location != nil {
+		out.Location = *n.Location
+	}
+
+	return &out, nil
+}
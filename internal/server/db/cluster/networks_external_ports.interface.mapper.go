@@ -0,0 +1,44 @@
+//go:build linux && cgo && !agent
+
+package cluster
+
+import "context"
+
+// NetworkExternalPortGenerated is an interface of generated methods for NetworkExternalPort.
+type NetworkExternalPortGenerated interface {
+	// GetNetworkExternalPortConfig returns all available NetworkExternalPort Config
+	// generator: network_external_port GetMany
+	GetNetworkExternalPortConfig(ctx context.Context, db tx, networkExternalPortID int, filters ...ConfigFilter) (map[string]string, error)
+
+	// GetNetworkExternalPorts returns all available network_external_ports.
+	// generator: network_external_port GetMany
+	GetNetworkExternalPorts(ctx context.Context, db dbtx, filters ...NetworkExternalPortFilter) ([]NetworkExternalPort, error)
+
+	// GetNetworkExternalPort returns the network_external_port with the given key.
+	// generator: network_external_port GetOne
+	GetNetworkExternalPort(ctx context.Context, db dbtx, networkID int64, name string) (*NetworkExternalPort, error)
+
+	// GetNetworkExternalPortID return the ID of the network_external_port with the given key.
+	// generator: network_external_port ID
+	GetNetworkExternalPortID(ctx context.Context, db tx, networkID int64, name string) (int64, error)
+
+	// CreateNetworkExternalPortConfig adds new network_external_port Config to the database.
+	// generator: network_external_port Create
+	CreateNetworkExternalPortConfig(ctx context.Context, db dbtx, networkExternalPortID int64, config map[string]string) error
+
+	// CreateNetworkExternalPort adds a new network_external_port to the database.
+	// generator: network_external_port Create
+	CreateNetworkExternalPort(ctx context.Context, db dbtx, object NetworkExternalPort) (int64, error)
+
+	// UpdateNetworkExternalPortConfig updates the network_external_port Config matching the given key parameters.
+	// generator: network_external_port Update
+	UpdateNetworkExternalPortConfig(ctx context.Context, db tx, networkExternalPortID int64, config map[string]string) error
+
+	// UpdateNetworkExternalPort updates the network_external_port matching the given key parameters.
+	// generator: network_external_port Update
+	UpdateNetworkExternalPort(ctx context.Context, db tx, networkID int64, name string, object NetworkExternalPort) error
+
+	// DeleteNetworkExternalPort deletes the network_external_port matching the given key parameters.
+	// generator: network_external_port DeleteOne-by-NetworkID-and-Name
+	DeleteNetworkExternalPort(ctx context.Context, db dbtx, networkID int64, name string) error
+}
@@ -58,6 +58,25 @@ var NetworkPeerTypes = map[string]int{
 	NetworkPeerTypeNames[NetworkPeerTypeRemote]: NetworkPeerTypeRemote,
 }
 
+// NetworkPeerApprovalKey is the config key holding the approval state of a peering between projects.
+const NetworkPeerApprovalKey = "volatile.approval"
+
+// Approval states of a peering between projects.
+const (
+	// NetworkPeerApprovalRequested is set on the peer which requested the peering.
+	NetworkPeerApprovalRequested = "requested"
+
+	// NetworkPeerApprovalPending is set on the peer added to the target network by the request, until its owner
+	// approves or rejects the peering.
+	NetworkPeerApprovalPending = "pending"
+
+	// NetworkPeerApprovalRejected is set on the peer which requested the peering once the target side rejected it.
+	NetworkPeerApprovalRejected = "rejected"
+)
+
+// NetworkPeerActiveIntegrationKey is the config key holding the integration a remote peering failed over to.
+const NetworkPeerActiveIntegrationKey = "volatile.target_integration.active"
+
 // NetworkPeer is a value object holding db-related details about a network peer.
 // Fields correspond to the columns in the networks_peers table.
 // generate-database will create CRUD methods and config helpers automatically.
@@ -99,6 +118,14 @@ func (n *NetworkPeer) ToAPI(ctx context.Context, tx *sql.Tx) (*api.NetworkPeer,
 		return nil, err
 	}
 
+	// The approval state is internal and reflected in the status instead.
+	approval := configMap[NetworkPeerApprovalKey]
+	delete(configMap, NetworkPeerApprovalKey)
+
+	// The active integration is reported in its own field.
+	activeIntegration := configMap[NetworkPeerActiveIntegrationKey]
+	delete(configMap, NetworkPeerActiveIntegrationKey)
+
 	resp := api.NetworkPeer{
 		NetworkPeerPut: api.NetworkPeerPut{
 			Description: n.Description,
@@ -141,6 +168,11 @@ func (n *NetworkPeer) ToAPI(ctx context.Context, tx *sql.Tx) (*api.NetworkPeer,
 		}
 
 		resp.TargetIntegration = integrations[0].Name
+		resp.ActiveIntegration = integrations[0].Name
+		if activeIntegration != "" {
+			resp.ActiveIntegration = activeIntegration
+		}
+
 		resp.Status = api.NetworkStatusCreated
 	} else {
 		// Peer has mutual peering from target network.
@@ -156,7 +188,14 @@ func (n *NetworkPeer) ToAPI(ctx context.Context, tx *sql.Tx) (*api.NetworkPeer,
 		} else {
 			if n.TargetNetworkID.Valid {
 				// Peer is linked to an mutual peer on the target network.
-				resp.Status = api.NetworkStatusCreated
+				switch approval {
+				case NetworkPeerApprovalRequested, NetworkPeerApprovalPending:
+					resp.Status = api.NetworkPeerStatusAwaitingApproval
+				case NetworkPeerApprovalRejected:
+					resp.Status = api.NetworkPeerStatusRejected
+				default:
+					resp.Status = api.NetworkStatusCreated
+				}
 			} else {
 				// Peer isn't linked to a mutual peer on the target network yet and has no joining details.
 				// Perhaps it was formerly joined (and had its joining details cleared) and subsequently
@@ -0,0 +1,78 @@
+//go:build linux && cgo && !agent
+
+package cluster
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// Code generation directives.
+//
+//generate-database:mapper target networks_target_groups.mapper.go
+//generate-database:mapper reset -i -b "//go:build linux && cgo && !agent"
+//
+//generate-database:mapper stmt -e network_target_group objects table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group objects-by-ID table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group objects-by-Name table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group objects-by-Project table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group objects-by-Project-and-Name table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group id table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group create struct=NetworkTargetGroup table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group rename table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group update struct=NetworkTargetGroup table=networks_target_groups
+//generate-database:mapper stmt -e network_target_group delete-by-Project-and-Name table=networks_target_groups
+//
+//generate-database:mapper method -i -e network_target_group ID struct=NetworkTargetGroup table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group Exists struct=NetworkTargetGroup table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group GetMany references=Config table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group GetOne struct=NetworkTargetGroup table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group Create references=Config table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group Rename table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group Update struct=NetworkTargetGroup references=Config table=networks_target_groups
+//generate-database:mapper method -i -e network_target_group DeleteOne-by-Project-and-Name table=networks_target_groups
+
+// NetworkTargetGroup is a value object holding db-related details about a network target group.
+type NetworkTargetGroup struct {
+	ID          int
+	ProjectID   int      `db:"omit=create,update"`
+	Project     string   `db:"primary=yes&join=projects.name"`
+	Name        string   `db:"primary=yes"`
+	Description string   `db:"coalesce=''"`
+	Addresses   []string `db:"marshal=json"`
+	Instances   []string `db:"marshal=json"`
+}
+
+// NetworkTargetGroupFilter specifies potential query parameter fields.
+type NetworkTargetGroupFilter struct {
+	ID      *int
+	Name    *string
+	Project *string
+}
+
+// ToAPI converts the DB records to an API record.
+func (n *NetworkTargetGroup) ToAPI(ctx context.Context, tx *sql.Tx) (*api.NetworkTargetGroup, error) {
+	// Get the config.
+	config, err := GetNetworkTargetGroupConfig(ctx, tx, n.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill in the struct.
+	resp := api.NetworkTargetGroup{
+		NetworkTargetGroupPost: api.NetworkTargetGroupPost{
+			Name: n.Name,
+		},
+		NetworkTargetGroupPut: api.NetworkTargetGroupPut{
+			Description: n.Description,
+			Addresses:   n.Addresses,
+			Instances:   n.Instances,
+			Config:      config,
+		},
+		Project: n.Project,
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,52 @@
+//go:build linux && cgo && !agent
+
+package cluster
+
+import "context"
+
+// NetworkTargetGroupGenerated is an interface of generated methods for NetworkTargetGroup.
+type NetworkTargetGroupGenerated interface {
+	// GetNetworkTargetGroupID return the ID of the network_target_group with the given key.
+	// generator: network_target_group ID
+	GetNetworkTargetGroupID(ctx context.Context, db tx, project string, name string) (int64, error)
+
+	// NetworkTargetGroupExists checks if a network_target_group with the given key exists.
+	// generator: network_target_group Exists
+	NetworkTargetGroupExists(ctx context.Context, db dbtx, project string, name string) (bool, error)
+
+	// GetNetworkTargetGroupConfig returns all available NetworkTargetGroup Config
+	// generator: network_target_group GetMany
+	GetNetworkTargetGroupConfig(ctx context.Context, db tx, networkTargetGroupID int, filters ...ConfigFilter) (map[string]string, error)
+
+	// GetNetworkTargetGroups returns all available network_target_groups.
+	// generator: network_target_group GetMany
+	GetNetworkTargetGroups(ctx context.Context, db dbtx, filters ...NetworkTargetGroupFilter) ([]NetworkTargetGroup, error)
+
+	// GetNetworkTargetGroup returns the network_target_group with the given key.
+	// generator: network_target_group GetOne
+	GetNetworkTargetGroup(ctx context.Context, db dbtx, project string, name string) (*NetworkTargetGroup, error)
+
+	// CreateNetworkTargetGroupConfig adds new network_target_group Config to the database.
+	// generator: network_target_group Create
+	CreateNetworkTargetGroupConfig(ctx context.Context, db dbtx, networkTargetGroupID int64, config map[string]string) error
+
+	// CreateNetworkTargetGroup adds a new network_target_group to the database.
+	// generator: network_target_group Create
+	CreateNetworkTargetGroup(ctx context.Context, db dbtx, object NetworkTargetGroup) (int64, error)
+
+	// RenameNetworkTargetGroup renames the network_target_group matching the given key parameters.
+	// generator: network_target_group Rename
+	RenameNetworkTargetGroup(ctx context.Context, db dbtx, project string, name string, to string) error
+
+	// UpdateNetworkTargetGroupConfig updates the network_target_group Config matching the given key parameters.
+	// generator: network_target_group Update
+	UpdateNetworkTargetGroupConfig(ctx context.Context, db tx, networkTargetGroupID int64, config map[string]string) error
+
+	// UpdateNetworkTargetGroup updates the network_target_group matching the given key parameters.
+	// generator: network_target_group Update
+	UpdateNetworkTargetGroup(ctx context.Context, db tx, project string, name string, object NetworkTargetGroup) error
+
+	// DeleteNetworkTargetGroup deletes the network_target_group matching the given key parameters.
+	// generator: network_target_group DeleteOne-by-Project-and-Name
+	DeleteNetworkTargetGroup(ctx context.Context, db dbtx, project string, name string) error
+}
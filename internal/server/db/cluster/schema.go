@@ -266,6 +266,25 @@ CREATE TABLE "networks_config" (
     FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE,
     FOREIGN KEY (node_id) REFERENCES "nodes" (id) ON DELETE CASCADE
 );
+CREATE INDEX networks_config_key_value_network_id_idx ON networks_config (key, value, network_id);
+CREATE TABLE "networks_external_ports" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    node_id INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    UNIQUE (network_id, name),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE,
+    FOREIGN KEY (node_id) REFERENCES "nodes" (id) ON DELETE CASCADE
+);
+CREATE TABLE "networks_external_ports_config" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_external_port_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (network_external_port_id, key),
+    FOREIGN KEY (network_external_port_id) REFERENCES "networks_external_ports" (id) ON DELETE CASCADE
+);
 CREATE TABLE "networks_forwards" (
     id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
     network_id INTEGER NOT NULL,
@@ -352,6 +371,52 @@ CREATE TABLE "networks_peers_config" (
     UNIQUE (network_peer_id, key),
     FOREIGN KEY (network_peer_id) REFERENCES "networks_peers" (id) ON DELETE CASCADE
 );
+CREATE TABLE "networks_restore_points" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    creation_date DATETIME NOT NULL,
+    expiry_date DATETIME,
+    definition TEXT NOT NULL,
+    UNIQUE (network_id, name),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE
+);
+CREATE TABLE "networks_target_groups" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    project_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    addresses TEXT NOT NULL,
+    instances TEXT NOT NULL,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES "projects" (id) ON DELETE CASCADE
+);
+CREATE TABLE "networks_target_groups_config" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_target_group_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (network_target_group_id, key),
+    FOREIGN KEY (network_target_group_id) REFERENCES "networks_target_groups" (id) ON DELETE CASCADE
+);
+CREATE TABLE "networks_traffic" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    node_id INTEGER NOT NULL,
+    ports_ingress_bytes INTEGER NOT NULL DEFAULT 0,
+    ports_ingress_packets INTEGER NOT NULL DEFAULT 0,
+    ports_egress_bytes INTEGER NOT NULL DEFAULT 0,
+    ports_egress_packets INTEGER NOT NULL DEFAULT 0,
+    uplink_ingress_bytes INTEGER NOT NULL DEFAULT 0,
+    uplink_ingress_packets INTEGER NOT NULL DEFAULT 0,
+    uplink_egress_bytes INTEGER NOT NULL DEFAULT 0,
+    uplink_egress_packets INTEGER NOT NULL DEFAULT 0,
+    updated_at DATETIME NOT NULL,
+    UNIQUE (network_id, node_id),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE,
+    FOREIGN KEY (node_id) REFERENCES "nodes" (id) ON DELETE CASCADE
+);
+CREATE INDEX networks_type_state_idx ON networks (type, state);
 CREATE UNIQUE INDEX networks_unique_network_id_node_id_key ON "networks_config" (network_id, IFNULL(node_id, -1), key);
 CREATE TABLE "networks_zones" (
     id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
@@ -674,5 +739,5 @@ CREATE TABLE "warnings" (
 );
 CREATE UNIQUE INDEX warnings_unique_node_id_project_id_entity_type_code_entity_id_type_code ON warnings(IFNULL(node_id, -1), IFNULL(project_id, -1), entity_type_code, entity_id, type_code);
 
-INSERT INTO schema (version, updated_at) VALUES (76, strftime("%s"))
+INSERT INTO schema (version, updated_at) VALUES (81, strftime("%s"))
 `
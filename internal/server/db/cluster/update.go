@@ -113,6 +113,132 @@ var updates = map[int]schema.Update{
 	74: updateFromV73,
 	75: updateFromV74,
 	76: updateFromV75,
+	77: updateFromV76,
+	78: updateFromV77,
+	79: updateFromV78,
+	80: updateFromV79,
+	81: updateFromV80,
+}
+
+func updateFromV80(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE TABLE "networks_target_groups" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    project_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    addresses TEXT NOT NULL,
+    instances TEXT NOT NULL,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES "projects" (id) ON DELETE CASCADE
+);
+CREATE TABLE "networks_target_groups_config" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_target_group_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (network_target_group_id, key),
+    FOREIGN KEY (network_target_group_id) REFERENCES "networks_target_groups" (id) ON DELETE CASCADE
+);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating networks_target_groups tables: %w", err)
+	}
+
+	return nil
+}
+
+func updateFromV79(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE INDEX networks_config_key_value_network_id_idx ON networks_config (key, value, network_id);
+CREATE INDEX networks_type_state_idx ON networks (type, state);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating network indexes: %w", err)
+	}
+
+	return nil
+}
+
+func updateFromV78(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE TABLE "networks_traffic" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    node_id INTEGER NOT NULL,
+    ports_ingress_bytes INTEGER NOT NULL DEFAULT 0,
+    ports_ingress_packets INTEGER NOT NULL DEFAULT 0,
+    ports_egress_bytes INTEGER NOT NULL DEFAULT 0,
+    ports_egress_packets INTEGER NOT NULL DEFAULT 0,
+    uplink_ingress_bytes INTEGER NOT NULL DEFAULT 0,
+    uplink_ingress_packets INTEGER NOT NULL DEFAULT 0,
+    uplink_egress_bytes INTEGER NOT NULL DEFAULT 0,
+    uplink_egress_packets INTEGER NOT NULL DEFAULT 0,
+    updated_at DATETIME NOT NULL,
+    UNIQUE (network_id, node_id),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE,
+    FOREIGN KEY (node_id) REFERENCES "nodes" (id) ON DELETE CASCADE
+);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating networks_traffic table: %w", err)
+	}
+
+	return nil
+}
+
+func updateFromV77(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE TABLE "networks_restore_points" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    creation_date DATETIME NOT NULL,
+    expiry_date DATETIME,
+    definition TEXT NOT NULL,
+    UNIQUE (network_id, name),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE
+);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating networks_restore_points table: %w", err)
+	}
+
+	return nil
+}
+
+func updateFromV76(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE TABLE "networks_external_ports" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    node_id INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    UNIQUE (network_id, name),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE,
+    FOREIGN KEY (node_id) REFERENCES "nodes" (id) ON DELETE CASCADE
+);
+
+CREATE TABLE "networks_external_ports_config" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_external_port_id INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (network_external_port_id, key),
+    FOREIGN KEY (network_external_port_id) REFERENCES "networks_external_ports" (id) ON DELETE CASCADE
+);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating networks_external_ports and networks_external_ports_config tables: %w", err)
+	}
+
+	return nil
 }
 
 func updateFromV75(ctx context.Context, tx *sql.Tx) error {
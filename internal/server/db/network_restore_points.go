@@ -0,0 +1,157 @@
+//go:build linux && cgo && !agent
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/lxc/incus/v6/internal/server/db/query"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// NetworkRestorePoint is a value object holding all db-related details about a network restore point.
+type NetworkRestorePoint struct {
+	ID           int64
+	NetworkID    int64
+	Name         string
+	CreationDate time.Time
+	ExpiryDate   time.Time
+	Definition   string
+}
+
+// GetNetworkRestorePoints returns the restore points of the network with the given ID, oldest first.
+func (c *ClusterTx) GetNetworkRestorePoints(ctx context.Context, networkID int64) ([]NetworkRestorePoint, error) {
+	var restorePoints []NetworkRestorePoint
+
+	q := `
+SELECT id, network_id, name, creation_date, expiry_date, definition
+  FROM networks_restore_points
+  WHERE network_id=?
+  ORDER BY creation_date, id
+`
+
+	err := query.Scan(ctx, c.Tx(), q, func(scan func(dest ...any) error) error {
+		var rp NetworkRestorePoint
+		var expiryDate sql.NullTime
+
+		err := scan(&rp.ID, &rp.NetworkID, &rp.Name, &rp.CreationDate, &expiryDate, &rp.Definition)
+		if err != nil {
+			return err
+		}
+
+		rp.ExpiryDate = expiryDate.Time // Convert nulls to zero.
+		restorePoints = append(restorePoints, rp)
+
+		return nil
+	}, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	return restorePoints, nil
+}
+
+// GetNetworkRestorePoint returns the restore point with the given name of the network with the given ID.
+func (c *ClusterTx) GetNetworkRestorePoint(ctx context.Context, networkID int64, name string) (*NetworkRestorePoint, error) {
+	rp := NetworkRestorePoint{
+		NetworkID: networkID,
+		Name:      name,
+	}
+
+	var expiryDate sql.NullTime
+
+	q := "SELECT id, creation_date, expiry_date, definition FROM networks_restore_points WHERE network_id=? AND name=?"
+	err := c.tx.QueryRowContext(ctx, q, networkID, name).Scan(&rp.ID, &rp.CreationDate, &expiryDate, &rp.Definition)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, api.StatusErrorf(http.StatusNotFound, "Network restore point not found")
+		}
+
+		return nil, err
+	}
+
+	rp.ExpiryDate = expiryDate.Time // Convert nulls to zero.
+
+	return &rp, nil
+}
+
+// CreateNetworkRestorePoint creates a new network restore point.
+func (c *ClusterTx) CreateNetworkRestorePoint(ctx context.Context, rp NetworkRestorePoint) error {
+	_, err := c.GetNetworkRestorePoint(ctx, rp.NetworkID, rp.Name)
+	if err == nil {
+		return api.StatusErrorf(http.StatusConflict, "A network restore point for that name already exists")
+	}
+
+	var expiryDate sql.NullTime
+	if rp.ExpiryDate.Unix() > 0 {
+		expiryDate = sql.NullTime{Time: rp.ExpiryDate, Valid: true}
+	}
+
+	_, err = c.tx.ExecContext(ctx, "INSERT INTO networks_restore_points (network_id, name, creation_date, expiry_date, definition) VALUES (?, ?, ?, ?, ?)", rp.NetworkID, rp.Name, rp.CreationDate, expiryDate, rp.Definition)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkRestorePoint removes the restore point with the given name of the network with the given ID.
+func (c *ClusterTx) DeleteNetworkRestorePoint(ctx context.Context, networkID int64, name string) error {
+	result, err := c.tx.ExecContext(ctx, "DELETE FROM networks_restore_points WHERE network_id=? AND name=?", networkID, name)
+	if err != nil {
+		return err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return api.StatusErrorf(http.StatusNotFound, "Network restore point not found")
+	}
+
+	return nil
+}
+
+// GetExpiredNetworkRestorePoints returns a list of expired network restore points.
+func (c *ClusterTx) GetExpiredNetworkRestorePoints(ctx context.Context) ([]NetworkRestorePoint, error) {
+	var restorePoints []NetworkRestorePoint
+
+	q := "SELECT id, network_id, name, expiry_date FROM networks_restore_points WHERE expiry_date IS NOT NULL"
+
+	err := query.Scan(ctx, c.Tx(), q, func(scan func(dest ...any) error) error {
+		var rp NetworkRestorePoint
+		var expiryDate sql.NullTime
+
+		err := scan(&rp.ID, &rp.NetworkID, &rp.Name, &expiryDate)
+		if err != nil {
+			return err
+		}
+
+		rp.ExpiryDate = expiryDate.Time // Convert nulls to zero.
+
+		// Since zero time causes some issues due to timezones, we check the
+		// unix timestamp instead of IsZero().
+		if rp.ExpiryDate.Unix() <= 0 {
+			// Restore point doesn't expire.
+			return nil
+		}
+
+		// Restore point has expired.
+		if time.Now().Unix()-rp.ExpiryDate.Unix() >= 0 {
+			restorePoints = append(restorePoints, rp)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restorePoints, nil
+}
@@ -0,0 +1,74 @@
+//go:build linux && cgo && !agent
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// AddNetworkTraffic adds the given traffic counters to the totals recorded for the network on the member.
+func (c *ClusterTx) AddNetworkTraffic(ctx context.Context, networkID int64, nodeID int64, ports api.NetworkTrafficCounters, uplink api.NetworkTrafficCounters) error {
+	q := `
+INSERT INTO networks_traffic (
+    network_id, node_id,
+    ports_ingress_bytes, ports_ingress_packets, ports_egress_bytes, ports_egress_packets,
+    uplink_ingress_bytes, uplink_ingress_packets, uplink_egress_bytes, uplink_egress_packets,
+    updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (network_id, node_id) DO UPDATE SET
+    ports_ingress_bytes=ports_ingress_bytes+excluded.ports_ingress_bytes,
+    ports_ingress_packets=ports_ingress_packets+excluded.ports_ingress_packets,
+    ports_egress_bytes=ports_egress_bytes+excluded.ports_egress_bytes,
+    ports_egress_packets=ports_egress_packets+excluded.ports_egress_packets,
+    uplink_ingress_bytes=uplink_ingress_bytes+excluded.uplink_ingress_bytes,
+    uplink_ingress_packets=uplink_ingress_packets+excluded.uplink_ingress_packets,
+    uplink_egress_bytes=uplink_egress_bytes+excluded.uplink_egress_bytes,
+    uplink_egress_packets=uplink_egress_packets+excluded.uplink_egress_packets,
+    updated_at=excluded.updated_at
+`
+
+	_, err := c.tx.ExecContext(ctx, q, networkID, nodeID,
+		ports.IngressBytes, ports.IngressPackets, ports.EgressBytes, ports.EgressPackets,
+		uplink.IngressBytes, uplink.IngressPackets, uplink.EgressBytes, uplink.EgressPackets,
+		time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetNetworkTraffic returns the traffic totals of the network with the given ID summed across all members.
+func (c *ClusterTx) GetNetworkTraffic(ctx context.Context, networkID int64) (*api.NetworkTraffic, error) {
+	q := `
+SELECT
+    COALESCE(SUM(ports_ingress_bytes), 0), COALESCE(SUM(ports_ingress_packets), 0),
+    COALESCE(SUM(ports_egress_bytes), 0), COALESCE(SUM(ports_egress_packets), 0),
+    COALESCE(SUM(uplink_ingress_bytes), 0), COALESCE(SUM(uplink_ingress_packets), 0),
+    COALESCE(SUM(uplink_egress_bytes), 0), COALESCE(SUM(uplink_egress_packets), 0)
+  FROM networks_traffic
+  WHERE network_id=?
+`
+
+	var traffic api.NetworkTraffic
+
+	err := c.tx.QueryRowContext(ctx, q, networkID).Scan(
+		&traffic.Ports.IngressBytes, &traffic.Ports.IngressPackets, &traffic.Ports.EgressBytes, &traffic.Ports.EgressPackets,
+		&traffic.Uplink.IngressBytes, &traffic.Uplink.IngressPackets, &traffic.Uplink.EgressBytes, &traffic.Uplink.EgressPackets)
+	if err != nil {
+		return nil, err
+	}
+
+	q = "SELECT updated_at FROM networks_traffic WHERE network_id=? ORDER BY updated_at DESC LIMIT 1"
+	err = c.tx.QueryRowContext(ctx, q, networkID).Scan(&traffic.UpdatedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return &traffic, nil
+}
@@ -42,6 +42,19 @@ func (c *ClusterTx) GetNetworksLocalConfig(ctx context.Context) (map[string]map[
 	return networks, nil
 }
 
+// GetHwaddrsInUse returns the MAC addresses used by instance NICs (set in their config or generated) and by the
+// router or bridge of networks.
+func (c *ClusterTx) GetHwaddrsInUse(ctx context.Context) ([]string, error) {
+	q := `
+SELECT value FROM instances_config WHERE key LIKE 'volatile.%.hwaddr'
+UNION SELECT value FROM instances_devices_config WHERE key = 'hwaddr'
+UNION SELECT value FROM profiles_devices_config WHERE key = 'hwaddr'
+UNION SELECT value FROM networks_config WHERE key IN ('bridge.hwaddr', 'volatile.router.hwaddr')
+`
+
+	return query.SelectStrings(ctx, c.tx, q)
+}
+
 // GetNonPendingNetworkIDs returns a map associating each network name to its ID.
 //
 // Pending networks are skipped.
@@ -520,6 +533,62 @@ func (c *ClusterTx) GetNetworksAllProjects(ctx context.Context) (map[string][]st
 	return response, nil
 }
 
+// GetCreatedNetworksByUplink returns the names of the created networks of the given type whose "network"
+// setting is set to the uplink network, keyed by project name.
+func (c *ClusterTx) GetCreatedNetworksByUplink(ctx context.Context, netType NetworkType, uplinkName string) (map[string][]string, error) {
+	q := `
+SELECT projects.name, networks.name
+  FROM networks
+  JOIN projects ON projects.id = networks.project_id
+  JOIN networks_config ON networks_config.network_id = networks.id
+  WHERE networks_config.key = 'network' AND networks_config.value = ? AND networks.type = ? AND networks.state = ?
+`
+
+	response := map[string][]string{}
+
+	err := query.Scan(ctx, c.tx, q, func(scan func(dest ...any) error) error {
+		var projectName string
+		var networkName string
+
+		err := scan(&projectName, &networkName)
+		if err != nil {
+			return err
+		}
+
+		response[projectName] = append(response[projectName], networkName)
+
+		return nil
+	}, uplinkName, netType, networkCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetCreatedNetworksConfigValuesByUplink returns the values of the given config keys across all of the created
+// networks of the given type whose "network" setting is set to the uplink network.
+func (c *ClusterTx) GetCreatedNetworksConfigValuesByUplink(ctx context.Context, netType NetworkType, uplinkName string, keys ...string) ([]string, error) {
+	if len(keys) == 0 {
+		return []string{}, nil
+	}
+
+	q := fmt.Sprintf(`
+SELECT config.value
+  FROM networks
+  JOIN networks_config AS uplink ON uplink.network_id = networks.id
+  JOIN networks_config AS config ON config.network_id = networks.id
+  WHERE uplink.key = 'network' AND uplink.value = ? AND networks.type = ? AND networks.state = ? AND config.key IN %s
+`, query.Params(len(keys)))
+
+	args := []any{uplinkName, netType, networkCreated}
+	for _, key := range keys {
+		args = append(args, key)
+	}
+
+	return query.SelectStrings(ctx, c.tx, q, args...)
+}
+
 // Get all networks matching the given WHERE filter (if given).
 func (c *ClusterTx) networks(ctx context.Context, project string, where string, args ...any) ([]string, error) {
 	q := "SELECT name FROM networks WHERE project_id = (SELECT id FROM projects WHERE name = ?)"
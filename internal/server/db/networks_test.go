@@ -4,12 +4,14 @@ package db_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/response"
 	"github.com/lxc/incus/v6/shared/api"
 )
@@ -43,6 +45,109 @@ func TestGetNetworksLocalConfigs(t *testing.T) {
 	})
 }
 
+// The GetCreatedNetworksByUplink and GetCreatedNetworksConfigValuesByUplink methods only consider created
+// networks of the given type using the uplink.
+func TestGetCreatedNetworksByUplink(t *testing.T) {
+	tx, cleanup := db.NewTestClusterTx(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := tx.CreateNetwork(ctx, api.ProjectDefaultName, "ovn1", "", db.NetworkTypeOVN, map[string]string{
+		"network":                       "uplink",
+		"volatile.network.ipv4.address": "10.0.0.2",
+		"volatile.network.ipv6.address": "2001:db8::2",
+	})
+	require.NoError(t, err)
+
+	_, err = tx.CreateNetwork(ctx, api.ProjectDefaultName, "ovn2", "", db.NetworkTypeOVN, map[string]string{
+		"network":                       "other",
+		"volatile.network.ipv4.address": "10.0.0.3",
+	})
+	require.NoError(t, err)
+
+	_, err = tx.CreateNetwork(ctx, api.ProjectDefaultName, "bridge1", "", db.NetworkTypeBridge, map[string]string{
+		"network": "uplink",
+	})
+	require.NoError(t, err)
+
+	networks, err := tx.GetCreatedNetworksByUplink(ctx, db.NetworkTypeOVN, "uplink")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{api.ProjectDefaultName: {"ovn1"}}, networks)
+
+	values, err := tx.GetCreatedNetworksConfigValuesByUplink(ctx, db.NetworkTypeOVN, "uplink", "volatile.network.ipv4.address", "volatile.network.ipv6.address")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"10.0.0.2", "2001:db8::2"}, values)
+
+	values, err = tx.GetCreatedNetworksConfigValuesByUplink(ctx, db.NetworkTypeOVN, "missing", "volatile.network.ipv4.address")
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+// Network target groups are unique per project, keep their addresses, instances and config, and can be renamed,
+// updated and deleted.
+func TestNetworkTargetGroups(t *testing.T) {
+	tx, cleanup := db.NewTestClusterTx(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	id, err := cluster.CreateNetworkTargetGroup(ctx, tx.Tx(), cluster.NetworkTargetGroup{
+		Project:     api.ProjectDefaultName,
+		Name:        "web",
+		Description: "Web servers",
+		Addresses:   []string{"10.0.0.2", "fd42::2"},
+		Instances:   []string{"web01"},
+	})
+	require.NoError(t, err)
+
+	err = cluster.CreateNetworkTargetGroupConfig(ctx, tx.Tx(), id, map[string]string{"user.foo": "bar"})
+	require.NoError(t, err)
+
+	_, err = cluster.CreateNetworkTargetGroup(ctx, tx.Tx(), cluster.NetworkTargetGroup{Project: api.ProjectDefaultName, Name: "web"})
+	assert.True(t, api.StatusErrorCheck(err, http.StatusConflict))
+
+	group, err := cluster.GetNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "web")
+	require.NoError(t, err)
+
+	apiGroup, err := group.ToAPI(ctx, tx.Tx())
+	require.NoError(t, err)
+	assert.Equal(t, "Web servers", apiGroup.Description)
+	assert.Equal(t, []string{"10.0.0.2", "fd42::2"}, apiGroup.Addresses)
+	assert.Equal(t, []string{"web01"}, apiGroup.Instances)
+	assert.Equal(t, map[string]string{"user.foo": "bar"}, apiGroup.Config)
+
+	group.Addresses = []string{"10.0.0.3"}
+	group.Instances = nil
+	err = cluster.UpdateNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "web", *group)
+	require.NoError(t, err)
+
+	err = cluster.UpdateNetworkTargetGroupConfig(ctx, tx.Tx(), id, map[string]string{})
+	require.NoError(t, err)
+
+	err = cluster.RenameNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "web", "frontend")
+	require.NoError(t, err)
+
+	exists, err := cluster.NetworkTargetGroupExists(ctx, tx.Tx(), api.ProjectDefaultName, "web")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	group, err = cluster.GetNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "frontend")
+	require.NoError(t, err)
+
+	apiGroup, err = group.ToAPI(ctx, tx.Tx())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.3"}, apiGroup.Addresses)
+	assert.Empty(t, apiGroup.Instances)
+	assert.Empty(t, apiGroup.Config)
+
+	err = cluster.DeleteNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "frontend")
+	require.NoError(t, err)
+
+	_, err = cluster.GetNetworkTargetGroup(ctx, tx.Tx(), api.ProjectDefaultName, "frontend")
+	assert.True(t, api.StatusErrorCheck(err, http.StatusNotFound))
+}
+
 func TestCreatePendingNetwork(t *testing.T) {
 	tx, cleanup := db.NewTestClusterTx(t)
 	defer cleanup()
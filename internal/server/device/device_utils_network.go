@@ -1,11 +1,9 @@
 package device
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net"
-	"net/netip"
 	"os"
 	"slices"
 	"strconv"
@@ -13,8 +11,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/mdlayher/arp"
-	"github.com/mdlayher/ndp"
 	"golang.org/x/sys/unix"
 
 	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
@@ -978,7 +974,7 @@ func networkPCIBindWaitInterface(pciDev pcidev.Device, ifName string) error {
 
 // networkSRIOVSetupContainerVFNIC configures the VF NIC interface ready for moving into container.
 // It configures the MAC address and MTU, then brings the interface up.
-func networkSRIOVSetupContainerVFNIC(hostName string, config map[string]string) error {
+func networkSRIOVSetupContainerVFNIC(s *state.State, hostName string, config map[string]string) error {
 	// Set the MAC address.
 	if config["hwaddr"] != "" {
 		hwaddr, err := net.ParseMAC(config["hwaddr"])
@@ -1031,7 +1027,7 @@ func networkSRIOVSetupContainerVFNIC(hostName string, config map[string]string)
 		}
 
 		// Try using a random MAC address and bringing interface up.
-		randMAC, err := instance.DeviceNextInterfaceHWAddr()
+		randMAC, err := instance.DeviceNextInterfaceHWAddr(s.ShutdownCtx, s)
 		if err != nil {
 			return fmt.Errorf("Failed generating random MAC for VF %q: %w", hostName, err)
 		}
@@ -1056,85 +1052,6 @@ func networkSRIOVSetupContainerVFNIC(hostName string, config map[string]string)
 	return nil
 }
 
-// isIPAvailable checks if address responds to ARP/NDP neighbour probe on the parentInterface.
-// Returns true if IP is in use.
-func isIPAvailable(ctx context.Context, address net.IP, parentInterface string) (bool, error) {
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		// Set default timeout of 500ms if no deadline context provided.
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, time.Duration(500*time.Millisecond))
-		defer cancel()
-		deadline, _ = ctx.Deadline()
-	}
-
-	// Handle IPv4 address.
-	if address.To4() != nil {
-		err := pingOverIfaceByName(deadline, address, parentInterface)
-		if err != nil {
-			var ne net.Error
-			if errors.As(err, &ne) && ne.Timeout() {
-				return false, nil
-			}
-
-			return false, err
-		}
-
-		return true, nil
-	}
-
-	// Handle IPv6 address.
-	networkInterface, err := net.InterfaceByName(parentInterface)
-	if err != nil {
-		return false, err
-	}
-
-	conn, _, err := ndp.Listen(networkInterface, ndp.LinkLocal)
-	if err != nil {
-		return false, err
-	}
-
-	defer func() { _ = conn.Close() }()
-
-	netipAddr, ok := netip.AddrFromSlice(address)
-	if !ok {
-		return false, errors.New("Couldn't convert address to netip")
-	}
-
-	solicitedNodeMulticast, err := ndp.SolicitedNodeMulticast(netipAddr)
-	if err != nil {
-		return false, err
-	}
-
-	neighbourSolicitationMessage := &ndp.NeighborSolicitation{
-		TargetAddress: netipAddr,
-	}
-
-	_ = conn.SetDeadline(deadline)
-	err = conn.WriteTo(neighbourSolicitationMessage, nil, solicitedNodeMulticast)
-	if err != nil {
-		return false, err
-	}
-
-	_ = conn.SetDeadline(deadline)
-	msg, _, _, err := conn.ReadFrom()
-	if err != nil {
-		var cause net.Error
-		if errors.As(err, &cause) && cause.Timeout() {
-			return false, nil
-		}
-
-		return false, err
-	}
-
-	neighbourAdvertisement, ok := msg.(*ndp.NeighborAdvertisement)
-	if ok && neighbourAdvertisement.TargetAddress == netipAddr {
-		return true, nil
-	}
-
-	return false, nil
-}
-
 // networkVLANListExpand takes in a list of raw VLAN values (string) that includes
 // different VLAN formats ("number" and "start-end") and convert them into a list of
 // expanded VLAN values in integer.
@@ -1153,38 +1070,3 @@ func networkVLANListExpand(rawVLANValues []string) ([]int, error) {
 
 	return networkVLANList, nil
 }
-
-// pingOverIfaceByName sends an ARP request to the given IPv4 address using the specified network interface.
-// It respects the provided deadline and returns an error if resolution fails (unless due to timeout).
-func pingOverIfaceByName(deadline time.Time, address net.IP, parentInterface string) error {
-	// Obtain the network interface.
-	ifi, err := net.InterfaceByName(parentInterface)
-	if err != nil {
-		return err
-	}
-
-	// Open an ARP client on that interface.
-	c, err := arp.Dial(ifi)
-	if err != nil {
-		return err
-	}
-
-	defer func() { _ = c.Close() }()
-
-	// Honour the caller’s deadline.
-	_ = c.SetDeadline(deadline)
-
-	// Convert to netip.Addr which arp.Client expects.
-	netipAddr, ok := netip.AddrFromSlice(address.To4())
-	if !ok {
-		return fmt.Errorf("Invalid IPv4 address: %v", address)
-	}
-
-	// Try to resolve the IP → MAC. If it answers, the IP is in use.
-	_, err = c.Resolve(netipAddr)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -16,6 +16,7 @@ import (
 	"github.com/mdlayher/netx/eui64"
 
 	"github.com/lxc/incus/v6/internal/linux"
+	"github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
 	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
@@ -28,6 +29,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/network/acl"
 	addressset "github.com/lxc/incus/v6/internal/server/network/address-set"
 	"github.com/lxc/incus/v6/internal/server/network/ovn"
+	"github.com/lxc/incus/v6/internal/server/network/ovs"
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/resources"
 	"github.com/lxc/incus/v6/internal/server/state"
@@ -43,12 +45,17 @@ import (
 type ovnNet interface {
 	network.Network
 
-	InstanceDevicePortValidateExternalRoutes(deviceInstance instance.Instance, deviceName string, externalRoutes []*net.IPNet) error
+	InstanceDevicePortValidateExternalRoutes(ctx context.Context, deviceInstance instance.Instance, deviceName string, externalRoutes []*net.IPNet) error
+	InstanceDevicePortValidateNeighbors(deviceInstance instance.Instance, deviceName string, neighbors []net.IP) error
 	InstanceDevicePortAdd(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error
+	InstanceDevicePortPrecreate(opts *network.OVNInstanceNICSetupOpts) ([]net.IP, error)
 	InstanceDevicePortStart(opts *network.OVNInstanceNICSetupOpts, securityACLsRemove []string) (ovn.OVNSwitchPort, []net.IP, error)
 	InstanceDevicePortStop(ovsExternalOVNPort ovn.OVNSwitchPort, opts *network.OVNInstanceNICStopOpts) error
 	InstanceDevicePortRemove(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error
+	InstanceDevicePortExternalAddressesUpdate(instanceUUID string, deviceName string, oldConfig deviceConfig.Device, newConfig deviceConfig.Device) error
 	InstanceDevicePortIPs(instanceUUID string, deviceName string) ([]net.IP, error)
+	InstanceDevicePortState(instanceUUID string, deviceName string) (*api.InstanceStateNetworkOVN, error)
+	IntegrationBridge() (string, error)
 }
 
 type nicOVN struct {
@@ -73,12 +80,35 @@ func (d *nicOVN) CanMigrate() bool {
 // UpdatableFields returns a list of fields that can be updated without triggering a device remove & add.
 func (d *nicOVN) UpdatableFields(oldDevice Type) []string {
 	// Check old and new device types match.
-	_, match := oldDevice.(*nicOVN)
+	oldNIC, match := oldDevice.(*nicOVN)
 	if !match {
 		return []string{}
 	}
 
-	return []string{"security.acls"}
+	fields := []string{"security.acls", "ipv4.address.external", "ipv6.address.external"}
+
+	// Moving to another OVN network of the same project is done by moving the logical switch port.
+	if oldNIC.network != nil && d.network != nil && oldNIC.network.Project() == d.network.Project() && d.canMoveNetwork() {
+		fields = append(fields, "network")
+	}
+
+	return fields
+}
+
+// canMoveNetwork returns whether the device's logical switch port can be moved to another network.
+// Nested NICs and NICs with nested NICs are tied to their parent's logical switch port and can't be.
+func (d *nicOVN) canMoveNetwork() bool {
+	if d.config["nested"] != "" {
+		return false
+	}
+
+	for _, devConfig := range d.inst.ExpandedDevices() {
+		if devConfig["type"] == "nic" && devConfig["nested"] == d.name {
+			return false
+		}
+	}
+
+	return true
 }
 
 // validateConfig checks the supplied config for correctness.
@@ -198,6 +228,22 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		//  shortdesc: Comma-delimited list of IPv6 static routes to route to the NIC and publish on uplink network
 		"ipv6.routes.external",
 
+		// gendoc:generate(entity=devices, group=nic_ovn, key=ipv4.neighbors)
+		//
+		// ---
+		//  type: string
+		//  managed: no
+		//  shortdesc: Comma-delimited list of static ARP entries (`<address>[=<MAC>]`) to install on the network's router for the NIC
+		"ipv4.neighbors",
+
+		// gendoc:generate(entity=devices, group=nic_ovn, key=ipv6.neighbors)
+		//
+		// ---
+		//  type: string
+		//  managed: no
+		//  shortdesc: Comma-delimited list of static neighbor entries (`<address>[=<MAC>]`) to install on the network's router for the NIC
+		"ipv6.neighbors",
+
 		// gendoc:generate(entity=devices, group=nic_ovn, key=boot.priority)
 		//
 		// ---
@@ -250,6 +296,17 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		//  shortdesc: Whether to log egress traffic that doesn't match any ACL rule
 		"security.acls.default.egress.logged",
 
+		// gendoc:generate(entity=devices, group=nic_ovn, key=security.acls.exclusive)
+		// When enabled, only the ACLs set in `security.acls` apply to the NIC and those of its network are ignored.
+		// This is meant for appliances such as firewalls or routers that must see all traffic.
+		// Requires {config:option}`project-restricted:restricted.networks.acls.exclusive` to be set to `allow` in restricted projects.
+		// ---
+		//  type: bool
+		//  default: false
+		//  managed: no
+		//  shortdesc: Whether to ignore the ACLs of the network
+		"security.acls.exclusive",
+
 		// gendoc:generate(entity=devices, group=nic_ovn, key=security.promiscuous)
 		//
 		// ---
@@ -268,6 +325,17 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		//  shortdesc: Enable hardware offloading (either `none`, `sriov` or `vdpa`)
 		"acceleration",
 
+		// gendoc:generate(entity=devices, group=nic_ovn, key=mode)
+		// In `routed` mode, the instance gets a `/32` subnet mask through DHCPv4 and its addresses are routed to it
+		// by the network's router, so all its traffic goes through the router like with a `routed` NIC.
+		// Other NICs on the same network keep using `switched` mode.
+		// ---
+		//  type: string
+		//  default: switched
+		//  managed: no
+		//  shortdesc: How the NIC is attached to the network (either `switched` or `routed`)
+		"mode",
+
 		// gendoc:generate(entity=devices, group=nic_ovn, key=nested)
 		//
 		// ---
@@ -283,6 +351,18 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		//  managed: no
 		//  shortdesc: The VLAN ID to use when nesting (see also `nested`)
 		"vlan",
+
+		// gendoc:generate(entity=devices, group=nic_ovn, key=precreate)
+		// The logical switch port is created in a disabled state when the device is added, and its addresses are
+		// recorded in `volatile.<name>.last_state.ip_addresses` and returned in the metadata of the instance
+		// creation operation. The port and its addresses are then used when the instance first starts.
+		//
+		// ---
+		//  type: bool
+		//  default: `false`
+		//  managed: no
+		//  shortdesc: Whether to allocate the addresses of the NIC before the instance first starts
+		"precreate",
 	}
 
 	// The NIC's network may be a non-default project, so lookup project and get network's project name.
@@ -338,6 +418,13 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		}
 	}
 
+	// OVN can only disable IP allocation of a single protocol when the other one uses a static address.
+	for _, keys := range [][2]string{{"ipv4.address", "ipv6.address"}, {"ipv6.address", "ipv4.address"}} {
+		if d.config[keys[0]] == "none" && d.config[keys[1]] == "" {
+			return fmt.Errorf("Cannot set %q to %q when %q is dynamically allocated", keys[0], "none", keys[1])
+		}
+	}
+
 	if d.config["ipv6.address"] != "" && d.config["ipv6.address"] != "none" {
 		// Static IPv6 is allowed only if static IPv4 is set as well.
 		if d.config["ipv4.address"] == "" {
@@ -364,6 +451,17 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 	// Apply network level config options to device config before validation.
 	d.config["mtu"] = netConfig["bridge.mtu"]
 
+	// Routed NICs need the network to have addresses to route and can't be nested.
+	if d.config["mode"] == "routed" {
+		if slices.Contains([]string{"", "none"}, netConfig["ipv4.address"]) && slices.Contains([]string{"", "none"}, netConfig["ipv6.address"]) {
+			return fmt.Errorf("Cannot use routed mode on network %q as it has no IP addresses", d.config["network"])
+		}
+
+		if d.config["nested"] != "" {
+			return errors.New("Routed NICs can't be nested")
+		}
+	}
+
 	// Check VLAN ID is valid.
 	if d.config["vlan"] != "" {
 		nestedVLAN, err := strconv.ParseUint(d.config["vlan"], 10, 16)
@@ -435,6 +533,9 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 		return validate.IsNetworkAddressV6(value)
 	})
 
+	rules["mode"] = validate.Optional(validate.IsOneOf("switched", "routed"))
+	rules["precreate"] = validate.Optional(validate.IsBool)
+
 	// Validate the external address against the list of network forwards.
 	isNetworkForward := func(value string) error {
 		return d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
@@ -455,6 +556,36 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 	rules["ipv4.address.external"] = validate.Optional(validate.And(validate.IsNetworkAddressV4, isNetworkForward))
 	rules["ipv6.address.external"] = validate.Optional(validate.And(validate.IsNetworkAddressV6, isNetworkForward))
 
+	// Validate the static neighbors are within the network's subnets.
+	isNetworkNeighbors := func(netKey string) func(value string) error {
+		return func(value string) error {
+			neighbors, err := network.StaticNeighborsParse(value)
+			if err != nil {
+				return err
+			}
+
+			routerIP, subnet, err := net.ParseCIDR(netConfig[netKey])
+			if err != nil {
+				return fmt.Errorf("Network %q has no usable %q", d.config["network"], netKey)
+			}
+
+			for _, neighbor := range neighbors {
+				if !subnet.Contains(neighbor.IP) {
+					return fmt.Errorf("Static neighbor %q not within network %q subnet", neighbor.IP.String(), d.config["network"])
+				}
+
+				if neighbor.IP.Equal(routerIP) {
+					return fmt.Errorf("Static neighbor %q is assigned to network %q", neighbor.IP.String(), d.config["network"])
+				}
+			}
+
+			return nil
+		}
+	}
+
+	rules["ipv4.neighbors"] = validate.Optional(isNetworkNeighbors("ipv4.address"))
+	rules["ipv6.neighbors"] = validate.Optional(isNetworkNeighbors("ipv6.address"))
+
 	// Now run normal validation.
 	err = d.config.Validate(rules)
 	if err != nil {
@@ -475,7 +606,27 @@ func (d *nicOVN) validateConfig(instConf instance.ConfigReader) error {
 	}
 
 	if len(externalRoutes) > 0 {
-		err = d.network.InstanceDevicePortValidateExternalRoutes(d.inst, d.name, externalRoutes)
+		err = d.network.InstanceDevicePortValidateExternalRoutes(d.state.ShutdownCtx, d.inst, d.name, externalRoutes)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check the static neighbors don't claim addresses used by other ports or the uplink.
+	var neighborIPs []net.IP
+	for _, k := range []string{"ipv4.neighbors", "ipv6.neighbors"} {
+		neighbors, err := network.StaticNeighborsParse(d.config[k])
+		if err != nil {
+			return err
+		}
+
+		for _, neighbor := range neighbors {
+			neighborIPs = append(neighborIPs, neighbor.IP)
+		}
+	}
+
+	if len(neighborIPs) > 0 {
+		err = d.network.InstanceDevicePortValidateNeighbors(d.inst, d.name, neighborIPs)
 		if err != nil {
 			return err
 		}
@@ -559,7 +710,46 @@ func (d *nicOVN) checkAddressConflict() error {
 
 // Add is run when a device is added to a non-snapshot instance whether or not the instance is running.
 func (d *nicOVN) Add() error {
-	return d.network.InstanceDevicePortAdd(d.inst.LocalConfig()["volatile.uuid"], d.name, d.config)
+	err := d.network.InstanceDevicePortAdd(d.inst.LocalConfig()["volatile.uuid"], d.name, d.config)
+	if err != nil {
+		return err
+	}
+
+	if util.IsFalseOrEmpty(d.config["precreate"]) {
+		return nil
+	}
+
+	// Populate device config with volatile fields if needed.
+	v := d.volatileGet()
+	networkVethFillFromVolatile(d.config, v)
+
+	var lastStateIPs []net.IP
+	for _, ipStr := range util.SplitNTrimSpace(v["last_state.ip_addresses"], ",", -1, true) {
+		lastStateIP := net.ParseIP(ipStr)
+		if lastStateIP != nil {
+			lastStateIPs = append(lastStateIPs, lastStateIP)
+		}
+	}
+
+	// Pre-create the logical switch port so its addresses are known before the instance first starts.
+	dnsIPs, err := d.network.InstanceDevicePortPrecreate(&network.OVNInstanceNICSetupOpts{
+		InstanceUUID: d.inst.LocalConfig()["volatile.uuid"],
+		DNSName:      d.inst.Name(),
+		DeviceName:   d.name,
+		DeviceConfig: d.config,
+		LastStateIPs: lastStateIPs,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed pre-creating OVN port: %w", err)
+	}
+
+	// Record the addresses as the sticky ones, so they're kept when the instance starts.
+	dnsIPsStr := make([]string, 0, len(dnsIPs))
+	for _, dnsIP := range dnsIPs {
+		dnsIPsStr = append(dnsIPsStr, dnsIP.String())
+	}
+
+	return d.volatileSet(map[string]string{"last_state.ip_addresses": strings.Join(dnsIPsStr, ",")})
 }
 
 // PreStartCheck checks the managed parent network is available (if relevant).
@@ -583,7 +773,10 @@ func (d *nicOVN) validateEnvironment() error {
 		return errors.New("Requires name property to start")
 	}
 
-	integrationBridge := d.state.GlobalConfig.NetworkOVNIntegrationBridge()
+	integrationBridge, err := d.network.IntegrationBridge()
+	if err != nil {
+		return err
+	}
 
 	if !util.PathExists(fmt.Sprintf("/sys/class/net/%s", integrationBridge)) {
 		return fmt.Errorf("OVS integration bridge device %q doesn't exist", integrationBridge)
@@ -607,6 +800,8 @@ func (d *nicOVN) init(inst instance.Instance, s *state.State, name string, conf
 
 // Start is run when the device is added to a running instance or instance is starting up.
 func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
+	ctx := d.state.ShutdownCtx
+
 	err := d.validateEnvironment()
 	if err != nil {
 		return nil, err
@@ -619,21 +814,9 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 	saveData["host_name"] = d.config["host_name"]
 
 	// Load uplink network config.
-	uplinkNetworkName := d.network.Config()["network"]
-	var uplink *api.Network
-	var uplinkConfig map[string]string
-
-	if uplinkNetworkName != "none" {
-		err = d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, uplinkNetworkName)
-
-			return err
-		})
-		if err != nil {
-			return nil, fmt.Errorf("Failed to load uplink network %q: %w", uplinkNetworkName, err)
-		}
-
-		uplinkConfig = uplink.Config
+	uplinkConfig, err := d.uplinkConfig(d.network)
+	if err != nil {
+		return nil, err
 	}
 
 	// Setup the host network interface (if not nested).
@@ -652,7 +835,7 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 				return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
 			}
 
-			offload, err := vswitch.GetHardwareOffload(context.TODO())
+			offload, err := vswitch.GetHardwareOffload(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -669,7 +852,10 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 				}
 			}
 
-			integrationBridge := d.state.GlobalConfig.NetworkOVNIntegrationBridge()
+			integrationBridge, err := d.network.IntegrationBridge()
+			if err != nil {
+				return nil, err
+			}
 
 			// Find free VF exclusively.
 			network.SRIOVVirtualFunctionMutex.Lock()
@@ -694,7 +880,7 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 
 			// Setup the guest network interface.
 			if d.inst.Type() == instancetype.Container {
-				err := networkSRIOVSetupContainerVFNIC(saveData["host_name"], d.config)
+				err := networkSRIOVSetupContainerVFNIC(d.state, saveData["host_name"], d.config)
 				if err != nil {
 					return nil, fmt.Errorf("Failed setting up container VF NIC: %w", err)
 				}
@@ -708,7 +894,7 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 				return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
 			}
 
-			offload, err := vswitch.GetHardwareOffload(context.TODO())
+			offload, err := vswitch.GetHardwareOffload(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -730,7 +916,10 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 				}
 			}
 
-			integrationBridge := d.state.GlobalConfig.NetworkOVNIntegrationBridge()
+			integrationBridge, err := d.network.IntegrationBridge()
+			if err != nil {
+				return nil, err
+			}
 
 			// Find free VF exclusively.
 			network.SRIOVVirtualFunctionMutex.Lock()
@@ -852,9 +1041,23 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 		})
 	})
 
+	// Publish the ports declared by the OCI image if requested.
+	if util.IsTrue(d.inst.ExpandedConfig()["network.publish_ports"]) && d.inst.LocalConfig()["volatile.container.oci.ports"] != "" {
+		listenAddress, err := d.publishPorts(ctx, dnsIPs)
+		if err != nil {
+			return nil, err
+		}
+
+		reverter.Add(func() {
+			_ = d.network.ForwardDelete(context.WithoutCancel(ctx), listenAddress.String(), request.ClientTypeNormal)
+		})
+
+		saveData["publish_address"] = listenAddress.String()
+	}
+
 	// Associated host side interface to OVN logical switch port (if not nested).
 	if integrationBridgeNICName != "" {
-		cleanup, err := d.setupHostNIC(integrationBridgeNICName, logicalPortName)
+		cleanup, err := d.setupHostNIC(ctx, integrationBridgeNICName, logicalPortName)
 		if err != nil {
 			return nil, err
 		}
@@ -870,14 +1073,14 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 		return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	chassisID, err := vswitch.GetChassisID(context.TODO())
+	chassisID, err := vswitch.GetChassisID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("Failed getting OVS Chassis ID: %w", err)
 	}
 
 	// Add post start hook for setting logical switch port chassis once instance has been started.
 	runConf.PostHooks = append(runConf.PostHooks, func() error {
-		err := d.ovnnb.UpdateLogicalSwitchPortOptions(context.TODO(), logicalPortName, map[string]string{"requested-chassis": chassisID})
+		err := d.ovnnb.UpdateLogicalSwitchPortOptions(ctx, logicalPortName, map[string]string{"requested-chassis": chassisID})
 		if err != nil {
 			return fmt.Errorf("Failed setting logical switch port chassis ID: %w", err)
 		}
@@ -946,6 +1149,55 @@ func (d *nicOVN) Start() (*deviceConfig.RunConfig, error) {
 	return &runConf, nil
 }
 
+// publishPorts exposes the ports declared by the OCI image through a network forward targeting the instance
+// address, using a listen address allocated from the uplink. IPv4 is used when the instance has an IPv4 address.
+func (d *nicOVN) publishPorts(ctx context.Context, instanceIPs []net.IP) (net.IP, error) {
+	var targetIP net.IP
+	for _, instanceIP := range instanceIPs {
+		if targetIP == nil || (instanceIP.To4() != nil && targetIP.To4() == nil) {
+			targetIP = instanceIP
+		}
+	}
+
+	if targetIP == nil {
+		return nil, errors.New("Cannot publish ports of an instance without an IP address")
+	}
+
+	forward := api.NetworkForwardsPost{
+		ListenAddress: net.IPv6unspecified.String(),
+		NetworkForwardPut: api.NetworkForwardPut{
+			Description: fmt.Sprintf("Published ports of instance %q", d.inst.Name()),
+			Config:      map[string]string{},
+		},
+	}
+
+	if targetIP.To4() != nil {
+		forward.ListenAddress = net.IPv4zero.String()
+	}
+
+	// Ports are declared as PORT[/PROTOCOL], the protocol defaulting to TCP.
+	for _, exposedPort := range util.SplitNTrimSpace(d.inst.LocalConfig()["volatile.container.oci.ports"], ",", -1, true) {
+		port, protocol, _ := strings.Cut(exposedPort, "/")
+		if protocol == "" {
+			protocol = "tcp"
+		}
+
+		forward.Ports = append(forward.Ports, api.NetworkForwardPort{
+			Description:   "Declared by the OCI image",
+			Protocol:      strings.ToLower(protocol),
+			ListenPort:    port,
+			TargetAddress: targetIP.String(),
+		})
+	}
+
+	listenAddress, err := d.network.ForwardCreate(ctx, forward, request.ClientTypeNormal)
+	if err != nil {
+		return nil, fmt.Errorf("Failed publishing ports: %w", err)
+	}
+
+	return listenAddress, nil
+}
+
 // postStart is run after the device is added to the instance.
 func (d *nicOVN) postStart() error {
 	err := bgpAddPrefix(&d.deviceCommon, d.network, d.config)
@@ -979,6 +1231,14 @@ func (d *nicOVN) Update(oldDevices deviceConfig.Devices, isRunning bool) error {
 		}
 	}
 
+	// Move the logical switch port when the NIC is moved to another network.
+	if d.config["network"] != oldConfig["network"] {
+		err := d.moveNetwork(d.state.ShutdownCtx, oldConfig, isRunning)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Apply any changes needed when assigned ACLs change.
 	if d.config["security.acls"] != oldConfig["security.acls"] {
 		// Work out which ACLs have been removed and remove logical port from those groups.
@@ -1000,27 +1260,13 @@ func (d *nicOVN) Update(oldDevices deviceConfig.Devices, isRunning bool) error {
 		// Setup the logical port with new ACLs if running.
 		if isRunning {
 			// Load uplink network config.
-			uplinkNetworkName := d.network.Config()["network"]
-			var uplink *api.Network
-			var uplinkConfig map[string]string
-
-			if uplinkNetworkName != "none" {
-				err := d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-					var err error
-
-					_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, uplinkNetworkName)
-
-					return err
-				})
-				if err != nil {
-					return fmt.Errorf("Failed to load uplink network %q: %w", uplinkNetworkName, err)
-				}
-
-				uplinkConfig = uplink.Config
+			uplinkConfig, err := d.uplinkConfig(d.network)
+			if err != nil {
+				return err
 			}
 
 			// Update OVN logical switch port for instance.
-			_, _, err := d.network.InstanceDevicePortStart(&network.OVNInstanceNICSetupOpts{
+			_, _, err = d.network.InstanceDevicePortStart(&network.OVNInstanceNICSetupOpts{
 				InstanceUUID: d.inst.LocalConfig()["volatile.uuid"],
 				DNSName:      d.inst.Name(),
 				DeviceName:   d.name,
@@ -1045,6 +1291,14 @@ func (d *nicOVN) Update(oldDevices deviceConfig.Devices, isRunning bool) error {
 		}
 	}
 
+	// Replace the SNAT rules of a changed external address in place.
+	if isRunning && (d.config["ipv4.address.external"] != oldConfig["ipv4.address.external"] || d.config["ipv6.address.external"] != oldConfig["ipv6.address.external"]) {
+		err := d.network.InstanceDevicePortExternalAddressesUpdate(d.inst.LocalConfig()["volatile.uuid"], d.name, oldConfig, d.config)
+		if err != nil {
+			return fmt.Errorf("Failed updating OVN port external addresses: %w", err)
+		}
+	}
+
 	// If an external address changed, update the BGP advertisements.
 	err := bgpRemovePrefix(&d.deviceCommon, oldConfig)
 	if err != nil {
@@ -1059,6 +1313,201 @@ func (d *nicOVN) Update(oldDevices deviceConfig.Devices, isRunning bool) error {
 	return nil
 }
 
+// moveNetwork moves the device from the OVN network of oldConfig to its current one. The MAC address and the host
+// side interface are kept, as are the static addresses and, when they fit the new network, the dynamic ones.
+func (d *nicOVN) moveNetwork(ctx context.Context, oldConfig deviceConfig.Device, isRunning bool) error {
+	n, err := network.LoadByName(d.state, d.network.Project(), oldConfig["network"])
+	if err != nil {
+		return fmt.Errorf("Error loading network config for %q: %w", oldConfig["network"], err)
+	}
+
+	oldNetwork, ok := n.(ovnNet)
+	if !ok {
+		return errors.New("Network is not ovnNet interface type")
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	instanceUUID := d.inst.LocalConfig()["volatile.uuid"]
+
+	err = d.network.InstanceDevicePortAdd(instanceUUID, d.name, d.config)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() { _ = d.network.InstanceDevicePortRemove(instanceUUID, d.name, d.config) })
+
+	if isRunning {
+		v := d.volatileGet()
+
+		// Hint the current addresses so they're kept if they fit the new network.
+		var lastStateIPs []net.IP
+		for _, ipStr := range util.SplitNTrimSpace(v["last_state.ip_addresses"], ",", -1, true) {
+			lastStateIP := net.ParseIP(ipStr)
+			if lastStateIP != nil {
+				lastStateIPs = append(lastStateIPs, lastStateIP)
+			}
+		}
+
+		uplinkConfig, err := d.uplinkConfig(d.network)
+		if err != nil {
+			return err
+		}
+
+		// Add the logical switch port on the new network.
+		logicalPortName, dnsIPs, err := d.network.InstanceDevicePortStart(&network.OVNInstanceNICSetupOpts{
+			InstanceUUID: instanceUUID,
+			DNSName:      d.inst.Name(),
+			DeviceName:   d.name,
+			DeviceConfig: d.config,
+			UplinkConfig: uplinkConfig,
+			LastStateIPs: lastStateIPs,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("Failed setting up OVN port: %w", err)
+		}
+
+		reverter.Add(func() {
+			_ = d.network.InstanceDevicePortStop("", &network.OVNInstanceNICStopOpts{
+				InstanceUUID: instanceUUID,
+				DeviceName:   d.name,
+				DeviceConfig: d.config,
+			})
+		})
+
+		// Link the host side interface to the new logical switch port.
+		integrationBridgeNICName := d.config["host_name"]
+		if d.config["acceleration"] == "sriov" || d.config["acceleration"] == "vdpa" {
+			integrationBridgeNICName, err = d.findRepresentorPort(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		vswitch, err := d.state.OVS()
+		if err != nil {
+			return fmt.Errorf("Failed to connect to OVS: %w", err)
+		}
+
+		oldPortName, err := vswitch.GetInterfaceAssociatedOVNSwitchPort(ctx, integrationBridgeNICName)
+		if err != nil {
+			return fmt.Errorf("Failed getting OVN switch port associated to OVS interface %q: %w", integrationBridgeNICName, err)
+		}
+
+		err = vswitch.AssociateInterfaceOVNSwitchPort(ctx, integrationBridgeNICName, string(logicalPortName))
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() {
+			_ = vswitch.AssociateInterfaceOVNSwitchPort(context.WithoutCancel(ctx), integrationBridgeNICName, oldPortName)
+		})
+
+		chassisID, err := vswitch.GetChassisID(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed getting OVS Chassis ID: %w", err)
+		}
+
+		err = d.ovnnb.UpdateLogicalSwitchPortOptions(ctx, logicalPortName, map[string]string{"requested-chassis": chassisID})
+		if err != nil {
+			return fmt.Errorf("Failed setting logical switch port chassis ID: %w", err)
+		}
+
+		// Remove the logical switch port from the old network.
+		err = oldNetwork.InstanceDevicePortStop(ovn.OVNSwitchPort(oldPortName), &network.OVNInstanceNICStopOpts{
+			InstanceUUID: instanceUUID,
+			DeviceName:   d.name,
+			DeviceConfig: oldConfig,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed removing OVN port from network %q: %w", oldConfig["network"], err)
+		}
+
+		var dnsIPsStr strings.Builder
+		for i, dnsIP := range dnsIPs {
+			if i > 0 {
+				dnsIPsStr.WriteString(",")
+			}
+
+			dnsIPsStr.WriteString(dnsIP.String())
+		}
+
+		saveData := map[string]string{"last_state.ip_addresses": dnsIPsStr.String()}
+
+		// Move the published ports along.
+		publishAddress := d.volatileGet()["publish_address"]
+		if publishAddress != "" {
+			listenAddress, err := d.publishPorts(ctx, dnsIPs)
+			if err != nil {
+				return err
+			}
+
+			reverter.Add(func() {
+				_ = d.network.ForwardDelete(context.WithoutCancel(ctx), listenAddress.String(), request.ClientTypeNormal)
+			})
+
+			err = oldNetwork.ForwardDelete(ctx, publishAddress, request.ClientTypeNormal)
+			if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+				d.logger.Error("Failed removing published ports", logger.Ctx{"listenAddress": publishAddress, "err": err})
+			}
+
+			saveData["publish_address"] = listenAddress.String()
+		}
+
+		err = d.volatileSet(saveData)
+		if err != nil {
+			return err
+		}
+
+		// Bounce the host side interface so the instance renews its addresses.
+		if d.config["acceleration"] == "" && network.InterfaceExists(d.config["host_name"]) {
+			link := &ip.Link{Name: d.config["host_name"]}
+			err := link.SetDown()
+			if err != nil {
+				return err
+			}
+
+			err = link.SetUp()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = oldNetwork.InstanceDevicePortRemove(instanceUUID, d.name, oldConfig)
+	if err != nil {
+		return fmt.Errorf("Failed removing OVN port records from network %q: %w", oldConfig["network"], err)
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// uplinkConfig returns the config of the uplink network of the OVN network, if any.
+func (d *nicOVN) uplinkConfig(n network.Network) (map[string]string, error) {
+	uplinkNetworkName := n.Config()["network"]
+	if uplinkNetworkName == "none" {
+		return nil, nil
+	}
+
+	var uplink *api.Network
+
+	err := d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, uplinkNetworkName)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load uplink network %q: %w", uplinkNetworkName, err)
+	}
+
+	return uplink.Config, nil
+}
+
 func (d *nicOVN) findRepresentorPort(volatile map[string]string) (string, error) {
 	physSwitchID, pfID, err := network.SRIOVGetSwitchAndPFID(volatile["last_state.vf.parent"])
 	if err != nil {
@@ -1087,6 +1536,9 @@ func (d *nicOVN) findRepresentorPort(volatile map[string]string) (string, error)
 
 // Stop is run when the device is removed from the instance.
 func (d *nicOVN) Stop() (*deviceConfig.RunConfig, error) {
+	// Instances are stopped as part of the daemon shutdown, so the teardown must outlive its cancellation.
+	ctx := context.WithoutCancel(d.state.ShutdownCtx)
+
 	runConf := deviceConfig.RunConfig{
 		PostHooks: []func() error{d.postStop},
 	}
@@ -1107,7 +1559,7 @@ func (d *nicOVN) Stop() (*deviceConfig.RunConfig, error) {
 
 	var ovsExternalOVNPort string
 	if d.config["nested"] == "" {
-		ovsExternalOVNPort, err = vswitch.GetInterfaceAssociatedOVNSwitchPort(context.TODO(), d.config["host_name"])
+		ovsExternalOVNPort, err = vswitch.GetInterfaceAssociatedOVNSwitchPort(ctx, d.config["host_name"])
 		if err != nil {
 			d.logger.Warn("Could not find OVN Switch port associated to OVS interface", logger.Ctx{"interface": d.config["host_name"]})
 		}
@@ -1126,10 +1578,12 @@ func (d *nicOVN) Stop() (*deviceConfig.RunConfig, error) {
 	// as if the instance is being migrated, this can cause port conflicts in OVN if the instance comes up on
 	// another host later.
 	if integrationBridgeNICName != "" {
-		integrationBridge := d.state.GlobalConfig.NetworkOVNIntegrationBridge()
+		integrationBridge, err := d.network.IntegrationBridge()
+		if err == nil {
+			// Detach host-side end of veth pair from OVS integration bridge.
+			err = vswitch.DeleteBridgePort(ctx, integrationBridge, integrationBridgeNICName)
+		}
 
-		// Detach host-side end of veth pair from OVS integration bridge.
-		err = vswitch.DeleteBridgePort(context.TODO(), integrationBridge, integrationBridgeNICName)
 		if err != nil {
 			// Don't fail here as we want the postStop hook to run to clean up the local veth pair.
 			d.logger.Error("Failed detaching interface from OVS integration bridge", logger.Ctx{"interface": integrationBridgeNICName, "bridge": integrationBridge, "err": err})
@@ -1147,6 +1601,14 @@ func (d *nicOVN) Stop() (*deviceConfig.RunConfig, error) {
 		d.logger.Error("Failed to remove OVN device port", logger.Ctx{"err": err})
 	}
 
+	// Remove the forward publishing the ports.
+	if v["publish_address"] != "" {
+		err = d.network.ForwardDelete(ctx, v["publish_address"], request.ClientTypeNormal)
+		if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+			d.logger.Error("Failed removing published ports", logger.Ctx{"listenAddress": v["publish_address"], "err": err})
+		}
+	}
+
 	// Remove BGP announcements.
 	err = bgpRemovePrefix(&d.deviceCommon, d.config)
 	if err != nil {
@@ -1171,6 +1633,7 @@ func (d *nicOVN) postStop() error {
 			"last_state.vf.vlan":       "",
 			"last_state.vf.spoofcheck": "",
 			"last_state.pci.driver":    "",
+			"publish_address":          "",
 		})
 	}()
 
@@ -1317,7 +1780,7 @@ func (d *nicOVN) State() (*api.InstanceStateNetwork, error) {
 				Netmask: v6mask,
 				Scope:   "global",
 			})
-		} else if util.IsFalseOrEmpty(netConfig["ipv6.dhcp.stateful"]) && d.config["hwaddr"] != "" && v6subnet != nil {
+		} else if d.config["ipv6.address"] != "none" && util.IsFalseOrEmpty(netConfig["ipv6.dhcp.stateful"]) && d.config["hwaddr"] != "" && v6subnet != nil {
 			// If no static DHCPv6 allocation and stateful DHCPv6 is disabled, and IPv6 is enabled on
 			// the bridge, the NIC is likely to use its MAC and SLAAC to configure its address.
 			hwAddr, err := net.ParseMAC(d.config["hwaddr"])
@@ -1346,31 +1809,82 @@ func (d *nicOVN) State() (*api.InstanceStateNetwork, error) {
 		mtu = iface.MTU
 	}
 
-	// Retrieve the host counters, as we report the values from the instance's point of view,
-	// those counters need to be reversed below.
-	hostCounters, err := resources.GetNetworkCounters(d.config["host_name"])
+	counters, err := d.counters()
 	if err != nil {
-		return nil, fmt.Errorf("Failed getting network interface counters: %w", err)
+		return nil, err
+	}
+
+	// Get the DHCP and router advertisement settings OVN is offering to the instance and the port binding.
+	ovnState, err := d.network.InstanceDevicePortState(d.inst.LocalConfig()["volatile.uuid"], d.name)
+	if err != nil {
+		d.logger.Warn("Failed getting OVN port state", logger.Ctx{"err": err})
 	}
 
 	network := api.InstanceStateNetwork{
 		Addresses: addresses,
-		Counters: api.InstanceStateNetworkCounters{
-			BytesReceived:   hostCounters.BytesSent,
-			BytesSent:       hostCounters.BytesReceived,
-			PacketsReceived: hostCounters.PacketsSent,
-			PacketsSent:     hostCounters.PacketsReceived,
-		},
-		Hwaddr:   d.config["hwaddr"],
-		HostName: d.config["host_name"],
-		Mtu:      mtu,
-		State:    "up",
-		Type:     "broadcast",
+		Counters:  *counters,
+		Hwaddr:    d.config["hwaddr"],
+		HostName:  d.config["host_name"],
+		Mtu:       mtu,
+		State:     "up",
+		Type:      "broadcast",
+		OVN:       ovnState,
 	}
 
 	return &network, nil
 }
 
+// counters returns the traffic counters of the NIC from the instance's point of view.
+// The statistics of the OVS interface attached to the integration bridge are used when available, as unlike the
+// kernel counters of the host interface they also account for hardware offloaded traffic.
+func (d *nicOVN) counters() (*api.InstanceStateNetworkCounters, error) {
+	integrationBridgeNICName := d.config["host_name"]
+	if d.config["acceleration"] == "sriov" || d.config["acceleration"] == "vdpa" {
+		representorPort, err := d.findRepresentorPort(d.volatileGet())
+		if err == nil {
+			integrationBridgeNICName = representorPort
+		}
+	}
+
+	if d.config["nested"] == "" && integrationBridgeNICName != "" {
+		var stats *ovs.InterfaceStatistics
+
+		vswitch, err := d.state.OVS()
+		if err == nil {
+			stats, err = vswitch.GetInterfaceStatistics(context.TODO(), integrationBridgeNICName)
+			if err == nil {
+				// What the switch receives was sent by the instance and the other way around.
+				return &api.InstanceStateNetworkCounters{
+					BytesReceived:          stats.TxBytes,
+					BytesSent:              stats.RxBytes,
+					PacketsReceived:        stats.TxPackets,
+					PacketsSent:            stats.RxPackets,
+					ErrorsReceived:         stats.TxErrors,
+					ErrorsSent:             stats.RxErrors,
+					PacketsDroppedInbound:  stats.TxDropped,
+					PacketsDroppedOutbound: stats.RxDropped,
+				}, nil
+			}
+		}
+
+		d.logger.Debug("Failed getting OVS interface statistics, falling back to host interface counters", logger.Ctx{"interface": integrationBridgeNICName, "err": err})
+	}
+
+	// Retrieve the host counters, as we report the values from the instance's point of view,
+	// those counters need to be reversed below.
+	hostCounters, err := resources.GetNetworkCounters(d.config["host_name"])
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting network interface counters: %w", err)
+	}
+
+	return &api.InstanceStateNetworkCounters{
+		BytesReceived:   hostCounters.BytesSent,
+		BytesSent:       hostCounters.BytesReceived,
+		PacketsReceived: hostCounters.PacketsSent,
+		PacketsSent:     hostCounters.PacketsReceived,
+	}, nil
+}
+
 // Register sets up anything needed on startup.
 func (d *nicOVN) Register() error {
 	// Skip when not using a managed network.
@@ -1398,7 +1912,7 @@ func (d *nicOVN) Register() error {
 	return nil
 }
 
-func (d *nicOVN) setupHostNIC(hostName string, ovnPortName ovn.OVNSwitchPort) (revert.Hook, error) {
+func (d *nicOVN) setupHostNIC(ctx context.Context, hostName string, ovnPortName ovn.OVNSwitchPort) (revert.Hook, error) {
 	reverter := revert.New()
 	defer reverter.Fail()
 
@@ -1416,22 +1930,25 @@ func (d *nicOVN) setupHostNIC(hostName string, ovnPortName ovn.OVNSwitchPort) (r
 	}
 
 	// Attach host side veth interface to bridge.
-	integrationBridge := d.state.GlobalConfig.NetworkOVNIntegrationBridge()
+	integrationBridge, err := d.network.IntegrationBridge()
+	if err != nil {
+		return nil, err
+	}
 
 	vswitch, err := d.state.OVS()
 	if err != nil {
 		return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	err = vswitch.CreateBridgePort(context.TODO(), integrationBridge, hostName, true)
+	err = vswitch.CreateBridgePort(ctx, integrationBridge, hostName, true)
 	if err != nil {
 		return nil, err
 	}
 
-	reverter.Add(func() { _ = vswitch.DeleteBridgePort(context.TODO(), integrationBridge, hostName) })
+	reverter.Add(func() { _ = vswitch.DeleteBridgePort(context.WithoutCancel(ctx), integrationBridge, hostName) })
 
 	// Link OVS port to OVN logical port.
-	err = vswitch.AssociateInterfaceOVNSwitchPort(context.TODO(), hostName, string(ovnPortName))
+	err = vswitch.AssociateInterfaceOVNSwitchPort(ctx, hostName, string(ovnPortName))
 	if err != nil {
 		return nil, err
 	}
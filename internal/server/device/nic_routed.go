@@ -442,7 +442,7 @@ func (d *nicRouted) checkIPAvailability(parent string) error {
 		go func(address net.IP) {
 			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 			defer cancel()
-			inUse, err := isIPAvailable(ctx, address, parent)
+			inUse, err := network.IsIPInUse(ctx, address, parent)
 			if err != nil {
 				d.logger.Warn("Failed checking IP address available on parent network", logger.Ctx{"IP": address, "parent": parent, "err": err})
 			}
@@ -1,7 +1,6 @@
 package instance
 
 import (
-	"bytes"
 	"context"
 	"crypto/rand"
 	"database/sql"
@@ -430,24 +429,45 @@ func LoadFromBackup(s *state.State, projectName string, instancePath string, app
 	return inst, nil
 }
 
-// DeviceNextInterfaceHWAddr generates a random MAC address.
-func DeviceNextInterfaceHWAddr() (string, error) {
-	// Generate a new random MAC address using the usual prefix
-	ret := bytes.Buffer{}
-	for _, c := range "10:66:6a:xx:xx:xx" {
-		if c == 'x' {
+// DeviceNextInterfaceHWAddr generates a random MAC address using the configured MAC address prefix, making sure it
+// isn't already used by an instance NIC or network of the cluster.
+func DeviceNextInterfaceHWAddr(ctx context.Context, s *state.State) (string, error) {
+	var usedHwaddrs []string
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		usedHwaddrs, err = tx.GetHwaddrsInUse(ctx)
+
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed getting MAC addresses in use: %w", err)
+	}
+
+	prefix := localUtil.DefaultHwaddrPrefix
+	if s.GlobalConfig != nil {
+		prefix = s.GlobalConfig.NetworkHwaddrPrefix()
+	}
+
+	for range 100 {
+		hwaddr, err := localUtil.RandomHwaddr(prefix, func() (int64, error) {
 			c, err := rand.Int(rand.Reader, big.NewInt(16))
 			if err != nil {
-				return "", err
+				return 0, err
 			}
 
-			ret.WriteString(fmt.Sprintf("%x", c.Int64()))
-		} else {
-			ret.WriteString(string(c))
+			return c.Int64(), nil
+		})
+		if err != nil {
+			return "", err
+		}
+
+		if !slices.ContainsFunc(usedHwaddrs, func(usedHwaddr string) bool { return strings.EqualFold(usedHwaddr, hwaddr) }) {
+			return hwaddr, nil
 		}
 	}
 
-	return ret.String(), nil
+	return "", fmt.Errorf("Failed finding an unused MAC address with prefix %q", prefix)
 }
 
 // BackupLoadByName load an instance backup from the database.
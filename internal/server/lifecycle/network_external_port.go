@@ -0,0 +1,28 @@
+package lifecycle
+
+import (
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// NetworkExternalPortAction represents a lifecycle event action for network external ports.
+type NetworkExternalPortAction string
+
+// All supported lifecycle events for network external ports.
+const (
+	NetworkExternalPortCreated = NetworkExternalPortAction(api.EventLifecycleNetworkExternalPortCreated)
+	NetworkExternalPortDeleted = NetworkExternalPortAction(api.EventLifecycleNetworkExternalPortDeleted)
+	NetworkExternalPortUpdated = NetworkExternalPortAction(api.EventLifecycleNetworkExternalPortUpdated)
+)
+
+// Event creates the lifecycle event for an action on a network external port.
+func (a NetworkExternalPortAction) Event(n network, portName string, requestor *api.EventLifecycleRequestor, ctx map[string]any) api.EventLifecycle {
+	u := api.NewURL().Path(version.APIVersion, "networks", n.Name(), "external-ports", portName).Project(n.Project())
+
+	return api.EventLifecycle{
+		Action:    string(a),
+		Source:    u.String(),
+		Context:   ctx,
+		Requestor: requestor,
+	}
+}
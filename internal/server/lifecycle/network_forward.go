@@ -12,6 +12,7 @@ type NetworkForwardAction string
 const (
 	NetworkForwardCreated = NetworkForwardAction(api.EventLifecycleNetworkForwardCreated)
 	NetworkForwardDeleted = NetworkForwardAction(api.EventLifecycleNetworkForwardDeleted)
+	NetworkForwardRenamed = NetworkForwardAction(api.EventLifecycleNetworkForwardRenamed)
 	NetworkForwardUpdated = NetworkForwardAction(api.EventLifecycleNetworkForwardUpdated)
 )
 
@@ -12,6 +12,7 @@ type NetworkLoadBalancerAction string
 const (
 	NetworkLoadBalancerCreated = NetworkLoadBalancerAction(api.EventLifecycleNetworkLoadBalancerCreated)
 	NetworkLoadBalancerDeleted = NetworkLoadBalancerAction(api.EventLifecycleNetworkLoadBalancerDeleted)
+	NetworkLoadBalancerRenamed = NetworkLoadBalancerAction(api.EventLifecycleNetworkLoadBalancerRenamed)
 	NetworkLoadBalancerUpdated = NetworkLoadBalancerAction(api.EventLifecycleNetworkLoadBalancerUpdated)
 )
 
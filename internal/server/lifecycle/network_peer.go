@@ -10,9 +10,11 @@ type NetworkPeerAction string
 
 // All supported lifecycle events for network peers.
 const (
-	NetworkPeerCreated = NetworkForwardAction(api.EventLifecycleNetworkPeerCreated)
-	NetworkPeerDeleted = NetworkForwardAction(api.EventLifecycleNetworkPeerDeleted)
-	NetworkPeerUpdated = NetworkForwardAction(api.EventLifecycleNetworkPeerUpdated)
+	NetworkPeerApproved = NetworkPeerAction(api.EventLifecycleNetworkPeerApproved)
+	NetworkPeerCreated  = NetworkForwardAction(api.EventLifecycleNetworkPeerCreated)
+	NetworkPeerDeleted  = NetworkForwardAction(api.EventLifecycleNetworkPeerDeleted)
+	NetworkPeerRejected = NetworkPeerAction(api.EventLifecycleNetworkPeerRejected)
+	NetworkPeerUpdated  = NetworkForwardAction(api.EventLifecycleNetworkPeerUpdated)
 )
 
 // Event creates the lifecycle event for an action on a network forward.
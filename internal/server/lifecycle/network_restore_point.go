@@ -0,0 +1,28 @@
+package lifecycle
+
+import (
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// NetworkRestorePointAction represents a lifecycle event action for network restore points.
+type NetworkRestorePointAction string
+
+// All supported lifecycle events for network restore points.
+const (
+	NetworkRestorePointCreated  = NetworkRestorePointAction(api.EventLifecycleNetworkRestorePointCreated)
+	NetworkRestorePointDeleted  = NetworkRestorePointAction(api.EventLifecycleNetworkRestorePointDeleted)
+	NetworkRestorePointRestored = NetworkRestorePointAction(api.EventLifecycleNetworkRestorePointRestored)
+)
+
+// Event creates the lifecycle event for an action on a network restore point.
+func (a NetworkRestorePointAction) Event(n network, restorePointName string, requestor *api.EventLifecycleRequestor, ctx map[string]any) api.EventLifecycle {
+	u := api.NewURL().Path(version.APIVersion, "networks", n.Name(), "restore-points", restorePointName).Project(n.Project())
+
+	return api.EventLifecycle{
+		Action:    string(a),
+		Source:    u.String(),
+		Context:   ctx,
+		Requestor: requestor,
+	}
+}
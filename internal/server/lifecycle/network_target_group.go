@@ -0,0 +1,29 @@
+package lifecycle
+
+import (
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// NetworkTargetGroupAction represents a lifecycle event action for network target groups.
+type NetworkTargetGroupAction string
+
+// All supported lifecycle events for network target groups.
+const (
+	NetworkTargetGroupCreated = NetworkTargetGroupAction(api.EventLifecycleNetworkTargetGroupCreated)
+	NetworkTargetGroupDeleted = NetworkTargetGroupAction(api.EventLifecycleNetworkTargetGroupDeleted)
+	NetworkTargetGroupUpdated = NetworkTargetGroupAction(api.EventLifecycleNetworkTargetGroupUpdated)
+	NetworkTargetGroupRenamed = NetworkTargetGroupAction(api.EventLifecycleNetworkTargetGroupRenamed)
+)
+
+// Event creates the lifecycle event for an action on a network target group.
+func (a NetworkTargetGroupAction) Event(projectName string, name string, requestor *api.EventLifecycleRequestor, ctx map[string]any) api.EventLifecycle {
+	u := api.NewURL().Path(version.APIVersion, "network-target-groups", name).Project(projectName)
+
+	return api.EventLifecycle{
+		Action:    string(a),
+		Source:    u.String(),
+		Context:   ctx,
+		Requestor: requestor,
+	}
+}
@@ -3,6 +3,11 @@ package metadata
 import (
 	"embed"
 	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lxc/incus/v6/shared/api"
 )
 
 var Data map[string]any
@@ -24,3 +29,61 @@ func init() {
 
 	Data = data
 }
+
+// NetworkConfigKeys returns the documented configuration keys of a network type, sorted by group and name.
+func NetworkConfigKeys(networkType string) ([]api.MetadataNetworkConfigKey, error) {
+	configs, _ := Data["configs"].(map[string]any)
+	groups, ok := configs["network_"+networkType].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("No configuration metadata for network type %q", networkType)
+	}
+
+	keys := []api.MetadataNetworkConfigKey{}
+	for groupName := range groups {
+		group, _ := groups[groupName].(map[string]any)
+		entries, _ := group["keys"].([]any)
+
+		for _, entry := range entries {
+			entryKeys, _ := entry.(map[string]any)
+			for name, fields := range entryKeys {
+				field := func(fieldName string) string {
+					values, _ := fields.(map[string]any)
+					value, _ := values[fieldName].(string)
+					return value
+				}
+
+				key := api.MetadataNetworkConfigKey{
+					Name:               name,
+					Group:              groupName,
+					Type:               field("type"),
+					DefaultDescription: field("defaultdesc"),
+					Condition:          field("condition"),
+					Required:           field("required") == "yes",
+					Scope:              field("scope"),
+					Description:        field("shortdesc"),
+					LongDescription:    field("longdesc"),
+				}
+
+				// Only expose literal defaults as values, anything else is a description.
+				defaultValue := field("default")
+				if len(defaultValue) > 2 && strings.Count(defaultValue, "`") == 2 && strings.HasPrefix(defaultValue, "`") && strings.HasSuffix(defaultValue, "`") {
+					key.Default = defaultValue[1 : len(defaultValue)-1]
+				} else if defaultValue != "" && key.DefaultDescription == "" {
+					key.DefaultDescription = defaultValue
+				}
+
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	slices.SortFunc(keys, func(a api.MetadataNetworkConfigKey, b api.MetadataNetworkConfigKey) int {
+		if a.Group != b.Group {
+			return strings.Compare(a.Group, b.Group)
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return keys, nil
+}
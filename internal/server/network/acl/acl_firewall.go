@@ -82,8 +82,11 @@ func FirewallACLRules(s *state.State, aclDeviceName string, aclProjectName strin
 			var err error
 
 			_, aclInfo, err = dbCluster.GetNetworkACLAPI(ctx, tx.Tx(), aclProjectName, aclName)
+			if err != nil {
+				return err
+			}
 
-			return err
+			return expandIncludes(ctx, tx, aclProjectName, aclInfo)
 		})
 		if err != nil {
 			return nil, fmt.Errorf("Failed loading ACL %q for network %q: %w", aclName, aclDeviceName, err)
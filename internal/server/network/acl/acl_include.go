@@ -0,0 +1,131 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/util"
+)
+
+// includedACLNames returns the names of the ACLs directly included by an ACL's config.
+func includedACLNames(config map[string]string) []string {
+	return util.SplitNTrimSpace(config["include"], ",", -1, true)
+}
+
+// includeCycle looks for an include cycle reachable from the named ACL, using the supplied map of ACL names to
+// the ACLs they directly include. Returns the ACL names forming the cycle, or nil if there is none.
+func includeCycle(name string, includes map[string][]string) []string {
+	done := map[string]bool{}
+
+	var visit func(path []string) []string
+	visit = func(path []string) []string {
+		for _, includeName := range includes[path[len(path)-1]] {
+			if slices.Contains(path, includeName) {
+				return append(path, includeName)
+			}
+
+			if done[includeName] {
+				continue
+			}
+
+			cycle := visit(append(slices.Clone(path), includeName))
+			if cycle != nil {
+				return cycle
+			}
+
+			done[includeName] = true
+		}
+
+		return nil
+	}
+
+	return visit([]string{name})
+}
+
+// expandIncludes appends the rules of the ACLs included by the ACL (directly or through other included ACLs) to
+// its own rules, so they can be rendered as a single rule set. Rules which are already present are skipped.
+func expandIncludes(ctx context.Context, tx *db.ClusterTx, projectName string, info *api.NetworkACL) error {
+	includes := map[string][]string{info.Name: includedACLNames(info.Config)}
+	if len(includes[info.Name]) == 0 {
+		return nil
+	}
+
+	appendRules := func(rules []api.NetworkACLRule, newRules []api.NetworkACLRule) []api.NetworkACLRule {
+		for _, rule := range newRules {
+			rule.Normalise()
+			if !slices.Contains(rules, rule) {
+				rules = append(rules, rule)
+			}
+		}
+
+		return rules
+	}
+
+	// Walk the included ACLs breadth first, so that the rules of directly included ACLs come first.
+	queue := slices.Clone(includes[info.Name])
+	for len(queue) > 0 {
+		includeName := queue[0]
+		queue = queue[1:]
+
+		_, found := includes[includeName]
+		if found {
+			continue
+		}
+
+		_, includeInfo, err := cluster.GetNetworkACLAPI(ctx, tx.Tx(), projectName, includeName)
+		if err != nil {
+			return fmt.Errorf("Failed loading included ACL %q: %w", includeName, err)
+		}
+
+		includes[includeName] = includedACLNames(includeInfo.Config)
+		queue = append(queue, includes[includeName]...)
+
+		info.Ingress = appendRules(info.Ingress, includeInfo.Ingress)
+		info.Egress = appendRules(info.Egress, includeInfo.Egress)
+	}
+
+	// Cycles are refused on update, this is only to protect against inconsistent database records.
+	cycle := includeCycle(info.Name, includes)
+	if cycle != nil {
+		return fmt.Errorf("ACL include cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// includingACLNames returns the supplied ACL name along with the names of all the ACLs including it, directly or
+// through other included ACLs.
+func includingACLNames(ctx context.Context, tx *db.ClusterTx, projectName string, name string) ([]string, error) {
+	acls, err := cluster.GetNetworkACLs(ctx, tx.Tx(), cluster.NetworkACLFilter{Project: &projectName})
+	if err != nil {
+		return nil, err
+	}
+
+	includedBy := map[string][]string{}
+	for _, acl := range acls {
+		_, aclInfo, err := cluster.GetNetworkACLAPI(ctx, tx.Tx(), projectName, acl.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, includeName := range includedACLNames(aclInfo.Config) {
+			includedBy[includeName] = append(includedBy[includeName], acl.Name)
+		}
+	}
+
+	names := []string{name}
+	for i := 0; i < len(names); i++ {
+		for _, includerName := range includedBy[names[i]] {
+			if !slices.Contains(names, includerName) {
+				names = append(names, includerName)
+			}
+		}
+	}
+
+	return names, nil
+}
@@ -124,7 +124,24 @@ func Exists(s *state.State, projectName string, name ...string) error {
 	return nil
 }
 
-// UsedBy finds all networks, profiles and instance NICs that use any of the specified ACLs and executes usageFunc
+// NetworkACLNames returns the ACLs applied to a network.
+// For OVN networks the project's default network ACLs are applied before the network's own ACLs.
+func NetworkACLNames(projectConfig map[string]string, netType string, netConfig map[string]string) []string {
+	aclNames := []string{}
+	if netType == "ovn" {
+		aclNames = append(aclNames, util.SplitNTrimSpace(projectConfig["networks.default.security.acls"], ",", -1, true)...)
+	}
+
+	for _, aclName := range util.SplitNTrimSpace(netConfig["security.acls"], ",", -1, true) {
+		if !slices.Contains(aclNames, aclName) {
+			aclNames = append(aclNames, aclName)
+		}
+	}
+
+	return aclNames
+}
+
+// UsedBy finds the project, networks, profiles and instance NICs that use any of the specified ACLs and executes usageFunc
 // once for each resource using one or more of the ACLs with info about the resource and matched ACLs being used.
 func UsedBy(s *state.State, aclProjectName string, usageFunc func(ctx context.Context, tx *db.ClusterTx, matchedACLNames []string, usageType any, nicName string, nicConfig map[string]string) error, matchACLNames ...string) error {
 	if len(matchACLNames) <= 0 {
@@ -135,6 +152,33 @@ func UsedBy(s *state.State, aclProjectName string, usageFunc func(ctx context.Co
 	profileDevices := map[string]map[string]cluster.Device{}
 
 	err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		// Load the project's default network ACLs.
+		dbProject, err := cluster.GetProject(ctx, tx.Tx(), aclProjectName)
+		if err != nil {
+			return fmt.Errorf("Failed loading project %q: %w", aclProjectName, err)
+		}
+
+		projectConfig, err := cluster.GetProjectConfig(ctx, tx.Tx(), dbProject.ID)
+		if err != nil {
+			return fmt.Errorf("Failed loading project %q config: %w", aclProjectName, err)
+		}
+
+		// The project's default network ACLs apply to all of its OVN networks, including future ones.
+		matchedACLNames := []string{}
+		for _, aclName := range util.SplitNTrimSpace(projectConfig["networks.default.security.acls"], ",", -1, true) {
+			if slices.Contains(matchACLNames, aclName) {
+				matchedACLNames = append(matchedACLNames, aclName)
+			}
+		}
+
+		if len(matchedACLNames) > 0 {
+			// Call usageFunc with a list of matched ACLs and info about the project.
+			err := usageFunc(ctx, tx, matchedACLNames, &api.Project{Name: dbProject.Name, Description: dbProject.Description, Config: projectConfig}, "", nil)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Find networks using the ACLs. Cheapest to do.
 		networkNames, err := tx.GetCreatedNetworkNamesByProject(ctx, aclProjectName)
 		if err != nil && !response.IsNotFoundError(err) {
@@ -147,7 +191,7 @@ func UsedBy(s *state.State, aclProjectName string, usageFunc func(ctx context.Co
 				return fmt.Errorf("Failed to get network config for %q: %w", networkName, err)
 			}
 
-			netACLNames := util.SplitNTrimSpace(network.Config["security.acls"], ",", -1, true)
+			netACLNames := NetworkACLNames(projectConfig, network.Type, network.Config)
 			matchedACLNames := []string{}
 			for _, netACLName := range netACLNames {
 				if slices.Contains(matchACLNames, netACLName) {
@@ -246,6 +290,13 @@ func UsedBy(s *state.State, aclProjectName string, usageFunc func(ctx context.Co
 
 			matchedACLNames := []string{}
 
+			// ACLs can include the rules of other ACLs.
+			for _, includeName := range includedACLNames(aclInfo.Config) {
+				if slices.Contains(matchACLNames, includeName) && !slices.Contains(matchedACLNames, includeName) {
+					matchedACLNames = append(matchedACLNames, includeName)
+				}
+			}
+
 			// Ingress rules can specify ACL names in their Source subjects.
 			for _, rule := range aclInfo.Ingress {
 				for _, subject := range util.SplitNTrimSpace(rule.Source, ",", -1, true) {
@@ -424,6 +475,8 @@ func NetworkUsage(s *state.State, aclProjectName string, aclNames []string, aclN
 
 		case *api.NetworkACL:
 			return nil // Nothing to do for ACL rules referencing us.
+		case *api.Project:
+			return nil // The project's networks are reported on their own.
 		default:
 			return fmt.Errorf("Unrecognised usage type %T", u)
 		}
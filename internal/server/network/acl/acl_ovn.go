@@ -179,8 +179,11 @@ func OVNEnsureACLs(s *state.State, l logger.Logger, client *ovn.NB, aclProjectNa
 			err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
 				// Load the config we'll need to create the port group with ACL rules.
 				_, aclInfo, err = cluster.GetNetworkACLAPI(ctx, tx.Tx(), aclProjectName, aclName)
+				if err != nil {
+					return err
+				}
 
-				return err
+				return expandIncludes(ctx, tx, aclProjectName, aclInfo)
 			})
 			if err != nil {
 				return nil, fmt.Errorf("Failed loading Network ACL %q: %w", aclName, err)
@@ -213,8 +216,11 @@ func OVNEnsureACLs(s *state.State, l logger.Logger, client *ovn.NB, aclProjectNa
 			if reapplyRules || !portGroupHasACLs || len(addACLNets) > 0 {
 				err = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
 					_, aclInfo, err = cluster.GetNetworkACLAPI(ctx, tx.Tx(), aclProjectName, aclName)
+					if err != nil {
+						return err
+					}
 
-					return err
+					return expandIncludes(ctx, tx, aclProjectName, aclInfo)
 				})
 				if err != nil {
 					return nil, fmt.Errorf("Failed loading Network ACL %q: %w", aclName, err)
@@ -418,6 +424,27 @@ func ovnApplyToPortGroup(s *state.State, l logger.Logger, client *ovn.NB, aclInf
 	extractAddressSets(aclInfo.Ingress)
 	extractAddressSets(aclInfo.Egress)
 
+	// OVN uses connection tracking on every switch with ports in a port group that has stateful rules.
+	// So if any network using the ACL is stateless, the allow rules are moved to the per-ACL-per-network port
+	// groups, allowing them to be stateless for the stateless networks only.
+	usageNets := make(map[string]NetworkACLUsage)
+	err := NetworkUsage(s, aclInfo.Project, []string{aclInfo.Name}, usageNets)
+	if err != nil {
+		return fmt.Errorf("Failed getting ACL %q network usage: %w", aclInfo.Name, err)
+	}
+
+	for _, aclNet := range aclNets {
+		usageNets[aclNet.Name] = aclNet
+	}
+
+	stateless := false
+	for _, usageNet := range usageNets {
+		if usageNet.Type == "ovn" && util.IsTrue(usageNet.Config["security.stateless"]) {
+			stateless = true
+			break
+		}
+	}
+
 	addressSetNames := make([]string, 0, len(addressSetNamesSet))
 	for setName := range addressSetNamesSet {
 		addressSetNames = append(addressSetNames, setName)
@@ -457,6 +484,10 @@ func ovnApplyToPortGroup(s *state.State, l logger.Logger, client *ovn.NB, aclInf
 				return err
 			}
 
+			if stateless && ovnACLRule.Action == "allow-related" {
+				networkSpecific = true
+			}
+
 			if rule.State == "logged" {
 				ovnACLRule.Log = true
 				ovnACLRule.LogName = fmt.Sprintf("%s-%s-%d", portGroupName, direction, ruleIndex)
@@ -474,7 +505,7 @@ func ovnApplyToPortGroup(s *state.State, l logger.Logger, client *ovn.NB, aclInf
 		return nil
 	}
 
-	err := convertACLRules("ingress", aclInfo.Ingress...)
+	err = convertACLRules("ingress", aclInfo.Ingress...)
 	if err != nil {
 		return fmt.Errorf("Failed converting ACL %q ingress rules for port group %q: %w", aclInfo.Name, portGroupName, err)
 	}
@@ -513,18 +544,49 @@ func ovnApplyToPortGroup(s *state.State, l logger.Logger, client *ovn.NB, aclInf
 		return fmt.Errorf("Failed applying ACL %q rules to port group %q: %w", aclInfo.Name, portGroupName, err)
 	}
 
+	// When the allow rules are network specific, the other OVN networks using the ACL need them too.
+	applyNets := aclNets
+	if stateless {
+		applyNets = make(map[string]NetworkACLUsage, len(usageNets))
+		for _, usageNet := range usageNets {
+			if usageNet.Type != "ovn" {
+				continue
+			}
+
+			_, found := aclNets[usageNet.Name]
+			if !found {
+				// Skip networks whose port group hasn't been set up yet, the rules get applied when it is.
+				netPortGroupUUID, _, err := client.GetPortGroupInfo(context.TODO(), OVNACLNetworkPortGroupName(aclNameIDs[aclInfo.Name], usageNet.ID))
+				if err != nil {
+					return fmt.Errorf("Failed getting port group UUID for security ACL %q and network %q: %w", aclInfo.Name, usageNet.Name, err)
+				}
+
+				if netPortGroupUUID == "" {
+					continue
+				}
+			}
+
+			applyNets[usageNet.Name] = usageNet
+		}
+	}
+
 	// Now apply the network specific rules to all networks requested (even if networkRules is empty).
-	for _, aclNet := range aclNets {
+	for _, aclNet := range applyNets {
 		netPortGroupName := OVNACLNetworkPortGroupName(aclNameIDs[aclInfo.Name], aclNet.ID)
 		l.Debug("Applying network specific ACL rules to network OVN port group", logger.Ctx{"networkACL": aclInfo.Name, "network": aclNet.Name, "portGroup": netPortGroupName})
 
+		netRules := networkRules
+		if util.IsTrue(aclNet.Config["security.stateless"]) {
+			netRules = ovnACLRulesStateless(networkRules)
+		}
+
 		// Setup per-network dynamic replacements for @internal/@external subject port selectors.
 		matchReplace := map[string]string{
 			fmt.Sprintf("@%s", ruleSubjectInternal): fmt.Sprintf("@%s", OVNIntSwitchPortGroupName(aclNet.ID)),
 			fmt.Sprintf("@%s", ruleSubjectExternal): fmt.Sprintf(`"%s"`, OVNIntSwitchRouterPortName(aclNet.ID)),
 		}
 
-		err = client.UpdatePortGroupACLRules(context.TODO(), netPortGroupName, matchReplace, networkRules...)
+		err = client.UpdatePortGroupACLRules(context.TODO(), netPortGroupName, matchReplace, netRules...)
 		if err != nil {
 			return fmt.Errorf("Failed applying ACL %q rules to port group %q for network %q: %w", aclInfo.Name, netPortGroupName, aclNet.Name, err)
 		}
@@ -533,6 +595,30 @@ func ovnApplyToPortGroup(s *state.State, l logger.Logger, client *ovn.NB, aclInf
 	return nil
 }
 
+// ovnACLRulesStateless returns a copy of the rules with the stateful allow actions replaced by stateless ones.
+func ovnACLRulesStateless(rules []ovn.OVNACLRule) []ovn.OVNACLRule {
+	statelessRules := make([]ovn.OVNACLRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Action == "allow-related" || rule.Action == "allow" {
+			rule.Action = "allow-stateless"
+		}
+
+		statelessRules = append(statelessRules, rule)
+	}
+
+	return statelessRules
+}
+
+// OVNACLAction returns the OVN ACL action to use for a default rule action, replacing allow by a stateless
+// allow on stateless networks.
+func OVNACLAction(action string, stateless bool) string {
+	if stateless && action == "allow" {
+		return "allow-stateless"
+	}
+
+	return action
+}
+
 // ovnRuleCriteriaToOVNACLRule converts an ACL rule into an OVNACLRule for an OVN port group or network.
 // Returns a bool indicating if any of the rule subjects are network specific.
 func ovnRuleCriteriaToOVNACLRule(s *state.State, direction string, rule *api.NetworkACLRule, portGroupName ovn.OVNPortGroup, aclNameIDs map[string]int64, peerTargetNetIDs map[cluster.NetworkPeerConnection]int64) (ovn.OVNACLRule, bool, []cluster.NetworkPeerConnection, error) {
@@ -753,7 +839,8 @@ func ovnRuleSubjectToOVNACLMatch(s *state.State, direction string, aclNameIDs ma
 }
 
 // OVNApplyNetworkBaselineRules applies preset baseline logical switch rules to a allow access to network services.
-func OVNApplyNetworkBaselineRules(client *ovn.NB, switchName ovn.OVNSwitch, routerPortName ovn.OVNSwitchPort, intRouterIPs []*net.IPNet, dnsIPs []net.IP) error {
+// If stateless is true, the rules don't use connection tracking.
+func OVNApplyNetworkBaselineRules(client *ovn.NB, switchName ovn.OVNSwitch, routerPortName ovn.OVNSwitchPort, intRouterIPs []*net.IPNet, dnsIPs []net.IP, stateless bool) error {
 	rules := []ovn.OVNACLRule{
 		{
 			Direction: "to-lport",
@@ -859,6 +946,10 @@ func OVNApplyNetworkBaselineRules(client *ovn.NB, switchName ovn.OVNSwitch, rout
 		)
 	}
 
+	if stateless {
+		rules = ovnACLRulesStateless(rules)
+	}
+
 	err := client.UpdateLogicalSwitchACLRules(context.TODO(), switchName, rules...)
 	if err != nil {
 		return fmt.Errorf("Failed applying baseline ACL rules to logical switch %q: %w", switchName, err)
@@ -1054,6 +1145,9 @@ func OVNPortGroupDeleteIfUnused(s *state.State, l logger.Logger, client *ovn.NB,
 				}
 			}
 
+		case *api.Project:
+			// The project's networks are reported on their own.
+
 		default:
 			return fmt.Errorf("Unrecognised usage type %T", u)
 		}
@@ -132,7 +132,7 @@ func (d *common) Info() *api.NetworkACL {
 func (d *common) usedBy(firstOnly bool) ([]string, error) {
 	usedBy := []string{}
 
-	// Find all networks, profiles and instance NICs that use this Network ACL.
+	// Find the project, networks, profiles and instance NICs that use this Network ACL.
 	err := UsedBy(d.state, d.projectName, func(ctx context.Context, tx *db.ClusterTx, _ []string, usageType any, _ string, _ map[string]string) error {
 		switch u := usageType.(type) {
 		case db.InstanceArgs:
@@ -163,6 +163,8 @@ func (d *common) usedBy(firstOnly bool) ([]string, error) {
 			}
 
 			usedBy = append(usedBy, uri)
+		case *api.Project:
+			usedBy = append(usedBy, fmt.Sprintf("/%s/projects/%s", version.APIVersion, u.Name))
 		default:
 			return fmt.Errorf("Unrecognised usage type %T", u)
 		}
@@ -211,7 +213,16 @@ func (d *common) validateName(name string) error {
 
 // validateConfig checks the config and rules are valid.
 func (d *common) validateConfig(info *api.NetworkACLPut) error {
-	err := d.validateConfigMap(info.Config, nil)
+	rules := map[string]func(value string) error{
+		"include": validate.Optional(validate.IsListOf(ValidName)),
+	}
+
+	err := d.validateConfigMap(info.Config, rules)
+	if err != nil {
+		return err
+	}
+
+	err = d.validateIncludes(info.Config)
 	if err != nil {
 		return err
 	}
@@ -266,6 +277,55 @@ func (d *common) validateConfig(info *api.NetworkACLPut) error {
 	return nil
 }
 
+// validateIncludes checks the ACLs included by the config exist and don't lead to an include cycle.
+func (d *common) validateIncludes(config map[string]string) error {
+	includeNames := includedACLNames(config)
+	if len(includeNames) == 0 {
+		return nil
+	}
+
+	if slices.Contains(includeNames, d.info.Name) {
+		return errors.New("ACL cannot include itself")
+	}
+
+	err := Exists(d.state, d.projectName, includeNames...)
+	if err != nil {
+		return fmt.Errorf("Invalid value for config option %q: %w", "include", err)
+	}
+
+	includes := map[string][]string{}
+	err = d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &d.projectName})
+		if err != nil {
+			return err
+		}
+
+		for _, acl := range acls {
+			_, aclInfo, err := dbCluster.GetNetworkACLAPI(ctx, tx.Tx(), d.projectName, acl.Name)
+			if err != nil {
+				return err
+			}
+
+			includes[acl.Name] = includedACLNames(aclInfo.Config)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading ACL includes: %w", err)
+	}
+
+	// Check the new includes against the current ones of the other ACLs.
+	includes[d.info.Name] = includeNames
+
+	cycle := includeCycle(d.info.Name, includes)
+	if cycle != nil {
+		return fmt.Errorf("ACL include cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
 // validateConfigMap checks ACL config map against rules.
 func (d *common) validateConfigMap(config map[string]string, rules map[string]func(value string) error) error {
 	checkedFields := map[string]struct{}{}
@@ -650,9 +710,20 @@ func (d *common) Update(config *api.NetworkACLPut, clientType request.ClientType
 		})
 	}
 
-	// Get a list of networks that are using this ACL (either directly or indirectly via a NIC).
+	// ACLs including this one also render its rules, so they need to be applied again too.
+	var aclNames []string
+	err = d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		aclNames, err = includingACLNames(ctx, tx, d.projectName, d.info.Name)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed getting ACLs including %q: %w", d.info.Name, err)
+	}
+
+	// Get a list of networks that are using these ACLs (either directly or indirectly via a NIC).
 	aclNets := map[string]NetworkACLUsage{}
-	err = NetworkUsage(d.state, d.projectName, []string{d.info.Name}, aclNets)
+	err = NetworkUsage(d.state, d.projectName, aclNames, aclNets)
 	if err != nil {
 		return fmt.Errorf("Failed getting ACL network usage: %w", err)
 	}
@@ -677,7 +748,7 @@ func (d *common) Update(config *api.NetworkACLPut, clientType request.ClientType
 
 	// Apply ACL changes to non-OVN networks on this member.
 	for _, aclNet := range aclNets {
-		err = addressset.FirewallApplyAddressSetsForACLRules(d.state, "inet", d.projectName, []string{d.info.Name})
+		err = addressset.FirewallApplyAddressSetsForACLRules(d.state, "inet", d.projectName, aclNames)
 		if err != nil {
 			return err
 		}
@@ -690,7 +761,7 @@ func (d *common) Update(config *api.NetworkACLPut, clientType request.ClientType
 
 	// If there are affected bridge NICs, apply the ACL changes to the bridge interface filter.
 	if len(aclBridgeNICs) > 0 {
-		err = addressset.FirewallApplyAddressSetsForACLRules(d.state, "bridge", d.projectName, []string{d.info.Name})
+		err = addressset.FirewallApplyAddressSetsForACLRules(d.state, "bridge", d.projectName, aclNames)
 		if err != nil {
 			return err
 		}
@@ -736,14 +807,14 @@ func (d *common) Update(config *api.NetworkACLPut, clientType request.ClientType
 		// apply those rules to each network affected by the ACL, so pass the full list of OVN networks
 		// affected by this ACL (either because the ACL is assigned directly or because it is assigned to
 		// an OVN NIC in an instance or profile).
-		cleanup, err := OVNEnsureACLs(d.state, d.logger, ovnnb, d.projectName, aclNameIDs, aclOVNNets, []string{d.info.Name}, true)
+		cleanup, err := OVNEnsureACLs(d.state, d.logger, ovnnb, d.projectName, aclNameIDs, aclOVNNets, aclNames, true)
 		if err != nil {
 			return fmt.Errorf("Failed ensuring ACL is configured in OVN: %w", err)
 		}
 
 		reverter.Add(cleanup)
 
-		cleanup, err = addressset.OVNEnsureAddressSetsViaACLs(d.state, d.logger, ovnnb, d.projectName, []string{d.info.Name})
+		cleanup, err = addressset.OVNEnsureAddressSetsViaACLs(d.state, d.logger, ovnnb, d.projectName, aclNames)
 		if err != nil {
 			return fmt.Errorf("Failed ensuring Address sets is configured for ACL %s in OVN: %w", d.info.Name, err)
 		}
@@ -752,7 +823,7 @@ func (d *common) Update(config *api.NetworkACLPut, clientType request.ClientType
 
 		// Run unused port group cleanup in case any formerly referenced ACL in this ACL's rules means that
 		// an ACL port group is now considered unused.
-		err = OVNPortGroupDeleteIfUnused(d.state, d.logger, ovnnb, d.projectName, nil, "", d.info.Name)
+		err = OVNPortGroupDeleteIfUnused(d.state, d.logger, ovnnb, d.projectName, nil, "", aclNames...)
 		if err != nil {
 			return fmt.Errorf("Failed removing unused OVN port groups: %w", err)
 		}
@@ -278,7 +278,10 @@ func GetAddressSetsForACLs(s *state.State, projectName string, ACLNames []string
 			projectSetsNames = append(projectSetsNames, set.Name)
 		}
 
-		return nil
+		// The rules of included ACLs are rendered along with the ACLs including them.
+		ACLNames, err = aclNamesWithIncludes(ctx, tx, projectName, ACLNames)
+
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Failed loading address set names for project %q: %w", projectName, err)
@@ -305,6 +308,29 @@ func GetAddressSetsForACLs(s *state.State, projectName string, ACLNames []string
 
 // Redefine ACL usage funcs because we run into circular import otherwise
 
+// aclNamesWithIncludes returns the supplied ACL names along with the names of all the ACLs they include.
+func aclNamesWithIncludes(ctx context.Context, tx *db.ClusterTx, projectName string, aclNames []string) ([]string, error) {
+	names := slices.Clone(aclNames)
+	for i := 0; i < len(names); i++ {
+		_, aclInfo, err := dbCluster.GetNetworkACLAPI(ctx, tx.Tx(), projectName, names[i])
+		if err != nil {
+			if response.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("Failed loading ACL %q: %w", names[i], err)
+		}
+
+		for _, includeName := range util.SplitNTrimSpace(aclInfo.Config["include"], ",", -1, true) {
+			if !slices.Contains(names, includeName) {
+				names = append(names, includeName)
+			}
+		}
+	}
+
+	return names, nil
+}
+
 // ACLisInUseByDevice returns any of the supplied matching ACL names found referenced by the NIC device.
 func ACLisInUseByDevice(d deviceConfig.Device, matchACLNames ...string) []string {
 	matchedACLNames := []string{}
@@ -97,7 +97,7 @@ func (n *bridge) checkClusterWideMACSafe(config map[string]string) error {
 }
 
 // FillConfig fills requested config with any default values.
-func (n *bridge) FillConfig(config map[string]string) error {
+func (n *bridge) FillConfig(ctx context.Context, config map[string]string) error {
 	// Set some default values where needed.
 	if config["ipv4.address"] == "" {
 		config["ipv4.address"] = "auto"
@@ -119,7 +119,7 @@ func (n *bridge) FillConfig(config map[string]string) error {
 	}
 
 	// Now replace any "auto" keys with generated values.
-	err := n.populateAutoConfig(config)
+	err := n.populateAutoConfig(ctx, config)
 	if err != nil {
 		return fmt.Errorf("Failed generating auto config: %w", err)
 	}
@@ -128,7 +128,7 @@ func (n *bridge) FillConfig(config map[string]string) error {
 }
 
 // populateAutoConfig replaces "auto" in config with generated values.
-func (n *bridge) populateAutoConfig(config map[string]string) error {
+func (n *bridge) populateAutoConfig(ctx context.Context, config map[string]string) error {
 	changedConfig := false
 
 	// Now populate "auto" values where needed.
@@ -154,7 +154,7 @@ func (n *bridge) populateAutoConfig(config map[string]string) error {
 
 	// Re-validate config if changed.
 	if changedConfig && n.state != nil {
-		return n.Validate(config)
+		return n.Validate(ctx, config)
 	}
 
 	return nil
@@ -172,7 +172,7 @@ func (n *bridge) ValidateName(name string) error {
 }
 
 // Validate network config.
-func (n *bridge) Validate(config map[string]string) error {
+func (n *bridge) Validate(ctx context.Context, config map[string]string) error {
 	// Build driver specific rules dynamically.
 	rules := map[string]func(value string) error{
 		// gendoc:generate(entity=network_bridge, group=common, key=bgp.ipv4.nexthop)
@@ -466,6 +466,15 @@ func (n *bridge) Validate(config map[string]string) error {
 		//  shortdesc: Comma-separated list of IPv6 ranges to use for child OVN network routers (FIRST-LAST format)
 		"ipv6.ovn.ranges": validate.Optional(validate.IsListOf(validate.IsNetworkRangeV6)),
 
+		// gendoc:generate(entity=network_bridge, group=common, key=ipv6.ovn.ranges.required)
+		// When disabled, child OVN network routers get an EUI64 address from the bridge subnet if `ipv6.ovn.ranges` isn't set.
+		// ---
+		//  type: bool
+		//  condition: -
+		//  defaultdesc: `false`
+		//  shortdesc: Whether to require `ipv6.ovn.ranges` rather than using EUI64 addresses for child OVN network routers
+		"ipv6.ovn.ranges.required": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=network_bridge, group=common, key=dns.nameservers)
 		//
 		// ---
@@ -473,7 +482,7 @@ func (n *bridge) Validate(config map[string]string) error {
 		//  condition: -
 		//  default: IPv4 and IPv6 address
 		//  shortdesc: DNS server IPs to advertise to DHCP clients and via Router Advertisements. Both IPv4 and IPv6 addresses get pushed via DHCP, and IPv6 addresses are also advertised as RDNSS via RA.
-		"dns.nameservers": validate.Optional(validate.IsListOf(validate.IsNetworkAddress)),
+		"dns.nameservers": validate.Optional(validateNameservers),
 
 		// gendoc:generate(entity=network_bridge, group=common, key=dns.domain)
 		//
@@ -1037,7 +1046,7 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 			return fmt.Errorf("Failed generating stable random bridge MAC: %w", err)
 		}
 
-		randomHwaddr := randomHwaddr(r)
+		randomHwaddr := randomHwaddr(n.state, r)
 		bridge.Address, err = net.ParseMAC(randomHwaddr)
 		if err != nil {
 			return fmt.Errorf("Failed parsing MAC address %q: %w", randomHwaddr, err)
@@ -2091,7 +2100,7 @@ func (n *bridge) Stop() error {
 func (n *bridge) Update(newNetwork api.NetworkPut, targetNode string, clientType request.ClientType) error {
 	n.logger.Debug("Update", logger.Ctx{"clientType": clientType, "newNetwork": newNetwork})
 
-	err := n.populateAutoConfig(newNetwork.Config)
+	err := n.populateAutoConfig(n.state.ShutdownCtx, newNetwork.Config)
 	if err != nil {
 		return fmt.Errorf("Failed generating auto config: %w", err)
 	}
@@ -2341,14 +2350,16 @@ func (n *bridge) forwardConvertToFirewallForwards(listenAddress net.IP, defaultT
 	}
 
 	for _, portMap := range portMaps {
-		vips = append(vips, firewallDrivers.AddressForward{
-			ListenAddress: listenAddress,
-			Protocol:      portMap.protocol,
-			TargetAddress: portMap.target.address,
-			ListenPorts:   portMap.listenPorts,
-			TargetPorts:   portMap.target.ports,
-			SNAT:          portMap.snat,
-		})
+		for _, protocol := range forwardPortProtocols(portMap.protocol) {
+			vips = append(vips, firewallDrivers.AddressForward{
+				ListenAddress: listenAddress,
+				Protocol:      protocol,
+				TargetAddress: portMap.target.address,
+				ListenPorts:   portMap.listenPorts,
+				TargetPorts:   portMap.target.ports,
+				SNAT:          portMap.snat,
+			})
+		}
 	}
 
 	return vips
@@ -2648,10 +2659,10 @@ func (n *bridge) getExternalSubnetInUse() ([]externalSubnetUsage, error) {
 }
 
 // ForwardCreate creates a network forward.
-func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error {
+func (n *bridge) ForwardCreate(ctx context.Context, forward api.NetworkForwardsPost, clientType request.ClientType) (net.IP, error) {
 	memberSpecific := true // bridge supports per-member forwards.
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		// Check if there is an existing forward using the same listen address.
 		networkID := n.ID()
 		dbRecords, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
@@ -2686,23 +2697,23 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 		return nil
 	})
 	if err == nil {
-		return api.StatusErrorf(http.StatusConflict, "A forward for that listen address already exists")
+		return nil, api.StatusErrorf(http.StatusConflict, "A forward for that listen address already exists")
 	}
 
 	// Convert listen address to subnet so we can check its valid and can be used.
 	listenAddressNet, err := ParseIPToNet(forward.ListenAddress)
 	if err != nil {
-		return fmt.Errorf("Failed parsing address forward listen address %q: %w", forward.ListenAddress, err)
+		return nil, fmt.Errorf("Failed parsing address forward listen address %q: %w", forward.ListenAddress, err)
 	}
 
-	_, err = n.forwardValidate(listenAddressNet.IP, &forward.NetworkForwardPut)
+	_, err = n.forwardValidate(ctx, listenAddressNet.IP, &forward.NetworkForwardPut)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	externalSubnetsInUse, err := n.getExternalSubnetInUse()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check the listen address subnet doesn't fall within any existing network external subnets.
@@ -2719,7 +2730,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 		if SubnetContains(&externalSubnetUser.subnet, listenAddressNet) || SubnetContains(listenAddressNet, &externalSubnetUser.subnet) {
 			// This error is purposefully vague so that it doesn't reveal any names of
 			// resources potentially outside of the network.
-			return fmt.Errorf("Forward listen address %q overlaps with another network or NIC", listenAddressNet.String())
+			return nil, fmt.Errorf("Forward listen address %q overlaps with another network or NIC", listenAddressNet.String())
 		}
 	}
 
@@ -2728,7 +2739,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 
 	var forwardID int64
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		// Create forward DB record.
 		nodeID := sql.NullInt64{
 			Valid: memberSpecific,
@@ -2760,7 +2771,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 		return nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	reverter.Add(func() {
@@ -2773,7 +2784,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 
 	err = n.forwardSetupFirewall()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if hairpin mode needs to be enabled on active NIC bridge ports.
@@ -2793,7 +2804,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 		if brNetfilterEnabled {
 			var listenAddresses map[int64]string
 
-			err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 				networkID := n.ID()
 				dbRecords, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
 					NetworkID: &networkID,
@@ -2812,14 +2823,14 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 				return err
 			})
 			if err != nil {
-				return fmt.Errorf("Failed loading network forwards: %w", err)
+				return nil, fmt.Errorf("Failed loading network forwards: %w", err)
 			}
 
 			// If we are the first forward on this bridge, enable hairpin mode on active NIC ports.
 			if len(listenAddresses) <= 1 {
 				filter := dbCluster.InstanceFilter{Node: &n.state.ServerName}
 
-				err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+				err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 					return tx.InstanceList(ctx, func(inst db.InstanceArgs, p api.Project) error {
 						// Get the instance's effective network project name.
 						instNetworkProject := project.NetworkProjectFromRecord(&p)
@@ -2858,7 +2869,7 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 					}, filter)
 				})
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
 		}
@@ -2867,22 +2878,22 @@ func (n *bridge) ForwardCreate(forward api.NetworkForwardsPost, clientType reque
 	// Refresh exported BGP prefixes on local member.
 	err = n.forwardBGPSetupPrefixes()
 	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+		return nil, fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
 	}
 
 	reverter.Success()
 
-	return nil
+	return net.ParseIP(forward.ListenAddress), nil
 }
 
 // ForwardUpdate updates a network forward.
-func (n *bridge) ForwardUpdate(listenAddress string, req api.NetworkForwardPut, clientType request.ClientType) error {
+func (n *bridge) ForwardUpdate(ctx context.Context, listenAddress string, req api.NetworkForwardPut, clientType request.ClientType) error {
 	var curForwardID int64
 	var curForward *api.NetworkForward
 
 	var curNodeID sql.NullInt64
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		var err error
 
 		networkID := n.ID()
@@ -2923,7 +2934,7 @@ func (n *bridge) ForwardUpdate(listenAddress string, req api.NetworkForwardPut,
 		return err
 	}
 
-	_, err = n.forwardValidate(net.ParseIP(curForward.ListenAddress), &req)
+	_, err = n.forwardValidate(ctx, net.ParseIP(curForward.ListenAddress), &req)
 	if err != nil {
 		return err
 	}
@@ -2950,7 +2961,7 @@ func (n *bridge) ForwardUpdate(listenAddress string, req api.NetworkForwardPut,
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		fwd := dbCluster.NetworkForward{
 			NetworkID:     n.ID(),
 			NodeID:        curNodeID,
@@ -3012,12 +3023,12 @@ func (n *bridge) ForwardUpdate(listenAddress string, req api.NetworkForwardPut,
 }
 
 // ForwardDelete deletes a network forward.
-func (n *bridge) ForwardDelete(listenAddress string, clientType request.ClientType) error {
+func (n *bridge) ForwardDelete(ctx context.Context, listenAddress string, clientType request.ClientType) error {
 	memberSpecific := true // bridge supports per-member forwards.
 	var forwardID int64
 	var forward *api.NetworkForward
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		var err error
 
 		networkID := n.ID()
@@ -3060,7 +3071,7 @@ func (n *bridge) ForwardDelete(listenAddress string, clientType request.ClientTy
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), forwardID)
 	})
 	if err != nil {
@@ -3169,12 +3180,12 @@ func (n *bridge) forwardSetupFirewall() error {
 			ipVersions[4] = struct{}{}
 		}
 
-		portMaps, err := n.forwardValidate(listenAddressNet.IP, &forward.NetworkForwardPut)
+		portMaps, err := n.forwardValidate(context.TODO(), listenAddressNet.IP, &forward.NetworkForwardPut)
 		if err != nil {
 			return fmt.Errorf("Failed validating firewall address forward for listen address %q: %w", forward.ListenAddress, err)
 		}
 
-		fwForwards = append(fwForwards, n.forwardConvertToFirewallForwards(listenAddressNet.IP, net.ParseIP(forward.Config["target_address"]), portMaps)...)
+		fwForwards = append(fwForwards, n.forwardConvertToFirewallForwards(listenAddressNet.IP, forwardDefaultTargetAddress(listenAddressNet.IP, forward.Config), portMaps)...)
 	}
 
 	if len(forwards) > 0 {
@@ -3213,7 +3224,7 @@ func (n *bridge) forwardSetupFirewall() error {
 
 // Leases returns a list of leases for the bridged network. It will reach out to other cluster members as needed.
 // The projectName passed here refers to the initial project from the API request which may differ from the network's project.
-func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
+func (n *bridge) Leases(ctx context.Context, projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
 	var err error
 	var projectMacs []string
 	leases := []api.NetworkLease{}
@@ -3230,13 +3241,14 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 						Hostname: fmt.Sprintf("%s.gw", n.Name()),
 						Address:  ip.String(),
 						Type:     "gateway",
+						State:    "bound",
 					})
 				}
 			}
 
 			// Include downstream OVN routers using the network as an uplink.
 			var projectNetworks map[string]map[int64]api.Network
-			err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 				projectNetworks, err = tx.GetCreatedNetworks(ctx)
 				return err
 			})
@@ -3259,6 +3271,7 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 								Hostname: fmt.Sprintf("%s-%s.uplink", projectName, network.Name),
 								Address:  v,
 								Type:     "uplink",
+								State:    "bound",
 							})
 						}
 					}
@@ -3289,6 +3302,7 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 					Hwaddr:   hwAddr.String(),
 					Type:     "static",
 					Location: inst.Node,
+					State:    "configured",
 				})
 			}
 
@@ -3300,6 +3314,7 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 					Hwaddr:   hwAddr.String(),
 					Type:     "static",
 					Location: inst.Node,
+					State:    "configured",
 				})
 			}
 
@@ -3314,6 +3329,7 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 						Hwaddr:   hwAddr.String(),
 						Type:     "dynamic",
 						Location: inst.Node,
+						State:    "configured",
 					})
 				}
 			}
@@ -3347,10 +3363,20 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 				macStr = fields[4][len(fields[4])-17:]
 			}
 
-			// Look for an existing static entry.
+			// Parse the expiry (0 means the lease never expires).
+			var expiresAt *time.Time
+			expiry, err := strconv.ParseInt(fields[0], 10, 64)
+			if err == nil && expiry > 0 {
+				expiryTime := time.Unix(expiry, 0)
+				expiresAt = &expiryTime
+			}
+
+			// Look for an existing static entry and mark it as bound.
 			found := false
-			for _, entry := range leases {
+			for i, entry := range leases {
 				if entry.Hwaddr == macStr && entry.Address == fields[2] {
+					leases[i].State = "bound"
+					leases[i].ExpiresAt = expiresAt
 					found = true
 					break
 				}
@@ -3375,11 +3401,13 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 
 			// Add the lease to the list.
 			leases = append(leases, api.NetworkLease{
-				Hostname: fields[3],
-				Address:  fields[2],
-				Hwaddr:   macStr,
-				Type:     "dynamic",
-				Location: n.state.ServerName,
+				Hostname:  fields[3],
+				Address:   fields[2],
+				Hwaddr:    macStr,
+				Type:      "dynamic",
+				Location:  n.state.ServerName,
+				State:     "bound",
+				ExpiresAt: expiresAt,
 			})
 		}
 	}
@@ -2,16 +2,20 @@ package network
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
 	"net"
+	"net/http"
 	"os"
 	"slices"
 	"strconv"
 	"strings"
 	"unicode"
 
+	"github.com/miekg/dns"
+
 	incus "github.com/lxc/incus/v6/client"
 	internalInstance "github.com/lxc/incus/v6/internal/instance"
 	"github.com/lxc/incus/v6/internal/iprange"
@@ -20,6 +24,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
 	"github.com/lxc/incus/v6/internal/server/network/acl"
 	"github.com/lxc/incus/v6/internal/server/resources"
 	"github.com/lxc/incus/v6/internal/server/state"
@@ -27,6 +32,8 @@ import (
 	"github.com/lxc/incus/v6/internal/version"
 	"github.com/lxc/incus/v6/shared/api"
 	"github.com/lxc/incus/v6/shared/logger"
+	"github.com/lxc/incus/v6/shared/revert"
+	"github.com/lxc/incus/v6/shared/units"
 	"github.com/lxc/incus/v6/shared/util"
 	"github.com/lxc/incus/v6/shared/validate"
 )
@@ -38,11 +45,31 @@ type Info struct {
 	AddressForwards    bool // Indicates if driver supports address forwards.
 	LoadBalancers      bool // Indicates if driver supports load balancers.
 	Peering            bool // Indicates if the driver supports network peering.
+	ExternalPorts      bool // Indicates if the driver supports external ports.
+	RestorePoints      bool // Indicates if the driver supports restore points.
+	TrafficAccounting  bool // Indicates if the driver supports traffic accounting.
+}
+
+// TrafficCounter represents the traffic counters of a single port of a network on the local member.
+type TrafficCounter struct {
+	Name     string // Unique name of the port, used to detect counter resets.
+	Uplink   bool   // Whether the port connects the network to its uplink.
+	Counters api.NetworkTrafficCounters
+}
+
+// AddressConflict represents an address owned by a network which was observed on its uplink with a foreign MAC
+// address.
+type AddressConflict struct {
+	Address net.IP
+	Owner   string           // What the network uses the address for.
+	MAC     net.HardwareAddr // MAC address observed on the uplink.
+	Source  string           // Where the MAC address was observed.
 }
 
-// forwardTarget represents a single port forward target.
+// forwardTarget represents a single port forward target, either an address or a network target group.
 type forwardTarget struct {
 	address net.IP
+	group   string
 	ports   []uint64
 }
 
@@ -72,6 +99,42 @@ const (
 	subnetUsageProxy
 )
 
+// Scopes of network forward and load balancer listen addresses.
+const (
+	// listenScopeExternal listen addresses are allocated from the uplink and reachable from outside the network.
+	listenScopeExternal = "external"
+
+	// listenScopeInternal listen addresses live within the network's subnet and are only reachable from inside it.
+	listenScopeInternal = "internal"
+)
+
+// listenScope returns the scope of a network forward or load balancer from its config.
+func listenScope(config map[string]string) string {
+	if config["scope"] == "" {
+		return listenScopeExternal
+	}
+
+	return config["scope"]
+}
+
+// listenAdvertised returns whether the listen address of a network forward or load balancer should be exported
+// over BGP from its config.
+func listenAdvertised(config map[string]string) bool {
+	return listenScope(config) != listenScopeInternal && util.IsTrueOrEmpty(config["bgp.advertise"])
+}
+
+// networkForwardConfigs returns the config of all network forwards indexed by forward ID.
+// They are loaded in a single query rather than one per forward.
+func networkForwardConfigs(ctx context.Context, tx *db.ClusterTx) (map[int]map[string]string, error) {
+	return dbCluster.GetConfig(ctx, tx.Tx(), "networks_forwards", "network_forward")
+}
+
+// networkLoadBalancerConfigs returns the config of all network load balancers indexed by load balancer ID.
+// They are loaded in a single query rather than one per load balancer.
+func networkLoadBalancerConfigs(ctx context.Context, tx *db.ClusterTx) (map[int]map[string]string, error) {
+	return dbCluster.GetConfig(ctx, tx.Tx(), "networks_load_balancers", "network_load_balancer")
+}
+
 // externalSubnetUsage represents usage of a subnet by a network or NIC.
 type externalSubnetUsage struct {
 	subnet          net.IPNet
@@ -116,7 +179,13 @@ func (n *common) init(s *state.State, id int64, projectName string, netInfo *api
 }
 
 // FillConfig fills requested config with any default values, by default this is a no-op.
-func (n *common) FillConfig(config map[string]string) error {
+func (n *common) FillConfig(ctx context.Context, config map[string]string) error {
+	return nil
+}
+
+// RenderConfigTemplates renders the templated values of the config of a network being created, by default this is
+// a no-op.
+func (n *common) RenderConfigTemplates(config map[string]string) error {
 	return nil
 }
 
@@ -395,11 +464,9 @@ func (n *common) update(applyNetwork api.NetworkPut, targetNode string, clientTy
 				return err
 			}
 
-			sendNetwork := applyNetwork
-			sendNetwork.Config = make(map[string]string)
-
 			// Don't forward node specific keys (these will be merged in on recipient node).
 			applyNetwork.Config = db.StripNodeSpecificNetworkConfig(applyNetwork.Config)
+			sendNetwork := applyNetwork
 
 			err = notifier(func(client incus.InstanceServer) error {
 				return client.UseProject(n.project).UpdateNetwork(n.name, sendNetwork, "")
@@ -604,6 +671,11 @@ func (n *common) handleDependencyChange(netName string, netConfig map[string]str
 	return nil
 }
 
+// ProjectConfigUpdated is a placeholder for networks that don't depend on the configuration of their project.
+func (n *common) ProjectConfigUpdated(oldProjectConfig map[string]string) error {
+	return nil
+}
+
 // bgpValidate.
 func (n *common) bgpValidationRules(config map[string]string) (map[string]func(value string) error, error) {
 	rules := map[string]func(value string) error{}
@@ -781,13 +853,18 @@ func (n *common) bgpNextHopAddress(ipVersion uint) net.IP {
 func (n *common) bgpSetupPrefixes(oldConfig map[string]string) error {
 	// Clear existing prefixes.
 	bgpOwner := fmt.Sprintf("network_%d", n.id)
-	if oldConfig != nil {
+	if oldConfig != nil || util.IsTrue(n.config["replication.standby"]) {
 		err := n.state.BGP.RemovePrefixByOwner(bgpOwner)
 		if err != nil {
 			return err
 		}
 	}
 
+	// Standby networks don't attract traffic until activated.
+	if util.IsTrue(n.config["replication.standby"]) {
+		return nil
+	}
+
 	// Add the new prefixes.
 	for _, ipVersion := range []uint{4, 6} {
 		nextHopAddr := n.bgpNextHopAddress(ipVersion)
@@ -860,8 +937,76 @@ func (n *common) bgpGetPeers(config map[string]string) []string {
 	return peers
 }
 
+// listenScopeValidate validates the scope of a network forward or load balancer listen address.
+// Internal listen addresses must be within the network's subnet and cannot be the network's own address.
+func (n *common) listenScopeValidate(listenAddress net.IP, scope string) error {
+	err := validate.Optional(validate.IsOneOf(listenScopeExternal, listenScopeInternal))(scope)
+	if err != nil {
+		return fmt.Errorf("Invalid scope: %w", err)
+	}
+
+	if scope != listenScopeInternal {
+		return nil
+	}
+
+	if n.netType != "ovn" {
+		return errors.New("Internal listen addresses are only supported on OVN networks")
+	}
+
+	netIPKey := "ipv4.address"
+	if listenAddress.To4() == nil {
+		netIPKey = "ipv6.address"
+	}
+
+	netIP, netSubnet, err := net.ParseCIDR(n.config[netIPKey])
+	if err != nil {
+		return fmt.Errorf("Internal listen addresses require %q to be set on the network", netIPKey)
+	}
+
+	if !SubnetContainsIP(netSubnet, listenAddress) {
+		return errors.New("Internal listen address is not within the network subnet")
+	}
+
+	if listenAddress.Equal(netIP) {
+		return errors.New("Internal listen address cannot be the network's own address")
+	}
+
+	// When a services subnet is set, internal listen addresses must come from it.
+	servicesKey := "ipv4.services"
+	if listenAddress.To4() == nil {
+		servicesKey = "ipv6.services"
+	}
+
+	if n.config[servicesKey] != "" {
+		_, servicesNet, err := net.ParseCIDR(n.config[servicesKey])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %q: %w", servicesKey, err)
+		}
+
+		if !SubnetContainsIP(servicesNet, listenAddress) {
+			return fmt.Errorf("Internal listen address is not within %q", servicesKey)
+		}
+	}
+
+	// Dynamic allocations come from the DHCP ranges, so the listen address must be outside of them.
+	if listenAddress.To4() != nil && n.config["ipv4.dhcp.ranges"] != "" {
+		dhcpRanges, err := parseIPRanges(n.config["ipv4.dhcp.ranges"], netSubnet)
+		if err != nil {
+			return err
+		}
+
+		for _, dhcpRange := range dhcpRanges {
+			if dhcpRange.ContainsIP(listenAddress) {
+				return errors.New("Internal listen address cannot be within the network's DHCP ranges")
+			}
+		}
+	}
+
+	return nil
+}
+
 // forwardValidate validates the forward request.
-func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwardPut) ([]*forwardPortMap, error) {
+func (n *common) forwardValidate(ctx context.Context, listenAddress net.IP, forward *api.NetworkForwardPut) ([]*forwardPortMap, error) {
 	if listenAddress == nil {
 		return nil, errors.New("Invalid listen address")
 	}
@@ -891,7 +1036,7 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 
 	// Look for any unknown config fields.
 	for k := range forward.Config {
-		if k == "target_address" {
+		if slices.Contains([]string{"target_address", "target_address.ipv4", "target_address.ipv6", "scope", "bgp.advertise", "hostname"}, k) {
 			continue
 		}
 
@@ -909,16 +1054,68 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 		return nil, fmt.Errorf("Invalid option %q", k)
 	}
 
-	// Validate default target address.
+	// Validate listen address scope.
+
+	// gendoc:generate(entity=network_forward, group=common, key=scope)
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Scope of the listen address, either `external` (allocated from the uplink) or `internal` (within the network subnet, only reachable from inside the network)
+	//  defaultdesc: `external`
+	err = n.listenScopeValidate(listenAddress, forward.Config["scope"])
+	if err != nil {
+		return nil, err
+	}
+
+	// gendoc:generate(entity=network_forward, group=common, key=bgp.advertise)
+	// Only applies to external forwards on networks exporting their addresses over BGP.
+	//
+	// ---
+	//  type: bool
+	//  shortdesc: Whether to advertise the listen address over BGP
+	//  defaultdesc: `true`
+	err = validate.Optional(validate.IsBool)(forward.Config["bgp.advertise"])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for %q: %w", "bgp.advertise", err)
+	}
+
+	// gendoc:generate(entity=network_forward, group=common, key=hostname)
+	// The name is published in the forward DNS zones of the network, and the listen address is published
+	// in its matching reverse DNS zone.
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Host name of the listen address in the network DNS zones
+	err = validate.Optional(validate.IsHostname)(forward.Config["hostname"])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for %q: %w", "hostname", err)
+	}
+
+	// Validate default target addresses.
 
 	// gendoc:generate(entity=network_forward, group=common, key=target_address)
 	//
 	// ---
 	//  type: string
 	//  shortdesc: Default target address for anything not covered through a port definition
-	defaultTargetAddress := net.ParseIP(forward.Config["target_address"])
+
+	// gendoc:generate(entity=network_forward, group=common, key=target_address.ipv4)
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Default IPv4 target address, used for IPv4 listen addresses when `target_address` isn't set
+
+	// gendoc:generate(entity=network_forward, group=common, key=target_address.ipv6)
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Default IPv6 target address, used for IPv6 listen addresses when `target_address` isn't set
+	if forward.Config["target_address"] != "" && (forward.Config["target_address.ipv4"] != "" || forward.Config["target_address.ipv6"] != "") {
+		return nil, errors.New(`"target_address" cannot be combined with "target_address.ipv4" or "target_address.ipv6"`)
+	}
 
 	if forward.Config["target_address"] != "" {
+		defaultTargetAddress := net.ParseIP(forward.Config["target_address"])
 		if defaultTargetAddress == nil {
 			return nil, errors.New("Invalid default target address")
 		}
@@ -934,8 +1131,28 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 		}
 	}
 
+	for _, family := range []string{"ipv4", "ipv6"} {
+		key := fmt.Sprintf("target_address.%s", family)
+		if forward.Config[key] == "" {
+			continue
+		}
+
+		defaultTargetAddress := net.ParseIP(forward.Config[key])
+		if defaultTargetAddress == nil || (defaultTargetAddress.To4() != nil) != (family == "ipv4") {
+			return nil, fmt.Errorf("Invalid default %s target address", family)
+		}
+
+		// Check default target address is within the network's subnet of the same family.
+		_, familySubnet, _ := net.ParseCIDR(n.config[fmt.Sprintf("%s.address", family)])
+		if !SubnetContainsIP(familySubnet, defaultTargetAddress) {
+			return nil, fmt.Errorf("Default %s target address is not within the network subnet", family)
+		}
+	}
+
+	defaultTargetAddress := forwardDefaultTargetAddress(listenAddress, forward.Config)
+
 	// Validate port rules.
-	validPortProcols := []string{"tcp", "udp"}
+	validPortProcols := []string{"tcp", "udp", "any"}
 
 	// Used to ensure that each listen port is only used once.
 	listenPorts := map[string]map[int64]struct{}{
@@ -950,23 +1167,35 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 			return nil, fmt.Errorf("Invalid port protocol in port specification %d, protocol must be one of: %s", portSpecID, strings.Join(validPortProcols, ", "))
 		}
 
-		targetAddress := net.ParseIP(portSpec.TargetAddress)
-		if targetAddress == nil {
-			return nil, fmt.Errorf("Invalid target address in port specification %d", portSpecID)
-		}
+		var targetAddress net.IP
+		if portSpec.TargetGroup != "" {
+			if portSpec.TargetAddress != "" {
+				return nil, fmt.Errorf("Target address and target group cannot be used together in port specification %d", portSpecID)
+			}
 
-		if targetAddress.Equal(defaultTargetAddress) {
-			return nil, fmt.Errorf("Target address is same as default target address in port specification %d", portSpecID)
-		}
+			err = n.targetGroupValidate(ctx, portSpec.TargetGroup)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid target group in port specification %d: %w", portSpecID, err)
+			}
+		} else {
+			targetAddress = net.ParseIP(portSpec.TargetAddress)
+			if targetAddress == nil {
+				return nil, fmt.Errorf("Invalid target address in port specification %d", portSpecID)
+			}
 
-		targetIsIP4 := targetAddress.To4() != nil
-		if listenIsIP4 != targetIsIP4 {
-			return nil, fmt.Errorf("Cannot mix IP versions in listen address and port specification %d target address", portSpecID)
-		}
+			if targetAddress.Equal(defaultTargetAddress) {
+				return nil, fmt.Errorf("Target address is same as default target address in port specification %d", portSpecID)
+			}
 
-		// Check target address is within network's subnet.
-		if netSubnet != nil && !SubnetContainsIP(netSubnet, targetAddress) {
-			return nil, fmt.Errorf("Target address is not within the network subnet in port specification %d", portSpecID)
+			targetIsIP4 := targetAddress.To4() != nil
+			if listenIsIP4 != targetIsIP4 {
+				return nil, fmt.Errorf("Cannot mix IP versions in listen address and port specification %d target address", portSpecID)
+			}
+
+			// Check target address is within network's subnet.
+			if netSubnet != nil && !SubnetContainsIP(netSubnet, targetAddress) {
+				return nil, fmt.Errorf("Target address is not within the network subnet in port specification %d", portSpecID)
+			}
 		}
 
 		// Check valid listen port(s) supplied.
@@ -979,6 +1208,7 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 			listenPorts: make([]uint64, 0),
 			target: forwardTarget{
 				address: targetAddress,
+				group:   portSpec.TargetGroup,
 			},
 			protocol: portSpec.Protocol,
 			snat:     portSpec.SNAT,
@@ -992,12 +1222,17 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 
 			for i := range portRange {
 				port := portFirst + i
-				_, found := listenPorts[portSpec.Protocol][port]
-				if found {
-					return nil, fmt.Errorf("Duplicate listen port %d for protocol %q in port specification %d", port, portSpec.Protocol, portSpecID)
+
+				// The "any" protocol uses the listen port for both TCP and UDP.
+				for _, protocol := range forwardPortProtocols(portSpec.Protocol) {
+					_, found := listenPorts[protocol][port]
+					if found {
+						return nil, fmt.Errorf("Duplicate listen port %d for protocol %q in port specification %d", port, protocol, portSpecID)
+					}
+
+					listenPorts[protocol][port] = struct{}{}
 				}
 
-				listenPorts[portSpec.Protocol][port] = struct{}{}
 				portMap.listenPorts = append(portMap.listenPorts, uint64(port))
 			}
 		}
@@ -1040,18 +1275,104 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 	return portMaps, err
 }
 
-// ForwardCreate returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error {
+// targetGroupValidate checks that the network target group exists and can be used by the network.
+func (n *common) targetGroupValidate(ctx context.Context, groupName string) error {
+	if n.netType != "ovn" {
+		return errors.New("Target groups can only be used with OVN networks")
+	}
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := dbCluster.GetNetworkTargetGroup(ctx, tx.Tx(), n.project, groupName)
+		return err
+	})
+	if err != nil {
+		if api.StatusErrorCheck(err, http.StatusNotFound) {
+			return fmt.Errorf("Network target group %q does not exist", groupName)
+		}
+
+		return fmt.Errorf("Failed loading network target group %q: %w", groupName, err)
+	}
+
+	return nil
+}
+
+// Health returns ErrNotImplemented for drivers that do not support health checks.
+func (n *common) Health(ctx context.Context) (*api.NetworkHealth, error) {
+	return nil, ErrNotImplemented
+}
+
+// OVN returns ErrNotImplemented for drivers that are not backed by OVN.
+func (n *common) OVN() (*api.NetworkOVN, error) {
+	return nil, ErrNotImplemented
+}
+
+// Routes returns ErrNotImplemented for drivers that don't have a router of their own.
+func (n *common) Routes() (*api.NetworkRoutes, error) {
+	return nil, ErrNotImplemented
+}
+
+// AddressConflicts returns ErrNotImplemented for drivers that do not own addresses on an uplink.
+func (n *common) AddressConflicts() ([]AddressConflict, error) {
+	return nil, ErrNotImplemented
+}
+
+// InstanceDevicePortDiff returns ErrNotImplemented for drivers that do not keep per-port records.
+func (n *common) InstanceDevicePortDiff(ctx context.Context, instanceUUID string, deviceName string, deviceConfig deviceConfig.Device, running bool) (*api.InstanceInterfaceOVN, error) {
+	return nil, ErrNotImplemented
+}
+
+// PruneStaleRecords returns ErrNotImplemented for drivers that do not keep per-port records.
+func (n *common) PruneStaleRecords(dryRun bool) ([]string, error) {
+	return nil, ErrNotImplemented
+}
+
+// Replicate returns ErrNotImplemented for drivers that do not support replication.
+func (n *common) Replicate() error {
+	return ErrNotImplemented
+}
+
+// LocalTraffic returns ErrNotImplemented for drivers that do not support traffic accounting.
+func (n *common) LocalTraffic(ctx context.Context) ([]TrafficCounter, error) {
+	return nil, ErrNotImplemented
+}
+
+// RemapTargets returns ErrNotImplemented for drivers that do not support remapping of target addresses.
+func (n *common) RemapTargets(ctx context.Context, newConfig map[string]string) (revert.Hook, error) {
+	return nil, ErrNotImplemented
+}
+
+// ExternalPortCreate returns ErrNotImplemented for drivers that do not support external ports.
+func (n *common) ExternalPortCreate(port api.NetworkExternalPortsPost) error {
+	return ErrNotImplemented
+}
+
+// ExternalPortUpdate returns ErrNotImplemented for drivers that do not support external ports.
+func (n *common) ExternalPortUpdate(portName string, newPort api.NetworkExternalPortPut) error {
 	return ErrNotImplemented
 }
 
+// ExternalPortDelete returns ErrNotImplemented for drivers that do not support external ports.
+func (n *common) ExternalPortDelete(portName string) error {
+	return ErrNotImplemented
+}
+
+// ForwardCreate returns ErrNotImplemented for drivers that do not support forwards.
+func (n *common) ForwardCreate(ctx context.Context, forward api.NetworkForwardsPost, clientType request.ClientType) (net.IP, error) {
+	return nil, ErrNotImplemented
+}
+
 // ForwardUpdate returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) ForwardUpdate(listenAddress string, newForward api.NetworkForwardPut, clientType request.ClientType) error {
+func (n *common) ForwardUpdate(ctx context.Context, listenAddress string, newForward api.NetworkForwardPut, clientType request.ClientType) error {
+	return ErrNotImplemented
+}
+
+// ForwardRename returns ErrNotImplemented for drivers that do not support moving forwards.
+func (n *common) ForwardRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) error {
 	return ErrNotImplemented
 }
 
 // ForwardDelete returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) ForwardDelete(listenAddress string, clientType request.ClientType) error {
+func (n *common) ForwardDelete(ctx context.Context, listenAddress string, clientType request.ClientType) error {
 	return ErrNotImplemented
 }
 
@@ -1071,10 +1392,23 @@ func (n *common) forwardBGPSetupPrefixes() error {
 			return err
 		}
 
+		configs, err := networkForwardConfigs(ctx, tx)
+		if err != nil {
+			return err
+		}
+
 		fwdListenAddresses = make(map[int64]string)
 		for _, dbRecord := range dbRecords {
 			// memberSpecific filtering
 			if !dbRecord.NodeID.Valid || (dbRecord.NodeID.Int64 == tx.GetNodeID()) {
+				config := configs[int(dbRecord.ID)]
+
+				// Internal forwards are only reachable from within the network so are never exported, and
+				// others can be kept off the routing fabric individually.
+				if !listenAdvertised(config) {
+					continue
+				}
+
 				// Get listen address
 				forwardID := int64(dbRecord.ID)
 				fwdListenAddresses[forwardID] = dbRecord.ListenAddress
@@ -1182,6 +1516,16 @@ func (n *common) getExternalSubnetInUse(ctx context.Context, tx *db.ClusterTx, u
 	projectNetworksLoadBalancersOnUplink := map[string]map[string][]string{}
 	projectNetworksForwardsOnUplink := map[string]map[string][]string{}
 
+	loadBalancerConfigs, err := networkLoadBalancerConfigs(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting network load balancer configs: %w", err)
+	}
+
+	forwardConfigs, err := networkForwardConfigs(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting network forward configs: %w", err)
+	}
+
 	for networkID, relatedNetwork := range relatedNetworks {
 		// Get all load balancers associated with this network.
 		loadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
@@ -1192,6 +1536,11 @@ func (n *common) getExternalSubnetInUse(ctx context.Context, tx *db.ClusterTx, u
 		}
 
 		for _, lb := range loadBalancers {
+			// Internal load balancers don't use any addresses from the uplink.
+			if listenScope(loadBalancerConfigs[int(lb.ID)]) == listenScopeInternal {
+				continue
+			}
+
 			if projectNetworksLoadBalancersOnUplink[relatedNetwork.Project] == nil {
 				projectNetworksLoadBalancersOnUplink[relatedNetwork.Project] = map[string][]string{}
 			}
@@ -1208,6 +1557,11 @@ func (n *common) getExternalSubnetInUse(ctx context.Context, tx *db.ClusterTx, u
 		}
 
 		for _, fwd := range networkForwards {
+			// Internal forwards don't use any addresses from the uplink.
+			if listenScope(forwardConfigs[int(fwd.ID)]) == listenScopeInternal {
+				continue
+			}
+
 			if !memberSpecific || (!fwd.NodeID.Valid || (fwd.NodeID.Int64 == tx.GetNodeID())) {
 				if projectNetworksForwardsOnUplink[relatedNetwork.Project] == nil {
 					projectNetworksForwardsOnUplink[relatedNetwork.Project] = map[string][]string{}
@@ -1270,7 +1624,7 @@ func (n *common) getExternalSubnetInUse(ctx context.Context, tx *db.ClusterTx, u
 }
 
 // loadBalancerValidate validates the load balancer request.
-func (n *common) loadBalancerValidate(listenAddress net.IP, forward *api.NetworkLoadBalancerPut) ([]*loadBalancerPortMap, error) {
+func (n *common) loadBalancerValidate(ctx context.Context, listenAddress net.IP, forward *api.NetworkLoadBalancerPut) ([]*loadBalancerPortMap, error) {
 	if listenAddress == nil {
 		return nil, errors.New("Invalid listen address")
 	}
@@ -1335,6 +1689,90 @@ func (n *common) loadBalancerValidate(listenAddress net.IP, forward *api.Network
 		//  shortdesc: Test timeout
 		//  defaultdesc: `30`
 		"healthcheck.timeout": validate.IsUint32,
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=healthcheck.udp.probe)
+		// OVN can't reliably check UDP backends, so those are only checked when a probe is set here.
+		// Possible values are `none`, `udp` (send `healthcheck.udp.payload` and wait for any reply),
+		// `dns` (send a DNS query and wait for any answer) and `icmp` (ping the backend address).
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Probe used to check UDP backends
+		//  defaultdesc: `none`
+		"healthcheck.udp.probe": validate.Optional(validate.IsOneOf("none", "udp", "dns", "icmp")),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=healthcheck.udp.port)
+		//
+		// ---
+		//  type: integer
+		//  shortdesc: Port to send `udp` and `dns` probes to
+		//  defaultdesc: first target port of the backend, or first listen port of the port specification
+		"healthcheck.udp.port": validate.Optional(validate.IsNetworkPort),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=healthcheck.udp.payload)
+		// For `udp` probes, the hex-encoded payload to send.
+		// For `dns` probes, the name to query.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Payload of the UDP probes
+		//  defaultdesc: empty payload for `udp`, `.` for `dns`
+		"healthcheck.udp.payload": validate.IsAny,
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=scope)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Scope of the listen address, either `external` (allocated from the uplink) or `internal` (within the network subnet, only reachable from inside the network)
+		//  defaultdesc: `external`
+		"scope": validate.Optional(validate.IsOneOf(listenScopeExternal, listenScopeInternal)),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=bgp.advertise)
+		// Only applies to external load balancers on networks exporting their addresses over BGP.
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether to advertise the listen address over BGP
+		//  defaultdesc: `true`
+		"bgp.advertise": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=bgp.advertise.delay)
+		// The listen address is only advertised once the load balancer has been online for that long, which
+		// avoids route churn from flapping backends.
+		//
+		// ---
+		//  type: integer
+		//  shortdesc: Seconds a load balancer must be online before its listen address is advertised over BGP
+		//  defaultdesc: `0`
+		"bgp.advertise.delay": validate.Optional(validate.IsUint32),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=bgp.withdraw.delay)
+		// The listen address is only withdrawn once the load balancer has been offline for that long.
+		//
+		// ---
+		//  type: integer
+		//  shortdesc: Seconds a load balancer must be offline before its listen address is withdrawn from BGP
+		//  defaultdesc: `0`
+		"bgp.withdraw.delay": validate.Optional(validate.IsUint32),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=networks)
+		// Instances of the listed networks reach the listen address directly, without going through the uplink.
+		// The networks must be OVN networks of the same project, and only those peered with the load balancer's
+		// network are considered.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Comma-separated list of other networks sharing the load balancer
+		"networks": validate.Optional(validate.IsListOf(validate.IsAny)),
+
+		// gendoc:generate(entity=network_load_balancer, group=common, key=hostname)
+		// The name is published in the forward DNS zones of the network, and the listen address is published
+		// in its matching reverse DNS zone.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Host name of the listen address in the network DNS zones
+		"hostname": validate.Optional(validate.IsHostname),
 	}
 
 	for k, v := range forward.Config {
@@ -1362,6 +1800,32 @@ func (n *common) loadBalancerValidate(listenAddress net.IP, forward *api.Network
 		return nil, fmt.Errorf("Invalid option %q", k)
 	}
 
+	err = n.listenScopeValidate(listenAddress, forward.Config["scope"])
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the UDP probe payload against the probe type.
+	udpPayload := forward.Config["healthcheck.udp.payload"]
+	if udpPayload != "" {
+		switch forward.Config["healthcheck.udp.probe"] {
+		case "udp":
+			_, err := hex.DecodeString(udpPayload)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid hex-encoded payload %q: %w", udpPayload, err)
+			}
+
+		case "dns":
+			_, ok := dns.IsDomainName(udpPayload)
+			if !ok {
+				return nil, fmt.Errorf("Invalid DNS name %q", udpPayload)
+			}
+
+		default:
+			return nil, errors.New("healthcheck.udp.payload can only be set with the udp and dns probes")
+		}
+	}
+
 	// Validate port rules.
 	validPortProcols := []string{"tcp", "udp"}
 
@@ -1385,24 +1849,42 @@ func (n *common) loadBalancerValidate(listenAddress net.IP, forward *api.Network
 			return nil, fmt.Errorf("Duplicate name %q in backend specification %d", backendSpec.Name, backendSpecID)
 		}
 
-		targetAddress := net.ParseIP(backendSpec.TargetAddress)
-		if targetAddress == nil {
-			return nil, fmt.Errorf("Invalid target address for backend %q", backendSpec.Name)
-		}
+		var targetAddress net.IP
+		if backendSpec.TargetGroup != "" {
+			if backendSpec.TargetAddress != "" {
+				return nil, fmt.Errorf("Target address and target group cannot be used together for backend %q", backendSpec.Name)
+			}
 
-		targetIsIP4 := targetAddress.To4() != nil
-		if listenIsIP4 != targetIsIP4 {
-			return nil, fmt.Errorf("Cannot mix IP versions in listen address and backend %q target address", backendSpec.Name)
-		}
+			// UDP probes are run against a single address per backend.
+			if slices.Contains([]string{"udp", "dns", "icmp"}, forward.Config["healthcheck.udp.probe"]) {
+				return nil, fmt.Errorf("UDP probes cannot be used with the target group of backend %q", backendSpec.Name)
+			}
+
+			err = n.targetGroupValidate(ctx, backendSpec.TargetGroup)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid target group for backend %q: %w", backendSpec.Name, err)
+			}
+		} else {
+			targetAddress = net.ParseIP(backendSpec.TargetAddress)
+			if targetAddress == nil {
+				return nil, fmt.Errorf("Invalid target address for backend %q", backendSpec.Name)
+			}
+
+			targetIsIP4 := targetAddress.To4() != nil
+			if listenIsIP4 != targetIsIP4 {
+				return nil, fmt.Errorf("Cannot mix IP versions in listen address and backend %q target address", backendSpec.Name)
+			}
 
-		// Check target address is within network's subnet.
-		if netSubnet != nil && !SubnetContainsIP(netSubnet, targetAddress) {
-			return nil, fmt.Errorf("Target address is not within the network subnet for backend %q", backendSpec.Name)
+			// Check target address is within network's subnet.
+			if netSubnet != nil && !SubnetContainsIP(netSubnet, targetAddress) {
+				return nil, fmt.Errorf("Target address is not within the network subnet for backend %q", backendSpec.Name)
+			}
 		}
 
 		// Check valid target port(s) supplied.
 		target := forwardTarget{
 			address: targetAddress,
+			group:   backendSpec.TargetGroup,
 		}
 
 		for portSpecID, portSpec := range util.SplitNTrimSpace(backendSpec.TargetPort, ",", -1, true) {
@@ -1483,42 +1965,88 @@ func (n *common) loadBalancerValidate(listenAddress net.IP, forward *api.Network
 }
 
 // LoadBalancerCreate returns ErrNotImplemented for drivers that do not support load balancers.
-func (n *common) LoadBalancerCreate(loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) error {
-	return ErrNotImplemented
+func (n *common) LoadBalancerCreate(ctx context.Context, loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) (net.IP, error) {
+	return nil, ErrNotImplemented
 }
 
 // LoadBalancerUpdate returns ErrNotImplemented for drivers that do not support load balancers..
-func (n *common) LoadBalancerUpdate(listenAddress string, newLoadBalancer api.NetworkLoadBalancerPut, clientType request.ClientType) error {
+func (n *common) LoadBalancerUpdate(ctx context.Context, listenAddress string, newLoadBalancer api.NetworkLoadBalancerPut, clientType request.ClientType) error {
 	return ErrNotImplemented
 }
 
 // LoadBalancerState returns ErrNotImplemented for drivers that do not support load balancers..
-func (n *common) LoadBalancerState(loadBalancer api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error) {
+func (n *common) LoadBalancerState(ctx context.Context, loadBalancer api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error) {
 	return nil, ErrNotImplemented
 }
 
+// TargetGroupsRefresh returns ErrNotImplemented for drivers that do not support target groups.
+func (n *common) TargetGroupsRefresh(ctx context.Context) error {
+	return ErrNotImplemented
+}
+
+// LoadBalancerRename returns ErrNotImplemented for drivers that do not support moving load balancers.
+func (n *common) LoadBalancerRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) error {
+	return ErrNotImplemented
+}
+
 // LoadBalancerDelete returns ErrNotImplemented for drivers that do not support load balancers..
-func (n *common) LoadBalancerDelete(listenAddress string, clientType request.ClientType) error {
+func (n *common) LoadBalancerDelete(ctx context.Context, listenAddress string, clientType request.ClientType) error {
+	return ErrNotImplemented
+}
+
+// LoadBalancerProbe returns ErrNotImplemented for drivers that do not support load balancers.
+func (n *common) LoadBalancerProbe() error {
 	return ErrNotImplemented
 }
 
 // Leases returns ErrNotImplemented for drivers that don't support address leases.
-func (n *common) Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
+func (n *common) Leases(ctx context.Context, projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
+	return nil, ErrNotImplemented
+}
+
+// DetachUplink returns ErrNotImplemented for drivers that don't have an uplink network.
+func (n *common) DetachUplink(ctx context.Context, clientType request.ClientType) error {
+	return ErrNotImplemented
+}
+
+// ListenAddressStatus returns the status of a forward or load balancer, which is always active for drivers that
+// don't disable them.
+func (n *common) ListenAddressStatus(config map[string]string) string {
+	return api.NetworkListenAddressStatusActive
+}
+
+// InstanceAddresses returns ErrNotImplemented for drivers that can't resolve addresses to instances.
+func (n *common) InstanceAddresses(ctx context.Context) (map[string]string, error) {
 	return nil, ErrNotImplemented
 }
 
 // PeerCrete returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) PeerCreate(forward api.NetworkPeersPost) error {
+func (n *common) PeerCreate(ctx context.Context, forward api.NetworkPeersPost) error {
 	return ErrNotImplemented
 }
 
 // PeerUpdate returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) PeerUpdate(peerName string, newPeer api.NetworkPeerPut) error {
+func (n *common) PeerUpdate(ctx context.Context, peerName string, newPeer api.NetworkPeerPut) error {
 	return ErrNotImplemented
 }
 
 // PeerDelete returns ErrNotImplemented for drivers that do not support forwards.
-func (n *common) PeerDelete(peerName string) error {
+func (n *common) PeerDelete(ctx context.Context, peerName string) error {
+	return ErrNotImplemented
+}
+
+// PeerApprove returns ErrNotImplemented for drivers that do not support peering.
+func (n *common) PeerApprove(ctx context.Context, peerName string, approve bool) error {
+	return ErrNotImplemented
+}
+
+// PeerRoutes returns the routes advertised by a remote peering.
+func (n *common) PeerRoutes(ctx context.Context, peerName string) (*api.NetworkPeerRoutes, error) {
+	return nil, ErrNotImplemented
+}
+
+// PeerFailover moves remote peerings to their backup integrations when needed.
+func (n *common) PeerFailover(ctx context.Context) error {
 	return ErrNotImplemented
 }
 
@@ -1534,11 +2062,43 @@ func (n *common) peerValidate(peerName string, peer *api.NetworkPeerPut) error {
 	}
 
 	// Look for any unknown config fields.
-	for k := range peer.Config {
+	for k, v := range peer.Config {
 		if k == "target_address" {
 			continue
 		}
 
+		if k == "routes.export" || k == "routes.import" {
+			err := validate.IsListOf(validate.IsNetwork)(v)
+			if err != nil {
+				return fmt.Errorf("Invalid value for %q: %w", k, err)
+			}
+
+			continue
+		}
+
+		if k == "target_integration.backups" {
+			err := validate.IsListOf(validate.IsNotEmpty)(v)
+			if err != nil {
+				return fmt.Errorf("Invalid value for %q: %w", k, err)
+			}
+
+			continue
+		}
+
+		if k == "limits.bandwidth" {
+			rate, err := units.ParseBitSizeString(v)
+			if err != nil {
+				return fmt.Errorf("Invalid value for %q: %w", k, err)
+			}
+
+			// OVN limits are expressed in kbps.
+			if rate < 1000 {
+				return fmt.Errorf("Invalid value for %q: Must be at least 1kbit", k)
+			}
+
+			continue
+		}
+
 		// User keys are not validated.
 		if internalInstance.IsUserConfig(k) {
 			continue
@@ -1645,7 +2205,7 @@ func (n *common) peerUsedBy(peerName string, firstOnly bool) ([]string, error) {
 	return usedBy, nil
 }
 
-func (n *common) State() (*api.NetworkState, error) {
+func (n *common) State(ctx context.Context) (*api.NetworkState, error) {
 	return resources.GetNetworkState(n.name)
 }
 
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/lxc/incus/v6/internal/server/cluster/request"
@@ -22,7 +23,7 @@ func (n *macvlan) DBType() db.NetworkType {
 }
 
 // Validate network config.
-func (n *macvlan) Validate(config map[string]string) error {
+func (n *macvlan) Validate(ctx context.Context, config map[string]string) error {
 	rules := map[string]func(value string) error{
 		// gendoc:generate(entity=network_macvlan, group=common, key=parent)
 		//
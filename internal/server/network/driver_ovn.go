@@ -4,10 +4,10 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
-	"math/big"
 	"net"
 	"net/http"
 	"net/netip"
@@ -16,14 +16,15 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/flosch/pongo2/v6"
 	"github.com/mdlayher/netx/eui64"
-	ovsClient "github.com/ovn-org/libovsdb/client"
 	ovsdbModel "github.com/ovn-org/libovsdb/model"
 
 	incus "github.com/lxc/incus/v6/client"
+	internalInstance "github.com/lxc/incus/v6/internal/instance"
 	"github.com/lxc/incus/v6/internal/iprange"
 	"github.com/lxc/incus/v6/internal/server/cluster"
 	"github.com/lxc/incus/v6/internal/server/cluster/request"
@@ -43,24 +44,55 @@ import (
 	"github.com/lxc/incus/v6/internal/server/state"
 	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	internalUtil "github.com/lxc/incus/v6/internal/util"
+	"github.com/lxc/incus/v6/internal/version"
 	"github.com/lxc/incus/v6/shared/api"
 	"github.com/lxc/incus/v6/shared/logger"
 	"github.com/lxc/incus/v6/shared/revert"
+	localtls "github.com/lxc/incus/v6/shared/tls"
+	"github.com/lxc/incus/v6/shared/units"
 	"github.com/lxc/incus/v6/shared/util"
 	"github.com/lxc/incus/v6/shared/validate"
 )
 
 const (
-	ovnChassisPriorityMax = 32767
-	ovnVolatileUplinkIPv4 = "volatile.network.ipv4.address"
-	ovnVolatileUplinkIPv6 = "volatile.network.ipv6.address"
+	ovnChassisPriorityMax   = 32767
+	ovnDHCPv4LeaseTimeMin   = time.Minute
+	ovnVolatileUplinkIPv4   = "volatile.network.ipv4.address"
+	ovnVolatileUplinkIPv6   = "volatile.network.ipv6.address"
+	ovnVolatileRouterHwaddr = "volatile.router.hwaddr"
+	ovnVolatileReplication  = "volatile.replication.version"
 )
 
+// Upper bounds on how long OVN operations may take so that stuck OVN connections can't hang them indefinitely.
+const (
+	ovnOperationTimeout     = 2 * time.Minute  // Network wide operations (setup, forwards, load balancers, peers).
+	ovnPortOperationTimeout = 30 * time.Second // Instance and external port operations.
+)
+
+// ovnDynamicAddressTimeout is how long to wait for OVN to allocate the dynamic addresses of a port by default.
+const ovnDynamicAddressTimeout = 10 * time.Second
+
+// ovnReadinessTimeout is how long instance NIC starts wait for the network to be ready by default.
+const ovnReadinessTimeout = 30 * time.Second
+
 const (
 	ovnRouterPolicyPeerAllowPriority = 600
 	ovnRouterPolicyPeerDropPriority  = 500
 )
 
+// ovnQoSPeerPriority is the priority of the QoS rules limiting the bandwidth of peerings.
+const ovnQoSPeerPriority = 100
+
+// Link-local subnets used for the router port on unnumbered uplinks.
+var (
+	ovnLinkLocalIPv4 = &net.IPNet{IP: net.IPv4(169, 254, 0, 0), Mask: net.CIDRMask(16, 32)}
+	ovnLinkLocalIPv6 = &net.IPNet{IP: net.ParseIP("fe80::"), Mask: net.CIDRMask(64, 128)}
+)
+
+// ovnRoutedNICDHCPv4Options are the DHCPv4 options overridden for routed NICs, the /32 subnet mask makes the
+// instance send all its traffic to the router.
+var ovnRoutedNICDHCPv4Options = map[string]string{"netmask": "255.255.255.255"}
+
 // ovnUplinkVars OVN object variables derived from uplink network.
 type ovnUplinkVars struct {
 	// Router.
@@ -84,6 +116,58 @@ type ovnUplinkPortBridgeVars struct {
 	ovsEnd    string
 }
 
+// ovnLoadBalancerProbe represents the state of the UDP probes of a load balancer on this member.
+type ovnLoadBalancerProbe struct {
+	nextRun  time.Time
+	backends map[string]*ovnLoadBalancerProbeBackend // Keyed by backend name.
+}
+
+// online returns false when all of the probed backends are offline.
+func (p *ovnLoadBalancerProbe) online() bool {
+	for _, backend := range p.backends {
+		if backend.status != "offline" {
+			return true
+		}
+	}
+
+	return len(p.backends) == 0
+}
+
+// ovnLoadBalancerProbeBackend represents the state of the UDP probes of a load balancer backend.
+type ovnLoadBalancerProbeBackend struct {
+	target    string // Probe type and address probed.
+	status    string // Either "unknown", "online" or "offline".
+	successes int
+	failures  int
+}
+
+// ovnLoadBalancerProbes holds the state of the UDP load balancer probes run by this member, keyed by network ID
+// and listen address.
+var (
+	ovnLoadBalancerProbes   = map[int64]map[string]*ovnLoadBalancerProbe{}
+	ovnLoadBalancerProbesMu sync.Mutex
+)
+
+// ovnLoadBalancerBGPHold represents the BGP advertisement state of a load balancer on this member.
+type ovnLoadBalancerBGPHold struct {
+	advertised bool        // Whether the listen address is currently exported.
+	timer      *time.Timer // Pending change of the advertisement (nil if none).
+}
+
+// ovnLoadBalancerBGPHolds holds the BGP advertisement state of the load balancers on this member, keyed by network
+// ID and listen address.
+var (
+	ovnLoadBalancerBGPHolds   = map[int64]map[string]*ovnLoadBalancerBGPHold{}
+	ovnLoadBalancerBGPHoldsMu sync.Mutex
+)
+
+// ovnExternalInterfaces holds the external interfaces bridged by this member, keyed by network ID and interface
+// name. The value is the error which prevented the interface from being attached (nil if attached).
+var (
+	ovnExternalInterfaces   = map[int64]map[string]error{}
+	ovnExternalInterfacesMu sync.Mutex
+)
+
 // OVNInstanceNICSetupOpts options for starting an OVN Instance NIC.
 type OVNInstanceNICSetupOpts struct {
 	InstanceUUID string
@@ -92,6 +176,7 @@ type OVNInstanceNICSetupOpts struct {
 	UplinkConfig map[string]string
 	DNSName      string
 	LastStateIPs []net.IP
+	Precreate    bool // Only create the port disabled and allocate its addresses, without starting it.
 }
 
 // OVNInstanceNICStopOpts options for stopping an OVN Instance NIC.
@@ -107,6 +192,9 @@ type ovn struct {
 
 	ovnnb *networkOVN.NB
 	ovnsb *networkOVN.SB
+
+	projectConfigMu sync.Mutex
+	projectConfig   map[string]string // Config of the network's project, loaded on first use.
 }
 
 func (n *ovn) init(s *state.State, id int64, projectName string, netInfo *api.Network, netNodes map[int64]db.NetworkNode) error {
@@ -137,11 +225,14 @@ func (n *ovn) Info() Info {
 	info.AddressForwards = true
 	info.LoadBalancers = true
 	info.Peering = true
+	info.ExternalPorts = true
+	info.RestorePoints = true
+	info.TrafficAccounting = true
 
 	return info
 }
 
-func (n *ovn) State() (*api.NetworkState, error) {
+func (n *ovn) State(ctx context.Context) (*api.NetworkState, error) {
 	// Get the addresses.
 	var addresses []api.NetworkStateAddress
 	IPv4Net, err := ParseIPCIDRToNet(n.config["ipv4.address"])
@@ -177,7 +268,7 @@ func (n *ovn) State() (*api.NetworkState, error) {
 	// Check if an uplink network is present.
 	if n.config["network"] != "none" {
 		// Get the current active chassis.
-		chassis, err = n.ovnsb.GetLogicalRouterPortActiveChassisHostname(context.TODO(), n.getRouterExtPortName())
+		chassis, err = n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
 		if err != nil {
 			return nil, err
 		}
@@ -201,19 +292,61 @@ func (n *ovn) State() (*api.NetworkState, error) {
 
 		hwaddr, ok = n.config["bridge.hwaddr"]
 		if !ok {
-			hwaddr, err = n.ovnnb.GetLogicalRouterPortHardwareAddress(context.TODO(), n.getRouterIntPortName())
+			hwaddr, err = n.ovnnb.GetLogicalRouterPortHardwareAddress(ctx, n.getRouterIntPortName())
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	// Get the tunnel key of the switch, which is only known once ovn-northd has processed it.
+	tunnelKey, err := n.ovnsb.GetLogicalSwitchTunnelKey(ctx, logicalSwitchName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN logical switch tunnel key: %w", err)
+	}
+
+	// Get the instances currently holding the failover addresses.
+	activeInstances, _, err := n.failoverActiveInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	failoverAddresses := []api.NetworkStateOVNFailoverAddress{}
+	for _, failover := range n.failoverAddresses(n.config) {
+		failoverAddresses = append(failoverAddresses, api.NetworkStateOVNFailoverAddress{
+			Name:     failover.name,
+			Address:  failover.address.String(),
+			Instance: activeInstances[failover.name],
+		})
+	}
+
 	// Get the switch MTU.
 	mtu := int(n.getBridgeMTU())
 	if mtu == 0 {
 		mtu = 1500
 	}
 
+	// Get the configuration sequence numbers and per-chassis state.
+	// A degraded southbound database shouldn't prevent reporting the rest of the network state.
+	seqNumbers, chassisHealth, err := n.chassisHealth(ctx)
+	if err != nil {
+		n.logger.Warn("Failed getting OVN chassis health", logger.Ctx{"err": err})
+		seqNumbers = &networkOVN.OVNSequenceNumbers{}
+	}
+
+	// Get the priorities of the HA chassis group.
+	chassisPriorities, err := n.chassisPriorities(ctx, chassisHealth)
+	if err != nil {
+		n.logger.Warn("Failed getting OVN chassis group priorities", logger.Ctx{"err": err})
+		chassisPriorities = []api.NetworkStateOVNChassisPriority{}
+	}
+
+	// Get the DNS names registered by several ports.
+	dnsConflicts, err := n.dnsConflicts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.NetworkState{
 		Addresses: addresses,
 		Hwaddr:    hwaddr,
@@ -226,10 +359,89 @@ func (n *ovn) State() (*api.NetworkState, error) {
 			LogicalSwitch: string(logicalSwitchName),
 			UplinkIPv4:    uplinkIPv4,
 			UplinkIPv6:    uplinkIPv6,
+			NbCfg:         seqNumbers.NbCfg,
+			SbCfg:         seqNumbers.SbCfg,
+			HvCfg:         seqNumbers.HvCfg,
+			ChassisHealth: chassisHealth,
+
+			ChassisPriorities:      chassisPriorities,
+			LogicalSwitchTunnelKey: tunnelKey,
+			FailoverAddresses:      failoverAddresses,
+			ExternalInterfaces:     n.externalInterfacesState(),
+			NorthboundEndpoint:     n.ovnnb.Endpoint(),
+			SouthboundEndpoint:     n.ovnsb.Endpoint(),
+			DNSConflicts:           dnsConflicts,
 		},
 	}, nil
 }
 
+// chassisPriorities returns the priorities of the chassis in the network's HA chassis group, highest first.
+// The hostnames are resolved from the supplied chassis health list.
+func (n *ovn) chassisPriorities(ctx context.Context, chassisHealth []api.NetworkStateOVNChassis) ([]api.NetworkStateOVNChassisPriority, error) {
+	priorities, err := n.ovnnb.GetChassisGroupPriorities(ctx, n.getChassisGroupName())
+	if err != nil {
+		if errors.Is(err, networkOVN.ErrNotFound) {
+			return []api.NetworkStateOVNChassisPriority{}, nil
+		}
+
+		return nil, fmt.Errorf("Failed getting OVN chassis group priorities: %w", err)
+	}
+
+	chassisPriorities := make([]api.NetworkStateOVNChassisPriority, 0, len(priorities))
+	for chassisID, priority := range priorities {
+		entry := api.NetworkStateOVNChassisPriority{
+			Name:     chassisID,
+			Priority: priority,
+		}
+
+		for _, chassis := range chassisHealth {
+			if chassis.Name == chassisID {
+				entry.Hostname = chassis.Hostname
+				break
+			}
+		}
+
+		chassisPriorities = append(chassisPriorities, entry)
+	}
+
+	slices.SortFunc(chassisPriorities, func(a api.NetworkStateOVNChassisPriority, b api.NetworkStateOVNChassisPriority) int {
+		if a.Priority != b.Priority {
+			return b.Priority - a.Priority
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return chassisPriorities, nil
+}
+
+// chassisHealth returns the OVN configuration sequence numbers along with how far behind each chassis is.
+// A chassis whose ovn-controller is slow or disconnected will show a growing lag and heartbeat age.
+func (n *ovn) chassisHealth(ctx context.Context) (*networkOVN.OVNSequenceNumbers, []api.NetworkStateOVNChassis, error) {
+	seqNumbers, err := n.ovnnb.GetSequenceNumbers(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed getting OVN sequence numbers: %w", err)
+	}
+
+	chassisStatus, err := n.ovnsb.GetChassisStatus(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed getting OVN chassis status: %w", err)
+	}
+
+	chassisHealth := make([]api.NetworkStateOVNChassis, 0, len(chassisStatus))
+	for _, chassis := range chassisStatus {
+		chassisHealth = append(chassisHealth, api.NetworkStateOVNChassis{
+			Name:         chassis.Name,
+			Hostname:     chassis.Hostname,
+			NbCfg:        chassis.NbCfg,
+			Lag:          max(seqNumbers.NbCfg-chassis.NbCfg, 0),
+			HeartbeatAge: int64(time.Since(chassis.NbCfgTimestamp).Seconds()),
+		})
+	}
+
+	return seqNumbers, chassisHealth, nil
+}
+
 // uplinkRoutes parses ipv4.routes and ipv6.routes settings for an uplink network into a slice of *net.IPNet.
 func (n *ovn) uplinkRoutes(uplink *api.Network) ([]*net.IPNet, error) {
 	var err error
@@ -248,15 +460,15 @@ func (n *ovn) uplinkRoutes(uplink *api.Network) ([]*net.IPNet, error) {
 	return uplinkRoutes, nil
 }
 
-// projectRestrictedSubnets parses the restrict.networks.subnets project setting and returns slice of *net.IPNet.
-// Returns nil slice if no project restrictions, or empty slice if no allowed subnets.
-func (n *ovn) projectRestrictedSubnets(p *api.Project, uplinkNetworkName string) ([]*net.IPNet, error) {
+// projectRestrictedSubnets parses a list of uplink subnets project setting (such as restrict.networks.subnets)
+// and returns slice of *net.IPNet. Returns nil slice if no project restrictions, or empty slice if no allowed subnets.
+func (n *ovn) projectRestrictedSubnets(p *api.Project, key string, uplinkNetworkName string) ([]*net.IPNet, error) {
 	// Parse project's restricted subnets.
 	var projectRestrictedSubnets []*net.IPNet // Nil value indicates not restricted.
-	if util.IsTrue(p.Config["restricted"]) && p.Config["restricted.networks.subnets"] != "" {
+	if util.IsTrue(p.Config["restricted"]) && p.Config[key] != "" {
 		projectRestrictedSubnets = []*net.IPNet{} // Empty slice indicates no allowed subnets.
 
-		for _, subnetRaw := range util.SplitNTrimSpace(p.Config["restricted.networks.subnets"], ",", -1, false) {
+		for _, subnetRaw := range util.SplitNTrimSpace(p.Config[key], ",", -1, false) {
 			subnetParts := strings.SplitN(subnetRaw, ":", 2)
 			if len(subnetParts) != 2 {
 				return nil, fmt.Errorf(`Project subnet %q invalid, must be in the format of "<uplink network>:<subnet>"`, subnetRaw)
@@ -348,12 +560,12 @@ func (n *ovn) validateExternalSubnet(uplink *api.Network, projectRestrictedSubne
 
 // getExternalSubnetInUse returns information about usage of external subnets by networks and NICs connected to,
 // or used by, the specified uplinkNetworkName.
-func (n *ovn) getExternalSubnetInUse(uplinkNetworkName string) ([]externalSubnetUsage, error) {
+func (n *ovn) getExternalSubnetInUse(ctx context.Context, uplinkNetworkName string) ([]externalSubnetUsage, error) {
 	var err error
 	var projectNetworks map[string]map[int64]api.Network
 	var externalSubnets []externalSubnetUsage
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		// Get all managed networks across all projects.
 		projectNetworks, err = tx.GetCreatedNetworks(ctx)
 		if err != nil {
@@ -381,7 +593,7 @@ func (n *ovn) getExternalSubnetInUse(uplinkNetworkName string) ([]externalSubnet
 	}
 
 	// Get external routes configured on OVN NICs using networks that use our uplink.
-	ovnNICExternalRoutes, err := n.ovnNICExternalRoutes(ovnProjectNetworksWithOurUplink)
+	ovnNICExternalRoutes, err := n.ovnNICExternalRoutes(ctx, ovnProjectNetworksWithOurUplink)
 	if err != nil {
 		return nil, err
 	}
@@ -393,7 +605,7 @@ func (n *ovn) getExternalSubnetInUse(uplinkNetworkName string) ([]externalSubnet
 }
 
 // Validate network config.
-func (n *ovn) Validate(config map[string]string) error {
+func (n *ovn) Validate(ctx context.Context, config map[string]string) error {
 	rules := map[string]func(value string) error{
 		// gendoc:generate(entity=network_ovn, group=common, key=network)
 		//
@@ -410,6 +622,9 @@ func (n *ovn) Validate(config map[string]string) error {
 
 		"bridge.hwaddr": validate.Optional(validate.IsNetworkMAC),
 		// gendoc:generate(entity=network_ovn, group=common, key=bridge.mtu)
+		// When set, the MTU is checked against the OVN underlay of every reachable cluster member, taking the Geneve
+		// overhead into account (58 bytes with IPv4 encapsulation and 78 bytes with IPv6 encapsulation).
+		// The change is refused if any member's underlay can't carry it.
 		//
 		// ---
 		//  type: integer
@@ -452,12 +667,22 @@ func (n *ovn) Validate(config map[string]string) error {
 		//
 		// ---
 		//  type: string
-		//  shortdesc: When to expire DHCP leases
+		//  shortdesc: When to expire DHCP leases (minimum `1m` when changed)
 		//  condition: IPv4 DHCP
 		//  default: `1h`
 		"ipv4.dhcp.expiry": validate.Optional(func(value string) error {
-			_, err := time.ParseDuration(value)
-			return err
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+
+			// Very short leases cause every instance to renew constantly, all handled by ovn-controller.
+			// Networks already using a shorter lease time keep it until it's changed.
+			if duration < ovnDHCPv4LeaseTimeMin && value != n.config["ipv4.dhcp.expiry"] {
+				return fmt.Errorf("DHCP lease time must be at least %s", ovnDHCPv4LeaseTimeMin)
+			}
+
+			return nil
 		}),
 
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.dhcp.ranges)
@@ -510,6 +735,36 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  default: `false`
 		"ipv6.dhcp.stateful": validate.Optional(validate.IsBool),
 
+		// gendoc:generate(entity=network_ovn, group=common, key=dynamic_addresses.timeout)
+		// How long an instance NIC start waits for OVN to allocate the dynamic addresses of its port.
+		//
+		// ---
+		//  type: integer
+		//  shortdesc: Time to wait for dynamic addresses (in seconds)
+		//  default: `10`
+		"dynamic_addresses.timeout": validate.Optional(validate.IsInRange(1, 120)),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=dynamic_addresses.on_failure)
+		// Set to `continue` to start instance NICs without the dynamic addresses OVN couldn't allocate in time,
+		// for instances relying on static, link-local or externally managed addressing.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: What to do when dynamic addresses can't be allocated (`fail` or `continue`)
+		//  default: `fail`
+		"dynamic_addresses.on_failure": validate.Optional(validate.IsOneOf("fail", "continue")),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=readiness.timeout)
+		// How long an instance NIC start waits for the network to be ready (DHCP options in place and uplink port bound
+		// to a chassis), for example right after a host reboot. The NIC is started anyway once it expires.
+		// Set to `0` to not wait.
+		//
+		// ---
+		//  type: integer
+		//  shortdesc: Time to wait for the network to be ready (in seconds)
+		//  default: `30`
+		"readiness.timeout": validate.Optional(validate.IsInRange(0, 600)),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.nat)
 		//
 		// ---
@@ -527,6 +782,16 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  condition: IPv4 address
 		"ipv4.nat.address": validate.Optional(validate.IsNetworkAddressV4),
 
+		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.nat.exclude.destinations)
+		// Traffic towards these destinations leaves the network with the original source address of the instances.
+		// See {ref}`network-ovn-nat-exclusions`.
+		//
+		// ---
+		//  type: string
+		//  condition: IPv4 address
+		//  shortdesc: Comma-separated list of destination subnets in CIDR notation for which outbound traffic isn't NATed
+		"ipv4.nat.exclude.destinations": validate.Optional(validate.IsListOf(validate.IsNetworkV4)),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv6.nat)
 		//
 		// ---
@@ -544,6 +809,40 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  shortdesc: The source address used for outbound traffic from the network (requires uplink `ovn.ingress_mode=routed`)
 		"ipv6.nat.address": validate.Optional(validate.IsNetworkAddressV6),
 
+		// gendoc:generate(entity=network_ovn, group=common, key=ipv6.nat.exclude.destinations)
+		// Traffic towards these destinations leaves the network with the original source address of the instances.
+		// See {ref}`network-ovn-nat-exclusions`.
+		//
+		// ---
+		//  type: string
+		//  condition: IPv6 address
+		//  shortdesc: Comma-separated list of destination subnets in CIDR notation for which outbound traffic isn't NATed
+		"ipv6.nat.exclude.destinations": validate.Optional(validate.IsListOf(validate.IsNetworkV6)),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.services)
+		//
+		// ---
+		//  type: string
+		//  condition: IPv4 address
+		//  shortdesc: Subnet within the network reserved for internal network forward and load balancer listen addresses (excluded from DHCP allocations) (CIDR)
+		"ipv4.services": validate.Optional(validate.IsNetworkV4),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=ipv6.services)
+		//
+		// ---
+		//  type: string
+		//  condition: IPv6 address
+		//  shortdesc: Subnet within the network reserved for internal network forward and load balancer listen addresses (CIDR)
+		"ipv6.services": validate.Optional(validate.IsNetworkV6),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=nat.hairpin)
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether instances on the network can reach network forwards and load balancers through their listen addresses (NAT hairpinning)
+		//  default: `true`
+		"nat.hairpin": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.l3only)
 		//
 		// ---
@@ -554,6 +853,8 @@ func (n *ovn) Validate(config map[string]string) error {
 		"ipv4.l3only": validate.Optional(validate.IsBool),
 
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv6.l3only)
+		// In layer 3 only mode, `ipv6.address` can use a subnet smaller than a `/64` (down to a `/124`).
+		// Instance NICs then get static addresses from the subnet, handed out through stateful DHCPv6.
 		//
 		// ---
 		//  type: bool
@@ -568,7 +869,7 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  type: string
 		//  shortdesc: DNS server IPs to advertise to DHCP clients and via Router Advertisements. Both IPv4 and IPv6 addresses get pushed via DHCP, and the first IPv6 address is also advertised as RDNSS via RA.
 		//  default: Uplink DNS servers (IPv4 and IPv6 address if no uplink is configured)
-		"dns.nameservers": validate.Optional(validate.IsListOf(validate.IsNetworkAddress)),
+		"dns.nameservers": validate.Optional(validateNameservers),
 
 		// gendoc:generate(entity=network_ovn, group=common, key=dns.domain)
 		//
@@ -579,11 +880,39 @@ func (n *ovn) Validate(config map[string]string) error {
 		"dns.domain": validate.IsAny,
 
 		// gendoc:generate(entity=network_ovn, group=common, key=dns.search)
+		// The list is advertised through the DHCPv4 domain search option (option 119), DHCPv6 and IPv6 router advertisements.
+		// Once encoded, it must fit within the 255 bytes of the DHCPv4 option.
 		//
 		// ---
 		//  type: string
 		//  shortdesc: Full comma-separated domain search list, defaulting to `dns.domain` value
-		"dns.search": validate.IsAny,
+		"dns.search": validate.Optional(validateDNSSearchList),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=dns.conflicts)
+		// Decides what happens when a starting port registers a DNS name already used by another port of the network,
+		// such as for the second NIC of an instance:
+		//
+		// - `allow`: Register the name as is, leaving which address gets returned unspecified
+		// - `reject`: Fail to start the port
+		// - `suffix`: Register the name with the NIC name appended instead (`<instance>-<nic>`)
+		// - `round-robin`: Resolve the name to the addresses of all the ports registering it
+		//
+		// Existing conflicts are reported in the network state.
+		//
+		// ---
+		//  type: string
+		//  default: `allow`
+		//  shortdesc: How to handle ports registering the same DNS name (`allow`, `reject`, `suffix` or `round-robin`)
+		"dns.conflicts": validate.Optional(validate.IsOneOf("allow", "reject", "suffix", "round-robin")),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=dns.forward.networks)
+		// The records of a listed network are only resolvable once the two networks are peered.
+		// See {ref}`network-ovn-dns-forwarding`.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Comma-separated list of OVN networks of the same project whose `dns.domain` is resolved on this network
+		"dns.forward.networks": validate.Optional(validate.IsListOf(validate.IsAny)),
 
 		// gendoc:generate(entity=network_ovn, group=common, key=dns.zone.forward)
 		//
@@ -606,6 +935,13 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  shortdesc: DNS zone name for IPv6 reverse DNS records
 		"dns.zone.reverse.ipv6": validate.IsAny,
 
+		// gendoc:generate(entity=network_ovn, group=common, key=ntp.servers)
+		// The servers are only advertised over DHCPv4 as OVN's DHCPv6 server doesn't support the NTP server option.
+		// ---
+		//  type: string
+		//  shortdesc: Comma-separated list of NTP server IPv4 addresses to advertise to DHCP clients (DHCPv4 option 42)
+		"ntp.servers": validate.Optional(validate.IsListOf(validate.IsNetworkAddressV4)),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=security.acls)
 		//
 		// ---
@@ -649,6 +985,91 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  condition: `security.acls`
 		"security.acls.default.egress.logged": validate.Optional(validate.IsBool),
 
+		// gendoc:generate(entity=network_ovn, group=common, key=security.stateless)
+		// When enabled, the ACL rules allowing traffic on this network are stateless and don't use connection tracking.
+		// Return traffic must then be allowed explicitly by ACL rules.
+		// The SNAT rules of the instance NIC external addresses (`ipv4.address.external` and `ipv6.address.external`)
+		// are stateless too. The SNAT rules of the network itself map many internal addresses to one external address
+		// and always use connection tracking.
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether to avoid connection tracking on the network
+		//  default: `false`
+		"security.stateless": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=replication.standby)
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether the network is a standby copy of a network on another cluster (its subnets aren't advertised over BGP until this is unset)
+		//  default: `false`
+		"replication.standby": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=replication.target.address)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: API address (`https://<host>:<port>`) of the standby cluster to mirror the network configuration to
+		"replication.target.address": validate.Optional(validate.IsRequestURL),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=replication.target.certificate)
+		//
+		// ---
+		//  type: string
+		//  condition: `replication.target.address`
+		//  shortdesc: PEM-encoded server certificate of the standby cluster
+		"replication.target.certificate": validate.Optional(func(value string) error {
+			_, err := localtls.CertFingerprintStr(value)
+			if err != nil {
+				return fmt.Errorf("Invalid certificate: %w", err)
+			}
+
+			return nil
+		}),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=replication.target.project)
+		//
+		// ---
+		//  type: string
+		//  condition: `replication.target.address`
+		//  shortdesc: Project of the standby network
+		//  default: same as the network's project
+		"replication.target.project": validate.Optional(validate.IsURLSegmentSafe),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=replication.target.network)
+		//
+		// ---
+		//  type: string
+		//  condition: `replication.target.address`
+		//  shortdesc: Name of the standby network
+		//  default: same as the network's name
+		"replication.target.network": validate.Optional(validate.IsNetworkName),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=restore_points.schedule)
+		// Specify either a cron expression (`<minute> <hour> <dom> <month> <dow>`), a comma-and-space-separated list of schedule aliases (`@hourly`, `@daily`, `@midnight`, `@weekly`, `@monthly`, `@annually`, `@yearly`), or `@never` to disable automatic restore points.
+		//
+		// A new restore point is only taken if the network changed since the previous one.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Schedule for automatic restore points of the network
+		//  default: `@daily`
+		"restore_points.schedule": validate.Optional(validate.IsCron([]string{"@hourly", "@daily", "@midnight", "@weekly", "@monthly", "@annually", "@yearly", "@never"})),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=restore_points.expiry)
+		// Specify an expression like `1M 2H 3d 4w 5m 6y`.
+		//
+		// ---
+		//  type: string
+		//  shortdesc: When automatic restore points are to be deleted
+		//  default: `1w`
+		"restore_points.expiry": func(value string) error {
+			// Validate expression
+			_, err := internalInstance.GetExpiry(time.Time{}, value)
+			return err
+		},
+
 		// gendoc:generate(entity=network_ovn, group=common, key=user.*)
 		//
 		// ---
@@ -656,8 +1077,101 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  shortdesc: User-provided free-form key/value pairs
 
 		// Volatile keys populated automatically as needed.
-		ovnVolatileUplinkIPv4: validate.Optional(validate.IsNetworkAddressV4),
-		ovnVolatileUplinkIPv6: validate.Optional(validate.IsNetworkAddressV6),
+		ovnVolatileUplinkIPv4:   validate.Optional(validate.IsNetworkAddressV4),
+		ovnVolatileUplinkIPv6:   validate.Optional(validate.IsNetworkAddressV6),
+		ovnVolatileRouterHwaddr: validate.Optional(validate.IsNetworkMAC),
+		ovnVolatileReplication:  validate.Optional(validate.IsUint32),
+	}
+
+	// gendoc:generate(entity=network_ovn, group=common, key=ovn.chassis.priority.MEMBER)
+	// The priority overrides the stable-random one otherwise computed for the member, allowing for a
+	// deterministic failover order. The member with the highest priority hosts the network's router.
+	//
+	// ---
+	//  type: integer
+	//  shortdesc: Priority of the cluster member in the network's HA chassis group
+	//  default: stable-random priority
+	for k := range config {
+		member, found := strings.CutPrefix(k, "ovn.chassis.priority.")
+		if !found {
+			continue
+		}
+
+		if member == "" {
+			return fmt.Errorf("Invalid network configuration key: %q", k)
+		}
+
+		rules[k] = validate.Optional(validate.IsInRange(0, ovnChassisPriorityMax))
+	}
+
+	// gendoc:generate(entity=network_ovn, group=common, key=failover.NAME.address)
+	// The address must be within one of the network's subnets and is kept out of dynamic allocations.
+	// See {ref}`network-ovn-failover-addresses`.
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Floating IPv4 or IPv6 address moved between the instances of the failover address
+
+	// gendoc:generate(entity=network_ovn, group=common, key=failover.NAME.instances)
+	// The address is held by the first instance of the list whose NIC on the network is up.
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Comma-separated list of instances which can hold the failover address, in priority order
+	for k := range config {
+		suffix, found := strings.CutPrefix(k, "failover.")
+		if !found {
+			continue
+		}
+
+		name, field, _ := strings.Cut(suffix, ".")
+		if name == "" {
+			return fmt.Errorf("Invalid network configuration key: %q", k)
+		}
+
+		switch field {
+		case "address":
+			rules[k] = validate.IsNetworkAddress
+		case "instances":
+			rules[k] = validate.IsListOf(func(value string) error { return instance.ValidName(value, false) })
+		default:
+			return fmt.Errorf("Invalid network configuration key: %q", k)
+		}
+	}
+
+	// gendoc:generate(entity=network_ovn, group=common, key=nat.policy.NAME.address)
+	// Outbound traffic towards the destinations of the policy uses this address rather than `ipv4.nat.address` or `ipv6.nat.address`.
+	// See {ref}`network-ovn-nat-policies`.
+	//
+	// ---
+	//  type: string
+	//  shortdesc: The source address used for outbound traffic towards the destinations of the NAT policy (requires uplink `ovn.ingress_mode=routed`)
+
+	// gendoc:generate(entity=network_ovn, group=common, key=nat.policy.NAME.destinations)
+	// The destinations must all be of the same address family as the policy address and can't overlap with those of other policies.
+	//
+	// ---
+	//  type: string
+	//  shortdesc: Comma-separated list of destination subnets in CIDR notation the NAT policy applies to
+	for k := range config {
+		suffix, found := strings.CutPrefix(k, "nat.policy.")
+		if !found {
+			continue
+		}
+
+		name, field, _ := strings.Cut(suffix, ".")
+		if name == "" {
+			return fmt.Errorf("Invalid network configuration key: %q", k)
+		}
+
+		switch field {
+		case "address":
+			rules[k] = validate.IsNetworkAddress
+		case "destinations":
+			rules[k] = validate.IsListOf(validate.IsNetwork)
+		default:
+			return fmt.Errorf("Invalid network configuration key: %q", k)
+		}
 	}
 
 	err := n.validate(config, rules)
@@ -694,17 +1208,23 @@ func (n *ovn) Validate(config map[string]string) error {
 
 	// Check that if IPv6 enabled then the network size must be at least a /64 as both RA and DHCPv6
 	// in OVN (as it generates addresses using EUI64) require at least a /64 subnet to operate.
+	// In l3only mode SLAAC isn't used and instance ports get static addresses handed out by stateful DHCPv6,
+	// so smaller subnets can be used there.
 	_, ipv6Net, _ := net.ParseCIDR(config["ipv6.address"])
 	if ipv6Net != nil {
 		ones, _ := ipv6Net.Mask.Size()
-		if ones > 64 {
-			return errors.New("IPv6 subnet must be at least a /64")
+		if ones > 64 && util.IsFalseOrEmpty(config["ipv6.l3only"]) {
+			return errors.New("IPv6 subnet must be at least a /64 unless ipv6.l3only is enabled")
+		}
+
+		if ones > 124 {
+			return errors.New("IPv6 subnet must be at least a /124")
 		}
 	}
 
 	// Load the project to get uplink network restrictions.
 	var p *api.Project
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
 		if err != nil {
 			return err
@@ -722,24 +1242,48 @@ func (n *ovn) Validate(config map[string]string) error {
 	var uplink *api.Network
 	projectRestrictedSubnets := []*net.IPNet{}
 
-	if n.config["network"] != "none" {
-		uplinkNetworkName, err := n.validateUplinkNetwork(p, config["network"])
+	if config["network"] != "none" {
+		uplinkNetworkName, err := n.validateUplinkNetwork(ctx, p, config["network"])
 		if err != nil {
 			return err
 		}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 			// Get uplink routes.
 			_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, uplinkNetworkName)
+			if err != nil {
+				return fmt.Errorf("Failed to load uplink network %q: %w", uplinkNetworkName, err)
+			}
 
-			return err
+			// Check that the members with a pinned chassis priority have access to the uplink.
+			if uplink.Config["ovn.cluster_groups"] == "" {
+				return nil
+			}
+
+			for k := range config {
+				memberName, found := strings.CutPrefix(k, "ovn.chassis.priority.")
+				if !found {
+					continue
+				}
+
+				member, err := tx.GetNodeByName(ctx, memberName)
+				if err != nil {
+					return fmt.Errorf("Failed loading cluster member %q: %w", memberName, err)
+				}
+
+				if !ovnUplinkMemberAllowed(uplink.Config, member) {
+					return fmt.Errorf("Cluster member %q isn't in any of the cluster groups of uplink network %q", memberName, uplinkNetworkName)
+				}
+			}
+
+			return nil
 		})
 		if err != nil {
-			return fmt.Errorf("Failed to load uplink network %q: %w", uplinkNetworkName, err)
+			return err
 		}
 
 		// Get project restricted routes.
-		projectRestrictedSubnets, err = n.projectRestrictedSubnets(p, uplinkNetworkName)
+		projectRestrictedSubnets, err = n.projectRestrictedSubnets(p, "restricted.networks.subnets", uplinkNetworkName)
 		if err != nil {
 			return err
 		}
@@ -759,38 +1303,212 @@ func (n *ovn) Validate(config map[string]string) error {
 		}
 	}
 
-	// Check Security ACLs exist.
-	if config["security.acls"] != "" {
-		err = acl.Exists(n.state, n.project, util.SplitNTrimSpace(config["security.acls"], ",", -1, true)...)
-		if err != nil {
-			return err
+	// Check the failover addresses fit the network.
+	failoverIPs := []net.IP{}
+	for _, failover := range n.failoverAddresses(config) {
+		if failover.address == nil {
+			return fmt.Errorf("Failover address %q requires %q to be set", failover.name, fmt.Sprintf("failover.%s.address", failover.name))
 		}
-	}
 
-	// Check that ipv6.l3only mode is used with ipvp.dhcp.stateful.
-	// As otherwise the router advertisements will configure an address using the subnet's mask.
-	if util.IsTrue(config["ipv6.l3only"]) && util.IsTrueOrEmpty(config["ipv6.dhcp"]) && util.IsFalseOrEmpty(config["ipv6.dhcp.stateful"]) {
-		return errors.New("The ipv6.dhcp.stateful setting must be enabled when using ipv6.l3only mode with ipv6.dhcp enabled")
-	}
+		if IPInSlice(failover.address, failoverIPs) {
+			return fmt.Errorf("Failover address %q is already in use by another failover address", failover.address.String())
+		}
 
-	// All tests below are related to the uplink network, skip if we don't have one.
-	if uplink == nil {
-		return nil
-	}
+		failoverIPs = append(failoverIPs, failover.address)
 
-	// If NAT disabled, parse the external subnets that are being requested.
-	var externalSubnets []*net.IPNet // Subnets to check for conflicts with other networks/NICs.
-	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
-		addressKey := fmt.Sprintf("%s.address", keyPrefix)
-		netSubnet := netSubnets[addressKey]
+		inSubnet := false
+		for addressKey, subnet := range netSubnets {
+			if !subnet.Contains(failover.address) {
+				continue
+			}
 
-		if util.IsFalseOrEmpty(config[fmt.Sprintf("%s.nat", keyPrefix)]) && netSubnet != nil {
-			// Add to list to check for conflicts.
-			externalSubnets = append(externalSubnets, netSubnet)
+			routerIP, _, _ := net.ParseCIDR(config[addressKey])
+			if failover.address.Equal(routerIP) || failover.address.Equal(subnet.IP) {
+				return fmt.Errorf("Failover address %q cannot be the network or router address", failover.address.String())
+			}
+
+			inSubnet = true
+		}
+
+		if !inSubnet {
+			return fmt.Errorf("Failover address %q isn't within the network's subnets", failover.address.String())
 		}
 	}
 
-	// Check SNAT addresses specified are allowed to be used based on uplink's ovn.ingress_mode setting.
+	// Check the NAT policies can be applied.
+	natPolicyDestinations := []*net.IPNet{}
+	for _, policy := range n.natPolicies(config) {
+		if policy.address == nil {
+			return fmt.Errorf("NAT policy %q requires %q to be set", policy.name, fmt.Sprintf("nat.policy.%s.address", policy.name))
+		}
+
+		if len(policy.destinations) == 0 {
+			return fmt.Errorf("NAT policy %q requires %q to be set", policy.name, fmt.Sprintf("nat.policy.%s.destinations", policy.name))
+		}
+
+		keyPrefix := "ipv6"
+		if policy.address.To4() != nil {
+			keyPrefix = "ipv4"
+		}
+
+		natKey := fmt.Sprintf("%s.nat", keyPrefix)
+		if util.IsFalseOrEmpty(config[natKey]) {
+			return fmt.Errorf("NAT policy %q requires %q to be enabled", policy.name, natKey)
+		}
+
+		for _, destination := range policy.destinations {
+			if (destination.IP.To4() == nil) != (policy.address.To4() == nil) {
+				return fmt.Errorf("NAT policy %q destination %q isn't of the same address family as its address", policy.name, destination.String())
+			}
+
+			for _, otherDestination := range natPolicyDestinations {
+				if SubnetContains(otherDestination, &destination) || SubnetContains(&destination, otherDestination) {
+					return fmt.Errorf("NAT policy %q destination %q overlaps with another NAT policy", policy.name, destination.String())
+				}
+			}
+
+			natPolicyDestinations = append(natPolicyDestinations, &destination)
+		}
+	}
+
+	// Check the NAT exclusions can be applied.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		excludeKey := fmt.Sprintf("%s.nat.exclude.destinations", keyPrefix)
+		if config[excludeKey] == "" {
+			continue
+		}
+
+		natKey := fmt.Sprintf("%s.nat", keyPrefix)
+		if util.IsFalseOrEmpty(config[natKey]) {
+			return fmt.Errorf("%q requires %q to be enabled", excludeKey, natKey)
+		}
+
+		for _, destination := range n.natExcludedDestinations(config, keyPrefix) {
+			for _, policyDestination := range natPolicyDestinations {
+				if SubnetContains(policyDestination, &destination) || SubnetContains(&destination, policyDestination) {
+					return fmt.Errorf("%q destination %q overlaps with a NAT policy", excludeKey, destination.String())
+				}
+			}
+		}
+	}
+
+	// Check Security ACLs exist.
+	if config["security.acls"] != "" {
+		err = acl.Exists(n.state, n.project, util.SplitNTrimSpace(config["security.acls"], ",", -1, true)...)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check the networks whose DNS records are forwarded.
+	domainName := config["dns.domain"]
+	if domainName == "" {
+		domainName = "incus"
+	}
+
+	for _, forwardNetwork := range util.SplitNTrimSpace(config["dns.forward.networks"], ",", -1, true) {
+		if forwardNetwork == n.name {
+			return fmt.Errorf("Network %q can't forward its own DNS records", n.name)
+		}
+
+		forwardNet, err := LoadByName(n.state, n.project, forwardNetwork)
+		if err != nil {
+			return fmt.Errorf("Failed loading network %q: %w", forwardNetwork, err)
+		}
+
+		if forwardNet.Type() != "ovn" {
+			return fmt.Errorf("Network %q isn't an OVN network", forwardNetwork)
+		}
+
+		if forwardNet.Config()["dns.domain"] == "" || forwardNet.Config()["dns.domain"] == domainName {
+			return fmt.Errorf("Network %q must have a %q different from %q to be forwarded", forwardNetwork, "dns.domain", domainName)
+		}
+	}
+
+	// Check the DNS domains are allowed by the project.
+	err = DNSDomainsAllowed(p.Config, config)
+	if err != nil {
+		return err
+	}
+
+	// Replication connects to another cluster, so it's limited to unrestricted projects and requires the
+	// standby cluster's certificate to be pinned.
+	if config["replication.target.address"] != "" {
+		if util.IsTrue(p.Config["restricted"]) {
+			return api.StatusErrorf(http.StatusForbidden, "Network replication can't be used in restricted projects")
+		}
+
+		if config["replication.target.certificate"] == "" {
+			return fmt.Errorf("%q must be set when using %q", "replication.target.certificate", "replication.target.address")
+		}
+	}
+
+	// Check that ipv6.l3only mode is used with ipvp.dhcp.stateful.
+	// As otherwise the router advertisements will configure an address using the subnet's mask.
+	if util.IsTrue(config["ipv6.l3only"]) && util.IsTrueOrEmpty(config["ipv6.dhcp"]) && util.IsFalseOrEmpty(config["ipv6.dhcp.stateful"]) {
+		return errors.New("The ipv6.dhcp.stateful setting must be enabled when using ipv6.l3only mode with ipv6.dhcp enabled")
+	}
+
+	// Check the services subnets are within the network's subnets and don't overlap with dynamic allocations.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		servicesKey := fmt.Sprintf("%s.services", keyPrefix)
+		if config[servicesKey] == "" {
+			continue
+		}
+
+		_, servicesNet, err := net.ParseCIDR(config[servicesKey])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %q: %w", servicesKey, err)
+		}
+
+		addressKey := fmt.Sprintf("%s.address", keyPrefix)
+		netSubnet := netSubnets[addressKey]
+		if netSubnet == nil {
+			return fmt.Errorf("%q requires %q to be set", servicesKey, addressKey)
+		}
+
+		if !SubnetContains(netSubnet, servicesNet) {
+			return fmt.Errorf("%q must be within the network subnet %q", servicesKey, netSubnet.String())
+		}
+
+		netIP, _, _ := net.ParseCIDR(config[addressKey])
+		if servicesNet.Contains(netIP) {
+			return fmt.Errorf("%q cannot include the network address %q", servicesKey, netIP.String())
+		}
+
+		if keyPrefix == "ipv4" && config["ipv4.dhcp.ranges"] != "" {
+			dhcpRanges, err := parseIPRanges(config["ipv4.dhcp.ranges"], netSubnet)
+			if err != nil {
+				return err
+			}
+
+			servicesRange := &iprange.Range{Start: servicesNet.IP, End: dhcpalloc.GetIP(servicesNet, -1)}
+			for _, dhcpRange := range dhcpRanges {
+				if IPRangesOverlap(dhcpRange, servicesRange) || IPRangesOverlap(servicesRange, dhcpRange) {
+					return fmt.Errorf("%q cannot overlap with %q", servicesKey, "ipv4.dhcp.ranges")
+				}
+			}
+		}
+	}
+
+	// All tests below are related to the uplink network, skip if we don't have one.
+	if uplink == nil {
+		return nil
+	}
+
+	// If NAT disabled, parse the external subnets that are being requested.
+	var externalSubnets []*net.IPNet // Subnets to check for conflicts with other networks/NICs.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		addressKey := fmt.Sprintf("%s.address", keyPrefix)
+		netSubnet := netSubnets[addressKey]
+
+		if util.IsFalseOrEmpty(config[fmt.Sprintf("%s.nat", keyPrefix)]) && netSubnet != nil {
+			// Add to list to check for conflicts.
+			externalSubnets = append(externalSubnets, netSubnet)
+		}
+	}
+
+	// Check SNAT addresses specified are allowed to be used based on uplink's ovn.ingress_mode setting.
 	var externalSNATSubnets []*net.IPNet // Subnets to check for conflicts with other networks/NICs.
 	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
 		snatAddressKey := fmt.Sprintf("%s.nat.address", keyPrefix)
@@ -814,8 +1532,26 @@ func (n *ovn) Validate(config map[string]string) error {
 		}
 	}
 
+	for _, policy := range n.natPolicies(config) {
+		if uplink.Config["ovn.ingress_mode"] != "routed" {
+			return fmt.Errorf(`Cannot specify %q when uplink ovn.ingress_mode is not "routed"`, fmt.Sprintf("nat.policy.%s.address", policy.name))
+		}
+
+		snatIPNet := IPToNet(policy.address)
+
+		// Add to list to check for conflicts.
+		externalSNATSubnets = append(externalSNATSubnets, &snatIPNet)
+	}
+
+	// Unnumbered uplinks don't provide an address to SNAT outbound traffic to.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		if util.IsTrue(uplink.Config[fmt.Sprintf("%s.ovn.unnumbered", keyPrefix)]) && util.IsTrue(config[fmt.Sprintf("%s.nat", keyPrefix)]) && config[fmt.Sprintf("%s.nat.address", keyPrefix)] == "" {
+			return fmt.Errorf(`%q must be set when %q is enabled and the uplink is unnumbered`, fmt.Sprintf("%s.nat.address", keyPrefix), fmt.Sprintf("%s.nat", keyPrefix))
+		}
+	}
+
 	if len(externalSubnets) > 0 || len(externalSNATSubnets) > 0 {
-		externalSubnetsInUse, err := n.getExternalSubnetInUse(config["network"])
+		externalSubnetsInUse, err := n.getExternalSubnetInUse(ctx, config["network"])
 		if err != nil {
 			return err
 		}
@@ -893,7 +1629,7 @@ func (n *ovn) Validate(config map[string]string) error {
 
 	// Check any existing network forward target addresses are suitable for this network's subnet.
 	var forwards map[int64]*api.NetworkForward
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		networkID := n.ID()
 		dbRecords, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
 			NetworkID: &networkID,
@@ -919,8 +1655,12 @@ func (n *ovn) Validate(config map[string]string) error {
 	}
 
 	for _, forward := range forwards {
-		if forward.Config["target_address"] != "" {
-			defaultTargetIP := net.ParseIP(forward.Config["target_address"])
+		for _, key := range []string{"target_address", "target_address.ipv4", "target_address.ipv6"} {
+			if forward.Config[key] == "" {
+				continue
+			}
+
+			defaultTargetIP := net.ParseIP(forward.Config[key])
 
 			netSubnet := netSubnets["ipv4.address"]
 			if defaultTargetIP.To4() == nil {
@@ -933,6 +1673,10 @@ func (n *ovn) Validate(config map[string]string) error {
 		}
 
 		for _, port := range forward.Ports {
+			if port.TargetGroup != "" {
+				continue
+			}
+
 			targetIP := net.ParseIP(port.TargetAddress)
 
 			netSubnet := netSubnets["ipv4.address"]
@@ -947,7 +1691,7 @@ func (n *ovn) Validate(config map[string]string) error {
 	}
 
 	var dbLoadBalancers []dbCluster.NetworkLoadBalancer
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		networkID := n.ID()
 
 		// Get the load balancers.
@@ -966,6 +1710,10 @@ func (n *ovn) Validate(config map[string]string) error {
 
 	for _, dbLoadBalancer := range dbLoadBalancers {
 		for _, port := range dbLoadBalancer.Backends {
+			if port.TargetGroup != "" {
+				continue
+			}
+
 			targetIP := net.ParseIP(port.TargetAddress)
 
 			netSubnet := netSubnets["ipv4.address"]
@@ -982,6 +1730,191 @@ func (n *ovn) Validate(config map[string]string) error {
 	return nil
 }
 
+// RemapTargets moves the target addresses of the network's forwards and load balancers to the same host offset
+// within the subnets of the new config. This allows changing the network's subnets without first having to
+// retarget each forward and load balancer individually. Returns a revert hook restoring the original targets.
+func (n *ovn) RemapTargets(ctx context.Context, newConfig map[string]string) (revert.Hook, error) {
+	// Find the subnets that are being moved.
+	type subnetMove struct {
+		oldSubnet *net.IPNet
+		newSubnet *net.IPNet
+	}
+
+	moves := make(map[string]subnetMove)
+	for _, key := range []string{"ipv4.address", "ipv6.address"} {
+		if n.config[key] == newConfig[key] {
+			continue
+		}
+
+		_, oldSubnet, err := net.ParseCIDR(n.config[key])
+		if err != nil {
+			continue
+		}
+
+		_, newSubnet, err := net.ParseCIDR(newConfig[key])
+		if err != nil {
+			continue
+		}
+
+		moves[key] = subnetMove{oldSubnet: oldSubnet, newSubnet: newSubnet}
+	}
+
+	if len(moves) == 0 {
+		return func() {}, nil
+	}
+
+	remapAddress := func(address string) (string, error) {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return address, nil
+		}
+
+		key := "ipv4.address"
+		if ip.To4() == nil {
+			key = "ipv6.address"
+		}
+
+		move, ok := moves[key]
+		if !ok || !move.oldSubnet.Contains(ip) {
+			return address, nil
+		}
+
+		newIP, err := subnetRemapIP(ip, move.oldSubnet, move.newSubnet)
+		if err != nil {
+			return "", err
+		}
+
+		return newIP.String(), nil
+	}
+
+	// Serialize with the forward and load balancer operations on the network.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlock()
+
+	var oldForwards []dbCluster.NetworkForward
+	var oldForwardConfigs map[int64]map[string]string
+	var oldLoadBalancers []dbCluster.NetworkLoadBalancer
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		oldForwards, err = dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed loading network forwards: %w", err)
+		}
+
+		oldForwardConfigs = make(map[int64]map[string]string, len(oldForwards))
+		for _, oldForward := range oldForwards {
+			forward, err := oldForward.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			oldForwardConfigs[oldForward.ID] = forward.Config
+
+			newForward := oldForward
+			newForward.Ports = make([]api.NetworkForwardPort, 0, len(oldForward.Ports))
+			for _, port := range oldForward.Ports {
+				port.TargetAddress, err = remapAddress(port.TargetAddress)
+				if err != nil {
+					return api.StatusErrorf(http.StatusBadRequest, "Failed remapping port target address of network forward for %q: %w", oldForward.ListenAddress, err)
+				}
+
+				newForward.Ports = append(newForward.Ports, port)
+			}
+
+			newConfig := maps.Clone(forward.Config)
+			for _, key := range []string{"target_address", "target_address.ipv4", "target_address.ipv6"} {
+				if newConfig[key] == "" {
+					continue
+				}
+
+				newConfig[key], err = remapAddress(newConfig[key])
+				if err != nil {
+					return api.StatusErrorf(http.StatusBadRequest, "Failed remapping default target address of network forward for %q: %w", oldForward.ListenAddress, err)
+				}
+			}
+
+			err = dbCluster.UpdateNetworkForward(ctx, tx.Tx(), networkID, oldForward.ListenAddress, newForward)
+			if err != nil {
+				return err
+			}
+
+			err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), oldForward.ID, newConfig)
+			if err != nil {
+				return err
+			}
+		}
+
+		oldLoadBalancers, err = dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancers: %w", err)
+		}
+
+		for _, oldLoadBalancer := range oldLoadBalancers {
+			newLoadBalancer := oldLoadBalancer
+			newLoadBalancer.Backends = make([]api.NetworkLoadBalancerBackend, 0, len(oldLoadBalancer.Backends))
+			for _, backend := range oldLoadBalancer.Backends {
+				backend.TargetAddress, err = remapAddress(backend.TargetAddress)
+				if err != nil {
+					return api.StatusErrorf(http.StatusBadRequest, "Failed remapping backend %q of network load balancer for %q: %w", backend.Name, oldLoadBalancer.ListenAddress, err)
+				}
+
+				newLoadBalancer.Backends = append(newLoadBalancer.Backends, backend)
+			}
+
+			err = dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), networkID, oldLoadBalancer.ListenAddress, newLoadBalancer)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			for _, oldForward := range oldForwards {
+				err := dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), oldForward.ListenAddress, oldForward)
+				if err != nil {
+					return err
+				}
+
+				err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), oldForward.ID, oldForwardConfigs[oldForward.ID])
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, oldLoadBalancer := range oldLoadBalancers {
+				err := dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), oldLoadBalancer.ListenAddress, oldLoadBalancer)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			n.logger.Error("Failed restoring network forward and load balancer targets", logger.Ctx{"err": err})
+		}
+	}, nil
+}
+
 // getBridgeMTU returns MTU that should be used for the bridge and instance devices.
 // Will also be used to configure the OVN DHCP and IPv6 RA options. Returns 0 if the bridge.mtu is not set/invalid.
 func (n *ovn) getBridgeMTU() uint32 {
@@ -997,8 +1930,8 @@ func (n *ovn) getBridgeMTU() uint32 {
 	return 0
 }
 
-// getUnderlayInfo returns the MTU for the underlay network interface and the enscapsulation IP for OVN tunnels.
-func (n *ovn) getUnderlayInfo() (uint32, net.IP, error) {
+// ovnUnderlayInfo returns the MTU for the underlay network interface and the enscapsulation IP for OVN tunnels.
+func ovnUnderlayInfo(ctx context.Context, s *state.State) (uint32, net.IP, error) {
 	// findMTUFromIP searches all interfaces on the host looking for one that has specified IP.
 	findMTUFromIP := func(findIP net.IP) (uint32, error) {
 		// Look for interface that has the OVN enscapsulation IP assigned.
@@ -1033,12 +1966,12 @@ func (n *ovn) getUnderlayInfo() (uint32, net.IP, error) {
 		return 0, fmt.Errorf("No matching interface found for OVN enscapsulation IP %q", findIP.String())
 	}
 
-	vswitch, err := n.state.OVS()
+	vswitch, err := s.OVS()
 	if err != nil {
 		return 0, nil, fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	encapIP, err := vswitch.GetOVNEncapIP(context.TODO())
+	encapIP, err := vswitch.GetOVNEncapIP(ctx)
 	if err != nil {
 		return 0, nil, fmt.Errorf("Failed getting OVN enscapsulation IP from OVS: %w", err)
 	}
@@ -1054,43 +1987,286 @@ func (n *ovn) getUnderlayInfo() (uint32, net.IP, error) {
 // getOptimalBridgeMTU returns the MTU that can be used for the bridge and instance devices based on the MTU value
 // of the OVN underlay network interface. This assumes that the OVN tunnel mechanism used is geneve and that the
 // same underlying network settings (MTU and encapsulation IP family) are used on all OVN nodes.
-func (n *ovn) getOptimalBridgeMTU() (uint32, error) {
-	// Get underlay MTU and encapsulation IP.
-	underlayMTU, encapIP, err := n.getUnderlayInfo()
+func (n *ovn) getOptimalBridgeMTU(ctx context.Context) (uint32, error) {
+	chassisID, err := n.localChassisID(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("Failed getting OVN underlay info: %w", err)
+		return 0, err
 	}
 
-	// Encapsulation family is IPv6.
-	if encapIP.To4() == nil {
-		// If the underlay's MTU is large enough to accommodate a 1500 overlay MTU and the geneve tunnel
-		// overhead of 78 bytes (when used with IPv6 encapsulation) then indicate 1500 MTU can be used.
-		if underlayMTU >= 1578 {
-			return 1500, nil
+	// Members without the OVN dataplane can't inspect the underlay, so use an MTU that works with a 1500 MTU
+	// underlay for every encapsulation registered by the chassis in the OVN southbound database.
+	if chassisID == "" {
+		encaps, err := n.ovnsb.GetChassisEncaps(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("Failed getting OVN chassis encapsulations: %w", err)
 		}
 
-		// Default to 1422 which can work with an underlay MTU of 1500.
-		return 1422, nil
+		bridgeMTU := ovnFallbackBridgeMTU(encaps)
+		n.logger.Warn("Local member doesn't run the OVN dataplane, using the default bridge MTU", logger.Ctx{"mtu": bridgeMTU})
+
+		return bridgeMTU, nil
+	}
+
+	// Get underlay MTU and encapsulation IP.
+	underlayMTU, encapIP, err := ovnUnderlayInfo(ctx, n.state)
+	if err != nil {
+		return 0, fmt.Errorf("Failed getting OVN underlay info: %w", err)
 	}
 
-	// If the underlay's MTU is large enough to accommodate a 1500 overlay MTU and the geneve tunnel
-	// overhead of 58 bytes (when used with IPv4 encapsulation) then indicate 1500 MTU can be used.
-	if underlayMTU >= 1558 {
+	// If the underlay's MTU is large enough to accommodate a 1500 overlay MTU and the geneve tunnel overhead
+	// then indicate 1500 MTU can be used. Otherwise default to an MTU which can work with an underlay MTU of 1500
+	// (1442 with IPv4 encapsulation and 1422 with IPv6 encapsulation).
+	overhead := ovnGeneveOverhead(encapIP)
+	if underlayMTU >= 1500+overhead {
 		return 1500, nil
 	}
 
-	// Default to 1442 which can work with underlay MTU of 1500.
-	return 1442, nil
+	return 1500 - overhead, nil
 }
 
-// getNetworkPrefix returns OVN network prefix to use for object names.
-func (n *ovn) getNetworkPrefix() string {
-	return acl.OVNNetworkPrefix(n.id)
-}
+// ovnGeneveOverhead returns the geneve tunnel overhead for the family of the OVN encapsulation IP.
+func ovnGeneveOverhead(encapIP net.IP) uint32 {
+	if encapIP.To4() == nil {
+		return 78
+	}
 
-// getChassisGroup returns OVN chassis group name to use.
-func (n *ovn) getChassisGroupName() networkOVN.OVNChassisGroup {
-	return networkOVN.OVNChassisGroup(n.getNetworkPrefix())
+	return 58
+}
+
+// ovnEncapOverhead returns the tunnel overhead of an OVN chassis encapsulation.
+func ovnEncapOverhead(encap ovnSB.Encap) uint32 {
+	var overhead uint32
+
+	switch encap.Type {
+	case ovnSB.EncapTypeVxlan:
+		overhead = 50
+	case ovnSB.EncapTypeSTT:
+		overhead = 72
+	default:
+		overhead = 58
+	}
+
+	// IPv6 encapsulation has a 20 bytes larger outer header.
+	encapIP := net.ParseIP(encap.IP)
+	if encapIP == nil || encapIP.To4() == nil {
+		overhead += 20
+	}
+
+	return overhead
+}
+
+// ovnFallbackBridgeMTU returns the bridge MTU which can be carried by a 1500 MTU underlay with all the given
+// encapsulations. Without any encapsulation, the worst case of geneve over IPv6 is assumed.
+func ovnFallbackBridgeMTU(encaps []ovnSB.Encap) uint32 {
+	overhead := ovnGeneveOverhead(net.IPv6loopback)
+	if len(encaps) > 0 {
+		overhead = 0
+		for _, encap := range encaps {
+			overhead = max(overhead, ovnEncapOverhead(encap))
+		}
+	}
+
+	return 1500 - overhead
+}
+
+// OVNUnderlay represents the OVN tunnel underlay of a cluster member.
+type OVNUnderlay struct {
+	Member       string `json:"member"`
+	MTU          uint32 `json:"mtu"`
+	MaxBridgeMTU uint32 `json:"max_bridge_mtu"`
+}
+
+// OVNLocalUnderlay returns the OVN tunnel underlay of the local member along with the largest bridge MTU it can
+// carry without fragmentation. Returns nil if the local member doesn't run the OVN dataplane.
+func OVNLocalUnderlay(ctx context.Context, s *state.State) (*OVNUnderlay, error) {
+	chassisID, err := ovnLocalChassisID(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if chassisID == "" {
+		return nil, nil
+	}
+
+	underlayMTU, encapIP, err := ovnUnderlayInfo(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN underlay info: %w", err)
+	}
+
+	return &OVNUnderlay{
+		Member:       s.ServerName,
+		MTU:          underlayMTU,
+		MaxBridgeMTU: underlayMTU - ovnGeneveOverhead(encapIP),
+	}, nil
+}
+
+// validateBridgeMTU checks that the OVN underlay of every reachable cluster member can carry the bridge MTU, so
+// that traffic between instances on different chassis isn't silently fragmented.
+func (n *ovn) validateBridgeMTU(ctx context.Context, bridgeMTU uint32) error {
+	underlays := []OVNUnderlay{}
+
+	localUnderlay, err := OVNLocalUnderlay(ctx, n.state)
+	if err != nil {
+		return err
+	}
+
+	if localUnderlay != nil {
+		underlays = append(underlays, *localUnderlay)
+	}
+
+	notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAlive)
+	if err != nil {
+		return err
+	}
+
+	var underlaysMu sync.Mutex
+	err = notifier(func(client incus.InstanceServer) error {
+		resp, _, err := client.RawQuery("GET", "/internal/ovn/underlay", nil, "")
+		if err != nil {
+			// Skip members which don't report their underlay yet.
+			if api.StatusErrorCheck(err, http.StatusNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		var underlay *OVNUnderlay
+		err = resp.MetadataAsStruct(&underlay)
+		if err != nil {
+			return err
+		}
+
+		if underlay != nil {
+			underlaysMu.Lock()
+			underlays = append(underlays, *underlay)
+			underlaysMu.Unlock()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed getting the OVN underlay of cluster members: %w", err)
+	}
+
+	tooSmall := []string{}
+	for _, underlay := range underlays {
+		if underlay.MaxBridgeMTU < bridgeMTU {
+			tooSmall = append(tooSmall, fmt.Sprintf("%s (underlay MTU %d, maximum bridge MTU %d)", underlay.Member, underlay.MTU, underlay.MaxBridgeMTU))
+		}
+	}
+
+	if len(tooSmall) > 0 {
+		slices.Sort(tooSmall)
+		return api.StatusErrorf(http.StatusBadRequest, "Bridge MTU %d can't be carried by the OVN underlay of: %s", bridgeMTU, strings.Join(tooSmall, ", "))
+	}
+
+	return nil
+}
+
+// ovnStatusError maps the typed OVN northbound errors to API status errors.
+// This lets clients tell transient failures which can be retried apart from permanent ones.
+func ovnStatusError(err error) error {
+	var status int
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, networkOVN.ErrConnectionDown):
+		status = http.StatusServiceUnavailable
+	case errors.Is(err, networkOVN.ErrQuotaExceeded):
+		status = http.StatusInsufficientStorage
+	case errors.Is(err, networkOVN.ErrDuplicate):
+		status = http.StatusConflict
+	case errors.Is(err, networkOVN.ErrConstraintViolation):
+		status = http.StatusBadRequest
+	case errors.Is(err, networkOVN.ErrSchemaUnsupported):
+		status = http.StatusNotImplemented
+	case errors.Is(err, context.DeadlineExceeded):
+		status = http.StatusGatewayTimeout
+	default:
+		return err
+	}
+
+	// Don't override an already mapped error.
+	if api.StatusErrorCheck(err) {
+		return err
+	}
+
+	return ovnStatusErr{status: api.StatusErrorf(status, "%v", err), err: err}
+}
+
+// ovnStatusErr is an API status error which keeps the OVN error it was derived from in its chain.
+type ovnStatusErr struct {
+	status api.StatusError
+	err    error
+}
+
+func (e ovnStatusErr) Error() string {
+	return e.status.Error()
+}
+
+func (e ovnStatusErr) Unwrap() error {
+	return e.err
+}
+
+// As allows matching the error as an api.StatusError.
+func (e ovnStatusErr) As(target any) bool {
+	statusErr, ok := target.(*api.StatusError)
+	if !ok {
+		return false
+	}
+
+	*statusErr = e.status
+
+	return true
+}
+
+// ovnRevertContext returns a context for reverting changes made as part of an operation using ctx.
+// It doesn't get cancelled along with ctx, so changes can still be reverted once the operation timed out,
+// but is bounded by its own timeout.
+func ovnRevertContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), ovnOperationTimeout)
+}
+
+// securityACLs returns the network's security ACLs merged with the project's default network ACLs.
+func (n *ovn) securityACLs(ctx context.Context, config map[string]string) ([]string, error) {
+	projectConfig, err := n.getProjectConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading project default network ACLs: %w", err)
+	}
+
+	return acl.NetworkACLNames(projectConfig, n.Type(), config), nil
+}
+
+// getNetworkPrefix returns OVN network prefix to use for object names.
+func (n *ovn) getNetworkPrefix() string {
+	return acl.OVNNetworkPrefix(n.id)
+}
+
+// getChassisGroup returns OVN chassis group name to use.
+func (n *ovn) getChassisGroupName() networkOVN.OVNChassisGroup {
+	return networkOVN.OVNChassisGroup(n.getNetworkPrefix())
+}
+
+// dynamicAddressTimeout returns how long to wait for OVN to allocate the dynamic addresses of a port.
+func (n *ovn) dynamicAddressTimeout() time.Duration {
+	timeout, err := strconv.Atoi(n.config["dynamic_addresses.timeout"])
+	if err != nil || timeout <= 0 {
+		return ovnDynamicAddressTimeout
+	}
+
+	return time.Duration(timeout) * time.Second
+}
+
+// readinessTimeout returns how long instance NIC starts wait for the network to be ready.
+func (n *ovn) readinessTimeout() time.Duration {
+	timeout, err := strconv.Atoi(n.config["readiness.timeout"])
+	if err != nil || timeout < 0 {
+		return ovnReadinessTimeout
+	}
+
+	return time.Duration(timeout) * time.Second
 }
 
 // getRouterName returns OVN logical router name to use.
@@ -1109,8 +2285,13 @@ func (n *ovn) getRouterIntPortName() networkOVN.OVNRouterPort {
 }
 
 // getRouterMAC returns OVN router MAC address to use for ports. Uses a stable seed to return stable random MAC.
-func (n *ovn) getRouterMAC() (net.HardwareAddr, error) {
+func (n *ovn) getRouterMAC(ctx context.Context) (net.HardwareAddr, error) {
 	hwAddr := n.config["bridge.hwaddr"]
+	if hwAddr == "" {
+		// Use the MAC recorded when the network was first set up so it survives certificate changes.
+		hwAddr = n.config[ovnVolatileRouterHwaddr]
+	}
+
 	if hwAddr == "" {
 		// Load server certificate. This is needs to be the same certificate for all nodes in a cluster.
 		cert, err := internalUtil.LoadCert(n.state.OS.VarDir)
@@ -1123,13 +2304,39 @@ func (n *ovn) getRouterMAC() (net.HardwareAddr, error) {
 		// It relies on the certificate being the same for all nodes in a cluster to allow the same MAC to
 		// be generated on each bridge interface in the network.
 		seed := fmt.Sprintf("%s.%d.%d", cert.Fingerprint(), 0, n.ID())
-		r, err := localUtil.GetStableRandomGenerator(seed)
+
+		// Skip over MAC addresses already used by other networks or instances of the cluster.
+		var usedHwaddrs []string
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			usedHwaddrs, err = tx.GetHwaddrsInUse(ctx)
+
+			return err
+		})
 		if err != nil {
-			return nil, fmt.Errorf("Failed generating stable random router MAC: %w", err)
+			return nil, fmt.Errorf("Failed getting MAC addresses in use: %w", err)
 		}
 
-		hwAddr = randomHwaddr(r)
-		n.logger.Debug("Stable MAC generated", logger.Ctx{"seed": seed, "hwAddr": hwAddr})
+		for attempt := 0; ; attempt++ {
+			if attempt >= 100 {
+				return nil, errors.New("Failed generating an unused router MAC address")
+			}
+
+			attemptSeed := seed
+			if attempt > 0 {
+				attemptSeed = fmt.Sprintf("%s.%d", seed, attempt)
+			}
+
+			r, err := localUtil.GetStableRandomGenerator(attemptSeed)
+			if err != nil {
+				return nil, fmt.Errorf("Failed generating stable random router MAC: %w", err)
+			}
+
+			hwAddr = randomHwaddr(n.state, r)
+			if !slices.ContainsFunc(usedHwaddrs, func(usedHwaddr string) bool { return strings.EqualFold(usedHwaddr, hwAddr) }) {
+				n.logger.Debug("Stable MAC generated", logger.Ctx{"seed": attemptSeed, "hwAddr": hwAddr})
+				break
+			}
+		}
 	}
 
 	mac, err := net.ParseMAC(hwAddr)
@@ -1194,7 +2401,13 @@ func (n *ovn) getDomainName() string {
 // getDNSSearchList returns OVN DHCP DNS search list. If no search list set returns getDomainName() as list.
 func (n *ovn) getDNSSearchList() []string {
 	if n.config["dns.search"] != "" {
-		return util.SplitNTrimSpace(n.config["dns.search"], ",", -1, false)
+		searchList := util.SplitNTrimSpace(n.config["dns.search"], ",", -1, false)
+		for i, domain := range searchList {
+			// Trailing dots would be encoded as an empty label in the DHCPv4 domain search option.
+			searchList[i] = strings.TrimSuffix(domain, ".")
+		}
+
+		return searchList
 	}
 
 	return []string{n.getDomainName()}
@@ -1230,11 +2443,189 @@ func (n *ovn) getIntSwitchInstancePortPrefix() string {
 	return fmt.Sprintf("%s-instance", n.getNetworkPrefix())
 }
 
+// getExternalInterfacePortName returns OVN logical internal switch port name for a local external interface.
+func (n *ovn) getExternalInterfacePortName(ifName string) networkOVN.OVNSwitchPort {
+	return networkOVN.OVNSwitchPort(fmt.Sprintf("%s-external-n%d-%s", n.getNetworkPrefix(), n.state.DB.Cluster.GetNodeID(), ifName))
+}
+
 // getLoadBalancerName returns OVN load balancer name to use for a listen address.
 func (n *ovn) getLoadBalancerName(listenAddress string) networkOVN.OVNLoadBalancer {
 	return networkOVN.OVNLoadBalancer(fmt.Sprintf("%s-lb-%s", n.getNetworkPrefix(), listenAddress))
 }
 
+// loadBalancerExists returns whether any OVN load balancer exists for the listen address.
+func (n *ovn) loadBalancerExists(ctx context.Context, listenAddress string) (bool, error) {
+	for _, protocol := range []string{"tcp", "udp"} {
+		lbName := networkOVN.OVNLoadBalancer(fmt.Sprintf("%s-%s", n.getLoadBalancerName(listenAddress), protocol))
+
+		_, err := n.ovnnb.GetLoadBalancer(ctx, lbName)
+		if err == nil {
+			return true, nil
+		}
+
+		if !errors.Is(err, networkOVN.ErrNotFound) {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// loadBalancerSwitchAttach returns whether a forward or load balancer should also be applied on the internal switch.
+// This is what allows instances on the network to reach it through its listen address (NAT hairpinning), and is
+// always needed for internal listen addresses as their traffic never reaches the router.
+func (n *ovn) loadBalancerSwitchAttach(config map[string]string) bool {
+	return util.IsTrueOrEmpty(n.config["nat.hairpin"]) || listenScope(config) == listenScopeInternal
+}
+
+// listenAddressDisabled returns whether a forward or load balancer can't currently be applied because the network
+// has no uplink. Only internal listen addresses can be served on an isolated network.
+func (n *ovn) listenAddressDisabled(config map[string]string) bool {
+	return n.config["network"] == "none" && listenScope(config) != listenScopeInternal
+}
+
+// ListenAddressStatus returns whether a forward or load balancer with the given config is applied on the network.
+func (n *ovn) ListenAddressStatus(config map[string]string) string {
+	if n.listenAddressDisabled(config) {
+		return api.NetworkListenAddressStatusDisabled
+	}
+
+	return api.NetworkListenAddressStatusActive
+}
+
+// TargetGroupsRefresh re-applies the forwards and load balancers of the network so that changes to the target
+// groups they reference take effect.
+func (n *ovn) TargetGroupsRefresh(ctx context.Context) error {
+	return n.loadBalancersRefresh(ctx)
+}
+
+// loadBalancersRefresh re-applies the OVN load balancers for all of the network's forwards and load balancers.
+// Those which are disabled because the network has no uplink have their OVN load balancer removed instead.
+func (n *ovn) loadBalancersRefresh(ctx context.Context) error {
+	// Serialize with the forward and load balancer operations on the network.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	var forwards []*api.NetworkForward
+	var loadBalancers []*api.NetworkLoadBalancer
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		dbForwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbForward := range dbForwards {
+			forward, err := dbForward.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			forwards = append(forwards, forward)
+		}
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			loadBalancer, err := dbLoadBalancer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			loadBalancers = append(loadBalancers, loadBalancer)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading network forwards and load balancers: %w", err)
+	}
+
+	for _, forward := range forwards {
+		if n.listenAddressDisabled(forward.Config) {
+			err = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(forward.ListenAddress))
+			if err != nil {
+				return fmt.Errorf("Failed removing OVN load balancer for disabled network forward %q: %w", forward.ListenAddress, err)
+			}
+
+			continue
+		}
+
+		portMaps, err := n.forwardValidate(ctx, net.ParseIP(forward.ListenAddress), &forward.NetworkForwardPut)
+		if err != nil {
+			return fmt.Errorf("Failed validating network forward %q: %w", forward.ListenAddress, err)
+		}
+
+		listenIP := net.ParseIP(forward.ListenAddress)
+		vips, err := n.forwardFlattenVIPs(ctx, listenIP, forwardDefaultTargetAddress(listenIP, forward.Config), portMaps)
+		if err != nil {
+			return err
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(forward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(forward.Config), vips...)
+		if err != nil {
+			return fmt.Errorf("Failed applying OVN load balancer for network forward %q: %w", forward.ListenAddress, err)
+		}
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		if n.listenAddressDisabled(loadBalancer.Config) {
+			err = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(loadBalancer.ListenAddress))
+			if err != nil {
+				return fmt.Errorf("Failed removing OVN load balancer for disabled network load balancer %q: %w", loadBalancer.ListenAddress, err)
+			}
+
+			continue
+		}
+
+		portMaps, err := n.loadBalancerValidate(ctx, net.ParseIP(loadBalancer.ListenAddress), &loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return fmt.Errorf("Failed validating network load balancer %q: %w", loadBalancer.ListenAddress, err)
+		}
+
+		vips, err := n.loadBalancerFlattenVIPs(ctx, net.ParseIP(loadBalancer.ListenAddress), portMaps)
+		if err != nil {
+			return err
+		}
+
+		healthCheck, err := n.getHealthCheck(loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return err
+		}
+
+		if healthCheck != nil {
+			for i := range vips {
+				vips[i].HealthCheck = healthCheck
+			}
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(loadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(loadBalancer.Config), vips...)
+		if err != nil {
+			return fmt.Errorf("Failed applying OVN load balancer for network load balancer %q: %w", loadBalancer.ListenAddress, err)
+		}
+
+		err = n.loadBalancerShare(ctx, loadBalancer.ListenAddress, loadBalancer.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getLogicalRouterPeerPortName returns OVN logical router port name to use for a peer connection.
 func (n *ovn) getLogicalRouterPeerPortName(peerNetworkID int64) networkOVN.OVNRouterPort {
 	return networkOVN.OVNRouterPort(fmt.Sprintf("%s-lrp-peer-net%d", n.getRouterName(), peerNetworkID))
@@ -1242,7 +2633,7 @@ func (n *ovn) getLogicalRouterPeerPortName(peerNetworkID int64) networkOVN.OVNRo
 
 // setupUplinkPort initializes the uplink connection. Returns the derived ovnUplinkVars settings used
 // during the initial creation of the logical network.
-func (n *ovn) setupUplinkPort(routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
+func (n *ovn) setupUplinkPort(ctx context.Context, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
 	if n.config["network"] == "none" {
 		return nil, nil
 	}
@@ -1255,9 +2646,9 @@ func (n *ovn) setupUplinkPort(routerMAC net.HardwareAddr) (*ovnUplinkVars, error
 
 	switch uplinkNet.Type() {
 	case "bridge":
-		return n.setupUplinkPortBridge(uplinkNet, routerMAC)
+		return n.setupUplinkPortBridge(ctx, uplinkNet, routerMAC)
 	case "physical":
-		return n.setupUplinkPortPhysical(uplinkNet, routerMAC)
+		return n.setupUplinkPortPhysical(ctx, uplinkNet, routerMAC)
 	}
 
 	return nil, fmt.Errorf("Failed setting up uplink port, network type %q unsupported as OVN uplink", uplinkNet.Type())
@@ -1265,7 +2656,7 @@ func (n *ovn) setupUplinkPort(routerMAC net.HardwareAddr) (*ovnUplinkVars, error
 
 // setupUplinkPortBridge allocates external IPs on the uplink bridge.
 // Returns the derived ovnUplinkVars settings.
-func (n *ovn) setupUplinkPortBridge(uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
+func (n *ovn) setupUplinkPortBridge(ctx context.Context, uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
 	bridgeNet, ok := uplinkNet.(*bridge)
 	if !ok {
 		return nil, errors.New("Network is not bridge type")
@@ -1276,7 +2667,7 @@ func (n *ovn) setupUplinkPortBridge(uplinkNet Network, routerMAC net.HardwareAdd
 		return nil, fmt.Errorf("Network %q is not suitable for use as OVN uplink: %w", bridgeNet.name, err)
 	}
 
-	v, err := n.allocateUplinkPortIPs(uplinkNet, routerMAC)
+	v, err := n.allocateUplinkPortIPs(ctx, uplinkNet, routerMAC)
 	if err != nil {
 		return nil, fmt.Errorf("Failed allocating uplink port IPs on network %q: %w", uplinkNet.Name(), err)
 	}
@@ -1286,8 +2677,8 @@ func (n *ovn) setupUplinkPortBridge(uplinkNet Network, routerMAC net.HardwareAdd
 
 // setupUplinkPortPhysical allocates external IPs on the uplink network.
 // Returns the derived ovnUplinkVars settings.
-func (n *ovn) setupUplinkPortPhysical(uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
-	v, err := n.allocateUplinkPortIPs(uplinkNet, routerMAC)
+func (n *ovn) setupUplinkPortPhysical(ctx context.Context, uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
+	v, err := n.allocateUplinkPortIPs(ctx, uplinkNet, routerMAC)
 	if err != nil {
 		return nil, fmt.Errorf("Failed allocating uplink port IPs on network %q: %w", uplinkNet.Name(), err)
 	}
@@ -1297,7 +2688,7 @@ func (n *ovn) setupUplinkPortPhysical(uplinkNet Network, routerMAC net.HardwareA
 
 // allocateUplinkPortIPs attempts to find a free IP in the uplink network's OVN ranges and then stores it in
 // ovnVolatileUplinkIPv4 and ovnVolatileUplinkIPv6 config keys on this network. Returns ovnUplinkVars settings.
-func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
+func (n *ovn) allocateUplinkPortIPs(ctx context.Context, uplinkNet Network, routerMAC net.HardwareAddr) (*ovnUplinkVars, error) {
 	v := &ovnUplinkVars{}
 
 	uplinkNetConf := uplinkNet.Config()
@@ -1329,6 +2720,10 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 		v.routerExtGwIPv6 = uplinkIPv6
 	}
 
+	// Unnumbered uplinks don't provide addresses for child routers, those only get a link-local address.
+	ipv4Unnumbered := util.IsTrue(uplinkNetConf["ipv4.ovn.unnumbered"])
+	ipv6Unnumbered := util.IsTrue(uplinkNetConf["ipv6.ovn.unnumbered"])
+
 	// Detect optional DNS server list.
 	if uplinkNetConf["dns.nameservers"] != "" {
 		// Reset nameservers.
@@ -1354,39 +2749,90 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 	routerExtPortIPv4 := net.ParseIP(n.config[ovnVolatileUplinkIPv4])
 	routerExtPortIPv6 := net.ParseIP(n.config[ovnVolatileUplinkIPv6])
 
+	// Discard existing allocations which don't match the uplink's addressing mode so they get replaced.
+	staleAddress := func(ip net.IP, unnumbered bool, uplinkSubnet *net.IPNet) bool {
+		if unnumbered {
+			return !ip.IsLinkLocalUnicast()
+		}
+
+		return ip.IsLinkLocalUnicast() && (uplinkSubnet == nil || !uplinkSubnet.Contains(ip))
+	}
+
+	if routerExtPortIPv4 != nil && staleAddress(routerExtPortIPv4, ipv4Unnumbered, uplinkIPv4Net) {
+		routerExtPortIPv4 = nil
+	}
+
+	if routerExtPortIPv6 != nil && staleAddress(routerExtPortIPv6, ipv6Unnumbered, uplinkIPv6Net) {
+		routerExtPortIPv6 = nil
+	}
+
 	// Check if uplink is viable at all.
 	if uplinkIPv4Net == nil && uplinkIPv6Net == nil {
 		return nil, errors.New("Uplink network doesn't have IPv4 or IPv6 configured")
 	}
 
-	// Decide whether we need to allocate new IP(s) and go to the expense of retrieving all allocated IPs.
-	if (uplinkIPv4Net != nil && routerExtPortIPv4 == nil) || (uplinkIPv6Net != nil && routerExtPortIPv6 == nil) {
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	// Work out the EUI64 address to use if the uplink doesn't provide IPv6 OVN ranges.
+	// On unnumbered uplinks this is always the link-local address of the router port.
+	var routerExtPortEUI64 net.IP
+	if uplinkIPv6Net != nil && routerExtPortIPv6 == nil && (ipv6Unnumbered || uplinkNetConf["ipv6.ovn.ranges"] == "") {
+		eui64Prefix := uplinkIPv6Net.IP
+		if ipv6Unnumbered {
+			eui64Prefix = ovnLinkLocalIPv6.IP
+		} else if util.IsTrue(uplinkNetConf["ipv6.ovn.ranges.required"]) {
+			return nil, errors.New(`Missing required "ipv6.ovn.ranges" config key on uplink network`)
+		}
+
+		var err error
+		routerExtPortEUI64, err = eui64.ParseMAC(eui64Prefix, routerMAC)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check nothing else on the uplink already answers for the address.
+		inUse, err := n.uplinkIPsInUse(ctx, uplinkNet, routerExtPortEUI64)
+		if err != nil {
+			n.logger.Warn("Uplink address availability not checked", logger.Ctx{"address": routerExtPortEUI64.String(), "uplink": uplinkNet.Name(), "err": err})
+		} else if len(inUse) > 0 {
+			return nil, fmt.Errorf("Uplink EUI64 address %q is already in use on the uplink network", routerExtPortEUI64.String())
+		}
+	}
+
+	// Decide whether we need to allocate new IP(s) and go to the expense of retrieving all allocated IPs.
+	if (uplinkIPv4Net != nil && routerExtPortIPv4 == nil) || (uplinkIPv6Net != nil && routerExtPortIPv6 == nil) {
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 			allAllocatedIPv4, allAllocatedIPv6, err := n.uplinkAllAllocatedIPs(ctx, tx, uplinkNet.Name())
 			if err != nil {
 				return fmt.Errorf("Failed to get all allocated IPs for uplink: %w", err)
 			}
 
 			if uplinkIPv4Net != nil && routerExtPortIPv4 == nil {
-				if uplinkNetConf["ipv4.ovn.ranges"] == "" {
-					return errors.New(`Missing required "ipv4.ovn.ranges" config key on uplink network`)
-				}
+				var ipRanges []*iprange.Range
 
-				dhcpSubnet := uplinkNet.DHCPv4Subnet()
-				allowedNets := []*net.IPNet{}
-
-				if dhcpSubnet != nil {
-					allowedNets = append(allowedNets, dhcpSubnet)
+				if ipv4Unnumbered {
+					// Pick a link-local address, the first and last /24 of the range are reserved.
+					ipRanges = []*iprange.Range{{Start: net.ParseIP("169.254.1.0"), End: net.ParseIP("169.254.254.255")}}
+					allAllocatedIPv4 = append(allAllocatedIPv4, uplinkIPv4)
 				} else {
-					allowedNets = append(allowedNets, uplinkIPv4Net)
-				}
+					if uplinkNetConf["ipv4.ovn.ranges"] == "" {
+						return errors.New(`Missing required "ipv4.ovn.ranges" config key on uplink network`)
+					}
 
-				ipRanges, err := parseIPRanges(uplinkNetConf["ipv4.ovn.ranges"], allowedNets...)
-				if err != nil {
-					return fmt.Errorf("Failed to parse uplink IPv4 OVN ranges: %w", err)
+					dhcpSubnet := uplinkNet.DHCPv4Subnet()
+					allowedNets := []*net.IPNet{}
+
+					if dhcpSubnet != nil {
+						allowedNets = append(allowedNets, dhcpSubnet)
+					} else {
+						allowedNets = append(allowedNets, uplinkIPv4Net)
+					}
+
+					ipRanges, err = parseIPRanges(uplinkNetConf["ipv4.ovn.ranges"], allowedNets...)
+					if err != nil {
+						return fmt.Errorf("Failed to parse uplink IPv4 OVN ranges: %w", err)
+					}
 				}
 
-				routerExtPortIPv4, err = n.uplinkAllocateIP(ipRanges, allAllocatedIPv4)
+				routerExtPortIPv4, err = n.uplinkAllocateFreeIP(ctx, uplinkNet, ipRanges, allAllocatedIPv4)
 				if err != nil {
 					return fmt.Errorf("Failed to allocate uplink IPv4 address: %w", err)
 				}
@@ -1396,7 +2842,7 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 
 			if uplinkIPv6Net != nil && routerExtPortIPv6 == nil {
 				// If IPv6 OVN ranges are specified by the uplink, allocate from them.
-				if uplinkNetConf["ipv6.ovn.ranges"] != "" {
+				if uplinkNetConf["ipv6.ovn.ranges"] != "" && !ipv6Unnumbered {
 					dhcpSubnet := uplinkNet.DHCPv6Subnet()
 					allowedNets := []*net.IPNet{}
 
@@ -1411,16 +2857,17 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 						return fmt.Errorf("Failed to parse uplink IPv6 OVN ranges: %w", err)
 					}
 
-					routerExtPortIPv6, err = n.uplinkAllocateIP(ipRanges, allAllocatedIPv6)
+					routerExtPortIPv6, err = n.uplinkAllocateFreeIP(ctx, uplinkNet, ipRanges, allAllocatedIPv6)
 					if err != nil {
 						return fmt.Errorf("Failed to allocate uplink IPv6 address: %w", err)
 					}
 				} else {
 					// Otherwise use EUI64 derived from MAC address.
-					routerExtPortIPv6, err = eui64.ParseMAC(uplinkIPv6Net.IP, routerMAC)
-					if err != nil {
-						return err
+					if slices.ContainsFunc(allAllocatedIPv6, routerExtPortEUI64.Equal) {
+						return fmt.Errorf("Uplink EUI64 address %q is already allocated", routerExtPortEUI64.String())
 					}
+
+					routerExtPortIPv6 = routerExtPortEUI64
 				}
 
 				n.config[ovnVolatileUplinkIPv6] = routerExtPortIPv6.String()
@@ -1445,6 +2892,10 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 			IP:   routerExtPortIPv4,
 		}
 
+		if ipv4Unnumbered {
+			routerExtPortIPv4Net.Mask = ovnLinkLocalIPv4.Mask
+		}
+
 		v.routerExtPortIPv4Net = routerExtPortIPv4Net.String()
 	}
 
@@ -1454,6 +2905,10 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 			IP:   routerExtPortIPv6,
 		}
 
+		if ipv6Unnumbered {
+			routerExtPortIPv6Net.Mask = ovnLinkLocalIPv6.Mask
+		}
+
 		v.routerExtPortIPv6Net = routerExtPortIPv6Net.String()
 	}
 
@@ -1462,32 +2917,21 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 
 // uplinkAllAllocatedIPs gets a list of all IPv4 and IPv6 addresses allocated to OVN networks connected to uplink.
 func (n *ovn) uplinkAllAllocatedIPs(ctx context.Context, tx *db.ClusterTx, uplinkNetName string) ([]net.IP, []net.IP, error) {
-	// Get all managed networks across all projects.
-	projectNetworks, err := tx.GetCreatedNetworks(ctx)
+	values, err := tx.GetCreatedNetworksConfigValuesByUplink(ctx, db.NetworkTypeOVN, uplinkNetName, ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Failed to load all networks: %w", err)
+		return nil, nil, fmt.Errorf("Failed to load uplink addresses of networks: %w", err)
 	}
 
 	v4IPs := make([]net.IP, 0)
 	v6IPs := make([]net.IP, 0)
 
-	for _, networks := range projectNetworks {
-		for _, netInfo := range networks {
-			if netInfo.Type != "ovn" || netInfo.Config["network"] != uplinkNetName {
-				continue
-			}
-
-			for _, k := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
-				if netInfo.Config[k] != "" {
-					ip := net.ParseIP(netInfo.Config[k])
-					if ip != nil {
-						if ip.To4() != nil {
-							v4IPs = append(v4IPs, ip)
-						} else {
-							v6IPs = append(v6IPs, ip)
-						}
-					}
-				}
+	for _, value := range values {
+		ip := net.ParseIP(value)
+		if ip != nil {
+			if ip.To4() != nil {
+				v4IPs = append(v4IPs, ip)
+			} else {
+				v6IPs = append(v6IPs, ip)
 			}
 		}
 	}
@@ -1495,47 +2939,223 @@ func (n *ovn) uplinkAllAllocatedIPs(ctx context.Context, tx *db.ClusterTx, uplin
 	return v4IPs, v6IPs, nil
 }
 
-// uplinkAllocateIP allocates a free IP from one of the IP ranges.
-func (n *ovn) uplinkAllocateIP(ipRanges []*iprange.Range, allAllocated []net.IP) (net.IP, error) {
-	for _, ipRange := range ipRanges {
-		inc := big.NewInt(1)
+// ovnUplinkProbeTimeout is how long an uplink address gets to answer ARP or NDP probes before being considered free.
+const ovnUplinkProbeTimeout = 500 * time.Millisecond
+
+// ovnUplinkProbeBatch is how many candidate addresses are probed together when allocating an uplink address.
+const ovnUplinkProbeBatch = 4
+
+// OVNUplinkProbe is a request to check whether addresses are already in use on an OVN uplink network.
+type OVNUplinkProbe struct {
+	Uplink    string   `json:"uplink"`
+	Addresses []string `json:"addresses"`
+}
+
+// OVNLocalUplinkProbe returns which of the probe's addresses answer ARP or NDP probes on the uplink network, as
+// seen from the local member. The addresses are probed concurrently.
+func OVNLocalUplinkProbe(ctx context.Context, s *state.State, probe OVNUplinkProbe) ([]string, error) {
+	uplinkNet, err := LoadByName(s, api.ProjectDefaultName, probe.Uplink)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading uplink network %q: %w", probe.Uplink, err)
+	}
+
+	uplinkInterface := uplinkNet.Name()
+	if uplinkNet.Type() == "physical" {
+		uplinkInterface = GetHostDevice(uplinkNet.Config()["parent"], uplinkNet.Config()["vlan"])
+	}
+
+	if !InterfaceExists(uplinkInterface) {
+		return nil, fmt.Errorf("Uplink interface %q doesn't exist on %q", uplinkInterface, s.ServerName)
+	}
+
+	inUse := []string{}
+	var inUseMu sync.Mutex
+	var probeErr error
+	var wg sync.WaitGroup
+
+	for _, address := range probe.Addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid address %q", address)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, ovnUplinkProbeTimeout)
+			defer cancel()
+
+			used, err := IsIPInUse(probeCtx, ip, uplinkInterface)
+
+			inUseMu.Lock()
+			defer inUseMu.Unlock()
 
-		// Convert IPs in range to native representations to allow incrementing and comparison.
-		startIP := ipRange.Start.To4()
-		if startIP == nil {
-			startIP = ipRange.Start.To16()
+			if err != nil {
+				probeErr = fmt.Errorf("Failed probing %q on %q: %w", address, uplinkInterface, err)
+				return
+			}
+
+			if used {
+				inUse = append(inUse, address)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if probeErr != nil {
+		return nil, probeErr
+	}
+
+	return inUse, nil
+}
+
+// uplinkIPsInUse returns which of the addresses are already used on the uplink network by unmanaged devices.
+// They are probed from the cluster member hosting the router's gateway chassis, as that's where the router's
+// uplink traffic is sent from. An error means the addresses couldn't be checked.
+func (n *ovn) uplinkIPsInUse(ctx context.Context, uplinkNet Network, ips ...net.IP) ([]net.IP, error) {
+	chassis, err := n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting the gateway chassis: %w", err)
+	}
+
+	if chassis == "" {
+		return nil, errors.New("No chassis is bound to the uplink router port yet")
+	}
+
+	probe := OVNUplinkProbe{Uplink: uplinkNet.Name()}
+	for _, ip := range ips {
+		probe.Addresses = append(probe.Addresses, ip.String())
+	}
+
+	var inUse []string
+
+	hostname, _ := os.Hostname()
+	if chassis == n.state.ServerName || chassis == hostname {
+		inUse, err = OVNLocalUplinkProbe(ctx, n.state, probe)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var memberAddress string
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			member, err := tx.GetNodeByName(ctx, chassis)
+			if err != nil {
+				return err
+			}
+
+			memberAddress = member.Address
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Failed finding the cluster member of gateway chassis %q: %w", chassis, err)
 		}
 
-		endIP := ipRange.End.To4()
-		if endIP == nil {
-			endIP = ipRange.End.To16()
+		client, err := cluster.Connect(memberAddress, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), nil, true)
+		if err != nil {
+			return nil, fmt.Errorf("Failed connecting to cluster member %q: %w", chassis, err)
 		}
 
-		startBig := big.NewInt(0)
-		startBig.SetBytes(startIP)
-		endBig := big.NewInt(0)
-		endBig.SetBytes(endIP)
+		resp, _, err := client.RawQuery("POST", "/internal/ovn/uplink-probe", probe, "")
+		if err != nil {
+			return nil, fmt.Errorf("Failed probing from cluster member %q: %w", chassis, err)
+		}
 
-		// Iterate through IPs in range, return the first unallocated one found.
-		for {
-			if startBig.Cmp(endBig) > 0 {
-				break
+		err = resp.MetadataAsStruct(&inUse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inUseIPs := make([]net.IP, 0, len(inUse))
+	for _, address := range inUse {
+		inUseIPs = append(inUseIPs, net.ParseIP(address))
+	}
+
+	return inUseIPs, nil
+}
+
+// uplinkAllocateFreeIP allocates an IP from one of the IP ranges which isn't already used on the uplink network.
+// Candidates are probed in batches and those found to be in use are skipped. If the uplink can't be probed, the
+// first candidate is used without being checked.
+func (n *ovn) uplinkAllocateFreeIP(ctx context.Context, uplinkNet Network, ipRanges []*iprange.Range, allAllocated []net.IP) (net.IP, error) {
+	for {
+		candidates := make([]net.IP, 0, ovnUplinkProbeBatch)
+		for len(candidates) < ovnUplinkProbeBatch {
+			ip, err := n.uplinkAllocateIP(ipRanges, allAllocated)
+			if err != nil {
+				if len(candidates) > 0 {
+					break
+				}
+
+				return nil, err
 			}
 
-			ip := net.IP(startBig.Bytes())
+			candidates = append(candidates, ip)
+			allAllocated = append(allAllocated, ip)
+		}
+
+		inUse, err := n.uplinkIPsInUse(ctx, uplinkNet, candidates...)
+		if err != nil {
+			n.logger.Warn("Uplink address availability not checked", logger.Ctx{"address": candidates[0].String(), "uplink": uplinkNet.Name(), "err": err})
+			return candidates[0], nil
+		}
 
-			// Check IP is not already allocated.
-			freeIP := true
-			if slices.ContainsFunc(allAllocated, ip.Equal) {
-				freeIP = false
+		for _, ip := range candidates {
+			if !slices.ContainsFunc(inUse, ip.Equal) {
+				return ip, nil
 			}
 
-			if !freeIP {
-				startBig.Add(startBig, inc)
+			n.logger.Warn("Skipping uplink address already in use by another device", logger.Ctx{"address": ip.String(), "uplink": uplinkNet.Name()})
+		}
+	}
+}
+
+// uplinkAllocateIP allocates a free IP from one of the IP ranges.
+func (n *ovn) uplinkAllocateIP(ipRanges []*iprange.Range, allAllocated []net.IP) (net.IP, error) {
+	// Sort the allocated addresses so that each range can be walked alongside them rather than checking
+	// every address of the range against all of them.
+	allocated := make([]netip.Addr, 0, len(allAllocated))
+	for _, ip := range allAllocated {
+		addr, ok := netip.AddrFromSlice(ip)
+		if ok {
+			allocated = append(allocated, addr.Unmap())
+		}
+	}
+
+	slices.SortFunc(allocated, netip.Addr.Compare)
+	allocated = slices.Compact(allocated)
+
+	for _, ipRange := range ipRanges {
+		startIP, ok := netip.AddrFromSlice(ipRange.Start)
+		if !ok {
+			continue
+		}
+
+		endIP := startIP
+		if ipRange.End != nil {
+			endIP, ok = netip.AddrFromSlice(ipRange.End)
+			if !ok {
 				continue
 			}
+		}
+
+		startIP = startIP.Unmap()
+		endIP = endIP.Unmap()
+
+		// Skip the allocated addresses below the range.
+		i, _ := slices.BinarySearchFunc(allocated, startIP, netip.Addr.Compare)
+
+		// Return the first address of the range that doesn't match the next allocated one.
+		for ip := startIP; ip.IsValid() && ip.Compare(endIP) <= 0; ip = ip.Next() {
+			if i >= len(allocated) || allocated[i] != ip {
+				return net.IP(ip.AsSlice()), nil
+			}
 
-			return ip, nil
+			i++
 		}
 	}
 
@@ -1543,7 +3163,7 @@ func (n *ovn) uplinkAllocateIP(ipRanges []*iprange.Range, allAllocated []net.IP)
 }
 
 // startUplinkPort performs any network start up logic needed to connect the uplink connection to OVN.
-func (n *ovn) startUplinkPort() error {
+func (n *ovn) startUplinkPort(ctx context.Context) error {
 	if n.config["network"] == "none" {
 		return nil
 	}
@@ -1559,9 +3179,19 @@ func (n *ovn) startUplinkPort() error {
 		return nil
 	}
 
+	// Members without the OVN dataplane have no OVS bridges to connect the uplink to.
+	chassisID, err := n.localChassisID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if chassisID == "" {
+		return nil
+	}
+
 	// Lock uplink network so that if multiple OVN networks are trying to connect to the same uplink we don't
 	// race each other setting up the connection.
-	unlock, err := locking.Lock(context.TODO(), n.uplinkOperationLockName(uplinkNet))
+	unlock, err := locking.Lock(ctx, n.uplinkOperationLockName(uplinkNet))
 	if err != nil {
 		return err
 	}
@@ -1570,9 +3200,9 @@ func (n *ovn) startUplinkPort() error {
 
 	switch uplinkNet.Type() {
 	case "bridge":
-		return n.startUplinkPortBridge(uplinkNet)
+		return n.startUplinkPortBridge(ctx, uplinkNet)
 	case "physical":
-		return n.startUplinkPortPhysical(uplinkNet)
+		return n.startUplinkPortPhysical(ctx, uplinkNet)
 	}
 
 	return fmt.Errorf("Failed starting uplink port, network type %q unsupported as OVN uplink", uplinkNet.Type())
@@ -1583,6 +3213,17 @@ func (n *ovn) uplinkOperationLockName(uplinkNet Network) string {
 	return fmt.Sprintf("network.ovn.%s", uplinkNet.Name())
 }
 
+// operationLockName returns the lock name to use for management operations on the network.
+func (n *ovn) operationLockName() string {
+	return fmt.Sprintf("network.ovn.%d/operation", n.ID())
+}
+
+// dhcpv6ReservationsLockName returns the lock name to use when modifying the DHCPv6 reservations of the network.
+// This is separate from the operation lock as the reservations are also modified while holding it.
+func (n *ovn) dhcpv6ReservationsLockName() string {
+	return fmt.Sprintf("network.ovn.%d/dhcpv6-reservations", n.ID())
+}
+
 // uplinkPortBridgeVars returns the uplink port bridge variables needed for port start/stop.
 func (n *ovn) uplinkPortBridgeVars(uplinkNet Network) *ovnUplinkPortBridgeVars {
 	ovsBridge := fmt.Sprintf("incusovn%d", uplinkNet.ID())
@@ -1596,16 +3237,16 @@ func (n *ovn) uplinkPortBridgeVars(uplinkNet Network) *ovnUplinkPortBridgeVars {
 
 // startUplinkPortBridge creates veth pair (if doesn't exist), creates OVS bridge (if doesn't exist) and
 // connects veth pair to uplink bridge and OVS bridge.
-func (n *ovn) startUplinkPortBridge(uplinkNet Network) error {
+func (n *ovn) startUplinkPortBridge(ctx context.Context, uplinkNet Network) error {
 	if uplinkNet.Config()["bridge.driver"] != "openvswitch" {
-		return n.startUplinkPortBridgeNative(uplinkNet, uplinkNet.Name())
+		return n.startUplinkPortBridgeNative(ctx, uplinkNet, uplinkNet.Name())
 	}
 
-	return n.startUplinkPortBridgeOVS(uplinkNet, uplinkNet.Name())
+	return n.startUplinkPortBridgeOVS(ctx, uplinkNet, uplinkNet.Name())
 }
 
 // startUplinkPortBridgeNative connects an OVN logical router to an uplink native bridge.
-func (n *ovn) startUplinkPortBridgeNative(uplinkNet Network, bridgeDevice string) error {
+func (n *ovn) startUplinkPortBridgeNative(ctx context.Context, uplinkNet Network, bridgeDevice string) error {
 	// Do this after gaining lock so that on failure we revert before release locking.
 	reverter := revert.New()
 	defer reverter.Fail()
@@ -1699,19 +3340,19 @@ func (n *ovn) startUplinkPortBridgeNative(uplinkNet Network, bridgeDevice string
 		return fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	err = vswitch.CreateBridge(context.TODO(), vars.ovsBridge, true, nil, 0)
+	err = vswitch.CreateBridge(ctx, vars.ovsBridge, true, nil, 0)
 	if err != nil {
 		return fmt.Errorf("Failed to create uplink OVS bridge %q: %w", vars.ovsBridge, err)
 	}
 
 	// Connect OVS end veth interface to OVS bridge.
-	err = vswitch.CreateBridgePort(context.TODO(), vars.ovsBridge, vars.ovsEnd, true)
+	err = vswitch.CreateBridgePort(ctx, vars.ovsBridge, vars.ovsEnd, true)
 	if err != nil {
 		return fmt.Errorf("Failed to connect uplink veth interface %q to uplink OVS bridge %q: %w", vars.ovsEnd, vars.ovsBridge, err)
 	}
 
 	// Associate OVS bridge to logical OVN provider.
-	err = vswitch.AddOVNBridgeMapping(context.TODO(), vars.ovsBridge, uplinkNet.Name())
+	err = vswitch.AddOVNBridgeMapping(ctx, vars.ovsBridge, uplinkNet.Name())
 	if err != nil {
 		return fmt.Errorf("Failed to associate uplink OVS bridge %q to OVN provider %q: %w", vars.ovsBridge, uplinkNet.Name(), err)
 	}
@@ -1725,7 +3366,7 @@ func (n *ovn) startUplinkPortBridgeNative(uplinkNet Network, bridgeDevice string
 }
 
 // startUplinkPortBridgeOVS connects an OVN logical router to an uplink OVS bridge.
-func (n *ovn) startUplinkPortBridgeOVS(uplinkNet Network, bridgeDevice string) error {
+func (n *ovn) startUplinkPortBridgeOVS(ctx context.Context, uplinkNet Network, bridgeDevice string) error {
 	// Do this after gaining lock so that on failure we revert before release locking.
 	reverter := revert.New()
 	defer reverter.Fail()
@@ -1736,7 +3377,7 @@ func (n *ovn) startUplinkPortBridgeOVS(uplinkNet Network, bridgeDevice string) e
 		return fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	err = vswitch.AddOVNBridgeMapping(context.TODO(), bridgeDevice, uplinkNet.Name())
+	err = vswitch.AddOVNBridgeMapping(ctx, bridgeDevice, uplinkNet.Name())
 	if err != nil {
 		return fmt.Errorf("Failed to associate uplink OVS bridge %q to OVN provider %q: %w", bridgeDevice, uplinkNet.Name(), err)
 	}
@@ -1756,7 +3397,9 @@ func (n *ovn) pingOVNRouter() {
 
 	for _, key := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
 		ip := net.ParseIP(n.config[key])
-		if ip != nil {
+
+		// Link-local addresses of unnumbered uplinks can't be reached from the host's routing table.
+		if ip != nil && !ip.IsLinkLocalUnicast() {
 			ips = append(ips, ip)
 		}
 	}
@@ -1777,7 +3420,7 @@ func (n *ovn) pingOVNRouter() {
 
 			// Try several attempts as it can take a few seconds for the network to come up.
 			for range 5 {
-				err = pingIP(context.TODO(), ip)
+				err = pingIP(n.state.ShutdownCtx, ip)
 				if err == nil {
 					n.logger.Debug("OVN router external IP address reachable", logger.Ctx{"ip": ip.String()})
 					return
@@ -1794,7 +3437,7 @@ func (n *ovn) pingOVNRouter() {
 }
 
 // startUplinkPortPhysical creates OVS bridge (if doesn't exist) and connects uplink interface to the OVS bridge.
-func (n *ovn) startUplinkPortPhysical(uplinkNet Network) error {
+func (n *ovn) startUplinkPortPhysical(ctx context.Context, uplinkNet Network) error {
 	// Do this after gaining lock so that on failure we revert before release locking.
 	reverter := revert.New()
 	defer reverter.Fail()
@@ -1808,7 +3451,7 @@ func (n *ovn) startUplinkPortPhysical(uplinkNet Network) error {
 
 	// Detect if uplink interface is a native bridge.
 	if IsNativeBridge(uplinkHostName) {
-		return n.startUplinkPortBridgeNative(uplinkNet, uplinkHostName)
+		return n.startUplinkPortBridgeNative(ctx, uplinkNet, uplinkHostName)
 	}
 
 	// Detect if uplink interface is a OVS bridge.
@@ -1817,11 +3460,11 @@ func (n *ovn) startUplinkPortPhysical(uplinkNet Network) error {
 		return fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	_, err = vswitch.GetBridge(context.TODO(), uplinkHostName)
+	_, err = vswitch.GetBridge(ctx, uplinkHostName)
 	if err != nil && !errors.Is(err, ovs.ErrNotFound) {
 		return err
 	} else if err == nil {
-		return n.startUplinkPortBridgeOVS(uplinkNet, uplinkHostName)
+		return n.startUplinkPortBridgeOVS(ctx, uplinkNet, uplinkHostName)
 	}
 
 	// If uplink is a normal physical interface, then use a separate OVS bridge and connect uplink to it.
@@ -1847,19 +3490,19 @@ func (n *ovn) startUplinkPortPhysical(uplinkNet Network) error {
 	}
 
 	// Create uplink OVS bridge if needed.
-	err = vswitch.CreateBridge(context.TODO(), vars.ovsBridge, true, nil, 0)
+	err = vswitch.CreateBridge(ctx, vars.ovsBridge, true, nil, 0)
 	if err != nil {
 		return fmt.Errorf("Failed to create uplink OVS bridge %q: %w", vars.ovsBridge, err)
 	}
 
 	// Connect OVS end veth interface to OVS bridge.
-	err = vswitch.CreateBridgePort(context.TODO(), vars.ovsBridge, uplinkHostName, true)
+	err = vswitch.CreateBridgePort(ctx, vars.ovsBridge, uplinkHostName, true)
 	if err != nil {
 		return fmt.Errorf("Failed to connect uplink interface %q to uplink OVS bridge %q: %w", uplinkHostName, vars.ovsBridge, err)
 	}
 
 	// Associate OVS bridge to logical OVN provider.
-	err = vswitch.AddOVNBridgeMapping(context.TODO(), vars.ovsBridge, uplinkNet.Name())
+	err = vswitch.AddOVNBridgeMapping(ctx, vars.ovsBridge, uplinkNet.Name())
 	if err != nil {
 		return fmt.Errorf("Failed to associate uplink OVS bridge %q to OVN provider %q: %w", vars.ovsBridge, uplinkNet.Name(), err)
 	}
@@ -1880,33 +3523,32 @@ func (n *ovn) startUplinkPortPhysical(uplinkNet Network) error {
 }
 
 // checkUplinkUse checks if uplink network is used by another OVN network.
-func (n *ovn) checkUplinkUse() (bool, error) {
+func (n *ovn) checkUplinkUse(ctx context.Context) (bool, error) {
 	if n.config["network"] == "none" {
 		return false, nil
 	}
 
-	// Get all managed networks across all projects.
-	var err error
-	var projectNetworks map[string]map[int64]api.Network
+	// Get the OVN networks using our uplink across all projects.
+	var projectNetworks map[string][]string
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		projectNetworks, err = tx.GetCreatedNetworksByUplink(ctx, db.NetworkTypeOVN, n.config["network"])
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		projectNetworks, err = tx.GetCreatedNetworks(ctx)
 		return err
 	})
 	if err != nil {
-		return false, fmt.Errorf("Failed to load all networks: %w", err)
+		return false, fmt.Errorf("Failed to load networks using uplink: %w", err)
 	}
 
-	for projectName, networks := range projectNetworks {
-		for _, network := range networks {
-			if (projectName == n.project && network.Name == n.name) || network.Type != "ovn" {
-				continue // Ignore our own DB record or non OVN networks.
+	for projectName, networkNames := range projectNetworks {
+		for _, networkName := range networkNames {
+			if projectName == n.project && networkName == n.name {
+				continue // Ignore our own DB record.
 			}
 
-			// Check if another network is using our uplink.
-			if network.Config["network"] == n.config["network"] {
-				return true, nil
-			}
+			return true, nil
 		}
 	}
 
@@ -1914,7 +3556,7 @@ func (n *ovn) checkUplinkUse() (bool, error) {
 }
 
 // deleteUplinkPort deletes the uplink connection.
-func (n *ovn) deleteUplinkPort() error {
+func (n *ovn) deleteUplinkPort(ctx context.Context) error {
 	if n.config["network"] == "none" {
 		return nil
 	}
@@ -1926,8 +3568,18 @@ func (n *ovn) deleteUplinkPort() error {
 			return fmt.Errorf("Failed loading uplink network %q: %w", n.config["network"], err)
 		}
 
+		// Members without the OVN dataplane never had the uplink port set up.
+		chassisID, err := n.localChassisID(ctx)
+		if err != nil {
+			return err
+		}
+
+		if chassisID == "" {
+			return nil
+		}
+
 		// Lock uplink network so we don't race each other networks using the OVS uplink bridge.
-		unlock, err := locking.Lock(context.TODO(), n.uplinkOperationLockName(uplinkNet))
+		unlock, err := locking.Lock(ctx, n.uplinkOperationLockName(uplinkNet))
 		if err != nil {
 			return err
 		}
@@ -1936,9 +3588,9 @@ func (n *ovn) deleteUplinkPort() error {
 
 		switch uplinkNet.Type() {
 		case "bridge":
-			return n.deleteUplinkPortBridge(uplinkNet)
+			return n.deleteUplinkPortBridge(ctx, uplinkNet)
 		case "physical":
-			return n.deleteUplinkPortPhysical(uplinkNet)
+			return n.deleteUplinkPortPhysical(ctx, uplinkNet)
 		}
 
 		return fmt.Errorf("Failed deleting uplink port, network type %q unsupported as OVN uplink", uplinkNet.Type())
@@ -1948,21 +3600,21 @@ func (n *ovn) deleteUplinkPort() error {
 }
 
 // deleteUplinkPortBridge disconnects the uplink port from the bridge and performs any cleanup.
-func (n *ovn) deleteUplinkPortBridge(uplinkNet Network) error {
+func (n *ovn) deleteUplinkPortBridge(ctx context.Context, uplinkNet Network) error {
 	if uplinkNet.Config()["bridge.driver"] != "openvswitch" {
-		return n.deleteUplinkPortBridgeNative(uplinkNet)
+		return n.deleteUplinkPortBridgeNative(ctx, uplinkNet)
 	}
 
-	return n.deleteUplinkPortBridgeOVS(uplinkNet, uplinkNet.Name())
+	return n.deleteUplinkPortBridgeOVS(ctx, uplinkNet, uplinkNet.Name())
 }
 
 // deleteUplinkPortBridge deletes uplink OVS bridge, OVN bridge mappings and veth interfaces if not in use.
-func (n *ovn) deleteUplinkPortBridgeNative(uplinkNet Network) error {
+func (n *ovn) deleteUplinkPortBridgeNative(ctx context.Context, uplinkNet Network) error {
 	// Check OVS uplink bridge exists, if it does, check whether the uplink network is in use.
 	removeVeths := false
 	vars := n.uplinkPortBridgeVars(uplinkNet)
 	if InterfaceExists(vars.ovsBridge) {
-		uplinkUsed, err := n.checkUplinkUse()
+		uplinkUsed, err := n.checkUplinkUse(ctx)
 		if err != nil {
 			return err
 		}
@@ -1976,12 +3628,12 @@ func (n *ovn) deleteUplinkPortBridgeNative(uplinkNet Network) error {
 				return fmt.Errorf("Failed to connect to OVS: %w", err)
 			}
 
-			err = vswitch.RemoveOVNBridgeMapping(context.TODO(), vars.ovsBridge, uplinkNet.Name())
+			err = vswitch.RemoveOVNBridgeMapping(ctx, vars.ovsBridge, uplinkNet.Name())
 			if err != nil {
 				return err
 			}
 
-			err = vswitch.DeleteBridge(context.TODO(), vars.ovsBridge)
+			err = vswitch.DeleteBridge(ctx, vars.ovsBridge)
 			if err != nil {
 				return err
 			}
@@ -2013,8 +3665,8 @@ func (n *ovn) deleteUplinkPortBridgeNative(uplinkNet Network) error {
 }
 
 // deleteUplinkPortBridge deletes OVN bridge mappings if not in use.
-func (n *ovn) deleteUplinkPortBridgeOVS(uplinkNet Network, ovsBridge string) error {
-	uplinkUsed, err := n.checkUplinkUse()
+func (n *ovn) deleteUplinkPortBridgeOVS(ctx context.Context, uplinkNet Network, ovsBridge string) error {
+	uplinkUsed, err := n.checkUplinkUse(ctx)
 	if err != nil {
 		return err
 	}
@@ -2026,7 +3678,7 @@ func (n *ovn) deleteUplinkPortBridgeOVS(uplinkNet Network, ovsBridge string) err
 			return fmt.Errorf("Failed to connect to OVS: %w", err)
 		}
 
-		err = vswitch.RemoveOVNBridgeMapping(context.TODO(), ovsBridge, uplinkNet.Name())
+		err = vswitch.RemoveOVNBridgeMapping(ctx, ovsBridge, uplinkNet.Name())
 		if err != nil {
 			return err
 		}
@@ -2036,13 +3688,13 @@ func (n *ovn) deleteUplinkPortBridgeOVS(uplinkNet Network, ovsBridge string) err
 }
 
 // deleteUplinkPortPhysical deletes uplink OVS bridge and OVN bridge mappings if not in use.
-func (n *ovn) deleteUplinkPortPhysical(uplinkNet Network) error {
+func (n *ovn) deleteUplinkPortPhysical(ctx context.Context, uplinkNet Network) error {
 	uplinkConfig := uplinkNet.Config()
 	uplinkHostName := GetHostDevice(uplinkConfig["parent"], uplinkConfig["vlan"])
 
 	// Detect if uplink interface is a native bridge.
 	if IsNativeBridge(uplinkHostName) {
-		return n.deleteUplinkPortBridgeNative(uplinkNet)
+		return n.deleteUplinkPortBridgeNative(ctx, uplinkNet)
 	}
 
 	// Detect if uplink interface is a OVS bridge.
@@ -2051,11 +3703,11 @@ func (n *ovn) deleteUplinkPortPhysical(uplinkNet Network) error {
 		return fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	_, err = vswitch.GetBridge(context.TODO(), uplinkHostName)
+	_, err = vswitch.GetBridge(ctx, uplinkHostName)
 	if err != nil && !errors.Is(err, ovs.ErrNotFound) {
 		return err
 	} else if err == nil {
-		return n.deleteUplinkPortBridgeOVS(uplinkNet, uplinkHostName)
+		return n.deleteUplinkPortBridgeOVS(ctx, uplinkNet, uplinkHostName)
 	}
 
 	// Otherwise if uplink is normal physical interface, attempt cleanup of OVS bridge.
@@ -2064,7 +3716,7 @@ func (n *ovn) deleteUplinkPortPhysical(uplinkNet Network) error {
 	releaseIF := false
 	vars := n.uplinkPortBridgeVars(uplinkNet)
 	if InterfaceExists(vars.ovsBridge) {
-		uplinkUsed, err := n.checkUplinkUse()
+		uplinkUsed, err := n.checkUplinkUse(ctx)
 		if err != nil {
 			return err
 		}
@@ -2073,12 +3725,12 @@ func (n *ovn) deleteUplinkPortPhysical(uplinkNet Network) error {
 		if !uplinkUsed {
 			releaseIF = true
 
-			err = vswitch.RemoveOVNBridgeMapping(context.TODO(), vars.ovsBridge, uplinkNet.Name())
+			err = vswitch.RemoveOVNBridgeMapping(ctx, vars.ovsBridge, uplinkNet.Name())
 			if err != nil {
 				return err
 			}
 
-			err = vswitch.DeleteBridge(context.TODO(), vars.ovsBridge)
+			err = vswitch.DeleteBridge(ctx, vars.ovsBridge)
 			if err != nil {
 				return err
 			}
@@ -2099,8 +3751,78 @@ func (n *ovn) deleteUplinkPortPhysical(uplinkNet Network) error {
 	return nil
 }
 
+// dhcpOptionsRebind points all ports of the internal switch at the current DHCP option sets.
+func (n *ovn) dhcpOptionsRebind(ctx context.Context) error {
+	dhcpv4UUID, dhcpv6UUID, err := n.getDhcpOptionUUIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ports, err := n.ovnnb.GetLogicalSwitchPorts(ctx, n.getIntSwitchName())
+	if err != nil {
+		return err
+	}
+
+	for portName := range ports {
+		err := n.ovnnb.UpdateLogicalSwitchPortDHCP(ctx, portName, dhcpv4UUID, dhcpv6UUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return n.routedPortsDHCPRefresh(ctx, dhcpv4UUID)
+}
+
+// routedPortsDHCPRefresh derives the DHCPv4 option sets of the routed NIC ports from the switch wide option set
+// again, or removes them if DHCPv4 isn't enabled anymore.
+func (n *ovn) routedPortsDHCPRefresh(ctx context.Context, dhcpv4UUID networkOVN.OVNDHCPOptionsUUID) error {
+	routedPorts, err := n.ovnnb.GetLogicalSwitchPortsDHCPv4Options(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting routed DHCPv4 options: %w", err)
+	}
+
+	for portName := range routedPorts {
+		if dhcpv4UUID == "" {
+			err = n.ovnnb.DeleteLogicalSwitchPortDHCPv4Options(ctx, portName, "")
+		} else {
+			err = n.ovnnb.SetLogicalSwitchPortDHCPv4Options(ctx, n.getIntSwitchName(), portName, dhcpv4UUID, ovnRoutedNICDHCPv4Options)
+		}
+
+		if err != nil {
+			return fmt.Errorf("Failed refreshing routed DHCPv4 options of port %q: %w", portName, err)
+		}
+	}
+
+	return nil
+}
+
+// nameserversRefresh makes sure the DNS servers advertised by the network reach existing clients.
+// The DHCP option sets and router advertisement settings must already have been updated by setup.
+// Clients only pick up the new servers when renewing their DHCP lease or on the next router advertisement, so
+// the expected timing is logged for the operator.
+func (n *ovn) nameserversRefresh(ctx context.Context) error {
+	err := n.dhcpOptionsRebind(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed refreshing DHCP options of internal switch ports: %w", err)
+	}
+
+	leaseTime := time.Hour
+	if n.config["ipv4.dhcp.expiry"] != "" {
+		duration, err := time.ParseDuration(n.config["ipv4.dhcp.expiry"])
+		if err == nil {
+			leaseTime = duration
+		}
+	}
+
+	// DHCPv4 clients renew their lease at half of the lease time, OVN sends periodic router advertisements at
+	// most every minute.
+	n.logger.Info("DNS servers changed, clients will pick them up on their next DHCP renewal or router advertisement", logger.Ctx{"dhcpRenewal": (leaseTime / 2).String(), "raInterval": time.Minute.String()})
+
+	return nil
+}
+
 // FillConfig fills requested config with any default values.
-func (n *ovn) FillConfig(config map[string]string) error {
+func (n *ovn) FillConfig(ctx context.Context, config map[string]string) error {
 	if config["ipv4.address"] == "" {
 		config["ipv4.address"] = "auto"
 	}
@@ -2112,8 +3834,13 @@ func (n *ovn) FillConfig(config map[string]string) error {
 		}
 	}
 
+	// Start tracking the replicated configuration version.
+	if config["replication.target.address"] != "" && config[ovnVolatileReplication] == "" {
+		config[ovnVolatileReplication] = "1"
+	}
+
 	// Now replace any "auto" keys with generated values.
-	err := n.populateAutoConfig(config)
+	err := n.populateAutoConfig(ctx, config)
 	if err != nil {
 		return fmt.Errorf("Failed generating auto config: %w", err)
 	}
@@ -2121,8 +3848,35 @@ func (n *ovn) FillConfig(config map[string]string) error {
 	return nil
 }
 
+// RenderConfigTemplates renders the config values which contain pongo2 templates.
+// This is only done for the config supplied when creating the network, stored values are never rendered again.
+// User keys are left untouched as they may hold templates meant for other consumers.
+func (n *ovn) RenderConfigTemplates(config map[string]string) error {
+	for k, v := range config {
+		if internalInstance.IsUserConfig(k) {
+			continue
+		}
+
+		if !strings.Contains(v, "{{") && !strings.Contains(v, "{%") {
+			continue
+		}
+
+		rendered, err := internalUtil.RenderTemplate(v, pongo2.Context{
+			"projectName": n.project,
+			"networkName": n.name,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed rendering template of config key %q: %w", k, err)
+		}
+
+		config[k] = rendered
+	}
+
+	return nil
+}
+
 // populateAutoConfig replaces "auto" in config with generated values.
-func (n *ovn) populateAutoConfig(config map[string]string) error {
+func (n *ovn) populateAutoConfig(ctx context.Context, config map[string]string) error {
 	changedConfig := false
 
 	if config["ipv4.address"] == "auto" {
@@ -2157,19 +3911,33 @@ func (n *ovn) populateAutoConfig(config map[string]string) error {
 
 	// Re-validate config if changed.
 	if changedConfig && n.state != nil {
-		return n.Validate(config)
+		return n.Validate(ctx, config)
 	}
 
 	return nil
 }
 
 // Create sets up network in OVN Northbound database.
-func (n *ovn) Create(clientType request.ClientType) error {
+func (n *ovn) Create(clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
 	n.logger.Debug("Create", logger.Ctx{"clientType": clientType, "config": n.config})
 
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
 	// We only need to setup the OVN Northbound database once, not on every clustered node.
 	if clientType == request.ClientTypeNormal {
-		err := n.setup(false)
+		// Check the requested bridge MTU can be carried between all the chassis.
+		bridgeMTU := n.getBridgeMTU()
+		if bridgeMTU != 0 {
+			err := n.validateBridgeMTU(ctx, bridgeMTU)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := n.setup(ctx, false, nil)
 		if err != nil {
 			return err
 		}
@@ -2179,10 +3947,10 @@ func (n *ovn) Create(clientType request.ClientType) error {
 }
 
 // allowedUplinkNetworks returns a list of allowed networks to use as uplinks based on project restrictions.
-func (n *ovn) allowedUplinkNetworks(p *api.Project) ([]string, error) {
+func (n *ovn) allowedUplinkNetworks(ctx context.Context, p *api.Project) ([]string, error) {
 	var uplinkNetworkNames []string
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		// Uplink networks are always from the default project.
 		networks, err := tx.GetCreatedNetworksByProject(ctx, api.ProjectDefaultName)
 		if err != nil {
@@ -2229,8 +3997,8 @@ func (n *ovn) allowedUplinkNetworks(p *api.Project) ([]string, error) {
 // validateUplinkNetwork checks if uplink network is allowed, and if empty string is supplied then tries to select
 // an uplink network from the allowedUplinkNetworks() list if there is only one allowed network.
 // Returns chosen uplink network name to use.
-func (n *ovn) validateUplinkNetwork(p *api.Project, uplinkNetworkName string) (string, error) {
-	allowedUplinkNetworks, err := n.allowedUplinkNetworks(p)
+func (n *ovn) validateUplinkNetwork(ctx context.Context, p *api.Project, uplinkNetworkName string) (string, error) {
+	allowedUplinkNetworks, err := n.allowedUplinkNetworks(ctx, p)
 	if err != nil {
 		return "", err
 	}
@@ -2295,6 +4063,22 @@ func (n *ovn) getDHCPv4Reservations() ([]iprange.Range, error) {
 				dhcpReserveIPv4s = append(dhcpReserveIPv4s, iprange.Range{Start: ovnRouter})
 			}
 		}
+
+		// Keep the services subnet out of dynamic allocations.
+		_, servicesNet, err := net.ParseCIDR(n.config["ipv4.services"])
+		if err == nil {
+			dhcpReserveIPv4s = append(dhcpReserveIPv4s, iprange.Range{
+				Start: net.ParseIP(servicesNet.IP.String()),
+				End:   net.ParseIP(dhcpalloc.GetIP(servicesNet, -1).String()),
+			})
+		}
+	}
+
+	// Keep the failover addresses out of dynamic allocations.
+	for _, failover := range n.failoverAddresses(n.config) {
+		if failover.address.To4() != nil && !ipInRanges(failover.address, dhcpReserveIPv4s) {
+			dhcpReserveIPv4s = append(dhcpReserveIPv4s, iprange.Range{Start: failover.address})
+		}
 	}
 
 	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
@@ -2314,13 +4098,19 @@ func (n *ovn) getDHCPv4Reservations() ([]iprange.Range, error) {
 	return dhcpReserveIPv4s, nil
 }
 
-func (n *ovn) setup(update bool) error {
-	// If we are in mock mode, just no-op.
-	if n.state.OS.MockMode {
-		return nil
+// setup creates or updates the logical network from the config.
+// When updating, changedKeys limits the refresh of the router SNAT rules to changes which affect them, nil meaning
+// that any key may have changed.
+func (n *ovn) setup(ctx context.Context, update bool, changedKeys []string) error {
+	n.logger.Debug("Setting up network")
+
+	// Serialize with the other management operations on the network.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
 	}
 
-	n.logger.Debug("Setting up network")
+	defer unlock()
 
 	reverter := revert.New()
 	defer reverter.Fail()
@@ -2334,7 +4124,7 @@ func (n *ovn) setup(update bool) error {
 	// Load the project to get uplink network restrictions.
 	var p *api.Project
 	var projectID int64
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
 		if err != nil {
 			return err
@@ -2353,7 +4143,7 @@ func (n *ovn) setup(update bool) error {
 	// Check project restrictions and get uplink network to use.
 	uplinkNetwork := "none"
 	if n.config["network"] != "none" {
-		uplinkNetwork, err = n.validateUplinkNetwork(p, n.config["network"])
+		uplinkNetwork, err = n.validateUplinkNetwork(ctx, p, n.config["network"])
 		if err != nil {
 			return err
 		}
@@ -2368,7 +4158,7 @@ func (n *ovn) setup(update bool) error {
 	bridgeMTU := n.getBridgeMTU()
 	if bridgeMTU == 0 {
 		// If no manual bridge MTU specified, derive it from the underlay network.
-		bridgeMTU, err = n.getOptimalBridgeMTU()
+		bridgeMTU, err = n.getOptimalBridgeMTU(ctx)
 		if err != nil {
 			return fmt.Errorf("Failed getting optimal bridge MTU: %w", err)
 		}
@@ -2383,12 +4173,23 @@ func (n *ovn) setup(update bool) error {
 		return fmt.Errorf("Failed getting DHCPv4 IP reservations: %w", err)
 	}
 
-	// Apply any config dynamically generated to the current config and store back to DB in single transaction.
-	if len(updatedConfig) > 0 {
-		maps.Copy(n.config, updatedConfig)
-
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			err = tx.UpdateNetwork(ctx, n.project, n.name, n.description, n.config)
+	// Record the router MAC so that it doesn't change if the server certificate is later replaced.
+	// For existing networks this stores the MAC derived from the current certificate.
+	if n.config["bridge.hwaddr"] == "" && n.config[ovnVolatileRouterHwaddr] == "" {
+		routerMAC, err := n.getRouterMAC(ctx)
+		if err != nil {
+			return err
+		}
+
+		updatedConfig[ovnVolatileRouterHwaddr] = routerMAC.String()
+	}
+
+	// Apply any config dynamically generated to the current config and store back to DB in single transaction.
+	if len(updatedConfig) > 0 {
+		maps.Copy(n.config, updatedConfig)
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			err = tx.UpdateNetwork(ctx, n.project, n.name, n.description, n.config)
 			if err != nil {
 				return fmt.Errorf("Failed saving updated network config: %w", err)
 			}
@@ -2401,13 +4202,13 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	// Get router MAC address.
-	routerMAC, err := n.getRouterMAC()
+	routerMAC, err := n.getRouterMAC(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Setup uplink port (do this first to check uplink is suitable).
-	uplinkNet, err := n.setupUplinkPort(routerMAC)
+	uplinkNet, err := n.setupUplinkPort(ctx, routerMAC)
 	if err != nil {
 		return err
 	}
@@ -2442,28 +4243,38 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	// Create chassis group.
-	err = n.ovnnb.CreateChassisGroup(context.TODO(), n.getChassisGroupName(), update)
+	err = n.ovnnb.CreateChassisGroup(ctx, n.getChassisGroupName(), update)
 	if err != nil {
 		return err
 	}
 
 	if !update {
-		reverter.Add(func() { _ = n.ovnnb.DeleteChassisGroup(context.TODO(), n.getChassisGroupName()) })
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.ovnnb.DeleteChassisGroup(ctx, n.getChassisGroupName())
+		})
 	}
 
 	// Configure logical router.
 	if routerIntPortIPv4 != nil || routerIntPortIPv6 != nil {
 		// Create logical router.
-		err = n.ovnnb.CreateLogicalRouter(context.TODO(), n.getRouterName(), update)
+		err = n.ovnnb.CreateLogicalRouter(ctx, n.getRouterName(), update)
 		if err != nil {
 			return fmt.Errorf("Failed adding router: %w", err)
 		}
 
 		if !update {
-			reverter.Add(func() { _ = n.ovnnb.DeleteLogicalRouter(context.TODO(), n.getRouterName()) })
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouter(ctx, n.getRouterName())
+			})
 		}
 	} else {
-		err := n.ovnnb.DeleteLogicalRouter(context.TODO(), n.getRouterName())
+		err := n.ovnnb.DeleteLogicalRouter(ctx, n.getRouterName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return fmt.Errorf("Failed deleting router: %w", err)
 		}
@@ -2486,58 +4297,72 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	if len(extRouterIPs) > 0 {
-		err = n.ovnnb.CreateLogicalSwitch(context.TODO(), n.getExtSwitchName(), update)
+		err = n.ovnnb.CreateLogicalSwitch(ctx, n.getExtSwitchName(), update)
 		if err != nil {
 			return fmt.Errorf("Failed adding external switch: %w", err)
 		}
 
 		if !update {
-			reverter.Add(func() { _ = n.ovnnb.DeleteLogicalSwitch(context.TODO(), n.getExtSwitchName()) })
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalSwitch(ctx, n.getExtSwitchName())
+			})
 		}
 
 		// Create external router port.
-		err = n.ovnnb.CreateLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterExtPortName(), routerMAC, bridgeMTU, extRouterIPs, n.getChassisGroupName(), update)
+		err = n.ovnnb.CreateLogicalRouterPort(ctx, n.getRouterName(), n.getRouterExtPortName(), routerMAC, bridgeMTU, extRouterIPs, n.getChassisGroupName(), update)
 		if err != nil {
 			return fmt.Errorf("Failed adding external router port: %w", err)
 		}
 
 		if !update {
 			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterExtPortName())
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), n.getRouterExtPortName())
 			})
 		}
 
 		// Create external switch port and link to router port.
-		err = n.ovnnb.CreateLogicalSwitchPort(context.TODO(), n.getExtSwitchName(), n.getExtSwitchRouterPortName(), nil, update)
+		err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getExtSwitchName(), n.getExtSwitchRouterPortName(), nil, update)
 		if err != nil {
 			return fmt.Errorf("Failed adding external switch router port: %w", err)
 		}
 
 		if !update {
 			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getExtSwitchName(), n.getExtSwitchRouterPortName())
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getExtSwitchName(), n.getExtSwitchRouterPortName())
 			})
 		}
 
-		err = n.ovnnb.UpdateLogicalSwitchPortLinkRouter(context.TODO(), n.getExtSwitchRouterPortName(), n.getRouterExtPortName())
+		err = n.ovnnb.UpdateLogicalSwitchPortLinkRouter(ctx, n.getExtSwitchRouterPortName(), n.getRouterExtPortName())
 		if err != nil {
 			return fmt.Errorf("Failed linking external router port to external switch port: %w", err)
 		}
 
 		// Create external switch port and link to external provider network.
-		err = n.ovnnb.CreateLogicalSwitchPort(context.TODO(), n.getExtSwitchName(), n.getExtSwitchProviderPortName(), nil, update)
+		err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getExtSwitchName(), n.getExtSwitchProviderPortName(), nil, update)
 		if err != nil {
 			return fmt.Errorf("Failed adding external switch provider port: %w", err)
 		}
 
 		if !update {
 			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getExtSwitchName(), n.getExtSwitchProviderPortName())
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getExtSwitchName(), n.getExtSwitchProviderPortName())
 			})
 		}
 
 		if uplinkNet != nil {
-			err = n.ovnnb.UpdateLogicalSwitchPortLinkProviderNetwork(context.TODO(), n.getExtSwitchProviderPortName(), uplinkNet.extSwitchProviderName)
+			err = n.ovnnb.UpdateLogicalSwitchPortLinkProviderNetwork(ctx, n.getExtSwitchProviderPortName(), uplinkNet.extSwitchProviderName)
 			if err != nil {
 				return fmt.Errorf("Failed linking external switch provider port to external provider network: %w", err)
 			}
@@ -2545,15 +4370,18 @@ func (n *ovn) setup(update bool) error {
 
 		// Remove any existing SNAT rules on update. As currently these are only defined from the network
 		// config rather than from any instance NIC config, so we can re-create the active config below.
-		if update {
-			err = n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "snat", true)
+		// Re-creating them drops the NAT state of established connections, so leave them alone when the
+		// update doesn't affect them.
+		refreshSNAT := !update || changedKeys == nil || ovnSNATKeysChanged(changedKeys)
+		if update && refreshSNAT {
+			err = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", true)
 			if err != nil {
 				return fmt.Errorf("Failed removing existing router SNAT rules: %w", err)
 			}
 		}
 
 		// Add SNAT rules.
-		if util.IsTrue(n.config["ipv4.nat"]) && routerIntPortIPv4Net != nil && routerExtPortIPv4 != nil {
+		if refreshSNAT && util.IsTrue(n.config["ipv4.nat"]) && routerIntPortIPv4Net != nil && routerExtPortIPv4 != nil {
 			snatIP := routerExtPortIPv4
 
 			if n.config["ipv4.nat.address"] != "" {
@@ -2561,15 +4389,17 @@ func (n *ovn) setup(update bool) error {
 				if snatIP == nil {
 					return fmt.Errorf("Failed parsing %q", "ipv4.nat.address")
 				}
+			} else if snatIP.IsLinkLocalUnicast() {
+				return fmt.Errorf("%q must be set when using an unnumbered uplink", "ipv4.nat.address")
 			}
 
-			err = n.ovnnb.CreateLogicalRouterNAT(context.TODO(), n.getRouterName(), "snat", routerIntPortIPv4Net, snatIP, nil, false, update)
+			err = n.routerSNATSetup(ctx, routerIntPortIPv4Net, snatIP, update)
 			if err != nil {
-				return fmt.Errorf("Failed adding router IPv4 SNAT rule: %w", err)
+				return fmt.Errorf("Failed adding router IPv4 SNAT rules: %w", err)
 			}
 		}
 
-		if util.IsTrue(n.config["ipv6.nat"]) && routerIntPortIPv6Net != nil && routerExtPortIPv6 != nil {
+		if refreshSNAT && util.IsTrue(n.config["ipv6.nat"]) && routerIntPortIPv6Net != nil && routerExtPortIPv6 != nil {
 			snatIP := routerExtPortIPv6
 
 			if n.config["ipv6.nat.address"] != "" {
@@ -2577,11 +4407,13 @@ func (n *ovn) setup(update bool) error {
 				if snatIP == nil {
 					return fmt.Errorf("Failed parsing %q", "ipv6.nat.address")
 				}
+			} else if snatIP.IsLinkLocalUnicast() {
+				return fmt.Errorf("%q must be set when using an unnumbered uplink", "ipv6.nat.address")
 			}
 
-			err = n.ovnnb.CreateLogicalRouterNAT(context.TODO(), n.getRouterName(), "snat", routerIntPortIPv6Net, snatIP, nil, false, update)
+			err = n.routerSNATSetup(ctx, routerIntPortIPv6Net, snatIP, update)
 			if err != nil {
-				return fmt.Errorf("Failed adding router IPv6 SNAT rule: %w", err)
+				return fmt.Errorf("Failed adding router IPv6 SNAT rules: %w", err)
 			}
 		}
 
@@ -2608,7 +4440,7 @@ func (n *ovn) setup(update bool) error {
 					return err
 				}
 
-				err = n.ovnnb.CreateStaticMACBinding(context.TODO(), n.getRouterExtPortName(), uplinkGatewayIP, uplinkGatewayMAC, true)
+				err = n.ovnnb.CreateStaticMACBinding(ctx, n.getRouterExtPortName(), uplinkGatewayIP, uplinkGatewayMAC, true)
 				if err != nil {
 					return err
 				}
@@ -2627,14 +4459,14 @@ func (n *ovn) setup(update bool) error {
 					return err
 				}
 
-				err = n.ovnnb.CreateStaticMACBinding(context.TODO(), n.getRouterExtPortName(), uplinkGatewayIP, uplinkGatewayMAC, true)
+				err = n.ovnnb.CreateStaticMACBinding(ctx, n.getRouterExtPortName(), uplinkGatewayIP, uplinkGatewayMAC, true)
 				if err != nil {
 					return err
 				}
 			}
 
 			// Clear any leftover MAC binding.
-			err = n.ovnnb.DeleteStaticMACBindings(context.TODO(), n.getRouterExtPortName(), uplinkConfig["ipv4.gateway.hwaddr"] == "", uplinkConfig["ipv6.gateway.hwaddr"] == "")
+			err = n.ovnnb.DeleteStaticMACBindings(ctx, n.getRouterExtPortName(), uplinkConfig["ipv4.gateway.hwaddr"] == "", uplinkConfig["ipv6.gateway.hwaddr"] == "")
 			if err != nil {
 				return err
 			}
@@ -2693,18 +4525,58 @@ func (n *ovn) setup(update bool) error {
 		}
 
 		if len(deleteRoutes) > 0 {
-			err = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), deleteRoutes...)
+			err = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), deleteRoutes...)
 			if err != nil {
 				return fmt.Errorf("Failed removing default routes: %w", err)
 			}
 		}
 
 		if len(defaultRoutes) > 0 {
-			err = n.ovnnb.CreateLogicalRouterRoute(context.TODO(), n.getRouterName(), update, defaultRoutes...)
+			err = n.ovnnb.CreateLogicalRouterRoute(ctx, n.getRouterName(), update, defaultRoutes...)
 			if err != nil {
 				return fmt.Errorf("Failed adding default routes: %w", err)
 			}
 		}
+	} else if update {
+		// Remove the external side of the network left behind by a previous uplink (e.g. when detaching it).
+		if routerIntPortIPv4 != nil || routerIntPortIPv6 != nil {
+			err = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", true)
+			if err != nil {
+				return fmt.Errorf("Failed removing router SNAT rules: %w", err)
+			}
+
+			deleteRoutes := []net.IPNet{
+				{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)},
+				{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)},
+			}
+
+			// The l3only discard routes only exist to keep traffic off the uplink.
+			for _, intSubnet := range []*net.IPNet{routerIntPortIPv4Net, routerIntPortIPv6Net} {
+				if intSubnet != nil {
+					deleteRoutes = append(deleteRoutes, *intSubnet)
+				}
+			}
+
+			err = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), deleteRoutes...)
+			if err != nil {
+				return fmt.Errorf("Failed removing default routes: %w", err)
+			}
+
+			err = n.ovnnb.DeleteStaticMACBindings(ctx, n.getRouterExtPortName(), true, true)
+			if err != nil {
+				return fmt.Errorf("Failed removing uplink gateway MAC bindings: %w", err)
+			}
+
+			err = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), n.getRouterExtPortName())
+			if err != nil {
+				return fmt.Errorf("Failed removing external router port: %w", err)
+			}
+		}
+
+		err = n.ovnnb.DeleteLogicalSwitch(ctx, n.getExtSwitchName())
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Failed removing external switch: %w", err)
+		}
 	}
 
 	// Gather internal router port IPs (in CIDR format).
@@ -2742,128 +4614,36 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	// Create internal logical switch if not updating.
-	err = n.ovnnb.CreateLogicalSwitch(context.TODO(), n.getIntSwitchName(), update)
+	err = n.ovnnb.CreateLogicalSwitch(ctx, n.getIntSwitchName(), update)
 	if err != nil {
 		return fmt.Errorf("Failed adding internal switch: %w", err)
 	}
 
 	if !update {
-		reverter.Add(func() { _ = n.ovnnb.DeleteLogicalSwitch(context.TODO(), n.getIntSwitchName()) })
-	}
-
-	// Add any listed existing external interface.
-	if n.config["bridge.external_interfaces"] != "" {
-		for _, entry := range strings.Split(n.config["bridge.external_interfaces"], ",") {
-			entry = strings.TrimSpace(entry)
-
-			// Test for extended configuration of external interface.
-			entryParts := strings.Split(entry, "/")
-			ifParent := ""
-			vlanID := 0
-
-			if len(entryParts) == 3 {
-				vlanID, err = strconv.Atoi(entryParts[2])
-				if err != nil || vlanID < 1 || vlanID > 4094 {
-					vlanID = 0
-					n.logger.Warn("Ignoring invalid VLAN ID", logger.Ctx{"interface": entry, "vlanID": entryParts[2]})
-				} else {
-					entry = strings.TrimSpace(entryParts[0])
-					ifParent = strings.TrimSpace(entryParts[1])
-				}
-			}
-
-			iface, err := net.InterfaceByName(entry)
-			if err != nil {
-				if vlanID == 0 {
-					n.logger.Warn("Skipping attaching missing external interface", logger.Ctx{"interface": entry})
-					continue
-				}
-
-				// If the interface doesn't exist and VLAN ID was provided, create the missing interface.
-				ok, err := VLANInterfaceCreate(ifParent, entry, strconv.Itoa(vlanID), false)
-				if ok {
-					iface, err = net.InterfaceByName(entry)
-				}
-
-				if !ok || err != nil {
-					return fmt.Errorf("Failed to create external interface %q", entry)
-				}
-			} else if vlanID > 0 {
-				// If the interface exists and VLAN ID was provided, ensure it has the same parent and VLAN ID and is not attached to a different network.
-				linkInfo, err := ip.LinkByName(entry)
-				if err != nil {
-					return fmt.Errorf("Failed to get link info for external interface %q", entry)
-				}
-
-				if linkInfo.Kind != "vlan" || linkInfo.Parent != ifParent || linkInfo.VlanID != vlanID || (linkInfo.Master != "" && linkInfo.Master != n.name) {
-					return fmt.Errorf("External interface %q already in use", entry)
-				}
-			}
-
-			unused := true
-			addrs, err := iface.Addrs()
-			if err == nil {
-				for _, addr := range addrs {
-					ipAddr, _, err := net.ParseCIDR(addr.String())
-					if ipAddr != nil && err == nil && ipAddr.IsGlobalUnicast() {
-						unused = false
-						break
-					}
-				}
-			}
-
-			if !unused {
-				return errors.New("Only unconfigured network interfaces can be bridged")
-			}
-
-			lspName := networkOVN.OVNSwitchPort(fmt.Sprintf("%s-external-n%d-%s", n.getNetworkPrefix(), n.state.DB.Cluster.GetNodeID(), entry))
-			err = n.ovnnb.CreateLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), lspName, &networkOVN.OVNSwitchPortOpts{
-				IPV4:        "none",
-				IPV6:        "none",
-				Promiscuous: true,
-			}, false)
-			if err != nil {
-				return fmt.Errorf("Failed to create logical switch port for %s: %w", entry, err)
-			}
-
-			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), lspName)
-			})
-
-			// Attach host side veth interface to bridge.
-			integrationBridge := n.state.GlobalConfig.NetworkOVNIntegrationBridge()
-
-			vswitch, err := n.state.OVS()
-			if err != nil {
-				return fmt.Errorf("Failed to connect to OVS: %w", err)
-			}
-
-			err = vswitch.CreateBridgePort(context.TODO(), integrationBridge, entry, true)
-			if err != nil {
-				return err
-			}
-
-			reverter.Add(func() { _ = vswitch.DeleteBridgePort(context.TODO(), integrationBridge, entry) })
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
 
-			// Link OVS port to OVN logical port.
-			err = vswitch.AssociateInterfaceOVNSwitchPort(context.TODO(), entry, string(lspName))
-			if err != nil {
-				return err
-			}
+			_ = n.ovnnb.DeleteLogicalSwitch(ctx, n.getIntSwitchName())
+		})
+	}
 
-			// Make sure the port is up.
-			link := &ip.Link{Name: entry}
-			err = link.SetUp()
-			if err != nil {
-				return fmt.Errorf("Failed to bring up the host interface %s: %w", entry, err)
-			}
-		}
+	err = n.dnsForwardSetup(ctx)
+	if err != nil {
+		return err
 	}
 
 	// Setup IP allocation config on logical switch.
-	err = n.ovnnb.UpdateLogicalSwitchIPAllocation(context.TODO(), n.getIntSwitchName(), &networkOVN.OVNIPAllocationOpts{
+	// OVN derives dynamic IPv6 addresses from the MAC address using EUI64, so subnets smaller than a /64 are
+	// left out and instance ports get static IPv6 addresses instead.
+	allocationIPv6Net := routerIntPortIPv6Net
+	if !ovnSubnetSupportsEUI64(allocationIPv6Net) {
+		allocationIPv6Net = nil
+	}
+
+	err = n.ovnnb.UpdateLogicalSwitchIPAllocation(ctx, n.getIntSwitchName(), &networkOVN.OVNIPAllocationOpts{
 		PrefixIPv4:  routerIntPortIPv4Net,
-		PrefixIPv6:  routerIntPortIPv6Net,
+		PrefixIPv6:  allocationIPv6Net,
 		ExcludeIPv4: dhcpReserveIPv4s,
 	})
 	if err != nil {
@@ -2872,41 +4652,47 @@ func (n *ovn) setup(update bool) error {
 
 	// Create internal switch address sets and add subnets to address set.
 	if update {
-		err = n.ovnnb.UpdateAddressSetAdd(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), intSubnets...)
+		err = n.ovnnb.UpdateAddressSetAdd(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), intSubnets...)
 		if err != nil {
 			return fmt.Errorf("Failed adding internal subnet address set entries: %w", err)
 		}
 	} else {
-		err = n.ovnnb.CreateAddressSet(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), intSubnets...)
+		err = n.ovnnb.CreateAddressSet(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), intSubnets...)
 		if err != nil {
 			return fmt.Errorf("Failed creating internal subnet address set entries: %w", err)
 		}
 
 		reverter.Add(func() {
-			_ = n.ovnnb.DeleteAddressSet(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()))
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.ovnnb.DeleteAddressSet(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()))
 		})
 	}
 
 	if routerIntPortIPv4 != nil || routerIntPortIPv6 != nil {
 		// Apply router security policy.
-		err = n.logicalRouterPolicySetup(n.ovnnb)
+		err = n.logicalRouterPolicySetup(ctx, n.ovnnb)
 		if err != nil {
 			return fmt.Errorf("Failed applying router security policy: %w", err)
 		}
 
 		// Create internal router port.
-		err = n.ovnnb.CreateLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterIntPortName(), routerMAC, bridgeMTU, intRouterIPs, "", update)
+		err = n.ovnnb.CreateLogicalRouterPort(ctx, n.getRouterName(), n.getRouterIntPortName(), routerMAC, bridgeMTU, intRouterIPs, "", update)
 		if err != nil {
 			return fmt.Errorf("Failed adding internal router port: %w", err)
 		}
 
 		if !update {
 			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterIntPortName())
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), n.getRouterIntPortName())
 			})
 		}
 	} else {
-		err := n.ovnnb.DeleteLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterIntPortName())
+		err := n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), n.getRouterIntPortName())
 		if err != nil && !errors.Is(err, ovs.ErrNotFound) {
 			return fmt.Errorf("Failed deleting logical router port: %w", err)
 		}
@@ -2918,7 +4704,7 @@ func (n *ovn) setup(update bool) error {
 	dhcpV6Subnet := n.DHCPv6Subnet()
 
 	if update {
-		dhcpv4UUID, dhcpv6UUID, err = n.getDhcpOptionUUIDs()
+		dhcpv4UUID, dhcpv6UUID, err = n.getDhcpOptionUUIDs(ctx)
 		if err != nil {
 			return err
 		}
@@ -2933,13 +4719,18 @@ func (n *ovn) setup(update bool) error {
 		}
 
 		if len(deleteDHCPRecords) > 0 {
-			err = n.ovnnb.DeleteLogicalSwitchDHCPOption(context.TODO(), n.getIntSwitchName(), deleteDHCPRecords...)
+			err = n.ovnnb.DeleteLogicalSwitchDHCPOption(ctx, n.getIntSwitchName(), deleteDHCPRecords...)
 			if err != nil {
 				return fmt.Errorf("Failed deleting existing DHCP settings for internal switch: %w", err)
 			}
 		}
 	}
 
+	var ntpServers []net.IP
+	for _, s := range util.SplitNTrimSpace(n.config["ntp.servers"], ",", -1, true) {
+		ntpServers = append(ntpServers, net.ParseIP(s))
+	}
+
 	var dnsIPv4 []net.IP
 	var dnsIPv6 []net.IP
 
@@ -2998,9 +4789,10 @@ func (n *ovn) setup(update bool) error {
 			DNSSearchList:      n.getDNSSearchList(),
 			StaticRoutes:       n.config["ipv4.dhcp.routes"],
 			RecursiveDNSServer: dnsIPv4,
+			NTPServers:         ntpServers,
 		}
 
-		err = n.ovnnb.UpdateLogicalSwitchDHCPv4Options(context.TODO(), n.getIntSwitchName(), dhcpv4UUID, dhcpV4Subnet, opts)
+		err = n.ovnnb.UpdateLogicalSwitchDHCPv4Options(ctx, n.getIntSwitchName(), dhcpv4UUID, dhcpV4Subnet, opts)
 		if err != nil {
 			return fmt.Errorf("Failed adding DHCPv4 settings for internal switch: %w", err)
 		}
@@ -3019,7 +4811,7 @@ func (n *ovn) setup(update bool) error {
 			DHCPv6Stateless:    util.IsFalseOrEmpty(n.config["ipv6.dhcp.stateful"]),
 		}
 
-		err = n.ovnnb.UpdateLogicalSwitchDHCPv6Options(context.TODO(), n.getIntSwitchName(), dhcpv6UUID, dhcpV6Subnet, opts)
+		err = n.ovnnb.UpdateLogicalSwitchDHCPv6Options(ctx, n.getIntSwitchName(), dhcpv6UUID, dhcpV6Subnet, opts)
 		if err != nil {
 			return fmt.Errorf("Failed adding DHCPv6 settings for internal switch: %w", err)
 		}
@@ -3030,22 +4822,20 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	if update && (dhcpv4Created || dhcpv6Created) {
-		dhcpv4UUID, dhcpv6UUID, err = n.getDhcpOptionUUIDs()
+		err = n.dhcpOptionsRebind(ctx)
 		if err != nil {
 			return err
 		}
+	} else if update {
+		// Carry the changes over to the option sets of the routed NIC ports.
+		if dhcpV4Subnet == nil {
+			dhcpv4UUID = ""
+		}
 
-		ports, err := n.ovnnb.GetLogicalSwitchPorts(context.TODO(), n.getIntSwitchName())
+		err = n.routedPortsDHCPRefresh(ctx, dhcpv4UUID)
 		if err != nil {
 			return err
 		}
-
-		for portName := range ports {
-			err := n.ovnnb.UpdateLogicalSwitchPortDHCP(context.TODO(), portName, dhcpv4UUID, dhcpv6UUID)
-			if err != nil {
-				return err
-			}
-		}
 	}
 
 	// Set IPv6 router advertisement settings.
@@ -3064,23 +4854,18 @@ func (n *ovn) setup(update bool) error {
 			recursiveDNSServer = dnsIPv6[0] // OVN only supports 1 RA DNS server.
 		}
 
-		err = n.ovnnb.UpdateLogicalRouterPort(context.TODO(), n.getRouterIntPortName(), &networkOVN.OVNIPv6RAOpts{
+		err = n.ovnnb.UpdateLogicalRouterPort(ctx, n.getRouterIntPortName(), &networkOVN.OVNIPv6RAOpts{
 			AddressMode:        adressMode,
 			SendPeriodic:       true,
 			DNSSearchList:      n.getDNSSearchList(),
 			RecursiveDNSServer: recursiveDNSServer,
 			MTU:                bridgeMTU,
-
-			// Keep these low until we support DNS search domains via DHCPv4, as otherwise RA DNSSL
-			// won't take effect until advert after DHCPv4 has run on instance.
-			MinInterval: time.Duration(time.Second * 30),
-			MaxInterval: time.Duration(time.Minute * 1),
 		})
 		if err != nil {
 			return fmt.Errorf("Failed setting internal router port IPv6 advertisement settings: %w", err)
 		}
 	} else {
-		err = n.ovnnb.UpdateLogicalRouterPort(context.TODO(), n.getRouterIntPortName(), &networkOVN.OVNIPv6RAOpts{})
+		err = n.ovnnb.UpdateLogicalRouterPort(ctx, n.getRouterIntPortName(), &networkOVN.OVNIPv6RAOpts{})
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return fmt.Errorf("Failed removing internal router port IPv6 advertisement settings: %w", err)
 		}
@@ -3088,23 +4873,26 @@ func (n *ovn) setup(update bool) error {
 
 	// Create internal switch port and link to router port.
 	if routerIntPortIPv4Net != nil || routerIntPortIPv6Net != nil {
-		err = n.ovnnb.CreateLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), n.getIntSwitchRouterPortName(), nil, update)
+		err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getIntSwitchName(), n.getIntSwitchRouterPortName(), nil, update)
 		if err != nil {
 			return fmt.Errorf("Failed adding internal switch router port: %w", err)
 		}
 
 		if !update {
 			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), n.getIntSwitchRouterPortName())
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), n.getIntSwitchRouterPortName())
 			})
 		}
 
-		err = n.ovnnb.UpdateLogicalSwitchPortLinkRouter(context.TODO(), n.getIntSwitchRouterPortName(), n.getRouterIntPortName())
+		err = n.ovnnb.UpdateLogicalSwitchPortLinkRouter(ctx, n.getIntSwitchRouterPortName(), n.getRouterIntPortName())
 		if err != nil {
 			return fmt.Errorf("Failed linking internal router port to internal switch port: %w", err)
 		}
 	} else {
-		err := n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), n.getIntSwitchRouterPortName())
+		err := n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), n.getIntSwitchRouterPortName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return fmt.Errorf("Failed removing logical switch port: %w", err)
 		}
@@ -3117,23 +4905,27 @@ func (n *ovn) setup(update bool) error {
 		dnsServers = append(dnsServers, dnsIPv6...)
 	}
 
-	err = acl.OVNApplyNetworkBaselineRules(n.ovnnb, n.getIntSwitchName(), n.getIntSwitchRouterPortName(), intRouterIPs, dnsServers)
+	err = acl.OVNApplyNetworkBaselineRules(n.ovnnb, n.getIntSwitchName(), n.getIntSwitchRouterPortName(), intRouterIPs, dnsServers, util.IsTrue(n.config["security.stateless"]))
 	if err != nil {
 		return fmt.Errorf("Failed applying baseline ACL rules to internal switch: %w", err)
 	}
 
 	// Create network port group if needed.
-	err = n.ensureNetworkPortGroup(projectID)
+	err = n.ensureNetworkPortGroup(ctx, projectID)
 	if err != nil {
 		return fmt.Errorf("Failed to setup network port group: %w", err)
 	}
 
 	// Ensure any network assigned security ACL port groups are created ready for instance NICs to use.
-	securityACLS := util.SplitNTrimSpace(n.config["security.acls"], ",", -1, true)
+	securityACLS, err := n.securityACLs(ctx, n.config)
+	if err != nil {
+		return err
+	}
+
 	if len(securityACLS) > 0 {
 		var aclNameIDs map[string]int64
 
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 			var err error
 
 			// Get map of ACL names to DB IDs (used for generating OVN port group names).
@@ -3177,9 +4969,9 @@ func (n *ovn) setup(update bool) error {
 	return nil
 }
 
-func (n *ovn) getDhcpOptionUUIDs() (v4Uuid networkOVN.OVNDHCPOptionsUUID, v6Uuid networkOVN.OVNDHCPOptionsUUID, err error) {
+func (n *ovn) getDhcpOptionUUIDs(ctx context.Context) (v4Uuid networkOVN.OVNDHCPOptionsUUID, v6Uuid networkOVN.OVNDHCPOptionsUUID, err error) {
 	// Find first existing DHCP options set for IPv4 and IPv6 and update them instead of adding sets.
-	existingOpts, err := n.ovnnb.GetLogicalSwitchDHCPOptions(context.TODO(), n.getIntSwitchName())
+	existingOpts, err := n.ovnnb.GetLogicalSwitchDHCPOptions(ctx, n.getIntSwitchName())
 	if err != nil {
 		return "", "", fmt.Errorf("Failed getting existing DHCP settings for internal switch: %w", err)
 	}
@@ -3207,7 +4999,7 @@ func (n *ovn) getDhcpOptionUUIDs() (v4Uuid networkOVN.OVNDHCPOptionsUUID, v6Uuid
 // Optionally excludePeers takes a list of peer network IDs to exclude from the router policy. This is useful
 // when removing a peer connection as it allows the security policy to be removed from OVN for that peer before the
 // peer connection has been removed from the database.
-func (n *ovn) logicalRouterPolicySetup(ovnnb *networkOVN.NB, excludePeers ...int64) error {
+func (n *ovn) logicalRouterPolicySetup(ctx context.Context, ovnnb *networkOVN.NB, excludePeers ...int64) error {
 	extRouterPort := n.getRouterExtPortName()
 	intRouterPort := n.getRouterIntPortName()
 	addrSetPrefix := acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID())
@@ -3239,7 +5031,7 @@ func (n *ovn) logicalRouterPolicySetup(ovnnb *networkOVN.NB, excludePeers ...int
 	// Add rules to drop inbound traffic arriving on external uplink port from peer connection addresses.
 	// This prevents source address spoofing of peer connection routes from the external network, which in
 	// turn allows us to use the peer connection's address set for referencing traffic from the peer in ACL.
-	err := n.forPeers(func(targetOVNNet *ovn) error {
+	err := n.forPeers(ctx, func(targetOVNNet *ovn) error {
 		if slices.Contains(excludePeers, targetOVNNet.ID()) {
 			return nil // Don't setup rules for this peer network connection.
 		}
@@ -3264,15 +5056,15 @@ func (n *ovn) logicalRouterPolicySetup(ovnnb *networkOVN.NB, excludePeers ...int
 		return err
 	}
 
-	return n.ovnnb.UpdateLogicalRouterPolicy(context.TODO(), n.getRouterName(), policies...)
+	return n.ovnnb.UpdateLogicalRouterPolicy(ctx, n.getRouterName(), policies...)
 }
 
 // ensureNetworkPortGroup ensures that the network level port group (used for classifying NICs connected to this
 // network as internal) exists.
-func (n *ovn) ensureNetworkPortGroup(projectID int64) error {
+func (n *ovn) ensureNetworkPortGroup(ctx context.Context, projectID int64) error {
 	// Create port group (if needed) for NICs to classify as internal.
 	intPortGroupName := acl.OVNIntSwitchPortGroupName(n.ID())
-	intPortGroupUUID, _, err := n.ovnnb.GetPortGroupInfo(context.TODO(), intPortGroupName)
+	intPortGroupUUID, _, err := n.ovnnb.GetPortGroupInfo(ctx, intPortGroupName)
 	if err != nil {
 		return fmt.Errorf("Failed getting port group UUID for network %q setup: %w", n.Name(), err)
 	}
@@ -3280,7 +5072,7 @@ func (n *ovn) ensureNetworkPortGroup(projectID int64) error {
 	if intPortGroupUUID == "" {
 		// Create internal port group and associate it with the logical switch, so that it will be
 		// removed when the logical switch is removed.
-		err = n.ovnnb.CreatePortGroup(context.TODO(), projectID, intPortGroupName, "", n.getIntSwitchName())
+		err = n.ovnnb.CreatePortGroup(ctx, projectID, intPortGroupName, "", n.getIntSwitchName())
 		if err != nil {
 			return fmt.Errorf("Failed creating port group %q for network %q setup: %w", intPortGroupName, n.Name(), err)
 		}
@@ -3292,22 +5084,23 @@ func (n *ovn) ensureNetworkPortGroup(projectID int64) error {
 // addChassisGroupEntry adds an entry for the local OVS chassis to the OVN logical network's chassis group.
 // The chassis priority value is a stable-random value derived from chassis group name and node ID. This is so we
 // don't end up using the same chassis for the primary uplink chassis for all OVN networks in a cluster.
-func (n *ovn) addChassisGroupEntry() error {
+// It can be overridden per member with the ovn.chassis.priority.<member> key of the supplied network config.
+func (n *ovn) addChassisGroupEntry(ctx context.Context, config map[string]string) error {
 	// Skip adding ourselves if parent=none
-	if n.config["parent"] == "none" {
+	if config["parent"] == "none" {
 		n.logger.Debug("Skipping chassis group entry: parent=none")
 		return nil
 	}
 
 	// Get local chassis ID for chassis group.
-	vswitch, err := n.state.OVS()
+	chassisID, err := n.localChassisID(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed to connect to OVS: %w", err)
+		return err
 	}
 
-	chassisID, err := vswitch.GetChassisID(context.TODO())
-	if err != nil {
-		return fmt.Errorf("Failed getting OVS Chassis ID: %w", err)
+	if chassisID == "" {
+		n.logger.Debug("Skipping chassis group entry: local member isn't an OVN chassis")
+		return nil
 	}
 
 	// Seed the stable random number generator with the chassis group name.
@@ -3322,7 +5115,7 @@ func (n *ovn) addChassisGroupEntry() error {
 	// Get all members in cluster.
 	ourMemberID := int(n.state.DB.Cluster.GetNodeID())
 	var memberIDs []int
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		members, err := tx.GetNodes(ctx)
 		if err != nil {
 			return fmt.Errorf("Failed getting cluster members for adding chassis group entry: %w", err)
@@ -3351,7 +5144,16 @@ func (n *ovn) addChassisGroupEntry() error {
 		}
 	}
 
-	err = n.ovnnb.SetChassisGroupPriority(context.TODO(), chassisGroupName, chassisID, priority)
+	// A priority pinned for the member in the network config takes precedence for deterministic failover.
+	pinnedPriority := config[fmt.Sprintf("ovn.chassis.priority.%s", n.state.ServerName)]
+	if pinnedPriority != "" {
+		priority, err = strconv.Atoi(pinnedPriority)
+		if err != nil {
+			return fmt.Errorf("Invalid pinned chassis priority %q: %w", pinnedPriority, err)
+		}
+	}
+
+	err = n.ovnnb.SetChassisGroupPriority(ctx, chassisGroupName, chassisID, priority)
 	if err != nil {
 		return fmt.Errorf("Failed adding OVS chassis %q with priority %d to chassis group %q: %w", chassisID, priority, chassisGroupName, err)
 	}
@@ -3362,7 +5164,7 @@ func (n *ovn) addChassisGroupEntry() error {
 }
 
 // deleteChassisGroupEntry deletes an entry for the local OVS chassis from the OVN logical network's chassis group.
-func (n *ovn) deleteChassisGroupEntry() error {
+func (n *ovn) deleteChassisGroupEntry(ctx context.Context) error {
 	// Skip deleting chassis group entry if parent=none
 	if n.config["parent"] == "none" {
 		n.logger.Debug("Skipping chassis group entry removal: parent=none")
@@ -3370,17 +5172,16 @@ func (n *ovn) deleteChassisGroupEntry() error {
 	}
 
 	// Remove local chassis from chassis group.
-	vswitch, err := n.state.OVS()
+	chassisID, err := n.localChassisID(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed to connect to OVS: %w", err)
+		return err
 	}
 
-	chassisID, err := vswitch.GetChassisID(context.TODO())
-	if err != nil {
-		return fmt.Errorf("Failed getting OVS Chassis ID: %w", err)
+	if chassisID == "" {
+		return nil // Local member can't be in the chassis group.
 	}
 
-	err = n.ovnnb.SetChassisGroupPriority(context.TODO(), n.getChassisGroupName(), chassisID, -1)
+	err = n.ovnnb.SetChassisGroupPriority(ctx, n.getChassisGroupName(), chassisID, -1)
 	if err != nil && !errors.Is(err, ovs.ErrNotFound) {
 		return fmt.Errorf("Failed deleting OVS chassis %q from chassis group %q: %w", chassisID, n.getChassisGroupName(), err)
 	}
@@ -3388,42 +5189,108 @@ func (n *ovn) deleteChassisGroupEntry() error {
 	return nil
 }
 
+// chassisGroupEntryRefresh adds or removes the local OVS chassis from the OVN logical network's chassis group
+// depending on whether the member should act as a chassis, applying its priority from the supplied network config.
+func (n *ovn) chassisGroupEntryRefresh(ctx context.Context, config map[string]string) error {
+	var chassisEnabled bool
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		chassisEnabled, err = n.chassisEnabled(ctx, tx)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if chassisEnabled {
+		return n.addChassisGroupEntry(ctx, config)
+	}
+
+	return n.deleteChassisGroupEntry(ctx)
+}
+
 // Delete deletes a network.
-func (n *ovn) Delete(clientType request.ClientType) error {
+func (n *ovn) Delete(clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
 	n.logger.Debug("Delete", logger.Ctx{"clientType": clientType})
 
-	err := n.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	err = n.Stop()
 	if err != nil {
 		return err
 	}
 
+	// Detach the interfaces of the external ports located on this member.
+	var externalPortConfigs []map[string]string
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+		records, err := dbCluster.GetNetworkExternalPorts(ctx, tx.Tx(), dbCluster.NetworkExternalPortFilter{NetworkID: &networkID})
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if record.NodeID != tx.GetNodeID() {
+				continue
+			}
+
+			config, err := dbCluster.GetNetworkExternalPortConfig(ctx, tx.Tx(), int(record.ID))
+			if err != nil {
+				return err
+			}
+
+			externalPortConfigs = append(externalPortConfigs, config)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading network external ports: %w", err)
+	}
+
+	for _, config := range externalPortConfigs {
+		err = n.externalPortDetach(ctx, config)
+		if err != nil {
+			return err
+		}
+	}
+
 	if clientType == request.ClientTypeNormal {
 		// Delete the router and anything tied to it (router ports, static routes, policies, nat, ...).
-		err = n.ovnnb.DeleteLogicalRouter(context.TODO(), n.getRouterName())
+		err = n.ovnnb.DeleteLogicalRouter(ctx, n.getRouterName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return err
 		}
 
 		// Delete the external logical switch and anything tied to it (ports, ...).
-		err = n.ovnnb.DeleteLogicalSwitch(context.TODO(), n.getExtSwitchName())
+		err = n.ovnnb.DeleteLogicalSwitch(ctx, n.getExtSwitchName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return err
 		}
 
 		// Delete the internal logical switch and anything tied to it (ports, ...).
-		err = n.ovnnb.DeleteLogicalSwitch(context.TODO(), n.getIntSwitchName())
+		err = n.ovnnb.DeleteLogicalSwitch(ctx, n.getIntSwitchName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return err
 		}
 
 		// Delete any related address sets.
-		err = n.ovnnb.DeleteAddressSet(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()))
+		err = n.ovnnb.DeleteAddressSet(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()))
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return err
 		}
 
 		// Delete address sets used in ACLs.
-		securityACLS := util.SplitNTrimSpace(n.config["security.acls"], ",", -1, true)
+		securityACLS, err := n.securityACLs(ctx, n.config)
+		if err != nil {
+			return err
+		}
 
 		// Load address sets referenced by ACLs.
 		err = addressset.OVNDeleteAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), securityACLS)
@@ -3432,14 +5299,13 @@ func (n *ovn) Delete(clientType request.ClientType) error {
 		}
 
 		// Delete the chassis group for the network.
-		err = n.ovnnb.DeleteChassisGroup(context.TODO(), n.getChassisGroupName())
+		err = n.ovnnb.DeleteChassisGroup(ctx, n.getChassisGroupName())
 		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
 			return err
 		}
 
 		// Clean up any now unused port group.
-		securityACLs := util.SplitNTrimSpace(n.config["security.acls"], ",", -1, true)
-		if len(securityACLs) > 0 {
+		if len(securityACLS) > 0 {
 			err = acl.OVNPortGroupDeleteIfUnused(n.state, n.logger, n.ovnnb, n.project, &api.Network{Name: n.name}, "")
 			if err != nil {
 				return fmt.Errorf("Failed removing unused OVN port groups: %w", err)
@@ -3450,7 +5316,7 @@ func (n *ovn) Delete(clientType request.ClientType) error {
 		forwardListenAddresses := map[int64]string{}
 		loadBalancerListenAddresses := map[int64]string{}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 			networkID := n.ID()
 
 			// Get the forward addresses.
@@ -3492,7 +5358,7 @@ func (n *ovn) Delete(clientType request.ClientType) error {
 			loadBalancers = append(loadBalancers, n.getLoadBalancerName(listenAddress))
 		}
 
-		err = n.ovnnb.DeleteLoadBalancer(context.TODO(), loadBalancers...)
+		err = n.ovnnb.DeleteLoadBalancer(ctx, loadBalancers...)
 		if err != nil {
 			return fmt.Errorf("Failed deleting network forwards and load balancers: %w", err)
 		}
@@ -3540,9 +5406,18 @@ func (n *ovn) chassisEnabled(ctx context.Context, tx *db.ClusterTx) (bool, error
 	// Determine whether to add ourselves as a chassis.
 	// If no server has the role, enable the chassis, otherwise only
 	// enable if the local server has the role.
+	// Members without access to the uplink are never used as a chassis.
 	enableChassis := -1
 
 	for _, member := range members {
+		if !ovnUplinkMemberAllowed(uplinkNet.Config(), member) {
+			if member.ID == memberID {
+				return false, nil
+			}
+
+			continue
+		}
+
 		hasRole := slices.Contains(member.Roles, db.ClusterRoleOVNChassis)
 
 		if hasRole {
@@ -3560,25 +5435,91 @@ func (n *ovn) chassisEnabled(ctx context.Context, tx *db.ClusterTx) (bool, error
 	return enableChassis != 0, nil
 }
 
+// ovnUplinkMemberAllowed returns whether the cluster member has access to the uplink network, that is whether it
+// belongs to one of the cluster groups the uplink is restricted to (if any).
+func ovnUplinkMemberAllowed(uplinkConfig map[string]string, member db.NodeInfo) bool {
+	groups := util.SplitNTrimSpace(uplinkConfig["ovn.cluster_groups"], ",", -1, true)
+	if len(groups) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(member.Groups, func(group string) bool { return slices.Contains(groups, group) })
+}
+
+// uplinkLocal returns whether the uplink network is present on the local member.
+func (n *ovn) uplinkLocal(ctx context.Context, tx *db.ClusterTx) (bool, error) {
+	if n.config["network"] == "" || n.config["network"] == "none" {
+		return false, nil
+	}
+
+	_, uplink, _, err := tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+	if err != nil {
+		return false, fmt.Errorf("Failed loading uplink network %q: %w", n.config["network"], err)
+	}
+
+	member, err := tx.GetNodeWithID(ctx, int(tx.GetNodeID()))
+	if err != nil {
+		return false, fmt.Errorf("Failed getting local cluster member: %w", err)
+	}
+
+	return ovnUplinkMemberAllowed(uplink.Config, member), nil
+}
+
+// localChassisID returns the OVN chassis ID of the local member.
+// An empty ID is returned when the member doesn't run the OVN dataplane, that is when the local OVS has no chassis ID
+// or OVN southbound database configured for ovn-controller. Such members only keep the OVN database state in sync and
+// skip any local OVS setup. Failures to reach OVS are returned as errors.
+func (n *ovn) localChassisID(ctx context.Context) (string, error) {
+	return ovnLocalChassisID(ctx, n.state)
+}
+
+// ovnLocalChassisID returns the OVN chassis ID of the local member, or an empty ID if it doesn't run the OVN dataplane.
+func ovnLocalChassisID(ctx context.Context, s *state.State) (string, error) {
+	// There is no Open vSwitch in mock mode, so behave like a member without the OVN dataplane.
+	if s.OS.MockMode {
+		return "", nil
+	}
+
+	vswitch, err := s.OVS()
+	if err != nil {
+		return "", fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	chassisID, err := vswitch.GetChassisID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Failed getting OVS Chassis ID: %w", err)
+	}
+
+	sbRemote, err := vswitch.GetOVNSouthboundDBRemoteAddress(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Failed getting OVN southbound database address from OVS: %w", err)
+	}
+
+	if chassisID == "" || sbRemote == "" {
+		return "", nil
+	}
+
+	return chassisID, nil
+}
+
 // Start starts adds the local OVS chassis ID to the OVN chass group and starts the local OVS uplink port.
-func (n *ovn) Start() error {
+func (n *ovn) Start() (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
 	n.logger.Debug("Start")
 
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	var err error
-
 	reverter.Add(func() { n.setUnavailable() })
 
-	// Check that uplink network is available.
-	if n.config["network"] != "" && n.config["network"] != "none" && !IsAvailable(api.ProjectDefaultName, n.config["network"]) {
-		return fmt.Errorf("Uplink network %q is unavailable", n.config["network"])
-	}
-
 	var projectID int64
 	var chassisEnabled bool
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	var uplinkLocal bool
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		// Get the project ID.
 		projectID, err = dbCluster.GetProjectID(context.Background(), tx.Tx(), n.project)
 		if err != nil {
@@ -3591,34 +5532,63 @@ func (n *ovn) Start() error {
 			return err
 		}
 
+		// Check if the uplink is present on this member.
+		uplinkLocal, err = n.uplinkLocal(ctx, tx)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("Failed getting project ID for project %q: %w", n.project, err)
 	}
 
+	// Check that uplink network is available.
+	if uplinkLocal && !IsAvailable(api.ProjectDefaultName, n.config["network"]) {
+		return fmt.Errorf("Uplink network %q is unavailable", n.config["network"])
+	}
+
 	// Ensure network level port group exists.
-	err = n.ensureNetworkPortGroup(projectID)
+	err = n.ensureNetworkPortGroup(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	// Members without the OVN dataplane only keep the database state of the network in sync.
+	chassisID, err := n.localChassisID(ctx)
 	if err != nil {
 		return err
 	}
 
+	if chassisID == "" {
+		n.logger.Info("Local member doesn't run the OVN dataplane, skipping local OVN setup")
+	}
+
 	// Handle chassis groups.
 	if chassisEnabled {
 		// Add local member's OVS chassis ID to logical chassis group.
-		err = n.addChassisGroupEntry()
+		err = n.addChassisGroupEntry(ctx, n.config)
 		if err != nil {
 			return err
 		}
 	} else {
 		// Make sure we don't have a group entry.
-		err = n.deleteChassisGroupEntry()
+		err = n.deleteChassisGroupEntry(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if uplinkLocal {
+		err = n.startUplinkPort(ctx)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = n.startUplinkPort()
+	// Bridge the local external interfaces, failures are reported in the network state.
+	err = n.externalInterfacesSetup(ctx)
 	if err != nil {
 		return err
 	}
@@ -3629,16 +5599,69 @@ func (n *ovn) Start() error {
 		return err
 	}
 
-	err = n.loadBalancerBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
-	}
-
-	// Setup event handler for monitored services.
-	handler := networkOVN.EventHandler{
-		Tables: []string{"Service_Monitor"},
+	// Setup event handler for the uplink port, so that load balancer prefixes are only exported while a
+	// chassis is hosting it.
+	uplinkHandler := networkOVN.EventHandler{
+		Tables: []string{"Port_Binding"},
 		Hook: func(action string, table string, oldObject ovsdbModel.Model, newObject ovsdbModel.Model) {
-			// Skip invalid notifications.
+			// portBound returns whether the object is the router's uplink port and if so whether it's bound.
+			portBound := func(dbObject ovsdbModel.Model) (bool, bool) {
+				pb, ok := dbObject.(*ovnSB.PortBinding)
+				if !ok || pb.LogicalPort != fmt.Sprintf("cr-%s", n.getRouterExtPortName()) {
+					return false, false
+				}
+
+				return pb.Chassis != nil, true
+			}
+
+			oldBound, oldFound := portBound(oldObject)
+			newBound, newFound := portBound(newObject)
+			if (!oldFound && !newFound) || oldBound == newBound {
+				return
+			}
+
+			if newBound {
+				ctx, cancel := context.WithTimeout(n.state.ShutdownCtx, ovnOperationTimeout)
+				defer cancel()
+
+				err := n.loadBalancerBGPSetupPrefixes(ctx)
+				if err != nil {
+					n.logger.Error("Failed restoring BGP prefixes for load balancers", logger.Ctx{"err": err})
+				}
+
+				return
+			}
+
+			n.logger.Warn("Uplink port isn't hosted by any chassis, withdrawing BGP prefixes for load balancers")
+
+			n.loadBalancerBGPHoldsReset()
+
+			err := n.loadBalancerBGPClearPrefixes()
+			if err != nil {
+				n.logger.Error("Failed withdrawing BGP prefixes for load balancers", logger.Ctx{"err": err})
+			}
+		},
+	}
+
+	if n.config["network"] != "none" {
+		err = networkOVN.AddOVNSBHandler(fmt.Sprintf("network_%d_uplink", n.id), uplinkHandler)
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() { _ = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d_uplink", n.id)) })
+	}
+
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+	}
+
+	// Setup event handler for monitored services.
+	handler := networkOVN.EventHandler{
+		Tables: []string{"Service_Monitor"},
+		Hook: func(action string, table string, oldObject ovsdbModel.Model, newObject ovsdbModel.Model) {
+			// Skip invalid notifications.
 			if oldObject == nil && newObject == nil {
 				return
 			}
@@ -3659,19 +5682,16 @@ func (n *ovn) Start() error {
 				return
 			}
 
+			ctx, cancel := context.WithTimeout(n.state.ShutdownCtx, ovnOperationTimeout)
+			defer cancel()
+
 			// Locate affected load-balancers.
-			lbs, err := n.ovnnb.GetLoadBalancersByStatusUpdate(context.TODO(), *srvStatus)
+			lbs, err := n.ovnnb.GetLoadBalancersByStatusUpdate(ctx, *srvStatus)
 			if err != nil {
 				return
 			}
 
 			for _, lb := range lbs {
-				// Check for status of all backends on this load-balancer.
-				online, err := n.ovnsb.CheckLoadBalancerOnline(context.TODO(), lb)
-				if err != nil {
-					return
-				}
-
 				// Parse the name.
 				fields := strings.Split(lb.Name, "-")
 				listenAddr := net.ParseIP(fields[3])
@@ -3679,61 +5699,76 @@ func (n *ovn) Start() error {
 					return
 				}
 
-				// Check if we have a matching UDP load-balancer.
-				fields[4] = "udp"
-				lbUDP, _ := n.ovnnb.GetLoadBalancer(context.TODO(), networkOVN.OVNLoadBalancer(strings.Join(fields, "-")))
-				if lbUDP != nil {
-					// UDP backends can't be checked, so have to assume online.
-					online = true
-				}
+				n.loadBalancerBGPHealthChanged(ctx, listenAddr)
+			}
+		},
+	}
 
-				// Prepare advertisement.
-				ipVersion := uint(4)
-				if listenAddr.To4() == nil {
-					ipVersion = 6
-				}
+	err = networkOVN.AddOVNSBHandler(fmt.Sprintf("network_%d", n.id), handler)
+	if err != nil {
+		return err
+	}
 
-				bgpOwner := fmt.Sprintf("network_%d_load_balancer", n.id)
-				nextHopAddr := n.bgpNextHopAddress(ipVersion)
-				natEnabled := util.IsTrue(n.config[fmt.Sprintf("ipv%d.nat", ipVersion)])
-				_, netSubnet, _ := net.ParseCIDR(n.config[fmt.Sprintf("ipv%d.address", ipVersion)])
+	reverter.Add(func() { _ = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d", n.id)) })
 
-				routeSubnetSize := 128
-				if ipVersion == 4 {
-					routeSubnetSize = 32
+	// Setup event handler for the instance ports, so that the routes to them on peer networks and the failover
+	// addresses follow whether they're actually up.
+	peerRoutesHandler := networkOVN.EventHandler{
+		Tables: []string{"Port_Binding"},
+		Hook: func(action string, table string, oldObject ovsdbModel.Model, newObject ovsdbModel.Model) {
+			// portUp returns whether the object is one of the network's instance ports and if so whether it's up.
+			portUp := func(dbObject ovsdbModel.Model) (bool, bool) {
+				pb, ok := dbObject.(*ovnSB.PortBinding)
+				if !ok || !strings.HasPrefix(pb.LogicalPort, fmt.Sprintf("incus-net%d-instance-", n.id)) {
+					return false, false
 				}
 
-				// Don't export internal address forwards (those inside the NAT enabled network's subnet).
-				if natEnabled && netSubnet != nil && netSubnet.Contains(listenAddr) {
-					return
-				}
+				return pb.Chassis != nil && pb.Up != nil && *pb.Up, true
+			}
+
+			oldUp, oldFound := portUp(oldObject)
+			newUp, newFound := portUp(newObject)
+			if (!oldFound && !newFound) || oldUp == newUp {
+				return
+			}
 
-				_, ipRouteSubnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", listenAddr.String(), routeSubnetSize))
+			// Don't block the event loop on the northbound changes.
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+				defer cancel()
+
+				err := n.peerRoutesReconcile(ctx)
 				if err != nil {
-					return
+					n.logger.Error("Failed reconciling peer routes", logger.Ctx{"err": err})
 				}
 
-				// Update the BGP state.
-				if online {
-					err = n.state.BGP.AddPrefix(*ipRouteSubnet, nextHopAddr, bgpOwner)
-					if err != nil {
-						return
-					}
-				} else {
-					err = n.state.BGP.RemovePrefix(*ipRouteSubnet, nextHopAddr)
-					if err != nil {
-						return
-					}
+				err = n.failoverReconcile(ctx)
+				if err != nil {
+					n.logger.Error("Failed reconciling failover addresses", logger.Ctx{"err": err})
 				}
-			}
+			}()
 		},
 	}
 
-	err = networkOVN.AddOVNSBHandler(fmt.Sprintf("network_%d", n.id), handler)
+	err = networkOVN.AddOVNSBHandler(fmt.Sprintf("network_%d_peer_routes", n.id), peerRoutesHandler)
 	if err != nil {
 		return err
 	}
 
+	reverter.Add(func() { _ = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d_peer_routes", n.id)) })
+
+	// Clear any routes left behind on peer networks while the network wasn't running.
+	err = n.peerRoutesReconcile(ctx)
+	if err != nil {
+		n.logger.Warn("Failed reconciling peer routes", logger.Ctx{"err": err})
+	}
+
+	// Move the failover addresses which changed hands while the network wasn't running.
+	err = n.failoverReconcile(ctx)
+	if err != nil {
+		n.logger.Warn("Failed reconciling failover addresses", logger.Ctx{"err": err})
+	}
+
 	reverter.Success()
 
 	// Ensure network is marked as available now its started.
@@ -3747,1446 +5782,1593 @@ func (n *ovn) Start() error {
 func (n *ovn) Stop() error {
 	n.logger.Debug("Stop")
 
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
 	// Delete local OVS chassis ID from logical OVN HA chassis group.
-	err := n.deleteChassisGroupEntry()
+	err := n.deleteChassisGroupEntry(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Delete local uplink port if not used by other OVN networks.
-	err = n.deleteUplinkPort()
+	err = n.deleteUplinkPort(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Clear BGP.
-	err = n.bgpClear(n.config)
+	// Clear event handlers for monitored services and the ports, so they can't export prefixes or routes anymore.
+	err = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d", n.id))
 	if err != nil {
 		return err
 	}
 
-	// Clear event handler for monitored services.
-	err = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d", n.id))
+	err = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d_uplink", n.id))
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	err = networkOVN.RemoveOVNSBHandler(fmt.Sprintf("network_%d_peer_routes", n.id))
+	if err != nil {
+		return err
+	}
 
-// instanceNICGetRoutes returns list of routes defined in nicConfig.
-func (n *ovn) instanceNICGetRoutes(nicConfig map[string]string) []net.IPNet {
-	var routes []net.IPNet
+	// Clear BGP.
+	err = n.bgpClear(n.config)
+	if err != nil {
+		return err
+	}
 
-	routeKeys := []string{"ipv4.routes", "ipv4.routes.external", "ipv6.routes", "ipv6.routes.external"}
+	// Withdraw the load balancer prefixes, including those added by the event handler.
+	n.loadBalancerBGPHoldsReset()
 
-	for _, key := range routeKeys {
-		for _, routeStr := range util.SplitNTrimSpace(nicConfig[key], ",", -1, true) {
-			_, route, err := net.ParseCIDR(routeStr)
-			if err != nil {
-				continue // Skip invalid routes (should never happen).
-			}
+	err = n.loadBalancerBGPClearPrefixes()
+	if err != nil {
+		return err
+	}
 
-			routes = append(routes, *route)
-		}
+	// Remove the local load balancer probe port.
+	err = n.loadBalancerProbeTeardown(ctx)
+	if err != nil {
+		return err
 	}
 
-	return routes
-}
+	// Forget the state of the local external interfaces.
+	ovnExternalInterfacesMu.Lock()
+	delete(ovnExternalInterfaces, n.id)
+	ovnExternalInterfacesMu.Unlock()
 
-// Update updates the network. Accepts notification boolean indicating if this update request is coming from a
-// cluster notification, in which case do not update the database, just apply local changes needed.
-func (n *ovn) Update(newNetwork api.NetworkPut, targetNode string, clientType request.ClientType) error {
-	n.logger.Debug("Update", logger.Ctx{"clientType": clientType, "newNetwork": newNetwork})
+	return nil
+}
 
-	err := n.populateAutoConfig(newNetwork.Config)
+// externalInterfacesSetup bridges the interfaces listed in bridge.external_interfaces into the network on the
+// local member and detaches the ones which were removed from the list.
+// Each interface is handled on its own, with failures being logged and reported in the network state rather
+// than returned. Only failing to reach the local OVS database is returned as an error.
+func (n *ovn) externalInterfacesSetup(ctx context.Context) error {
+	// Members without the OVN dataplane can't bridge anything.
+	chassisID, err := n.localChassisID(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed generating auto config: %w", err)
+		return err
 	}
 
-	if clientType == request.ClientTypeNotifier {
-		// Reload BGP on notifications.
-		err = n.bgpSetup(nil)
-		if err != nil {
-			return err
-		}
-
-		err = n.loadBalancerBGPSetupPrefixes()
-		if err != nil {
-			return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
-		}
-
+	if chassisID == "" {
 		return nil
 	}
 
-	dbUpdateNeeded, changedKeys, oldNetwork, err := n.common.configChanged(newNetwork)
+	integrationBridge, err := n.getIntegrationBridge(ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed getting OVS integration bridge: %w", err)
 	}
 
-	if !dbUpdateNeeded {
-		return nil // Nothing changed.
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return fmt.Errorf("Failed to connect to OVS: %w", err)
 	}
 
-	// If the network as a whole has not had any previous creation attempts, or the node itself is still
-	// pending, then don't apply the new settings to the node, just to the database record (ready for the
-	// actual global create request to be initiated).
-	if n.Status() == api.NetworkStatusPending || n.LocalStatus() == api.NetworkStatusPending {
-		return n.common.update(newNetwork, targetNode, clientType)
-	}
+	interfaces := map[string]error{}
+	for _, entry := range util.SplitNTrimSpace(n.config["bridge.external_interfaces"], ",", -1, true) {
+		ifName, err := n.externalInterfaceAttach(ctx, vswitch, integrationBridge, entry)
+		if err != nil {
+			n.logger.Warn("Failed attaching external interface", logger.Ctx{"interface": ifName, "err": err})
+		}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+		interfaces[ifName] = err
+	}
 
-	// Define a function which reverts everything.
-	reverter.Add(func() {
-		// Reset changes to all nodes and database.
-		_ = n.common.update(oldNetwork, targetNode, clientType)
+	ovnExternalInterfacesMu.Lock()
+	oldInterfaces := ovnExternalInterfaces[n.id]
+	ovnExternalInterfaces[n.id] = interfaces
+	ovnExternalInterfacesMu.Unlock()
 
-		// Reset any change that was made to logical network.
-		if clientType == request.ClientTypeNormal {
-			_ = n.setup(true)
+	// Detach the interfaces which aren't listed anymore.
+	for ifName, attachErr := range oldInterfaces {
+		_, found := interfaces[ifName]
+		if found || attachErr != nil {
+			continue
 		}
 
-		_ = n.Start()
-	})
+		err = vswitch.DeleteBridgePort(ctx, integrationBridge, ifName)
+		if err != nil && !errors.Is(err, ovs.ErrNotFound) {
+			n.logger.Warn("Failed detaching external interface", logger.Ctx{"interface": ifName, "err": err})
+		}
 
-	// Stop network before new config applied if uplink network is changing.
-	if slices.Contains(changedKeys, "network") {
-		err = n.Stop()
+		err = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), n.getExternalInterfacePortName(ifName))
 		if err != nil {
-			return err
+			n.logger.Warn("Failed deleting logical switch port of external interface", logger.Ctx{"interface": ifName, "err": err})
 		}
-
-		// Remove volatile keys associated with old network in new config.
-		delete(newNetwork.Config, ovnVolatileUplinkIPv4)
-		delete(newNetwork.Config, ovnVolatileUplinkIPv6)
 	}
 
-	// Apply changes to all nodes and database.
-	err = n.common.update(newNetwork, targetNode, clientType)
-	if err != nil {
-		return err
-	}
+	return nil
+}
 
-	// Re-setup the logical network after config applied if needed.
-	if len(changedKeys) > 0 && clientType == request.ClientTypeNormal {
-		err = n.setup(true)
-		if err != nil {
-			return err
-		}
+// externalInterfaceAttach bridges a bridge.external_interfaces entry (either an interface name or
+// name/parent/vlan) into the network on the local member. Returns the name of the interface.
+func (n *ovn) externalInterfaceAttach(ctx context.Context, vswitch *ovs.VSwitch, integrationBridge string, entry string) (string, error) {
+	reverter := revert.New()
+	defer reverter.Fail()
 
-		// Work out which ACLs have been added and removed.
-		oldACLs := util.SplitNTrimSpace(oldNetwork.Config["security.acls"], ",", -1, true)
-		newACLs := util.SplitNTrimSpace(newNetwork.Config["security.acls"], ",", -1, true)
-		removedACLs := []string{}
-		for _, oldACL := range oldACLs {
-			if !slices.Contains(newACLs, oldACL) {
-				removedACLs = append(removedACLs, oldACL)
-			}
-		}
+	// Test for extended configuration of external interface.
+	entryParts := strings.Split(entry, "/")
+	ifName := entry
+	ifParent := ""
+	vlanID := 0
 
-		addedACLs := []string{}
-		for _, newACL := range newACLs {
-			if !slices.Contains(oldACLs, newACL) {
-				addedACLs = append(addedACLs, newACL)
-			}
-		}
+	if len(entryParts) == 3 {
+		ifName = strings.TrimSpace(entryParts[0])
+		ifParent = strings.TrimSpace(entryParts[1])
 
-		// Detect if network default rule config has changed.
-		defaultRuleKeys := []string{"security.acls.default.ingress.action", "security.acls.default.egress.action", "security.acls.default.ingress.logged", "security.acls.default.egress.logged"}
-		changedDefaultRuleKeys := []string{}
-		for _, k := range defaultRuleKeys {
-			if slices.Contains(changedKeys, k) {
-				changedDefaultRuleKeys = append(changedDefaultRuleKeys, k)
-			}
+		var err error
+		vlanID, err = strconv.Atoi(entryParts[2])
+		if err != nil || vlanID < 1 || vlanID > 4094 {
+			return ifName, fmt.Errorf("Invalid VLAN ID %q", entryParts[2])
 		}
+	}
 
-		var aclNameIDs map[string]int64
-
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Get map of ACL names to DB IDs (used for generating OVN port group names).
-			acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &n.project})
-			if err != nil {
-				return err
-			}
+	iface, err := net.InterfaceByName(ifName)
+	if err != nil {
+		if vlanID == 0 {
+			return ifName, errors.New("Interface doesn't exist")
+		}
 
-			aclNameIDs = make(map[string]int64, len(acls))
-			for _, acl := range acls {
-				aclNameIDs[acl.Name] = int64(acl.ID)
-			}
+		// If the interface doesn't exist and VLAN ID was provided, create the missing interface.
+		ok, err := VLANInterfaceCreate(ifParent, ifName, strconv.Itoa(vlanID), false)
+		if ok {
+			iface, err = net.InterfaceByName(ifName)
+		}
 
-			return nil
-		})
+		if !ok || err != nil {
+			return ifName, errors.New("Failed to create VLAN interface")
+		}
+	} else if vlanID > 0 {
+		// If the interface exists and VLAN ID was provided, ensure it has the same parent and VLAN ID and is not attached to a different network.
+		linkInfo, err := ip.LinkByName(ifName)
 		if err != nil {
-			return fmt.Errorf("Failed getting network ACL IDs for security ACL update: %w", err)
+			return ifName, fmt.Errorf("Failed to get link info: %w", err)
 		}
 
-		addChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
-		removeChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
+		if linkInfo.Kind != "vlan" || linkInfo.Parent != ifParent || linkInfo.VlanID != vlanID || (linkInfo.Master != "" && linkInfo.Master != n.name) {
+			return ifName, errors.New("Interface already in use")
+		}
+	}
 
-		// Get list of active switch ports (avoids repeated querying of OVN NB).
-		activePorts, err := n.ovnnb.GetLogicalSwitchPorts(context.TODO(), n.getIntSwitchName())
-		if err != nil {
-			return fmt.Errorf("Failed getting active ports: %w", err)
+	addrs, err := iface.Addrs()
+	if err == nil {
+		for _, addr := range addrs {
+			ipAddr, _, err := net.ParseCIDR(addr.String())
+			if ipAddr != nil && err == nil && ipAddr.IsGlobalUnicast() {
+				return ifName, errors.New("Only unconfigured network interfaces can be bridged")
+			}
 		}
+	}
 
-		aclConfigChanged := len(addedACLs) > 0 || len(removedACLs) > 0 || len(changedDefaultRuleKeys) > 0
+	lspName := n.getExternalInterfacePortName(ifName)
+	err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getIntSwitchName(), lspName, &networkOVN.OVNSwitchPortOpts{
+		IPV4:        "none",
+		IPV6:        "none",
+		Promiscuous: true,
+	}, true)
+	if err != nil {
+		return ifName, fmt.Errorf("Failed to create logical switch port: %w", err)
+	}
 
-		var localNICRoutes []net.IPNet
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
 
-		// Apply ACL changes to running instance NICs that use this network.
-		err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
-			nicACLs := util.SplitNTrimSpace(nicConfig["security.acls"], ",", -1, true)
+		_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), lspName)
+	})
 
-			// Get logical port UUID and name.
-			instancePortName := n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+	// Attach the interface to the integration bridge.
+	err = vswitch.CreateBridgePort(ctx, integrationBridge, ifName, true)
+	if err != nil {
+		return ifName, fmt.Errorf("Failed to add the interface to the integration bridge: %w", err)
+	}
 
-			portUUID, found := activePorts[instancePortName]
-			if !found {
-				return nil // No need to update a port that isn't started yet.
-			}
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
 
-			// Apply security ACL and default rule changes.
-			if aclConfigChanged {
-				// Update relevant address sets and Remove from removedACL.
-				if len(addedACLs) > 0 {
-					cleanup, err := addressset.OVNEnsureAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), addedACLs)
-					if err != nil {
-						return fmt.Errorf("Failed ensuring address sets for added ACLs are configured in OVN for network: %w", err)
-					}
+		_ = vswitch.DeleteBridgePort(ctx, integrationBridge, ifName)
+	})
 
-					reverter.Add(cleanup)
-				}
+	// Link OVS port to OVN logical port.
+	err = vswitch.AssociateInterfaceOVNSwitchPort(ctx, ifName, string(lspName))
+	if err != nil {
+		return ifName, fmt.Errorf("Failed to associate the interface with its logical switch port: %w", err)
+	}
 
-				if len(removedACLs) > 0 {
-					err = addressset.OVNDeleteAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), removedACLs)
-					if err != nil {
-						return fmt.Errorf("Failed to delete address set for removed ACLs are configured in OVN for network: %w", err)
-					}
-				}
+	// Make sure the port is up.
+	link := &ip.Link{Name: ifName}
+	err = link.SetUp()
+	if err != nil {
+		return ifName, fmt.Errorf("Failed to bring up the interface: %w", err)
+	}
 
-				// Check whether we need to add any of the new ACLs to the NIC.
-				for _, addedACL := range addedACLs {
-					if slices.Contains(nicACLs, addedACL) {
-						continue // NIC already has this ACL applied directly, so no need to add.
-					}
+	reverter.Success()
 
-					aclID, found := aclNameIDs[addedACL]
-					if !found {
-						return fmt.Errorf("Cannot find security ACL ID for %q", addedACL)
-					}
+	return ifName, nil
+}
 
-					// Add NIC port to ACL port group.
-					portGroupName := acl.OVNACLPortGroupName(aclID)
-					acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, portGroupName)
-					n.logger.Debug("Scheduled logical port for ACL port group addition", logger.Ctx{"networkACL": addedACL, "portGroup": portGroupName, "port": instancePortName})
-				}
+// externalInterfacesState returns the state of the external interfaces bridged by the local member, sorted by name.
+func (n *ovn) externalInterfacesState() []api.NetworkStateOVNExternalInterface {
+	ovnExternalInterfacesMu.Lock()
+	defer ovnExternalInterfacesMu.Unlock()
 
-				// Check whether we need to remove any of the removed ACLs from the NIC.
-				for _, removedACL := range removedACLs {
-					if slices.Contains(nicACLs, removedACL) {
-						continue // NIC still has this ACL applied directly, so don't remove.
-					}
+	interfaces := make([]api.NetworkStateOVNExternalInterface, 0, len(ovnExternalInterfaces[n.id]))
+	for ifName, attachErr := range ovnExternalInterfaces[n.id] {
+		iface := api.NetworkStateOVNExternalInterface{
+			Name:     ifName,
+			Attached: attachErr == nil,
+		}
 
-					aclID, found := aclNameIDs[removedACL]
-					if !found {
-						return fmt.Errorf("Cannot find security ACL ID for %q", removedACL)
-					}
+		if attachErr != nil {
+			iface.Error = attachErr.Error()
+		}
 
-					// Remove NIC port from ACL port group.
-					portGroupName := acl.OVNACLPortGroupName(aclID)
-					acl.OVNPortGroupInstanceNICSchedule(portUUID, removeChangeSet, portGroupName)
-					n.logger.Debug("Scheduled logical port for ACL port group removal", logger.Ctx{"networkACL": removedACL, "portGroup": portGroupName, "port": instancePortName})
-				}
+		interfaces = append(interfaces, iface)
+	}
 
-				// If there are no ACLs being applied to the NIC (either from network or NIC) then
-				// we should remove the default rule from the NIC.
-				if len(newACLs) <= 0 && len(nicACLs) <= 0 {
-					err = n.ovnnb.ClearPortGroupPortACLRules(context.TODO(), acl.OVNIntSwitchPortGroupName(n.ID()), instancePortName)
-					if err != nil {
-						return fmt.Errorf("Failed clearing OVN default ACL rules for instance NIC: %w", err)
-					}
+	slices.SortFunc(interfaces, func(a api.NetworkStateOVNExternalInterface, b api.NetworkStateOVNExternalInterface) int {
+		return strings.Compare(a.Name, b.Name)
+	})
 
-					n.logger.Debug("Cleared NIC default rules", logger.Ctx{"port": instancePortName})
-				} else {
-					defaultRuleChange := false
-
-					// If there are ACLs being applied, then decide if the default rule config
-					// has changed materially for the NIC and update it if needed.
-					for _, k := range changedDefaultRuleKeys {
-						_, found := nicConfig[k]
-						if found {
-							continue // Skip if changed key is overridden in NIC.
-						}
+	return interfaces
+}
 
-						defaultRuleChange = true
-						break
-					}
+// instanceNICGetRoutes returns list of routes defined in nicConfig.
+func (n *ovn) instanceNICGetRoutes(nicConfig map[string]string) []net.IPNet {
+	var routes []net.IPNet
 
-					// If the default rule config has changed materially for this NIC or the
-					// network previously didn't have any ACLs applied and now does, then add
-					// the default rule to the NIC.
-					if defaultRuleChange || len(oldACLs) <= 0 {
-						// Set the automatic default ACL rule for the port.
-						ingressAction, ingressLogged := n.instanceDeviceACLDefaults(nicConfig, "ingress")
-						egressAction, egressLogged := n.instanceDeviceACLDefaults(nicConfig, "egress")
+	routeKeys := []string{"ipv4.routes", "ipv4.routes.external", "ipv6.routes", "ipv6.routes.external"}
 
-						logPrefix := fmt.Sprintf("%s-%s", inst.Config["volatile.uuid"], nicName)
-						err = acl.OVNApplyInstanceNICDefaultRules(n.ovnnb, acl.OVNIntSwitchPortGroupName(n.ID()), logPrefix, instancePortName, ingressAction, ingressLogged, egressAction, egressLogged)
-						if err != nil {
-							return fmt.Errorf("Failed applying OVN default ACL rules for instance NIC: %w", err)
-						}
-
-						n.logger.Debug("Set NIC default rule", logger.Ctx{"port": instancePortName, "ingressAction": ingressAction, "ingressLogged": ingressLogged, "egressAction": egressAction, "egressLogged": egressLogged})
-					}
-				}
+	for _, key := range routeKeys {
+		for _, routeStr := range util.SplitNTrimSpace(nicConfig[key], ",", -1, true) {
+			_, route, err := net.ParseCIDR(routeStr)
+			if err != nil {
+				continue // Skip invalid routes (should never happen).
 			}
 
-			// Add NIC routes to list.
-			localNICRoutes = append(localNICRoutes, n.instanceNICGetRoutes(nicConfig)...)
+			routes = append(routes, *route)
+		}
+	}
 
-			return nil
-		})
-		if err != nil {
-			return err
+	return routes
+}
+
+// ProjectConfigUpdated applies a change of the project's default network ACLs to the network and its instance NICs.
+func (n *ovn) ProjectConfigUpdated(oldProjectConfig map[string]string) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	if n.LocalStatus() != api.NetworkStatusCreated {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	oldACLs := acl.NetworkACLNames(oldProjectConfig, n.Type(), n.config)
+
+	newACLs, err := n.securityACLs(ctx, n.config)
+	if err != nil {
+		return err
+	}
+
+	if slices.Equal(oldACLs, newACLs) {
+		return nil
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Set up the port groups of the added ACLs.
+	err = n.setup(ctx, true, []string{})
+	if err != nil {
+		return err
+	}
+
+	_, err = n.securityACLsApply(ctx, reverter, oldACLs, newACLs, nil)
+	if err != nil {
+		return err
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// securityACLsApply applies a change of the security ACLs of the network, from oldACLs to newACLs, and of the
+// related network keys to its running instance NICs. It returns the routes of those NICs.
+func (n *ovn) securityACLsApply(ctx context.Context, reverter *revert.Reverter, oldACLs []string, newACLs []string, changedKeys []string) ([]net.IPNet, error) {
+	removedACLs := []string{}
+	for _, oldACL := range oldACLs {
+		if !slices.Contains(newACLs, oldACL) {
+			removedACLs = append(removedACLs, oldACL)
 		}
+	}
 
-		// Apply add/remove changesets.
-		if len(addChangeSet) > 0 || len(removeChangeSet) > 0 {
-			n.logger.Debug("Applying ACL port group member change sets")
-			err = n.ovnnb.UpdatePortGroupMembers(context.TODO(), addChangeSet, removeChangeSet)
-			if err != nil {
-				return fmt.Errorf("Failed applying OVN port group member change sets for instance NIC: %w", err)
-			}
+	addedACLs := []string{}
+	for _, newACL := range newACLs {
+		if !slices.Contains(oldACLs, newACL) {
+			addedACLs = append(addedACLs, newACL)
 		}
+	}
 
-		// Check if any of the removed ACLs should have any unused port groups deleted.
-		if len(removedACLs) > 0 {
-			err = acl.OVNPortGroupDeleteIfUnused(n.state, n.logger, n.ovnnb, n.project, &api.Network{Name: n.name}, "", newACLs...)
-			if err != nil {
-				return fmt.Errorf("Failed removing unused OVN port groups: %w", err)
-			}
+	// Detect if network default rule config has changed.
+	defaultRuleKeys := []string{"security.acls.default.ingress.action", "security.acls.default.egress.action", "security.acls.default.ingress.logged", "security.acls.default.egress.logged"}
+	changedDefaultRuleKeys := []string{}
+	for _, k := range defaultRuleKeys {
+		if slices.Contains(changedKeys, k) {
+			changedDefaultRuleKeys = append(changedDefaultRuleKeys, k)
 		}
+	}
 
-		// Ensure all active NIC routes are present in internal switch's address set.
-		err = n.ovnnb.UpdateAddressSetAdd(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), localNICRoutes...)
+	var aclNameIDs map[string]int64
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		// Get map of ACL names to DB IDs (used for generating OVN port group names).
+		acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &n.project})
 		if err != nil {
-			return fmt.Errorf("Failed adding active NIC routes to switch address set: %w", err)
+			return err
 		}
 
-		// Remove any old unused subnet addresses from the internal switch's address set.
-		rebuildPeers := false
-		for _, key := range []string{"ipv4.address", "ipv6.address"} {
-			if slices.Contains(changedKeys, key) {
-				rebuildPeers = true
-				_, oldRouterIntPortIPNet, _ := net.ParseCIDR(oldNetwork.Config[key])
-				if oldRouterIntPortIPNet != nil {
-					err = n.ovnnb.UpdateAddressSetRemove(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), *oldRouterIntPortIPNet)
-					if err != nil {
-						return fmt.Errorf("Failed removing old network subnet %q from switch address set: %w", oldRouterIntPortIPNet.String(), err)
-					}
-				}
+		aclNameIDs = make(map[string]int64, len(acls))
+		for _, acl := range acls {
+			aclNameIDs[acl.Name] = int64(acl.ID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting network ACL IDs for security ACL update: %w", err)
+	}
+
+	addChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
+	removeChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
+
+	// Get list of active switch ports (avoids repeated querying of OVN NB).
+	activePorts, err := n.ovnnb.GetLogicalSwitchPorts(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting active ports: %w", err)
+	}
+
+	// Toggling stateless mode changes the actions of the ACL and default rules.
+	statelessChanged := slices.Contains(changedKeys, "security.stateless")
+	statelessACLs := slices.Clone(newACLs)
+
+	aclConfigChanged := len(addedACLs) > 0 || len(removedACLs) > 0 || len(changedDefaultRuleKeys) > 0 || statelessChanged
+
+	var localNICRoutes []net.IPNet
+
+	// Apply ACL changes to running instance NICs that use this network.
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		nicACLs := util.SplitNTrimSpace(nicConfig["security.acls"], ",", -1, true)
+		exclusive := util.IsTrue(nicConfig["security.acls.exclusive"])
+
+		for _, nicACL := range nicACLs {
+			if !slices.Contains(statelessACLs, nicACL) {
+				statelessACLs = append(statelessACLs, nicACL)
 			}
 		}
 
-		if rebuildPeers {
-			// Rebuild peering config.
-			opts, err := n.peerGetLocalOpts(localNICRoutes)
+		// Get logical port UUID and name.
+		instancePortName := n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+
+		portUUID, found := activePorts[instancePortName]
+		if !found {
+			return nil // No need to update a port that isn't started yet.
+		}
+
+		// The SNAT rules of the NIC's external addresses also follow the stateless mode.
+		if statelessChanged {
+			err = n.instanceDevicePortExternalSNATRefresh(ctx, reverter, instancePortName, nicConfig)
 			if err != nil {
 				return err
 			}
+		}
 
-			err = n.forPeers(func(targetOVNNet *ovn) error {
-				err = n.peerSetup(n.ovnnb, targetOVNNet, *opts)
+		// Apply security ACL and default rule changes.
+		if aclConfigChanged {
+			// Update relevant address sets and Remove from removedACL.
+			if len(addedACLs) > 0 {
+				cleanup, err := addressset.OVNEnsureAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), addedACLs)
 				if err != nil {
-					return err
+					return fmt.Errorf("Failed ensuring address sets for added ACLs are configured in OVN for network: %w", err)
 				}
 
-				return nil
-			})
-			if err != nil {
-				return err
+				reverter.Add(cleanup)
 			}
-		}
-	}
 
-	// If uplink network is changing, start network after config applied.
-	if slices.Contains(changedKeys, "network") {
-		err = n.Start()
-		if err != nil {
-			return err
-		}
+			if len(removedACLs) > 0 {
+				err = addressset.OVNDeleteAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), removedACLs)
+				if err != nil {
+					return fmt.Errorf("Failed to delete address set for removed ACLs are configured in OVN for network: %w", err)
+				}
+			}
 
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
-		if err != nil {
-			return err
-		}
+			// Check whether we need to add any of the new ACLs to the NIC.
+			for _, addedACL := range addedACLs {
+				if exclusive {
+					break // NIC ignores the network ACLs.
+				}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).UpdateNetwork(n.name, newNetwork, "")
-		})
-		if err != nil {
-			return err
-		}
-	} else {
-		// Setup BGP.
-		err = n.bgpSetup(oldNetwork.Config)
-		if err != nil {
-			return err
-		}
-	}
+				if slices.Contains(nicACLs, addedACL) {
+					continue // NIC already has this ACL applied directly, so no need to add.
+				}
 
-	err = n.loadBalancerBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
-	}
+				aclID, found := aclNameIDs[addedACL]
+				if !found {
+					return fmt.Errorf("Cannot find security ACL ID for %q", addedACL)
+				}
 
-	// Delete any address set that is unused
-	err = addressset.OVNAddressSetsDeleteIfUnused(n.state, n.logger, n.ovnnb, n.Project())
-	if err != nil {
-		return fmt.Errorf("Failed removing unused OVN address sets: %w", err)
-	}
+				// Add NIC port to ACL port group.
+				portGroupName := acl.OVNACLPortGroupName(aclID)
+				acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, portGroupName)
+				n.logger.Debug("Scheduled logical port for ACL port group addition", logger.Ctx{"networkACL": addedACL, "portGroup": portGroupName, "port": instancePortName})
+			}
 
-	reverter.Success()
-	return nil
-}
+			// Check whether we need to remove any of the removed ACLs from the NIC.
+			for _, removedACL := range removedACLs {
+				if exclusive {
+					break // NIC never had the network ACLs applied.
+				}
 
-// getInstanceDevicePortName returns the switch port name to use for an instance device.
-func (n *ovn) getInstanceDevicePortName(instanceUUID string, deviceName string) networkOVN.OVNSwitchPort {
-	return networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s-%s", n.getIntSwitchInstancePortPrefix(), instanceUUID, deviceName))
-}
+				if slices.Contains(nicACLs, removedACL) {
+					continue // NIC still has this ACL applied directly, so don't remove.
+				}
 
-// instanceDevicePortRoutesParse parses the instance NIC device config for internal routes and external routes.
-func (n *ovn) instanceDevicePortRoutesParse(deviceConfig map[string]string) ([]*net.IPNet, []*net.IPNet, error) {
-	var err error
+				aclID, found := aclNameIDs[removedACL]
+				if !found {
+					return fmt.Errorf("Cannot find security ACL ID for %q", removedACL)
+				}
 
-	internalRoutes := []*net.IPNet{}
-	for _, key := range []string{"ipv4.routes", "ipv6.routes"} {
-		if deviceConfig[key] == "" {
-			continue
+				// Remove NIC port from ACL port group.
+				portGroupName := acl.OVNACLPortGroupName(aclID)
+				acl.OVNPortGroupInstanceNICSchedule(portUUID, removeChangeSet, portGroupName)
+				n.logger.Debug("Scheduled logical port for ACL port group removal", logger.Ctx{"networkACL": removedACL, "portGroup": portGroupName, "port": instancePortName})
+			}
+
+			// If there are no ACLs being applied to the NIC (either from network or NIC) then
+			// we should remove the default rule from the NIC.
+			if (exclusive || len(newACLs) <= 0) && len(nicACLs) <= 0 {
+				err = n.ovnnb.ClearPortGroupPortACLRules(ctx, acl.OVNIntSwitchPortGroupName(n.ID()), instancePortName)
+				if err != nil {
+					return fmt.Errorf("Failed clearing OVN default ACL rules for instance NIC: %w", err)
+				}
+
+				n.logger.Debug("Cleared NIC default rules", logger.Ctx{"port": instancePortName})
+			} else {
+				defaultRuleChange := false
+
+				// If there are ACLs being applied, then decide if the default rule config
+				// has changed materially for the NIC and update it if needed.
+				for _, k := range changedDefaultRuleKeys {
+					_, found := nicConfig[k]
+					if found {
+						continue // Skip if changed key is overridden in NIC.
+					}
+
+					defaultRuleChange = true
+					break
+				}
+
+				// If the default rule config has changed materially for this NIC or the
+				// network previously didn't have any ACLs applied and now does, then add
+				// the default rule to the NIC.
+				if defaultRuleChange || len(oldACLs) <= 0 || statelessChanged {
+					// Set the automatic default ACL rule for the port.
+					ingressAction, ingressLogged := n.instanceDeviceACLDefaults(nicConfig, "ingress")
+					egressAction, egressLogged := n.instanceDeviceACLDefaults(nicConfig, "egress")
+
+					logPrefix := fmt.Sprintf("%s-%s", inst.Config["volatile.uuid"], nicName)
+					err = acl.OVNApplyInstanceNICDefaultRules(n.ovnnb, acl.OVNIntSwitchPortGroupName(n.ID()), logPrefix, instancePortName, ingressAction, ingressLogged, egressAction, egressLogged)
+					if err != nil {
+						return fmt.Errorf("Failed applying OVN default ACL rules for instance NIC: %w", err)
+					}
+
+					n.logger.Debug("Set NIC default rule", logger.Ctx{"port": instancePortName, "ingressAction": ingressAction, "ingressLogged": ingressLogged, "egressAction": egressAction, "egressLogged": egressLogged})
+				}
+			}
 		}
 
-		internalRoutes, err = SubnetParseAppend(internalRoutes, util.SplitNTrimSpace(deviceConfig[key], ",", -1, false)...)
+		// Add NIC routes to list.
+		localNICRoutes = append(localNICRoutes, n.instanceNICGetRoutes(nicConfig)...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply add/remove changesets.
+	if len(addChangeSet) > 0 || len(removeChangeSet) > 0 {
+		n.logger.Debug("Applying ACL port group member change sets")
+		err = n.ovnnb.UpdatePortGroupMembers(ctx, addChangeSet, removeChangeSet)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Invalid %q value: %w", key, err)
+			return nil, fmt.Errorf("Failed applying OVN port group member change sets for instance NIC: %w", err)
 		}
 	}
 
-	externalRoutes := []*net.IPNet{}
-	for _, key := range []string{"ipv4.routes.external", "ipv6.routes.external"} {
-		if deviceConfig[key] == "" {
-			continue
+	// Reapply the rules of the ACLs used on the network so that their allow rules match the new mode.
+	if statelessChanged && len(statelessACLs) > 0 {
+		aclNets := map[string]acl.NetworkACLUsage{
+			n.Name(): {Name: n.Name(), Type: n.Type(), ID: n.ID(), Config: n.Config()},
 		}
 
-		externalRoutes, err = SubnetParseAppend(externalRoutes, util.SplitNTrimSpace(deviceConfig[key], ",", -1, false)...)
+		cleanup, err := acl.OVNEnsureACLs(n.state, n.logger, n.ovnnb, n.Project(), aclNameIDs, aclNets, statelessACLs, true)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Invalid %q value: %w", key, err)
+			return nil, fmt.Errorf("Failed reapplying security ACLs for stateless mode change: %w", err)
 		}
-	}
 
-	return internalRoutes, externalRoutes, nil
-}
+		reverter.Add(cleanup)
+	}
 
-// InstanceDevicePortValidateExternalRoutes validates the external routes for an OVN instance port.
-func (n *ovn) InstanceDevicePortValidateExternalRoutes(deviceInstance instance.Instance, deviceName string, portExternalRoutes []*net.IPNet) error {
-	if n.config["network"] == "none" {
-		return nil
+	// Check if any of the removed ACLs should have any unused port groups deleted.
+	if len(removedACLs) > 0 {
+		err = acl.OVNPortGroupDeleteIfUnused(n.state, n.logger, n.ovnnb, n.project, &api.Network{Name: n.name}, "", newACLs...)
+		if err != nil {
+			return nil, fmt.Errorf("Failed removing unused OVN port groups: %w", err)
+		}
 	}
 
-	var p *api.Project
-	var uplink *api.Network
+	return localNICRoutes, nil
+}
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		var err error
+// Update updates the network. Accepts notification boolean indicating if this update request is coming from a
+// cluster notification, in which case do not update the database, just apply local changes needed.
+func (n *ovn) Update(newNetwork api.NetworkPut, targetNode string, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
 
-		// Get uplink routes.
-		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+	n.logger.Debug("Update", logger.Ctx{"clientType": clientType, "newNetwork": newNetwork})
 
-		return err
-	})
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	err = n.populateAutoConfig(ctx, newNetwork.Config)
 	if err != nil {
-		return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+		return fmt.Errorf("Failed generating auto config: %w", err)
 	}
 
-	// Check port's external routes are sufficiently small when using l2proxy ingress mode on uplink.
-	if slices.Contains([]string{"l2proxy", ""}, uplink.Config["ovn.ingress_mode"]) {
-		for _, portExternalRoute := range portExternalRoutes {
-			rOnes, rBits := portExternalRoute.Mask.Size()
-			if rBits > 32 && rOnes < 122 {
-				return fmt.Errorf("External route %q is too large. Maximum size for IPv6 external route is /122", portExternalRoute.String())
-			} else if rOnes < 26 {
-				return fmt.Errorf("External route %q is too large. Maximum size for IPv4 external route is /26", portExternalRoute.String())
+	// Carry over the recorded router MAC unless a new one is provided.
+	// Dropping it would cause it to be re-derived from the (possibly rotated) server certificate.
+	if newNetwork.Config[ovnVolatileRouterHwaddr] == "" && n.config[ovnVolatileRouterHwaddr] != "" {
+		newNetwork.Config[ovnVolatileRouterHwaddr] = n.config[ovnVolatileRouterHwaddr]
+	}
+
+	// Carry over the replicated configuration version unless a new one is provided.
+	if newNetwork.Config[ovnVolatileReplication] == "" && n.config[ovnVolatileReplication] != "" {
+		newNetwork.Config[ovnVolatileReplication] = n.config[ovnVolatileReplication]
+	}
+
+	if clientType == request.ClientTypeNotifier {
+		// Re-apply the local chassis group entry in case its pinned priority has changed.
+		// The notification is sent before the new config is stored, so use the supplied one.
+		if n.LocalStatus() == api.NetworkStatusCreated {
+			err = n.chassisGroupEntryRefresh(ctx, newNetwork.Config)
+			if err != nil {
+				return err
 			}
 		}
-	}
 
-	// Load the project to get uplink network restrictions.
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
+		// Reload BGP on notifications.
+		err = n.bgpSetup(nil)
 		if err != nil {
 			return err
 		}
 
-		p, err = project.ToAPI(ctx, tx.Tx())
+		err = n.loadBalancerBGPSetupPrefixes(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+		}
 
-		return err
-	})
-	if err != nil {
-		return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		return nil
 	}
 
-	externalSubnetsInUse, err := n.getExternalSubnetInUse(n.config["network"])
+	err = n.replicationVersionUpdate(newNetwork)
 	if err != nil {
 		return err
 	}
 
-	// Get project restricted routes.
-	projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, n.config["network"])
+	dbUpdateNeeded, changedKeys, oldNetwork, err := n.common.configChanged(newNetwork)
 	if err != nil {
 		return err
 	}
 
-	// Check if uplink has routed ingress anycast mode enabled, as this relaxes the overlap checks.
-	ipv4UplinkAnycast := n.uplinkHasIngressRoutedAnycastIPv4(uplink)
-	ipv6UplinkAnycast := n.uplinkHasIngressRoutedAnycastIPv6(uplink)
+	if !dbUpdateNeeded {
+		return nil // Nothing changed.
+	}
 
-	for _, portExternalRoute := range portExternalRoutes {
-		// Check the external port route is allowed within both the uplink's external routes and any
-		// project restricted subnets.
-		err = n.validateExternalSubnet(uplink, projectRestrictedSubnets, portExternalRoute)
+	// Check the new bridge MTU can be carried between all the chassis.
+	if slices.Contains(changedKeys, "bridge.mtu") && newNetwork.Config["bridge.mtu"] != "" {
+		bridgeMTU, err := strconv.ParseUint(newNetwork.Config["bridge.mtu"], 10, 32)
 		if err != nil {
-			return err
-		}
-
-		// Skip overlap checks if the external route's protocol has anycast mode enabled on the uplink.
-		if portExternalRoute.IP.To4() == nil {
-			if ipv6UplinkAnycast {
-				continue
-			}
-		} else if ipv4UplinkAnycast {
-			continue
+			return fmt.Errorf("Failed parsing %q: %w", "bridge.mtu", err)
 		}
 
-		// Check the external port route doesn't fall within any existing OVN network external subnets.
-		for _, externalSubnetUser := range externalSubnetsInUse {
-			// Skip our own network's SNAT address (as it can be used for NICs in the network).
-			if externalSubnetUser.usageType == subnetUsageNetworkSNAT && externalSubnetUser.networkProject == n.project && externalSubnetUser.networkName == n.name {
-				continue
-			}
-
-			if deviceInstance == nil {
-				// Skip checking instance devices during profile validation, only do this when
-				// an instance is supplied.
-				if externalSubnetUser.instanceDevice != "" {
-					continue
-				}
-			} else {
-				// Skip our own NIC device.
-				if externalSubnetUser.instanceProject == deviceInstance.Project().Name && externalSubnetUser.instanceName == deviceInstance.Name() && externalSubnetUser.instanceDevice == deviceName {
-					continue
-				}
-			}
-
-			if SubnetContains(&externalSubnetUser.subnet, portExternalRoute) || SubnetContains(portExternalRoute, &externalSubnetUser.subnet) {
-				// This error is purposefully vague so that it doesn't reveal any names of
-				// resources potentially outside of the network's project.
-				return fmt.Errorf("External subnet %q overlaps with another network or NIC", portExternalRoute.String())
-			}
+		err = n.validateBridgeMTU(ctx, uint32(bridgeMTU))
+		if err != nil {
+			return err
 		}
 	}
 
-	return nil
-}
-
-// InstanceDevicePortAdd adds empty DNS record (to indicate port has been added) and any DHCP reservations for
-// instance device port.
-func (n *ovn) InstanceDevicePortAdd(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error {
-	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+	// If the network as a whole has not had any previous creation attempts, or the node itself is still
+	// pending, then don't apply the new settings to the node, just to the database record (ready for the
+	// actual global create request to be initiated).
+	if n.Status() == api.NetworkStatusPending || n.LocalStatus() == api.NetworkStatusPending {
+		return n.common.update(newNetwork, targetNode, clientType)
+	}
 
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	dnsUUID, err := n.ovnnb.UpdateLogicalSwitchPortDNS(context.TODO(), n.getIntSwitchName(), instancePortName, "", nil)
-	if err != nil {
-		return fmt.Errorf("Failed adding DNS record: %w", err)
-	}
-
+	// Define a function which reverts everything.
 	reverter.Add(func() {
-		_ = n.ovnnb.DeleteLogicalSwitchPortDNS(context.TODO(), n.getIntSwitchName(), dnsUUID, true)
-	})
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
 
-	// If NIC has static IPv4 address then create a DHCPv4 reservation.
-	if deviceConfig["ipv4.address"] != "" {
-		ip := net.ParseIP(deviceConfig["ipv4.address"])
-		if ip != nil {
-			dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName())
-			if err != nil {
-				return fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
-			}
+		// Reset changes to all nodes and database.
+		_ = n.common.update(oldNetwork, targetNode, clientType)
 
-			if !n.hasDHCPv4Reservation(dhcpReservations, ip) {
-				dhcpReservations = append(dhcpReservations, iprange.Range{Start: ip})
-				err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName(), dhcpReservations)
-				if err != nil {
-					return fmt.Errorf("Failed adding DHCPv4 reservation for %q: %w", ip.String(), err)
-				}
-			}
+		// Reset any change that was made to logical network.
+		if clientType == request.ClientTypeNormal {
+			_ = n.setup(ctx, true, changedKeys)
 		}
-	}
 
-	reverter.Success()
-	return nil
-}
+		_ = n.Start()
+	})
 
-// hasDHCPv4Reservation returns whether IP is in the supplied reservation list.
-func (n *ovn) hasDHCPv4Reservation(dhcpReservations []iprange.Range, ip net.IP) bool {
-	for _, dhcpReservation := range dhcpReservations {
-		if dhcpReservation.Start.Equal(ip) && dhcpReservation.End == nil {
-			return true
+	// Stop network before new config applied if uplink network is changing.
+	if slices.Contains(changedKeys, "network") {
+		err = n.Stop()
+		if err != nil {
+			return err
 		}
-	}
 
-	return false
-}
-
-// InstanceDevicePortStart sets up an instance device port to the internal logical switch.
-// Accepts a list of ACLs being removed from the NIC device (if called as part of a NIC update).
-// Returns the logical switch port name and a list of IPs that were allocated to the port for DNS.
-func (n *ovn) InstanceDevicePortStart(opts *OVNInstanceNICSetupOpts, securityACLsRemove []string) (networkOVN.OVNSwitchPort, []net.IP, error) {
-	if opts.InstanceUUID == "" {
-		return "", nil, errors.New("Instance UUID is required")
+		// Remove volatile keys associated with old network in new config.
+		delete(newNetwork.Config, ovnVolatileUplinkIPv4)
+		delete(newNetwork.Config, ovnVolatileUplinkIPv6)
 	}
 
-	mac, err := net.ParseMAC(opts.DeviceConfig["hwaddr"])
+	// Apply changes to all nodes and database.
+	err = n.common.update(newNetwork, targetNode, clientType)
 	if err != nil {
-		return "", nil, err
+		return err
 	}
 
-	ipv4 := opts.DeviceConfig["ipv4.address"]
-	ipv6 := opts.DeviceConfig["ipv6.address"]
+	// Re-setup the logical network after config applied if needed.
+	if len(changedKeys) > 0 && clientType == request.ClientTypeNormal {
+		err = n.setup(ctx, true, changedKeys)
+		if err != nil {
+			return err
+		}
 
-	internalRoutes, externalRoutes, err := n.instanceDevicePortRoutesParse(opts.DeviceConfig)
-	if err != nil {
-		return "", nil, fmt.Errorf("Failed parsing NIC device routes: %w", err)
-	}
+		// Re-apply the local chassis group entry if a pinned priority has been changed.
+		// The other members re-apply their own entry when notified of the update.
+		if slices.ContainsFunc(changedKeys, func(k string) bool { return strings.HasPrefix(k, "ovn.chassis.priority.") }) {
+			err = n.chassisGroupEntryRefresh(ctx, newNetwork.Config)
+			if err != nil {
+				return err
+			}
+		}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+		// Re-bridge the local external interfaces if they've been changed.
+		if slices.Contains(changedKeys, "bridge.external_interfaces") {
+			err = n.externalInterfacesSetup(ctx)
+			if err != nil {
+				return err
+			}
+		}
 
-	// Get existing DHCPv4 static reservations.
-	// This is used for both checking sticky DHCPv4 allocation availability and for ensuring static DHCP
-	// reservations exist.
-	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName())
-	if err != nil {
-		return "", nil, fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
-	}
+		// Propagate DNS server changes to the existing clients.
+		if slices.Contains(changedKeys, "dns.nameservers") {
+			err = n.nameserversRefresh(ctx)
+			if err != nil {
+				return err
+			}
+		}
 
-	dhcpv4Subnet := n.DHCPv4Subnet()
-	dhcpv6Subnet := n.DHCPv6Subnet()
-	var dhcpV4UUID, dhcpV6UUID networkOVN.OVNDHCPOptionsUUID
+		// Re-assign the failover addresses if they've been changed.
+		if slices.ContainsFunc(changedKeys, func(k string) bool { return strings.HasPrefix(k, "failover.") }) {
+			err = n.failoverReconcile(ctx)
+			if err != nil {
+				return err
+			}
+		}
 
-	if dhcpv4Subnet != nil || dhcpv6Subnet != nil {
-		dhcpV4UUID, dhcpV6UUID, err = n.getDhcpOptionUUIDs()
+		// Re-apply forwards and load balancers if NAT hairpinning has been toggled, their targets may have moved
+		// or the uplink has been attached or detached.
+		if slices.Contains(changedKeys, "nat.hairpin") || slices.Contains(changedKeys, "ipv4.address") || slices.Contains(changedKeys, "ipv6.address") || slices.Contains(changedKeys, "network") {
+			err = n.loadBalancersRefresh(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Work out which ACLs have been added and removed.
+		oldACLs, err := n.securityACLs(ctx, oldNetwork.Config)
 		if err != nil {
-			return "", nil, err
+			return err
 		}
-	}
-	if dhcpv4Subnet != nil {
-		if dhcpV4UUID == "" {
-			return "", nil, fmt.Errorf("Could not find DHCPv4 options for instance port for subnet %q", dhcpv4Subnet.String())
+
+		newACLs, err := n.securityACLs(ctx, newNetwork.Config)
+		if err != nil {
+			return err
 		}
 
-		// If using dynamic IPv4, look for previously used sticky IPs from the NIC's last state.
-		var dhcpV4StickyIP net.IP
-		if opts.DeviceConfig["ipv4.address"] == "" {
-			for _, entry := range opts.LastStateIPs {
-				if entry.To4() != nil && SubnetContainsIP(dhcpv4Subnet, entry) {
-					dhcpV4StickyIP = entry
-					break
-				}
-			}
+		localNICRoutes, err := n.securityACLsApply(ctx, reverter, oldACLs, newACLs, changedKeys)
+		if err != nil {
+			return err
 		}
 
-		// If a previously used IP has been found and its not one of the static IPs, then check if
-		// the IP is available for use and if not then we can request this port use it statically.
-		if dhcpV4StickyIP != nil && dhcpV4StickyIP.String() != ipv4 {
-			// If the sticky IP isn't statically reserved, lets check its not used dynamically
-			// on any active port.
-			if !n.hasDHCPv4Reservation(dhcpReservations, dhcpV4StickyIP) {
-				existingPortIPs, err := n.ovnnb.GetLogicalSwitchIPs(context.TODO(), n.getIntSwitchName())
-				if err != nil {
-					return "", nil, fmt.Errorf("Failed getting existing switch port IPs: %w", err)
-				}
+		// Ensure all active NIC routes are present in internal switch's address set.
+		err = n.ovnnb.UpdateAddressSetAdd(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), localNICRoutes...)
+		if err != nil {
+			return fmt.Errorf("Failed adding active NIC routes to switch address set: %w", err)
+		}
 
-				found := false
-				for _, ips := range existingPortIPs {
-					if IPInSlice(dhcpV4StickyIP, ips) {
-						found = true
-						break // IP is in use with another port, so cannot use it.
+		// Remove any old unused subnet addresses from the internal switch's address set.
+		rebuildPeers := false
+		for _, key := range []string{"ipv4.address", "ipv6.address"} {
+			if slices.Contains(changedKeys, key) {
+				rebuildPeers = true
+				_, oldRouterIntPortIPNet, _ := net.ParseCIDR(oldNetwork.Config[key])
+				if oldRouterIntPortIPNet != nil {
+					err = n.ovnnb.UpdateAddressSetRemove(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), *oldRouterIntPortIPNet)
+					if err != nil {
+						return fmt.Errorf("Failed removing old network subnet %q from switch address set: %w", oldRouterIntPortIPNet.String(), err)
 					}
 				}
-
-				// If IP is not in use then request OVN use previously used IP for port.
-				if !found {
-					ipv4 = dhcpV4StickyIP.String()
-				}
 			}
 		}
-	}
-
-	if dhcpv6Subnet != nil {
-		if dhcpV6UUID == "" {
-			return "", nil, fmt.Errorf("Could not find DHCPv6 options for instance port for subnet %q", dhcpv6Subnet.String())
-		}
 
-		// If port isn't going to have fully dynamic IPs allocated by OVN, and instead only static
-		// IPv4 addresses have been added, then add an EUI64 static IPv6 address so that the switch
-		// port has an IPv6 address that will be used to generate a DNS record. This works around a
-		// limitation in OVN that prevents us requesting dynamic IPv6 address allocation when
-		// static IPv4 allocation is used.
-		if ipv4 != "" && ipv6 == "" {
-			eui64IP, err := eui64.ParseMAC(dhcpv6Subnet.IP, mac)
+		if rebuildPeers {
+			// Rebuild peering config.
+			opts, err := n.peerGetLocalOpts(ctx, localNICRoutes)
 			if err != nil {
-				return "", nil, fmt.Errorf("Failed generating EUI64 for instance port %q: %w", mac.String(), err)
+				return err
 			}
 
-			// Add EUI64 as the IPv6 address.
-			ipv6 = eui64IP.String()
+			err = n.forPeers(ctx, func(targetOVNNet *ovn) error {
+				err = n.peerSetup(ctx, n.ovnnb, targetOVNNet, *opts)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
 		}
 	}
 
-	instancePortName := n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceName)
+	// If uplink network is changing, start network after config applied.
+	if slices.Contains(changedKeys, "network") {
+		err = n.Start()
+		if err != nil {
+			return err
+		}
 
-	var nestedPortParentName networkOVN.OVNSwitchPort
-	var nestedPortVLAN uint16
-	if opts.DeviceConfig["nested"] != "" {
-		nestedPortParentName = n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceConfig["nested"])
-		nestedPortVLANInt64, err := strconv.ParseUint(opts.DeviceConfig["vlan"], 10, 16)
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
 		if err != nil {
-			return "", nil, fmt.Errorf("Invalid VLAN ID %q: %w", opts.DeviceConfig["vlan"], err)
+			return err
 		}
 
-		nestedPortVLAN = uint16(nestedPortVLANInt64)
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).UpdateNetwork(n.name, newNetwork, "")
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		// Setup BGP.
+		err = n.bgpSetup(oldNetwork.Config)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Add port with mayExist set to true, so that if instance port exists, we don't fail and continue below
-	// to configure the port as needed. This is required in case the OVN northbound database was unavailable
-	// when the instance NIC was stopped and was unable to remove the port on last stop, which would otherwise
-	// prevent future NIC starts.
-	err = n.ovnnb.CreateLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), instancePortName, &networkOVN.OVNSwitchPortOpts{
-		DHCPv4OptsID: dhcpV4UUID,
-		DHCPv6OptsID: dhcpV6UUID,
-		MAC:          mac,
-		IPV4:         ipv4,
-		IPV6:         ipv6,
-		Parent:       nestedPortParentName,
-		VLAN:         nestedPortVLAN,
-		Location:     n.state.ServerName,
-		Promiscuous:  util.IsTrue(opts.DeviceConfig["security.promiscuous"]),
-	}, true)
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
 	if err != nil {
-		return "", nil, err
+		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
 	}
 
-	reverter.Add(func() {
-		_ = n.ovnnb.DeleteLogicalSwitchPort(context.TODO(), n.getIntSwitchName(), instancePortName)
-	})
+	// Delete any address set that is unused
+	err = addressset.OVNAddressSetsDeleteIfUnused(n.state, n.logger, n.ovnnb, n.Project())
+	if err != nil {
+		return fmt.Errorf("Failed removing unused OVN address sets: %w", err)
+	}
 
-	// Add DNS records for port's IPs, and retrieve the IP addresses used.
-	var dnsIPv4, dnsIPv6 net.IP
-	dnsIPs := make([]net.IP, 0, 2)
+	reverter.Success()
+	return nil
+}
 
-	// checkAndStoreIP checks if the supplied IP is valid and can be used for a missing DNS IP.
-	// If the found IP is needed, stores into the relevant dnsIPv{X} variable and into dnsIPs slice.
-	checkAndStoreIP := func(ip net.IP) {
-		if ip != nil {
-			isV4 := ip.To4() != nil
-			if dnsIPv4 == nil && isV4 {
-				dnsIPv4 = ip
-			} else if dnsIPv6 == nil && !isV4 {
-				dnsIPv6 = ip
-			}
+// DetachUplink turns the network into an isolated network while keeping its instances running.
+// The router's external port, the SNAT rules, the default routes and the addresses allocated on the uplink are
+// released, while the internal switch and its DHCP options are left untouched. Forwards and load balancers using
+// external listen addresses are disabled until an uplink is attached again.
+func (n *ovn) DetachUplink(ctx context.Context, clientType request.ClientType) error {
+	if n.config["network"] == "none" {
+		return api.StatusErrorf(http.StatusBadRequest, "Network doesn't have an uplink")
+	}
 
-			dnsIPs = append(dnsIPs, ip)
-		}
+	newNetwork := api.NetworkPut{
+		Description: n.description,
+		Config:      maps.Clone(n.config),
 	}
 
-	// Populate DNS IP variables with any static IPs first before checking if we need to extract dynamic IPs.
-	for _, staticIP := range []string{ipv4, ipv6} {
-		if staticIP == "" || staticIP == "none" {
-			continue
-		}
+	newNetwork.Config["network"] = "none"
 
-		checkAndStoreIP(net.ParseIP(staticIP))
+	err := n.Validate(ctx, newNetwork.Config)
+	if err != nil {
+		return api.StatusErrorf(http.StatusBadRequest, "Network can't be isolated: %v", err)
 	}
 
-	// Apply device specific external address if any.
-	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
-		// Check if the address is present.
-		value := opts.DeviceConfig[fmt.Sprintf("%s.address.external", keyPrefix)]
-		if value == "" {
-			continue
-		}
+	return n.Update(newNetwork, "", clientType)
+}
 
-		// Check if the family is configured.
-		if keyPrefix == "ipv4" && ipv4 == "" {
+// ovnReplicatedConfig returns the part of the network config that is mirrored to a standby network.
+func ovnReplicatedConfig(config map[string]string) map[string]string {
+	replicatedConfig := make(map[string]string, len(config))
+	for k, v := range config {
+		if strings.HasPrefix(k, "volatile.") || strings.HasPrefix(k, "replication.") {
 			continue
 		}
 
-		if keyPrefix == "ipv6" && ipv6 == "" {
-			continue
-		}
+		replicatedConfig[k] = v
+	}
 
-		// Parse the internal address.
-		var intNet *net.IPNet
-		if keyPrefix == "ipv4" {
-			_, intNet, err = net.ParseCIDR(fmt.Sprintf("%s/32", ipv4))
-			if err != nil {
-				return "", nil, fmt.Errorf("Invalid internal address %q: %w", ipv4, err)
-			}
-		} else {
-			_, intNet, err = net.ParseCIDR(fmt.Sprintf("%s/128", ipv6))
-			if err != nil {
-				return "", nil, fmt.Errorf("Invalid internal address %q: %w", ipv6, err)
-			}
-		}
+	return replicatedConfig
+}
 
-		// Parse the external address.
-		extIP := net.ParseIP(value)
-		if extIP == nil {
-			return "", nil, fmt.Errorf("Invalid external address %q", value)
-		}
+// replicationVersionUpdate maintains the replicated configuration version in the new network config.
+// When replicating to a standby network, the version is increased whenever the replicated configuration changes.
+// On a standby network, configuration older than the current one is rejected.
+func (n *ovn) replicationVersionUpdate(newNetwork api.NetworkPut) error {
+	currentVersion, _ := strconv.ParseUint(n.config[ovnVolatileReplication], 10, 32)
 
-		if err := n.ovnnb.CreateLogicalRouterNAT(
-			context.TODO(),
-			n.getRouterName(),
-			"snat",
-			intNet,
-			extIP,
-			nil,
-			false,
-			true,
-		); err != nil {
-			return "", nil, fmt.Errorf("Failed to add SNAT %q: %w", value, err)
-		}
+	newVersion, err := strconv.ParseUint(newNetwork.Config[ovnVolatileReplication], 10, 32)
+	if err != nil && newNetwork.Config[ovnVolatileReplication] != "" {
+		return fmt.Errorf("Invalid %q value: %w", ovnVolatileReplication, err)
+	}
 
-		reverter.Add(func() {
-			_ = n.ovnnb.DeleteLogicalRouterNAT(
-				context.TODO(),
-				n.getRouterName(),
-				"snat",
-				false,
-				extIP,
-			)
-		})
+	if util.IsTrue(n.config["replication.standby"]) && newVersion < currentVersion {
+		return api.StatusErrorf(http.StatusConflict, "Replicated configuration version %d is older than the current version %d", newVersion, currentVersion)
 	}
 
-	// Get dynamic IPs for switch port if any IPs not assigned statically.
-	if (ipv4 != "none" && dnsIPv4 == nil) || (ipv6 != "none" && dnsIPv6 == nil) {
-		var dynamicIPs []net.IP
+	if newNetwork.Config["replication.target.address"] == "" {
+		return nil
+	}
 
-		// Retry a few times in case port has not yet allocated dynamic IPs.
-		for range 40 {
-			dynamicIPs, err = n.ovnnb.GetLogicalSwitchPortDynamicIPs(context.TODO(), instancePortName)
-			if err == nil {
-				if len(dynamicIPs) > 0 {
-					break
-				}
-			} else if !errors.Is(err, ovsClient.ErrNotFound) {
-				return "", nil, err
-			}
+	if newVersion == 0 || newNetwork.Description != n.description || !maps.Equal(ovnReplicatedConfig(n.config), ovnReplicatedConfig(newNetwork.Config)) {
+		newNetwork.Config[ovnVolatileReplication] = strconv.FormatUint(newVersion+1, 10)
+	}
 
-			time.Sleep(250 * time.Millisecond)
-		}
+	return nil
+}
 
-		for _, dynamicIP := range dynamicIPs {
-			// Try and find the first IPv4 and IPv6 addresses from the dynamic address list.
-			checkAndStoreIP(dynamicIP)
-		}
+// Replicate mirrors the logical configuration of the network to its standby network on another cluster.
+// The standby network is created if missing and only updated when its configuration version differs.
+func (n *ovn) Replicate() (err error) {
+	defer func() { err = ovnStatusError(err) }()
 
-		// Check, after considering all dynamic IPs, whether we have got the required ones.
-		if (dnsIPv4 == nil && dhcpv4Subnet != nil) || (dnsIPv6 == nil && dhcpv6Subnet != nil) {
-			return "", nil, errors.New("Insufficient dynamic addresses allocated")
-		}
+	if n.config["replication.target.address"] == "" {
+		return nil
 	}
 
-	dnsName := fmt.Sprintf("%s.%s", opts.DNSName, n.getDomainName())
-	dnsUUID, err := n.ovnnb.UpdateLogicalSwitchPortDNS(context.TODO(), n.getIntSwitchName(), instancePortName, dnsName, dnsIPs)
-	if err != nil {
-		return "", nil, fmt.Errorf("Failed setting DNS for %q: %w", dnsName, err)
+	targetProject := n.config["replication.target.project"]
+	if targetProject == "" {
+		targetProject = n.project
 	}
 
-	reverter.Add(func() {
-		_ = n.ovnnb.DeleteLogicalSwitchPortDNS(context.TODO(), n.getIntSwitchName(), dnsUUID, false)
-	})
+	targetNetwork := n.config["replication.target.network"]
+	if targetNetwork == "" {
+		targetNetwork = n.name
+	}
 
-	// If NIC has static IPv4 address then ensure a DHCPv4 reservation exists.
-	// Do this at start time as well as add time in case an instance was copied (causing a duplicate address
-	// conflict at add time) which is later resolved by deleting the original instance, meaning a reservation needs to
-	// be added when the copied instance next starts.
-	if opts.DeviceConfig["ipv4.address"] != "" && dnsIPv4 != nil {
-		if !n.hasDHCPv4Reservation(dhcpReservations, dnsIPv4) {
-			dhcpReservations = append(dhcpReservations, iprange.Range{Start: dnsIPv4})
-			err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName(), dhcpReservations)
-			if err != nil {
-				return "", nil, fmt.Errorf("Failed adding DHCPv4 reservation for %q: %w", dnsIPv4.String(), err)
-			}
-		}
+	// Connect to the standby cluster using the dedicated replication identity.
+	clientCert, clientKey := n.state.GlobalConfig.NetworkReplicationTLS()
+	if clientCert == "" || clientKey == "" {
+		return fmt.Errorf("No replication client certificate configured (%q and %q)", "network.replication.client_cert", "network.replication.client_key")
 	}
 
-	// Publish NIC's IPs on uplink network if NAT is disabled and using l2proxy ingress mode on uplink.
-	if slices.Contains([]string{"l2proxy", ""}, opts.UplinkConfig["ovn.ingress_mode"]) {
-		for _, k := range []string{"ipv4.nat", "ipv6.nat"} {
-			if util.IsTrue(n.config[k]) {
-				continue
-			}
+	args := &incus.ConnectionArgs{
+		TLSClientCert: clientCert,
+		TLSClientKey:  clientKey,
+		TLSServerCert: n.config["replication.target.certificate"],
+		UserAgent:     version.UserAgent,
+		SkipGetEvents: true,
+		SkipGetServer: true,
+	}
 
-			// Select the correct destination IP from the DNS records.
-			var ip net.IP
-			if k == "ipv4.nat" {
-				ip = dnsIPv4
-			} else if k == "ipv6.nat" {
-				ip = dnsIPv6
-			}
+	client, err := incus.ConnectIncus(n.config["replication.target.address"], args)
+	if err != nil {
+		return fmt.Errorf("Failed connecting to standby cluster %q: %w", n.config["replication.target.address"], err)
+	}
 
-			if ip == nil {
-				continue // No qualifying target IP from DNS records.
-			}
+	defer client.Disconnect()
 
-			err = n.ovnnb.CreateLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", nil, ip, ip, true, true)
-			if err != nil {
-				return "", nil, err
-			}
+	client = client.UseProject(targetProject)
 
-			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", false, ip)
-			})
+	config := ovnReplicatedConfig(n.config)
+	config[ovnVolatileReplication] = n.config[ovnVolatileReplication]
+
+	standby, etag, err := client.GetNetwork(targetNetwork)
+	if err != nil {
+		if !api.StatusErrorCheck(err, http.StatusNotFound) {
+			return fmt.Errorf("Failed getting standby network %q: %w", targetNetwork, err)
+		}
+
+		config["replication.standby"] = "true"
+
+		err = client.CreateNetwork(api.NetworksPost{
+			Name: targetNetwork,
+			Type: n.Type(),
+			NetworkPut: api.NetworkPut{
+				Description: n.description,
+				Config:      config,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Failed creating standby network %q: %w", targetNetwork, err)
 		}
+
+		n.logger.Info("Created standby network", logger.Ctx{"target": n.config["replication.target.address"], "project": targetProject, "network": targetNetwork, "version": config[ovnVolatileReplication]})
+
+		return nil
 	}
 
-	var routes []networkOVN.OVNRouterRoute
+	if standby.Type != n.Type() {
+		return fmt.Errorf("Standby network %q isn't of type %q", targetNetwork, n.Type())
+	}
 
-	// In l3only mode we add the instance port's IPs as static routes to the router.
-	if util.IsTrue(n.config["ipv4.l3only"]) && dnsIPv4 != nil {
-		ipNet := IPToNet(dnsIPv4)
-		internalRoutes = append(internalRoutes, &ipNet)
+	// Never overwrite a network which has been activated.
+	if util.IsFalseOrEmpty(standby.Config["replication.standby"]) {
+		return api.StatusErrorf(http.StatusConflict, "Network %q on the standby cluster isn't a standby network", targetNetwork)
 	}
 
-	if util.IsTrue(n.config["ipv6.l3only"]) && dnsIPv6 != nil {
-		ipNet := IPToNet(dnsIPv6)
-		internalRoutes = append(internalRoutes, &ipNet)
+	if standby.Config[ovnVolatileReplication] == config[ovnVolatileReplication] {
+		return nil // Already up to date.
 	}
 
-	// Add each internal route (using the IPs set for DNS as target).
-	for _, internalRoute := range internalRoutes {
-		targetIP := dnsIPv4
-		if internalRoute.IP.To4() == nil {
-			targetIP = dnsIPv6
+	// Keep the standby network's own replication settings and volatile keys.
+	for k, v := range standby.Config {
+		if k == ovnVolatileReplication {
+			continue
 		}
 
-		if targetIP == nil {
-			return "", nil, fmt.Errorf("Cannot add static route for %q as target IP is not set", internalRoute.String())
+		if strings.HasPrefix(k, "volatile.") || strings.HasPrefix(k, "replication.") {
+			config[k] = v
 		}
+	}
 
-		routes = append(routes, networkOVN.OVNRouterRoute{
-			Prefix:  *internalRoute,
-			NextHop: targetIP,
-			Port:    n.getRouterIntPortName(),
-		})
+	err = client.UpdateNetwork(targetNetwork, api.NetworkPut{Description: n.description, Config: config}, etag)
+	if err != nil {
+		return fmt.Errorf("Failed updating standby network %q: %w", targetNetwork, err)
 	}
 
-	// Add each external route (using the IPs set for DNS as target).
-	for _, externalRoute := range externalRoutes {
-		targetIP := dnsIPv4
-		if externalRoute.IP.To4() == nil {
-			targetIP = dnsIPv6
-		}
+	n.logger.Info("Updated standby network", logger.Ctx{"target": n.config["replication.target.address"], "project": targetProject, "network": targetNetwork, "version": config[ovnVolatileReplication]})
 
-		if targetIP == nil {
-			return "", nil, fmt.Errorf("Cannot add static route for %q as target IP is not set", externalRoute.String())
-		}
+	return nil
+}
 
-		routes = append(routes, networkOVN.OVNRouterRoute{
-			Prefix:  *externalRoute,
-			NextHop: targetIP,
-			Port:    n.getRouterIntPortName(),
-		})
+// getInstanceDevicePortName returns the switch port name to use for an instance device.
+func (n *ovn) getInstanceDevicePortName(instanceUUID string, deviceName string) networkOVN.OVNSwitchPort {
+	return networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s-%s", n.getIntSwitchInstancePortPrefix(), instanceUUID, deviceName))
+}
 
-		// When using l2proxy ingress mode on uplink, in order to advertise the external route to the
-		// uplink network using proxy ARP/NDP we need to add a stateless dnat_and_snat rule (as to my
-		// knowledge this is the only way to get the OVN router to respond to ARP/NDP requests for IPs that
-		// it doesn't actually have). However we have to add each IP in the external route individually as
-		// DNAT doesn't support whole subnets.
-		if slices.Contains([]string{"l2proxy", ""}, opts.UplinkConfig["ovn.ingress_mode"]) {
-			err = SubnetIterate(externalRoute, func(ip net.IP) error {
-				err = n.ovnnb.CreateLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", nil, ip, ip, true, true)
-				if err != nil {
-					return err
-				}
+// getExternalPortName returns the switch port name to use for an external port.
+func (n *ovn) getExternalPortName(portName string) networkOVN.OVNSwitchPort {
+	return networkOVN.OVNSwitchPort(fmt.Sprintf("%s-external-port-%s", n.getNetworkPrefix(), portName))
+}
 
-				reverter.Add(func() {
-					_ = n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", false, ip)
-				})
+// instanceDevicePortRoutesParse parses the instance NIC device config for internal routes and external routes.
+func (n *ovn) instanceDevicePortRoutesParse(deviceConfig map[string]string) ([]*net.IPNet, []*net.IPNet, error) {
+	var err error
 
-				return nil
-			})
-			if err != nil {
-				return "", nil, err
-			}
+	internalRoutes := []*net.IPNet{}
+	for _, key := range []string{"ipv4.routes", "ipv6.routes"} {
+		if deviceConfig[key] == "" {
+			continue
 		}
-	}
 
-	if len(routes) > 0 {
-		// Add routes to local router.
-		err = n.ovnnb.CreateLogicalRouterRoute(context.TODO(), n.getRouterName(), true, routes...)
+		internalRoutes, err = SubnetParseAppend(internalRoutes, util.SplitNTrimSpace(deviceConfig[key], ",", -1, false)...)
 		if err != nil {
-			return "", nil, err
+			return nil, nil, fmt.Errorf("Invalid %q value: %w", key, err)
 		}
+	}
 
-		routePrefixes := make([]net.IPNet, 0, len(routes))
-		for _, route := range routes {
-			routePrefixes = append(routePrefixes, route.Prefix)
+	externalRoutes := []*net.IPNet{}
+	for _, key := range []string{"ipv4.routes.external", "ipv6.routes.external"} {
+		if deviceConfig[key] == "" {
+			continue
 		}
 
-		reverter.Add(func() {
-			_ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), routePrefixes...)
-		})
-
-		// Add routes to internal switch's address set for ACL usage.
-		err = n.ovnnb.UpdateAddressSetAdd(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), routePrefixes...)
+		externalRoutes, err = SubnetParseAppend(externalRoutes, util.SplitNTrimSpace(deviceConfig[key], ",", -1, false)...)
 		if err != nil {
-			return "", nil, fmt.Errorf("Failed adding switch address set entries: %w", err)
+			return nil, nil, fmt.Errorf("Invalid %q value: %w", key, err)
 		}
+	}
 
-		reverter.Add(func() {
-			_ = n.ovnnb.UpdateAddressSetRemove(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), routePrefixes...)
-		})
+	return internalRoutes, externalRoutes, nil
+}
 
-		routerIntPortIPv4, _, err := n.parseRouterIntPortIPv4Net()
-		if err != nil {
-			return "", nil, fmt.Errorf("Failed parsing local router's peering port IPv4 Net: %w", err)
+// instanceDevicePortNeighborsParse parses the ipv4.neighbors and ipv6.neighbors settings of the NIC device config.
+// Entries without a MAC address use the supplied NIC MAC address.
+func (n *ovn) instanceDevicePortNeighborsParse(deviceConfig map[string]string, mac net.HardwareAddr) ([]StaticNeighbor, error) {
+	neighbors := []StaticNeighbor{}
+	for _, key := range []string{"ipv4.neighbors", "ipv6.neighbors"} {
+		if deviceConfig[key] == "" {
+			continue
 		}
 
-		routerIntPortIPv6, _, err := n.parseRouterIntPortIPv6Net()
+		keyNeighbors, err := StaticNeighborsParse(deviceConfig[key])
 		if err != nil {
-			return "", nil, fmt.Errorf("Failed parsing local router's peering port IPv6 Net: %w", err)
+			return nil, fmt.Errorf("Invalid %q value: %w", key, err)
 		}
 
-		// Add routes to peer routers, and security policies for each peer port on local router.
-		err = n.forPeers(func(targetOVNNet *ovn) error {
-			targetRouterName := targetOVNNet.getRouterName()
-			targetRouterPort := targetOVNNet.getLogicalRouterPeerPortName(n.ID())
-			targetRouterRoutes := make([]networkOVN.OVNRouterRoute, 0, len(routes))
-			for _, route := range routes {
-				nexthop := routerIntPortIPv4
-				if route.Prefix.IP.To4() == nil {
-					nexthop = routerIntPortIPv6
-				}
-
-				if nexthop == nil {
-					continue // Skip routes that cannot be supported by local router.
-				}
-
-				targetRouterRoutes = append(targetRouterRoutes, networkOVN.OVNRouterRoute{
-					Prefix:  route.Prefix,
-					NextHop: nexthop,
-					Port:    targetRouterPort,
-				})
-			}
-
-			err = n.ovnnb.CreateLogicalRouterRoute(context.TODO(), targetRouterName, true, targetRouterRoutes...)
-			if err != nil {
-				return fmt.Errorf("Failed adding static routes to peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+		for _, neighbor := range keyNeighbors {
+			if neighbor.MAC == nil {
+				neighbor.MAC = mac
 			}
 
-			reverter.Add(func() { _ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), targetRouterName, routePrefixes...) })
-
-			return nil
-		})
-		if err != nil {
-			return "", nil, err
+			neighbors = append(neighbors, neighbor)
 		}
 	}
 
-	// Merge network and NIC assigned security ACL lists.
-	netACLNames := util.SplitNTrimSpace(n.config["security.acls"], ",", -1, true)
-	nicACLNames := util.SplitNTrimSpace(opts.DeviceConfig["security.acls"], ",", -1, true)
+	return neighbors, nil
+}
 
-	for _, aclName := range netACLNames {
-		if !slices.Contains(nicACLNames, aclName) {
-			nicACLNames = append(nicACLNames, aclName)
-		}
+// staticNeighborsApply installs static MAC bindings on the internal router port for the neighbors owned by the
+// logical switch port. Bindings may be shared between ports (e.g. a VRRP VIP) as long as their MAC addresses match.
+func (n *ovn) staticNeighborsApply(ctx context.Context, portName networkOVN.OVNSwitchPort, neighbors []StaticNeighbor) error {
+	existingBindings, err := n.ovnnb.GetStaticMACBindings(ctx, n.getRouterIntPortName())
+	if err != nil {
+		return fmt.Errorf("Failed getting static MAC bindings: %w", err)
 	}
 
-	// Apply Security ACL port group settings.
-	addChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
-	removeChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
-
-	// Get logical port UUID.
-	portUUID, err := n.ovnnb.GetLogicalSwitchPortUUID(context.TODO(), instancePortName)
-	if err != nil || portUUID == "" {
-		return "", nil, fmt.Errorf("Failed getting logical port UUID for security ACL removal: %w", err)
+	portNeighbors, err := n.ovnnb.GetLogicalSwitchNeighbors(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting static neighbors: %w", err)
 	}
 
-	// Add NIC port to network port group (this includes the port in the @internal subject for ACL rules).
-	acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, acl.OVNIntSwitchPortGroupName(n.ID()))
-	n.logger.Debug("Scheduled logical port for network port group addition", logger.Ctx{"portGroup": acl.OVNIntSwitchPortGroupName(n.ID()), "port": instancePortName})
-
-	if len(nicACLNames) > 0 || len(securityACLsRemove) > 0 {
-		var aclNameIDs map[string]int64
-
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Get map of ACL names to DB IDs (used for generating OVN port group names).
-			acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &n.project})
-			if err != nil {
-				return err
-			}
-
-			aclNameIDs = make(map[string]int64, len(acls))
-			for _, acl := range acls {
-				aclNameIDs[acl.Name] = int64(acl.ID)
+	for _, neighbor := range neighbors {
+		existingMAC, found := existingBindings[neighbor.IP.String()]
+		if found && existingMAC.String() != neighbor.MAC.String() {
+			for otherPortName, otherIPs := range portNeighbors {
+				if otherPortName != portName && IPInSlice(neighbor.IP, otherIPs) {
+					return api.StatusErrorf(http.StatusConflict, "Static neighbor %q is already in use with MAC address %q", neighbor.IP.String(), existingMAC.String())
+				}
 			}
+		}
 
-			return nil
-		})
+		err = n.ovnnb.CreateStaticMACBinding(ctx, n.getRouterIntPortName(), neighbor.IP, neighbor.MAC, true)
 		if err != nil {
-			return "", nil, fmt.Errorf("Failed getting network ACL IDs for security ACL setup: %w", err)
+			return fmt.Errorf("Failed adding static neighbor %q: %w", neighbor.IP.String(), err)
 		}
+	}
 
-		// Add port to ACLs requested.
-		if len(nicACLNames) > 0 {
-			// Request our network is setup with the specified ACLs.
-			aclNets := map[string]acl.NetworkACLUsage{
-				n.Name(): {Name: n.Name(), Type: n.Type(), ID: n.ID(), Config: n.Config()},
-			}
+	return nil
+}
 
-			cleanup, err := addressset.OVNEnsureAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), nicACLNames)
-			if err != nil {
-				return "", nil, fmt.Errorf("Failed ensuring address sets for nic ACLs are configured in OVN for network: %w", err)
-			}
+// staticNeighborsRemove removes the static MAC bindings from the internal router port for the specified IPs,
+// unless they are still owned by a logical switch port other than the one specified.
+func (n *ovn) staticNeighborsRemove(ctx context.Context, portName networkOVN.OVNSwitchPort, ips []net.IP) error {
+	if len(ips) == 0 {
+		return nil
+	}
 
-			reverter.Add(cleanup)
-			cleanup, err = acl.OVNEnsureACLs(n.state, n.logger, n.ovnnb, n.Project(), aclNameIDs, aclNets, nicACLNames, false)
-			if err != nil {
-				return "", nil, fmt.Errorf("Failed ensuring security ACLs are configured in OVN for instance: %w", err)
+	portNeighbors, err := n.ovnnb.GetLogicalSwitchNeighbors(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting static neighbors: %w", err)
+	}
+
+	removeIPs := make([]net.IP, 0, len(ips))
+	for _, ip := range ips {
+		inUse := false
+		for otherPortName, otherIPs := range portNeighbors {
+			if otherPortName != portName && IPInSlice(ip, otherIPs) {
+				inUse = true
+				break
 			}
+		}
 
-			reverter.Add(cleanup)
+		if !inUse {
+			removeIPs = append(removeIPs, ip)
+		}
+	}
 
-			for _, aclName := range nicACLNames {
-				aclID, found := aclNameIDs[aclName]
-				if !found {
-					return "", nil, fmt.Errorf("Cannot find security ACL ID for %q", aclName)
-				}
+	err = n.ovnnb.DeleteStaticMACBinding(ctx, n.getRouterIntPortName(), removeIPs...)
+	if err != nil {
+		return fmt.Errorf("Failed removing static neighbors: %w", err)
+	}
 
-				// Add NIC port to ACL port group.
-				portGroupName := acl.OVNACLPortGroupName(aclID)
-				acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, portGroupName)
-				n.logger.Debug("Scheduled logical port for ACL port group addition", logger.Ctx{"networkACL": aclName, "portGroup": portGroupName, "port": instancePortName})
-			}
-		}
+	return nil
+}
 
-		// Remove port from ACLs requested.
-		for _, aclName := range securityACLsRemove {
-			// Don't remove ACLs that are in the add ACLs list (there are possibly added from
-			// the network assigned ACLs).
-			if slices.Contains(nicACLNames, aclName) {
-				continue
-			}
+// ovnFailoverAddress represents a floating address held by one of a set of instances of the network.
+type ovnFailoverAddress struct {
+	name      string
+	address   net.IP
+	instances []string
+}
 
-			aclID, found := aclNameIDs[aclName]
-			if !found {
-				return "", nil, fmt.Errorf("Cannot find security ACL ID for %q", aclName)
-			}
+// failoverAddresses returns the failover addresses defined in the network config, sorted by name.
+func (n *ovn) failoverAddresses(config map[string]string) []ovnFailoverAddress {
+	names := []string{}
+	for k := range config {
+		suffix, found := strings.CutPrefix(k, "failover.")
+		if !found {
+			continue
+		}
 
-			// Remove NIC port from ACL port group.
-			portGroupName := acl.OVNACLPortGroupName(aclID)
-			acl.OVNPortGroupInstanceNICSchedule(portUUID, removeChangeSet, portGroupName)
-			n.logger.Debug("Scheduled logical port for ACL port group removal", logger.Ctx{"networkACL": aclName, "portGroup": portGroupName, "port": instancePortName})
+		name, _, _ := strings.Cut(suffix, ".")
+		if !slices.Contains(names, name) {
+			names = append(names, name)
 		}
 	}
 
-	// Add instance NIC switch port to port groups required. Always run this as the addChangeSet should always
-	// be populated even if no ACLs being applied, because the NIC port needs to be added to the network level
-	// port group.
-	n.logger.Debug("Applying instance NIC port group member change sets")
-	err = n.ovnnb.UpdatePortGroupMembers(context.TODO(), addChangeSet, removeChangeSet)
-	if err != nil {
-		return "", nil, fmt.Errorf("Failed applying OVN port group member change sets for instance NIC: %w", err)
+	slices.Sort(names)
+
+	failovers := make([]ovnFailoverAddress, 0, len(names))
+	for _, name := range names {
+		failovers = append(failovers, ovnFailoverAddress{
+			name:      name,
+			address:   net.ParseIP(config[fmt.Sprintf("failover.%s.address", name)]),
+			instances: util.SplitNTrimSpace(config[fmt.Sprintf("failover.%s.instances", name)], ",", -1, true),
+		})
 	}
 
-	// Set the automatic default ACL rule for the port.
-	if len(nicACLNames) > 0 {
-		ingressAction, ingressLogged := n.instanceDeviceACLDefaults(opts.DeviceConfig, "ingress")
-		egressAction, egressLogged := n.instanceDeviceACLDefaults(opts.DeviceConfig, "egress")
+	return failovers
+}
 
-		logPrefix := fmt.Sprintf("%s-%s", opts.InstanceUUID, opts.DeviceName)
-		err = acl.OVNApplyInstanceNICDefaultRules(n.ovnnb, acl.OVNIntSwitchPortGroupName(n.ID()), logPrefix, instancePortName, ingressAction, ingressLogged, egressAction, egressLogged)
-		if err != nil {
-			return "", nil, fmt.Errorf("Failed applying OVN default ACL rules for instance NIC: %w", err)
+// ovnSNATKeysChanged returns whether any of the changed config keys affects the router SNAT rules.
+func ovnSNATKeysChanged(changedKeys []string) bool {
+	return slices.ContainsFunc(changedKeys, func(k string) bool {
+		if slices.Contains([]string{"network", "ipv4.address", "ipv6.address", ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6}, k) {
+			return true
 		}
 
-		n.logger.Debug("Set NIC default rule", logger.Ctx{"port": instancePortName, "ingressAction": ingressAction, "ingressLogged": ingressLogged, "egressAction": egressAction, "egressLogged": egressLogged})
-	} else {
-		err = n.ovnnb.ClearPortGroupPortACLRules(context.TODO(), acl.OVNIntSwitchPortGroupName(n.ID()), instancePortName)
-		if err != nil {
-			return "", nil, fmt.Errorf("Failed clearing OVN default ACL rules for instance NIC: %w", err)
+		return strings.HasPrefix(k, "ipv4.nat") || strings.HasPrefix(k, "ipv6.nat") || strings.HasPrefix(k, "nat.policy.")
+	})
+}
+
+// routerSNATSetup adds the SNAT rules translating outbound traffic from intNet to snatIP on the network's router.
+// Traffic towards the destinations of a NAT policy of the same address family is translated to the policy address
+// and traffic towards the excluded destinations isn't translated at all.
+// These rules stay stateful even with security.stateless as they need connection tracking to map the replies of
+// the many internal addresses sharing the external address.
+func (n *ovn) routerSNATSetup(ctx context.Context, intNet *net.IPNet, snatIP net.IP, mayExist bool) error {
+	keyPrefix := "ipv6"
+	if snatIP.To4() != nil {
+		keyPrefix = "ipv4"
+	}
+
+	excludedDestinations := n.natExcludedDestinations(n.config, keyPrefix)
+
+	policyDestinations := []net.IPNet{}
+	for i, policy := range n.natPolicies(n.config) {
+		if policy.address == nil || (policy.address.To4() == nil) != (snatIP.To4() == nil) {
+			continue
 		}
 
-		err := addressset.OVNAddressSetsDeleteIfUnused(n.state, n.logger, n.ovnnb, n.Project())
+		addressSetPrefix := networkOVN.OVNAddressSet(fmt.Sprintf("incus_net%d_snat%d", n.ID(), i))
+		err := n.ovnnb.CreateLogicalRouterSelectiveSNAT(ctx, n.getRouterName(), intNet, policy.address, addressSetPrefix, policy.destinations, false)
 		if err != nil {
-			return "", nil, fmt.Errorf("Failed removing unused OVN address sets: %w", err)
+			return fmt.Errorf("Failed adding SNAT rule for NAT policy %q: %w", policy.name, err)
 		}
 
-		n.logger.Debug("Cleared NIC default rule", logger.Ctx{"port": instancePortName})
+		policyDestinations = append(policyDestinations, policy.destinations...)
 	}
 
-	reverter.Success()
-	return instancePortName, dnsIPs, nil
-}
-
-// instanceDeviceACLDefaults returns the action and logging mode to use for the specified direction's default rule.
-// If the security.acls.default.{in,e}gress.action or security.acls.default.{in,e}gress.logged settings are not
-// specified in the NIC device config, then the settings on the network are used, and if not specified there then
-// it returns "reject" and false respectively.
-func (n *ovn) instanceDeviceACLDefaults(deviceConfig deviceConfig.Device, direction string) (string, bool) {
-	defaults := map[string]string{
-		fmt.Sprintf("security.acls.default.%s.action", direction): "reject",
-		fmt.Sprintf("security.acls.default.%s.logged", direction): "false",
+	if len(policyDestinations) == 0 && len(excludedDestinations) == 0 {
+		return n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "snat", intNet, snatIP, nil, false, mayExist)
 	}
 
-	for k := range defaults {
-		if deviceConfig[k] != "" {
-			defaults[k] = deviceConfig[k]
-		} else if n.config[k] != "" {
-			defaults[k] = n.config[k]
+	// The default rule must leave the policy and excluded destinations alone.
+	addressSetPrefix := networkOVN.OVNAddressSet(fmt.Sprintf("incus_net%d_snat", n.ID()))
+	return n.ovnnb.CreateLogicalRouterSelectiveSNAT(ctx, n.getRouterName(), intNet, snatIP, addressSetPrefix, append(policyDestinations, excludedDestinations...), true)
+}
+
+// natExcludedDestinations returns the destinations outbound traffic of the address family isn't NATed for.
+// Destinations which fail to parse are skipped.
+func (n *ovn) natExcludedDestinations(config map[string]string, keyPrefix string) []net.IPNet {
+	destinations := []net.IPNet{}
+	for _, value := range util.SplitNTrimSpace(config[fmt.Sprintf("%s.nat.exclude.destinations", keyPrefix)], ",", -1, true) {
+		_, destination, err := net.ParseCIDR(value)
+		if err != nil {
+			continue
 		}
+
+		destinations = append(destinations, *destination)
 	}
 
-	return defaults[fmt.Sprintf("security.acls.default.%s.action", direction)], util.IsTrue(defaults[fmt.Sprintf("security.acls.default.%s.logged", direction)])
+	return destinations
 }
 
-// InstanceDevicePortIPs returns the allocated IPs for a device port.
-func (n *ovn) InstanceDevicePortIPs(instanceUUID string, deviceName string) ([]net.IP, error) {
-	if instanceUUID == "" {
-		return nil, errors.New("Instance UUID is required")
-	}
+// ovnNATPolicy represents a NAT policy defined in the network config.
+type ovnNATPolicy struct {
+	name         string
+	address      net.IP
+	destinations []net.IPNet
+}
 
-	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+// natPolicies returns the NAT policies defined in the network config, sorted by name.
+// Destinations which fail to parse are skipped.
+func (n *ovn) natPolicies(config map[string]string) []ovnNATPolicy {
+	names := []string{}
+	for k := range config {
+		suffix, found := strings.CutPrefix(k, "nat.policy.")
+		if !found {
+			continue
+		}
 
-	devIPs, err := n.ovnnb.GetLogicalSwitchPortIPs(context.TODO(), instancePortName)
-	if err != nil {
-		return nil, fmt.Errorf("Failed to get OVN switch port IPs: %w", err)
+		name, _, _ := strings.Cut(suffix, ".")
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
 	}
 
-	return devIPs, nil
-}
+	slices.Sort(names)
 
-// InstanceDevicePortStop deletes an instance device port from the internal logical switch.
-func (n *ovn) InstanceDevicePortStop(ovsExternalOVNPort networkOVN.OVNSwitchPort, opts *OVNInstanceNICStopOpts) error {
-	// Decide whether to use OVS provided OVN port name or internally derived OVN port name.
-	instancePortName := ovsExternalOVNPort
-	source := "OVS"
-	if ovsExternalOVNPort == "" {
-		if opts.InstanceUUID == "" {
-			return errors.New("Instance UUID is required")
+	policies := make([]ovnNATPolicy, 0, len(names))
+	for _, name := range names {
+		policy := ovnNATPolicy{
+			name:    name,
+			address: net.ParseIP(config[fmt.Sprintf("nat.policy.%s.address", name)]),
 		}
 
-		instancePortName = n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceName)
-		source = "internal"
-	}
+		for _, value := range util.SplitNTrimSpace(config[fmt.Sprintf("nat.policy.%s.destinations", name)], ",", -1, true) {
+			_, destination, err := net.ParseCIDR(value)
+			if err != nil {
+				continue
+			}
 
-	portLocation, err := n.ovnnb.GetLogicalSwitchPortLocation(context.TODO(), instancePortName)
-	if err != nil {
-		return fmt.Errorf("Failed getting instance switch port options: %w", err)
-	}
+			policy.destinations = append(policy.destinations, *destination)
+		}
 
-	// Don't delete logical switch port if already active on another chassis (i.e during live cluster move).
-	if portLocation != "" && portLocation != n.state.ServerName {
-		return nil
+		policies = append(policies, policy)
 	}
 
-	n.logger.Debug("Deleting instance port", logger.Ctx{"port": instancePortName, "source": source})
+	return policies
+}
 
-	internalRoutes, externalRoutes, err := n.instanceDevicePortRoutesParse(opts.DeviceConfig)
-	if err != nil {
-		return fmt.Errorf("Failed parsing NIC device routes: %w", err)
+// failoverActiveInstances returns the instance holding each failover address (keyed by failover name) along with
+// the failover addresses to assign to each logical switch port. A failover address is held by the first of its
+// instances whose NIC on the network is up, using the first NIC of instances having several.
+func (n *ovn) failoverActiveInstances(ctx context.Context) (map[string]string, map[networkOVN.OVNSwitchPort][]net.IP, error) {
+	activeInstances := map[string]string{}
+	portAddresses := map[networkOVN.OVNSwitchPort][]net.IP{}
+
+	failovers := n.failoverAddresses(n.config)
+	if len(failovers) == 0 {
+		return activeInstances, portAddresses, nil
 	}
 
-	var uplink *api.Network
+	instanceNICs := map[string]string{}
+	instancePorts := map[string]networkOVN.OVNSwitchPort{}
+	err := UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		if inst.Project != n.Project() {
+			return nil
+		}
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Load uplink network config.
-		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+		existingNIC, found := instanceNICs[inst.Name]
+		if found && existingNIC < nicName {
+			return nil
+		}
 
-		return err
+		instanceNICs[inst.Name] = nicName
+		instancePorts[inst.Name] = n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+
+		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+		return nil, nil, fmt.Errorf("Failed getting instance NICs: %w", err)
 	}
 
-	// Get DNS records.
-	dnsUUID, _, dnsIPs, err := n.ovnnb.GetLogicalSwitchPortDNS(context.TODO(), instancePortName)
+	boundPorts, err := n.ovnsb.GetBoundLogicalSwitchPorts(ctx, slices.Collect(maps.Values(instancePorts))...)
 	if err != nil {
-		return err
+		return nil, nil, fmt.Errorf("Failed getting bound ports: %w", err)
 	}
 
-	// Cleanup logical switch port and associated config.
-	err = n.ovnnb.CleanupLogicalSwitchPort(context.TODO(), instancePortName, n.getIntSwitchName(), acl.OVNIntSwitchPortGroupName(n.ID()), dnsUUID)
-	if err != nil {
-		return err
-	}
+	for _, failover := range failovers {
+		for _, instanceName := range failover.instances {
+			portName, found := instancePorts[instanceName]
+			if !found || !boundPorts[portName] {
+				continue
+			}
 
-	var removeRoutes []net.IPNet
-	var removeNATIPs []net.IP
+			activeInstances[failover.name] = instanceName
+			portAddresses[portName] = append(portAddresses[portName], failover.address)
 
-	if len(dnsIPs) > 0 {
-		// When using l3only mode the instance port's IPs are added as static routes to the router.
-		// So try and remove these in case l3only is (or was) being used.
-		for _, dnsIP := range dnsIPs {
-			removeRoutes = append(removeRoutes, IPToNet(dnsIP))
+			break
 		}
+	}
 
-		// Delete any associated external IP DNAT rules for the DNS IPs.
-		removeNATIPs = append(removeNATIPs, dnsIPs...)
+	return activeInstances, portAddresses, nil
+}
+
+// failoverReconcile moves the failover addresses to the logical switch ports of the instances which should
+// currently hold them.
+func (n *ovn) failoverReconcile(ctx context.Context) error {
+	_, portAddresses, err := n.failoverActiveInstances(ctx)
+	if err != nil {
+		return err
 	}
 
-	// Delete internal routes.
-	if len(internalRoutes) > 0 {
-		for _, internalRoute := range internalRoutes {
-			removeRoutes = append(removeRoutes, *internalRoute)
+	err = n.ovnnb.UpdateLogicalSwitchFailoverAddresses(ctx, n.getIntSwitchName(), portAddresses)
+	if err != nil {
+		return fmt.Errorf("Failed updating failover addresses: %w", err)
+	}
+
+	return nil
+}
+
+// bgpNexthop returns the next-hop address used when advertising the network's prefixes over BGP.
+func (n *ovn) bgpNexthop(ipv4 bool) net.IP {
+	if ipv4 {
+		nexthop := net.ParseIP(n.config["bgp.ipv4.nexthop"])
+		if nexthop == nil {
+			nexthop = net.ParseIP(n.config[ovnVolatileUplinkIPv4])
 		}
+
+		return nexthop
 	}
 
-	// Delete external routes.
-	for _, externalRoute := range externalRoutes {
-		removeRoutes = append(removeRoutes, *externalRoute)
+	nexthop := net.ParseIP(n.config["bgp.ipv6.nexthop"])
+	if nexthop == nil {
+		nexthop = net.ParseIP(n.config[ovnVolatileUplinkIPv6])
+	}
 
-		// Remove the DNAT rules when using l2proxy ingress mode on uplink.
-		if slices.Contains([]string{"l2proxy", ""}, uplink.Config["ovn.ingress_mode"]) {
-			err = SubnetIterate(externalRoute, func(ip net.IP) error {
-				removeNATIPs = append(removeNATIPs, ip)
+	return nexthop
+}
 
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+// InstanceDevicePortValidateExternalRoutes validates the external routes for an OVN instance port.
+func (n *ovn) InstanceDevicePortValidateExternalRoutes(ctx context.Context, deviceInstance instance.Instance, deviceName string, portExternalRoutes []*net.IPNet) error {
+	if n.config["network"] == "none" {
+		return nil
+	}
+
+	var p *api.Project
+	var uplink *api.Network
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		// Get uplink routes.
+		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+	}
+
+	// Check the network has an address in each route's family, as the NIC's address is used as the next-hop.
+	for _, portExternalRoute := range portExternalRoutes {
+		addressKey := "ipv4.address"
+		if portExternalRoute.IP.To4() == nil {
+			addressKey = "ipv6.address"
+		}
+
+		if validate.IsOneOf("", "none")(n.config[addressKey]) == nil {
+			return fmt.Errorf("External route %q requires %q to be set on the network", portExternalRoute.String(), addressKey)
 		}
 	}
 
-	if len(removeRoutes) > 0 {
-		// Delete routes from local router.
-		err = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), removeRoutes...)
-		if err != nil {
-			return err
+	// Check port's external routes are sufficiently small when using l2proxy ingress mode on uplink.
+	// Each address has to be individually proxied unless the uplink aggregates them on the router port, routed
+	// ingress mode doesn't have this limitation either and the routes are instead advertised over BGP.
+	if slices.Contains([]string{"l2proxy", ""}, uplink.Config["ovn.ingress_mode"]) && util.IsFalseOrEmpty(uplink.Config["ovn.l2proxy.aggregate"]) {
+		for _, portExternalRoute := range portExternalRoutes {
+			rOnes, rBits := portExternalRoute.Mask.Size()
+			if rBits > 32 && rOnes < 122 {
+				return fmt.Errorf(`External route %q is too large. Maximum size for IPv6 external route is /122 unless the uplink uses "ovn.ingress_mode=routed" or "ovn.l2proxy.aggregate"`, portExternalRoute.String())
+			} else if rOnes < 26 {
+				return fmt.Errorf(`External route %q is too large. Maximum size for IPv4 external route is /26 unless the uplink uses "ovn.ingress_mode=routed" or "ovn.l2proxy.aggregate"`, portExternalRoute.String())
+			}
 		}
+	}
 
-		// Delete routes from switch address set.
-		err = n.ovnnb.UpdateAddressSetRemove(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), removeRoutes...)
+	// With routed ingress, the routes are advertised with the network's uplink address as the next-hop, so
+	// check they can be originated by it.
+	if uplink.Config["ovn.ingress_mode"] == "routed" {
+		uplinkRoutes, err := n.uplinkRoutes(uplink)
 		if err != nil {
-			return fmt.Errorf("Failed deleting switch address set entries: %w", err)
+			return err
 		}
 
-		// Delete routes from peer routers.
-		err = n.forPeers(func(targetOVNNet *ovn) error {
-			targetRouterName := targetOVNNet.getRouterName()
-			err = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), targetRouterName, removeRoutes...)
+		for _, portExternalRoute := range portExternalRoutes {
+			err = externalRouteOriginValidate(uplinkRoutes, n.bgpNexthop(portExternalRoute.IP.To4() != nil), portExternalRoute)
 			if err != nil {
-				return fmt.Errorf("Failed deleting static routes from peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+				return err
 			}
+		}
+	}
 
-			return nil
-		})
+	// Load the project to get uplink network restrictions.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
 		if err != nil {
 			return err
 		}
+
+		p, err = project.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
 	}
 
-	if len(removeNATIPs) > 0 {
-		err = n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", false, removeNATIPs...)
+	externalSubnetsInUse, err := n.getExternalSubnetInUse(ctx, n.config["network"])
+	if err != nil {
+		return err
+	}
+
+	// Get project restricted routes.
+	projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, "restricted.networks.subnets", n.config["network"])
+	if err != nil {
+		return err
+	}
+
+	// Check if uplink has routed ingress anycast mode enabled, as this relaxes the overlap checks.
+	ipv4UplinkAnycast := n.uplinkHasIngressRoutedAnycastIPv4(uplink)
+	ipv6UplinkAnycast := n.uplinkHasIngressRoutedAnycastIPv6(uplink)
+
+	for _, portExternalRoute := range portExternalRoutes {
+		// Check the external port route is allowed within both the uplink's external routes and any
+		// project restricted subnets.
+		err = n.validateExternalSubnet(uplink, projectRestrictedSubnets, portExternalRoute)
 		if err != nil {
 			return err
 		}
-	}
 
-	// Tear down per‑NIC egress SNAT rules (ipv4/ipv6.address.external)
-	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
-		// Check if the address is present.
-		value := opts.DeviceConfig[fmt.Sprintf("%s.address.external", keyPrefix)]
-		if value == "" {
+		// Skip overlap checks if the external route's protocol has anycast mode enabled on the uplink.
+		if portExternalRoute.IP.To4() == nil {
+			if ipv6UplinkAnycast {
+				continue
+			}
+		} else if ipv4UplinkAnycast {
 			continue
 		}
 
-		// Validate the address.
-		extIP := net.ParseIP(value)
-		if extIP == nil {
-			return fmt.Errorf("Invalid external address %q", value)
+		// Check the external port route doesn't fall within any existing OVN network external subnets.
+		for _, externalSubnetUser := range externalSubnetsInUse {
+			// Skip our own network's SNAT address (as it can be used for NICs in the network).
+			if externalSubnetUser.usageType == subnetUsageNetworkSNAT && externalSubnetUser.networkProject == n.project && externalSubnetUser.networkName == n.name {
+				continue
+			}
+
+			if deviceInstance == nil {
+				// Skip checking instance devices during profile validation, only do this when
+				// an instance is supplied.
+				if externalSubnetUser.instanceDevice != "" {
+					continue
+				}
+			} else {
+				// Skip our own NIC device.
+				if externalSubnetUser.instanceProject == deviceInstance.Project().Name && externalSubnetUser.instanceName == deviceInstance.Name() && externalSubnetUser.instanceDevice == deviceName {
+					continue
+				}
+			}
+
+			if SubnetContains(&externalSubnetUser.subnet, portExternalRoute) || SubnetContains(portExternalRoute, &externalSubnetUser.subnet) {
+				// This error is purposefully vague so that it doesn't reveal any names of
+				// resources potentially outside of the network's project.
+				return fmt.Errorf("External subnet %q overlaps with another network or NIC", portExternalRoute.String())
+			}
 		}
+	}
 
-		// Remove the SNAT entry.
-		err := n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "snat", false, extIP)
-		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
-			return err
+	return nil
+}
+
+// InstanceDevicePortValidateNeighbors checks the static neighbors of an OVN instance port don't use addresses
+// which belong to other ports of the network (static, DHCP or SLAAC) or to the network's uplink port.
+func (n *ovn) InstanceDevicePortValidateNeighbors(deviceInstance instance.Instance, deviceName string, neighbors []net.IP) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	// Skip our own NIC device, when validating an instance rather than a profile.
+	var skipPort networkOVN.OVNSwitchPort
+	if deviceInstance != nil {
+		skipPort = n.getInstanceDevicePortName(deviceInstance.LocalConfig()["volatile.uuid"], deviceName)
+	}
+
+	portAddresses, err := n.portAddresses(ctx, skipPort)
+	if err != nil {
+		return err
+	}
+
+	for _, neighbor := range neighbors {
+		_, found := portAddresses[neighbor.String()]
+		if found {
+			// This error is purposefully vague so that it doesn't reveal the names of other ports.
+			return fmt.Errorf("Static neighbor %q is used by another port of network %q", neighbor.String(), n.name)
+		}
+
+		for _, key := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
+			uplinkIP := net.ParseIP(n.config[key])
+			if uplinkIP != nil && uplinkIP.Equal(neighbor) {
+				return fmt.Errorf("Static neighbor %q is used by the uplink port of network %q", neighbor.String(), n.name)
+			}
 		}
 	}
 
 	return nil
 }
 
-// InstanceDevicePortRemove unregisters the NIC device in the OVN database by removing the DNS entry that should
-// have been created during InstanceDevicePortAdd(). If the DNS record exists at remove time then this indicates
-// the NIC device was successfully added and this function also clears any DHCP reservations for the NIC's IPs.
-func (n *ovn) InstanceDevicePortRemove(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error {
+// InstanceDevicePortAdd adds empty DNS record (to indicate port has been added) and any DHCP reservations for
+// instance device port.
+func (n *ovn) InstanceDevicePortAdd(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error {
 	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
 
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	// Get DNS records.
-	dnsUUID, _, _, err := n.ovnnb.GetLogicalSwitchPortDNS(context.TODO(), instancePortName)
+	dnsUUID, err := n.ovnnb.UpdateLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), instancePortName, "", nil)
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed adding DNS record: %w", err)
 	}
 
-	// Remove DNS record if exists.
-	if dnsUUID != "" {
-		// If NIC has static IPv4 address then remove the DHCPv4 reservation.
-		if deviceConfig["ipv4.address"] != "" {
-			ip := net.ParseIP(deviceConfig["ipv4.address"])
-			if ip != nil {
-				dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName())
-				if err != nil {
-					return fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
-				}
-
-				dhcpReservations = append(dhcpReservations, iprange.Range{Start: ip})
-				dhcpReservationsNew := make([]iprange.Range, 0, len(dhcpReservations))
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
 
-				found := false
-				for _, dhcpReservation := range dhcpReservations {
-					if dhcpReservation.Start.Equal(ip) && dhcpReservation.End == nil {
-						found = true
-						continue
-					}
+		_ = n.ovnnb.DeleteLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), dnsUUID, true)
+	})
 
-					dhcpReservationsNew = append(dhcpReservationsNew, dhcpReservation)
-				}
+	// If NIC has static IPv4 address then create a DHCPv4 reservation.
+	if deviceConfig["ipv4.address"] != "" {
+		ip := net.ParseIP(deviceConfig["ipv4.address"])
+		if ip != nil {
+			dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName())
+			if err != nil {
+				return fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
+			}
 
-				if found {
-					err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(context.TODO(), n.getIntSwitchName(), dhcpReservationsNew)
-					if err != nil {
-						return fmt.Errorf("Failed removing DHCPv4 reservation for %q: %w", ip.String(), err)
-					}
+			if !n.hasDHCPv4Reservation(dhcpReservations, ip) {
+				dhcpReservations = append(dhcpReservations, iprange.Range{Start: ip})
+				err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName(), dhcpReservations)
+				if err != nil {
+					return fmt.Errorf("Failed adding DHCPv4 reservation for %q: %w", ip.String(), err)
 				}
 			}
 		}
+	}
 
-		err = n.ovnnb.DeleteLogicalSwitchPortDNS(context.TODO(), n.getIntSwitchName(), dnsUUID, true)
-		if err != nil {
-			return fmt.Errorf("Failed deleting DNS record: %w", err)
+	// If NIC has static IPv6 address then create a DHCPv6 reservation.
+	if deviceConfig["ipv6.address"] != "" {
+		ip := net.ParseIP(deviceConfig["ipv6.address"])
+		if ip != nil && ip.To4() == nil {
+			err = n.dhcpv6ReservationAdd(ctx, ip)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -5194,1415 +7376,7697 @@ func (n *ovn) InstanceDevicePortRemove(instanceUUID string, deviceName string, d
 	return nil
 }
 
-// DHCPv4Subnet returns the DHCPv4 subnet (if DHCP is enabled on network).
-func (n *ovn) DHCPv4Subnet() *net.IPNet {
-	// DHCP is disabled on this network (an empty ipv4.dhcp setting indicates enabled by default).
-	if util.IsFalse(n.config["ipv4.dhcp"]) {
-		return nil
+// hasDHCPv4Reservation returns whether IP is in the supplied reservation list.
+func (n *ovn) hasDHCPv4Reservation(dhcpReservations []iprange.Range, ip net.IP) bool {
+	for _, dhcpReservation := range dhcpReservations {
+		if dhcpReservation.Start.Equal(ip) && dhcpReservation.End == nil {
+			return true
+		}
 	}
 
-	_, subnet, err := n.parseRouterIntPortIPv4Net()
+	return false
+}
+
+// dhcpv6ReservationAdd records a DHCPv6 reservation for the static IPv6 address unless one already exists.
+func (n *ovn) dhcpv6ReservationAdd(ctx context.Context, ip net.IP) error {
+	// Prevent concurrent NIC changes from overwriting each other's reservations.
+	unlock, err := locking.Lock(ctx, n.dhcpv6ReservationsLockName())
 	if err != nil {
-		return nil
+		return err
 	}
 
-	return subnet
-}
+	defer unlock()
 
-// DHCPv6Subnet returns the DHCPv6 subnet (if DHCP or SLAAC is enabled on network).
-func (n *ovn) DHCPv6Subnet() *net.IPNet {
-	// DHCP is disabled on this network (an empty ipv6.dhcp setting indicates enabled by default).
-	if util.IsFalse(n.config["ipv6.dhcp"]) {
+	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting DHCPv6 reservations: %w", err)
+	}
+
+	if IPInSlice(ip, dhcpReservations) {
 		return nil
 	}
 
-	_, subnet, err := n.parseRouterIntPortIPv6Net()
+	err = n.ovnnb.UpdateLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName(), append(dhcpReservations, ip))
 	if err != nil {
-		return nil
+		return fmt.Errorf("Failed adding DHCPv6 reservation for %q: %w", ip.String(), err)
 	}
 
-	if subnet != nil {
-		ones, _ := subnet.Mask.Size()
-		if ones < 64 {
-			return nil // OVN only supports DHCPv6 allocated using EUI64 (which needs at least a /64).
-		}
-	}
-
-	return subnet
+	return nil
 }
 
-// ovnNetworkExternalSubnets returns a list of external subnets used by OVN networks using the same uplink as this
-// OVN network. OVN networks are considered to be using external subnets for their ipv4.address and/or ipv6.address
-// if they have NAT disabled, and/or if they have external NAT addresses specified.
-func (n *ovn) ovnNetworkExternalSubnets(ovnProjectNetworksWithOurUplink map[string][]*api.Network) ([]externalSubnetUsage, error) {
-	externalSubnets := make([]externalSubnetUsage, 0)
-	for netProject, networks := range ovnProjectNetworksWithOurUplink {
-		for _, netInfo := range networks {
-			for _, keyPrefix := range []string{"ipv4", "ipv6"} {
-				// If NAT is disabled, then network subnet is an external subnet.
-				if util.IsFalseOrEmpty(netInfo.Config[fmt.Sprintf("%s.nat", keyPrefix)]) {
-					key := fmt.Sprintf("%s.address", keyPrefix)
-
-					_, ipNet, err := net.ParseCIDR(netInfo.Config[key])
-					if err != nil {
-						continue // Skip invalid/unspecified network addresses.
-					}
+// dhcpv6ReservationRemove removes the DHCPv6 reservation of the static IPv6 address if it exists.
+func (n *ovn) dhcpv6ReservationRemove(ctx context.Context, ip net.IP) error {
+	// Prevent concurrent NIC changes from overwriting each other's reservations.
+	unlock, err := locking.Lock(ctx, n.dhcpv6ReservationsLockName())
+	if err != nil {
+		return err
+	}
 
-					externalSubnets = append(externalSubnets, externalSubnetUsage{
-						subnet:         *ipNet,
-						networkProject: netProject,
-						networkName:    netInfo.Name,
-						usageType:      subnetUsageNetwork,
-					})
-				}
+	defer unlock()
 
-				// Find any external subnets used for network SNAT.
-				if netInfo.Config[fmt.Sprintf("%s.nat.address", keyPrefix)] != "" {
-					key := fmt.Sprintf("%s.nat.address", keyPrefix)
+	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting DHCPv6 reservations: %w", err)
+	}
 
-					subnetSize := 128
-					if keyPrefix == "ipv4" {
-						subnetSize = 32
-					}
+	if !IPInSlice(ip, dhcpReservations) {
+		return nil
+	}
 
-					_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", netInfo.Config[key], subnetSize))
-					if err != nil {
-						return nil, fmt.Errorf("Failed parsing %q of %q in project %q: %w", key, netInfo.Name, netProject, err)
-					}
+	dhcpReservationsNew := slices.DeleteFunc(dhcpReservations, ip.Equal)
 
-					externalSubnets = append(externalSubnets, externalSubnetUsage{
-						subnet:         *ipNet,
-						networkProject: netProject,
-						networkName:    netInfo.Name,
-						usageType:      subnetUsageNetworkSNAT,
-					})
-				}
-			}
-		}
+	err = n.ovnnb.UpdateLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName(), dhcpReservationsNew)
+	if err != nil {
+		return fmt.Errorf("Failed removing DHCPv6 reservation for %q: %w", ip.String(), err)
 	}
 
-	return externalSubnets, nil
+	return nil
 }
 
-// ovnNICExternalRoutes returns a list of external routes currently used by OVN NICs that are connected to OVN
-// networks that share the same uplink as this network uses.
-func (n *ovn) ovnNICExternalRoutes(ovnProjectNetworksWithOurUplink map[string][]*api.Network) ([]externalSubnetUsage, error) {
-	externalRoutes := make([]externalSubnetUsage, 0)
+// InstanceDevicePortStart sets up an instance device port to the internal logical switch.
+// Accepts a list of ACLs being removed from the NIC device (if called as part of a NIC update).
+// Returns the logical switch port name and a list of IPs that were allocated to the port for DNS.
+func (n *ovn) InstanceDevicePortStart(opts *OVNInstanceNICSetupOpts, securityACLsRemove []string) (networkOVN.OVNSwitchPort, []net.IP, error) {
+	if opts.InstanceUUID == "" {
+		return "", nil, errors.New("Instance UUID is required")
+	}
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		return tx.InstanceList(ctx, func(inst db.InstanceArgs, p api.Project) error {
-			// Get the instance's effective network project name.
-			instNetworkProject := project.NetworkProjectFromRecord(&p)
-			devices := db.ExpandInstanceDevices(inst.Devices.Clone(), inst.Profiles)
+	instancePortName := n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceName)
+	logPrefix := fmt.Sprintf("%s-%s", opts.InstanceUUID, opts.DeviceName)
 
-			// Iterate through each of the instance's devices, looking for OVN NICs that are linked to networks
-			// that use our uplink.
-			for devName, devConfig := range devices {
-				if devConfig["type"] != "nic" {
-					continue
-				}
+	// Leave room for waiting on the network and the dynamic addresses of the port on top of the port operations themselves.
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout+n.readinessTimeout()+n.dynamicAddressTimeout())
+	defer cancel()
 
-				// Check whether the NIC device references one of the OVN networks supplied.
-				if !NICUsesNetwork(devConfig, ovnProjectNetworksWithOurUplink[instNetworkProject]...) {
-					continue
-				}
+	err := n.waitReady(ctx)
+	if err != nil {
+		return "", nil, err
+	}
 
-				// For OVN NICs that are connected to networks that use the same uplink as we do, check
-				// if they have any external routes configured, and if so add them to the list to return.
-				for _, key := range []string{"ipv4.routes.external", "ipv6.routes.external"} {
-					for _, cidr := range util.SplitNTrimSpace(devConfig[key], ",", -1, true) {
-						_, ipNet, _ := net.ParseCIDR(cidr)
-						if ipNet == nil {
-							// Sip if NIC device doesn't have a valid route.
-							continue
-						}
+	return n.switchPortStart(ctx, instancePortName, logPrefix, opts, securityACLsRemove)
+}
 
-						externalRoutes = append(externalRoutes, externalSubnetUsage{
-							subnet:          *ipNet,
-							networkProject:  instNetworkProject,
-							networkName:     devConfig["network"],
-							instanceProject: inst.Project,
-							instanceName:    inst.Name,
-							instanceDevice:  devName,
-							usageType:       subnetUsageInstance,
-						})
-					}
-				}
-			}
+// InstanceDevicePortPrecreate creates the logical switch port of an instance device ahead of its first start, in
+// a disabled state, so that its addresses are allocated and known in advance.
+// InstanceDevicePortStart then adopts the port along with its addresses.
+// Returns the IPs allocated to the port.
+func (n *ovn) InstanceDevicePortPrecreate(opts *OVNInstanceNICSetupOpts) ([]net.IP, error) {
+	if opts.InstanceUUID == "" {
+		return nil, errors.New("Instance UUID is required")
+	}
 
-			return nil
-		})
-	})
+	instancePortName := n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceName)
+	logPrefix := fmt.Sprintf("%s-%s", opts.InstanceUUID, opts.DeviceName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout+n.dynamicAddressTimeout())
+	defer cancel()
+
+	precreateOpts := *opts
+	precreateOpts.Precreate = true
+
+	_, ips, err := n.switchPortStart(ctx, instancePortName, logPrefix, &precreateOpts, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return externalRoutes, nil
+	return ips, nil
 }
 
-// ovnProjectNetworksWithUplink accepts a map of all networks in all projects and returns a filtered map of OVN
-// networks that use the uplink specified.
-func (n *ovn) ovnProjectNetworksWithUplink(uplink string, projectNetworks map[string]map[int64]api.Network) map[string][]*api.Network {
-	ovnProjectNetworksWithOurUplink := make(map[string][]*api.Network)
-	for netProject, networks := range projectNetworks {
-		for _, ni := range networks {
-			network := ni // Local var creating pointer to rather than iterator.
-
-			// Skip non-OVN networks or those networks that don't use the uplink specified.
-			if network.Type != "ovn" || network.Config["network"] != uplink {
-				continue
-			}
+// readyCheck checks whether the network is ready for instance NICs to start.
+// Returns the reason why it isn't, or an empty string if it is.
+func (n *ovn) readyCheck(ctx context.Context) (string, error) {
+	dhcpv4Subnet := n.DHCPv4Subnet()
+	dhcpv6Subnet := n.DHCPv6Subnet()
 
-			if ovnProjectNetworksWithOurUplink[netProject] == nil {
-				ovnProjectNetworksWithOurUplink[netProject] = []*api.Network{&network}
-			} else {
-				ovnProjectNetworksWithOurUplink[netProject] = append(ovnProjectNetworksWithOurUplink[netProject], &network)
-			}
+	if dhcpv4Subnet != nil || dhcpv6Subnet != nil {
+		dhcpV4UUID, dhcpV6UUID, err := n.getDhcpOptionUUIDs(ctx)
+		if err != nil {
+			return "", err
 		}
-	}
 
-	return ovnProjectNetworksWithOurUplink
-}
+		if dhcpv4Subnet != nil && dhcpV4UUID == "" {
+			return "Missing DHCPv4 options", nil
+		}
 
-// uplinkHasIngressRoutedAnycastIPv4 returns true if the uplink network has IPv4 routed ingress anycast enabled.
-func (n *ovn) uplinkHasIngressRoutedAnycastIPv4(uplink *api.Network) bool {
-	return util.IsTrue(uplink.Config["ipv4.routes.anycast"]) && uplink.Config["ovn.ingress_mode"] == "routed"
-}
+		if dhcpv6Subnet != nil && dhcpV6UUID == "" {
+			return "Missing DHCPv6 options", nil
+		}
+	}
 
-// uplinkHasIngressRoutedAnycastIPv6 returns true if the uplink network has routed IPv6 ingress anycast enabled.
-func (n *ovn) uplinkHasIngressRoutedAnycastIPv6(uplink *api.Network) bool {
-	return util.IsTrue(uplink.Config["ipv6.routes.anycast"]) && uplink.Config["ovn.ingress_mode"] == "routed"
-}
+	if n.config["network"] != "none" {
+		// A chassis is only expected to claim the uplink port once a member has been added to the chassis group.
+		priorities, err := n.ovnnb.GetChassisGroupPriorities(ctx, n.getChassisGroupName())
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return "", fmt.Errorf("Failed getting OVN chassis group priorities: %w", err)
+		}
 
-// handleDependencyChange applies changes from uplink network if specific watched keys have changed.
-func (n *ovn) handleDependencyChange(uplinkName string, uplinkConfig map[string]string, changedKeys []string) error {
-	// Detect changes that need to be applied to the network.
-	for _, k := range []string{"dns.nameservers", "ipv4.gateway", "ipv6.gateway", "ipv4.gateway.hwaddr", "ipv6.gateway.hwaddr"} {
-		if slices.Contains(changedKeys, k) {
-			n.logger.Debug("Applying changes from uplink network", logger.Ctx{"uplink": uplinkName})
+		if len(priorities) > 0 {
+			_, err = n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
+			if errors.Is(err, networkOVN.ErrNotFound) || errors.Is(err, networkOVN.ErrNotBound) {
+				return "Uplink port isn't bound to a chassis", nil
+			}
 
-			// Re-setup logical network in order to apply uplink changes.
-			err := n.setup(true)
 			if err != nil {
-				return err
+				return "", fmt.Errorf("Failed getting the gateway chassis: %w", err)
 			}
-
-			break // Only run setup once per notification (all changes will be applied).
 		}
 	}
 
-	// Add or remove the instance NIC l2proxy DNAT_AND_SNAT rules if uplink's ovn.ingress_mode has changed.
-	if slices.Contains(changedKeys, "ovn.ingress_mode") {
-		n.logger.Debug("Applying ingress mode changes from uplink network to instance NICs", logger.Ctx{"uplink": uplinkName})
+	return "", nil
+}
 
-		if slices.Contains([]string{"l2proxy", ""}, uplinkConfig["ovn.ingress_mode"]) {
-			// Get list of active switch ports (avoids repeated querying of OVN NB).
-			activePorts, err := n.ovnnb.GetLogicalSwitchPorts(context.TODO(), n.getIntSwitchName())
-			if err != nil {
-				return fmt.Errorf("Failed getting active ports: %w", err)
-			}
+// waitReady waits up to the readiness timeout for the network to be ready for instance NICs to start.
+// Port binding changes in the southbound database trigger a new check, other changes are picked up periodically.
+// Only failures to check the network state are returned, a network which isn't ready in time is just logged.
+func (n *ovn) waitReady(ctx context.Context) error {
+	timeout := n.readinessTimeout()
+	if timeout == 0 {
+		return nil
+	}
 
-			// Find all instance NICs that use this network, and re-add the logical OVN instance port.
-			// This will restore the l2proxy DNAT_AND_SNAT rules.
-			err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-				return tx.InstanceList(ctx, func(inst db.InstanceArgs, p api.Project) error {
-					// Get the instance's effective network project name.
-					instNetworkProject := project.NetworkProjectFromRecord(&p)
+	reason, err := n.readyCheck(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed checking network readiness: %w", err)
+	}
 
-					// Skip instances who's effective network project doesn't match this network's
-					// project.
-					if n.Project() != instNetworkProject {
-						return nil
-					}
+	if reason == "" {
+		return nil
+	}
 
-					devices := db.ExpandInstanceDevices(inst.Devices.Clone(), inst.Profiles)
+	changed := make(chan struct{}, 1)
+	handlerName := fmt.Sprintf("network_%d_readiness_%p", n.id, changed)
+	_ = networkOVN.AddOVNSBHandler(handlerName, networkOVN.EventHandler{
+		Tables: []string{"Port_Binding"},
+		Hook: func(action string, table string, oldObject ovsdbModel.Model, newObject ovsdbModel.Model) {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		},
+	})
 
-					// Iterate through each of the instance's devices, looking for NICs that are linked
-					// this network.
-					for devName, devConfig := range devices {
-						if devConfig["type"] != "nic" || n.Name() != devConfig["network"] {
-							continue
-						}
+	defer func() { _ = networkOVN.RemoveOVNSBHandler(handlerName) }()
 
-						// Check if instance port exists, if not then we can skip.
-						instanceUUID := inst.Config["volatile.uuid"]
-						instancePortName := n.getInstanceDevicePortName(instanceUUID, devName)
-						_, found := activePorts[instancePortName]
-						if !found {
-							continue // No need to update a port that isn't started yet.
-						}
+	waitCtx, waitCancel := context.WithTimeout(ctx, timeout)
+	defer waitCancel()
 
-						if devConfig["hwaddr"] == "" {
-							// Load volatile MAC if no static MAC specified.
-							devConfig["hwaddr"] = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", devName)]
-						}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 
-						// Re-add logical switch port to apply the l2proxy DNAT_AND_SNAT rules.
-						n.logger.Debug("Re-adding instance OVN NIC port to apply ingress mode changes", logger.Ctx{"project": inst.Project, "instance": inst.Name, "device": devName})
-						_, _, err = n.InstanceDevicePortStart(&OVNInstanceNICSetupOpts{
-							InstanceUUID: instanceUUID,
-							DNSName:      inst.Name,
-							DeviceName:   devName,
-							DeviceConfig: devConfig,
-							UplinkConfig: uplinkConfig,
-						}, nil)
-						if err != nil {
-							n.logger.Error("Failed re-adding instance OVN NIC port", logger.Ctx{"project": inst.Project, "instance": inst.Name, "err": err})
-							continue
-						}
-					}
+	start := time.Now()
+	for {
+		select {
+		case <-waitCtx.Done():
+			n.logger.Warn("Network isn't ready, starting instance port anyway", logger.Ctx{"reason": reason, "timeout": timeout})
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
 
-					return nil
-				})
-			})
-			if err != nil {
-				return fmt.Errorf("Failed adding instance NIC ingress mode l2proxy rules: %w", err)
-			}
-		} else {
-			// Remove all DNAT_AND_SNAT rules if not using l2proxy ingress mode, as currently we only
-			// use DNAT_AND_SNAT rules for this feature so it is safe to do.
-			err := n.ovnnb.DeleteLogicalRouterNAT(context.TODO(), n.getRouterName(), "dnat_and_snat", true)
-			if err != nil {
-				return fmt.Errorf("Failed deleting instance NIC ingress mode l2proxy rules: %w", err)
+		newReason, err := n.readyCheck(waitCtx)
+		if err != nil {
+			// The check was interrupted by the timeout, report the last known reason.
+			if waitCtx.Err() != nil && ctx.Err() == nil {
+				continue
 			}
+
+			return fmt.Errorf("Failed checking network readiness: %w", err)
 		}
-	}
 
-	return nil
+		reason = newReason
+		if reason == "" {
+			n.logger.Debug("Network became ready", logger.Ctx{"waited": time.Since(start)})
+			return nil
+		}
+	}
 }
 
-// forwardFlattenVIPs flattens forwards into format compatible with OVN load balancers.
-func (n *ovn) forwardFlattenVIPs(listenAddress net.IP, defaultTargetAddress net.IP, portMaps []*forwardPortMap) []networkOVN.OVNLoadBalancerVIP {
-	var vips []networkOVN.OVNLoadBalancerVIP
-
-	if defaultTargetAddress != nil {
-		vips = append(vips, networkOVN.OVNLoadBalancerVIP{
-			ListenAddress: listenAddress,
-			Targets:       []networkOVN.OVNLoadBalancerTarget{{Address: defaultTargetAddress}},
-		})
+// switchPortStart sets up the named logical switch port on the internal logical switch using the supplied
+// device config. The logPrefix is used to name the default ACL rules of the port.
+func (n *ovn) switchPortStart(ctx context.Context, instancePortName networkOVN.OVNSwitchPort, logPrefix string, opts *OVNInstanceNICSetupOpts, securityACLsRemove []string) (networkOVN.OVNSwitchPort, []net.IP, error) {
+	mac, err := net.ParseMAC(opts.DeviceConfig["hwaddr"])
+	if err != nil {
+		return "", nil, err
 	}
 
-	for _, portMap := range portMaps {
-		targetPortsLen := len(portMap.target.ports)
-
-		for i, lp := range portMap.listenPorts {
-			targetPort := lp // Default to using same port as listen port for target port.
-
-			if targetPortsLen == 1 {
-				// If a single target port is specified, forward all listen ports to it.
-				targetPort = portMap.target.ports[0]
-			} else if targetPortsLen > 1 {
-				// If more than 1 target port specified, use listen port index to get the
-				// target port to use.
-				targetPort = portMap.target.ports[i]
-			}
+	ipv4 := opts.DeviceConfig["ipv4.address"]
+	ipv6 := opts.DeviceConfig["ipv6.address"]
 
-			vips = append(vips, networkOVN.OVNLoadBalancerVIP{
-				ListenAddress: listenAddress,
-				Protocol:      portMap.protocol,
-				ListenPort:    lp,
-				Targets: []networkOVN.OVNLoadBalancerTarget{
-					{
-						Address: portMap.target.address,
-						Port:    targetPort,
-					},
-				},
-			})
-		}
+	internalRoutes, externalRoutes, err := n.instanceDevicePortRoutesParse(opts.DeviceConfig)
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed parsing NIC device routes: %w", err)
 	}
 
-	return vips
-}
+	neighbors, err := n.instanceDevicePortNeighborsParse(opts.DeviceConfig, mac)
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed parsing NIC device static neighbors: %w", err)
+	}
 
-// ForwardCreate creates a network forward.
-func (n *ovn) ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error {
-	if n.config["network"] == "none" {
-		return errors.New("Isolated OVN network cannot use network forwards")
+	neighborIPs := make([]net.IP, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		neighborIPs = append(neighborIPs, neighbor.IP)
 	}
 
 	reverter := revert.New()
 	defer reverter.Fail()
 
-	if clientType == request.ClientTypeNormal {
-		memberSpecific := false // OVN doesn't support per-member forwards.
-
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Check if there is an existing forward using the same listen address.
-			_, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), forward.ListenAddress)
+	// Get existing DHCPv4 static reservations.
+	// This is used for both checking sticky DHCPv4 allocation availability and for ensuring static DHCP
+	// reservations exist.
+	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
+	}
 
-			return err
-		})
-		if err == nil {
-			return api.StatusErrorf(http.StatusConflict, "A forward for that listen address already exists")
-		}
+	dhcpv4Subnet := n.DHCPv4Subnet()
+	dhcpv6Subnet := n.DHCPv6Subnet()
+	var dhcpV4UUID, dhcpV6UUID networkOVN.OVNDHCPOptionsUUID
 
-		// Convert listen address to subnet so we can check its valid and can be used.
-		listenAddressNet, err := ParseIPToNet(forward.ListenAddress)
+	if dhcpv4Subnet != nil || dhcpv6Subnet != nil {
+		dhcpV4UUID, dhcpV6UUID, err = n.getDhcpOptionUUIDs(ctx)
 		if err != nil {
-			return fmt.Errorf("Failed parsing %q: %w", forward.ListenAddress, err)
+			return "", nil, err
+		}
+	}
+	if dhcpv4Subnet != nil {
+		if dhcpV4UUID == "" {
+			return "", nil, fmt.Errorf("Could not find DHCPv4 options for instance port for subnet %q", dhcpv4Subnet.String())
 		}
 
-		portMaps, err := n.forwardValidate(listenAddressNet.IP, &forward.NetworkForwardPut)
-		if err != nil {
-			return err
+		// If using dynamic IPv4, look for previously used sticky IPs from the NIC's last state.
+		var dhcpV4StickyIP net.IP
+		if opts.DeviceConfig["ipv4.address"] == "" {
+			for _, entry := range opts.LastStateIPs {
+				if entry.To4() != nil && SubnetContainsIP(dhcpv4Subnet, entry) {
+					dhcpV4StickyIP = entry
+					break
+				}
+			}
 		}
 
-		// Load the project to get uplink network restrictions.
-		var p *api.Project
-		var uplink *api.Network
+		// If a previously used IP has been found and its not one of the static IPs, then check if
+		// the IP is available for use and if not then we can request this port use it statically.
+		if dhcpV4StickyIP != nil && dhcpV4StickyIP.String() != ipv4 {
+			// If the sticky IP isn't statically reserved, lets check its not used dynamically
+			// on any active port.
+			if !n.hasDHCPv4Reservation(dhcpReservations, dhcpV4StickyIP) {
+				existingPortIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+				if err != nil {
+					return "", nil, fmt.Errorf("Failed getting existing switch port IPs: %w", err)
+				}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
-			if err != nil {
-				return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+				found := false
+				for _, ips := range existingPortIPs {
+					if IPInSlice(dhcpV4StickyIP, ips) {
+						found = true
+						break // IP is in use with another port, so cannot use it.
+					}
+				}
+
+				// If IP is not in use then request OVN use previously used IP for port.
+				if !found {
+					ipv4 = dhcpV4StickyIP.String()
+				}
 			}
+		}
+	}
+
+	// If IPv6 is disabled on the port, skip IPv6 entirely including the DHCPv6 options.
+	if ipv6 == "none" {
+		dhcpV6UUID = ""
+	} else if dhcpv6Subnet != nil {
+		if dhcpV6UUID == "" {
+			return "", nil, fmt.Errorf("Could not find DHCPv6 options for instance port for subnet %q", dhcpv6Subnet.String())
+		}
 
-			p, err = project.ToAPI(ctx, tx.Tx())
+		// If the subnet is too small for OVN's EUI64 based allocation, pick static addresses for the port.
+		// As OVN can't combine a dynamic IPv4 address with a static IPv6 one, the IPv4 address is picked
+		// too when dynamic.
+		if ipv6 == "" && !ovnSubnetSupportsEUI64(dhcpv6Subnet) {
+			ipv4, ipv6, err = n.instancePortStaticIPs(ctx, ipv4, opts.LastStateIPs)
 			if err != nil {
-				return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+				return "", nil, err
 			}
+		}
 
-			// Get uplink routes.
-			_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+		// If port isn't going to have fully dynamic IPs allocated by OVN, and instead only static
+		// IPv4 addresses have been added, then add an EUI64 static IPv6 address so that the switch
+		// port has an IPv6 address that will be used to generate a DNS record. This works around a
+		// limitation in OVN that prevents us requesting dynamic IPv6 address allocation when
+		// static IPv4 allocation is used. Setting ipv6.address to none on the NIC disables this.
+		if ipv4 != "" && ipv6 == "" {
+			eui64IP, err := eui64.ParseMAC(dhcpv6Subnet.IP, mac)
 			if err != nil {
-				return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+				return "", nil, fmt.Errorf("Failed generating EUI64 for instance port %q: %w", mac.String(), err)
 			}
 
-			return nil
-		})
-		if err != nil {
-			return err
+			// Add EUI64 as the IPv6 address.
+			ipv6 = eui64IP.String()
 		}
+	}
 
-		// Get project restricted routes.
-		projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, n.config["network"])
+	var nestedPortParentName networkOVN.OVNSwitchPort
+	var nestedPortVLAN uint16
+	if opts.DeviceConfig["nested"] != "" {
+		nestedPortParentName = n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceConfig["nested"])
+		nestedPortVLANInt64, err := strconv.ParseUint(opts.DeviceConfig["vlan"], 10, 16)
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Invalid VLAN ID %q: %w", opts.DeviceConfig["vlan"], err)
 		}
 
-		externalSubnetsInUse, err := n.getExternalSubnetInUse(n.config["network"])
+		nestedPortVLAN = uint16(nestedPortVLANInt64)
+	}
+
+	// Add port with mayExist set to true, so that if instance port exists, we don't fail and continue below
+	// to configure the port as needed. This is required in case the OVN northbound database was unavailable
+	// when the instance NIC was stopped and was unable to remove the port on last stop, which would otherwise
+	// prevent future NIC starts.
+	err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getIntSwitchName(), instancePortName, &networkOVN.OVNSwitchPortOpts{
+		DHCPv4OptsID: dhcpV4UUID,
+		DHCPv6OptsID: dhcpV6UUID,
+		MAC:          mac,
+		IPV4:         ipv4,
+		IPV6:         ipv6,
+		Parent:       nestedPortParentName,
+		VLAN:         nestedPortVLAN,
+		Location:     n.state.ServerName,
+		Promiscuous:  util.IsTrue(opts.DeviceConfig["security.promiscuous"]),
+		Neighbors:    neighborIPs,
+		Disabled:     opts.Precreate,
+	}, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), instancePortName)
+	})
+
+	// Routed NICs get their own DHCPv4 option set with a /32 subnet mask so that all their traffic goes
+	// through the router, the option set is removed along with the port.
+	routed := opts.DeviceConfig["mode"] == "routed"
+	if routed && dhcpV4UUID != "" {
+		err = n.ovnnb.SetLogicalSwitchPortDHCPv4Options(ctx, n.getIntSwitchName(), instancePortName, dhcpV4UUID, ovnRoutedNICDHCPv4Options)
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Failed setting routed DHCPv4 options: %w", err)
 		}
+	}
 
-		// Check the listen address subnet is allowed within both the uplink's external routes and any
-		// project restricted subnets.
-		err = n.validateExternalSubnet(uplink, projectRestrictedSubnets, listenAddressNet)
+	// Install static ARP/ND entries on the router for addresses owned by the port but not assigned to it.
+	if len(neighbors) > 0 && !opts.Precreate {
+		err = n.staticNeighborsApply(ctx, instancePortName, neighbors)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 
-		// Check the listen address subnet doesn't fall within any existing OVN network external subnets.
-		for _, externalSubnetUser := range externalSubnetsInUse {
-			// Check if usage is from our own network.
-			if externalSubnetUser.networkProject == n.project && externalSubnetUser.networkName == n.name {
-				// Skip checking conflict with our own network's subnet or SNAT address.
-				// But do not allow other conflict with other usage types within our own network.
-				if externalSubnetUser.usageType == subnetUsageNetwork || externalSubnetUser.usageType == subnetUsageNetworkSNAT {
-					continue
-				}
-			}
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
 
-			if SubnetContains(&externalSubnetUser.subnet, listenAddressNet) || SubnetContains(listenAddressNet, &externalSubnetUser.subnet) {
-				// This error is purposefully vague so that it doesn't reveal any names of
-				// resources potentially outside of the network's project.
-				return fmt.Errorf("Forward listen address %q overlaps with another network or NIC", listenAddressNet.String())
+			_ = n.staticNeighborsRemove(ctx, instancePortName, neighborIPs)
+		})
+	}
+
+	// Add DNS records for port's IPs, and retrieve the IP addresses used.
+	var dnsIPv4, dnsIPv6 net.IP
+	dnsIPs := make([]net.IP, 0, 2)
+
+	// checkAndStoreIP checks if the supplied IP is valid and can be used for a missing DNS IP.
+	// If the found IP is needed, stores into the relevant dnsIPv{X} variable and into dnsIPs slice.
+	checkAndStoreIP := func(ip net.IP) {
+		if ip != nil {
+			isV4 := ip.To4() != nil
+			if dnsIPv4 == nil && isV4 {
+				dnsIPv4 = ip
+			} else if dnsIPv6 == nil && !isV4 {
+				dnsIPv6 = ip
 			}
+
+			dnsIPs = append(dnsIPs, ip)
 		}
+	}
 
-		var forwardID int64
+	// Populate DNS IP variables with any static IPs first before checking if we need to extract dynamic IPs.
+	for _, staticIP := range []string{ipv4, ipv6} {
+		if staticIP == "" || staticIP == "none" {
+			continue
+		}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Create forward DB record.
-			nodeID := sql.NullInt64{
-				Valid: memberSpecific,
-				Int64: tx.GetNodeID(),
-			}
+		checkAndStoreIP(net.ParseIP(staticIP))
+	}
 
-			dbRecord := dbCluster.NetworkForward{
-				NetworkID:     n.ID(),
-				NodeID:        nodeID,
-				ListenAddress: forward.ListenAddress,
-				Description:   forward.Description,
-				Ports:         forward.Ports,
-			}
+	// Apply device specific external address if any.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		// Check if the address is present.
+		value := opts.DeviceConfig[fmt.Sprintf("%s.address.external", keyPrefix)]
+		if value == "" || opts.Precreate {
+			continue
+		}
 
-			if forward.Ports == nil {
-				dbRecord.Ports = []api.NetworkForwardPort{}
-			}
+		// Check if the family is configured.
+		if keyPrefix == "ipv4" && (ipv4 == "" || ipv4 == "none") {
+			continue
+		}
 
-			forwardID, err = dbCluster.CreateNetworkForward(ctx, tx.Tx(), dbRecord)
+		if keyPrefix == "ipv6" && (ipv6 == "" || ipv6 == "none") {
+			continue
+		}
+
+		// Parse the internal address.
+		var intNet *net.IPNet
+		if keyPrefix == "ipv4" {
+			_, intNet, err = net.ParseCIDR(fmt.Sprintf("%s/32", ipv4))
 			if err != nil {
-				return err
+				return "", nil, fmt.Errorf("Invalid internal address %q: %w", ipv4, err)
 			}
-
-			err = dbCluster.CreateNetworkForwardConfig(ctx, tx.Tx(), forwardID, forward.Config)
+		} else {
+			_, intNet, err = net.ParseCIDR(fmt.Sprintf("%s/128", ipv6))
 			if err != nil {
-				return err
+				return "", nil, fmt.Errorf("Invalid internal address %q: %w", ipv6, err)
 			}
+		}
 
-			return nil
-		})
-		if err != nil {
-			return err
+		// Parse the external address.
+		extIP := net.ParseIP(value)
+		if extIP == nil {
+			return "", nil, fmt.Errorf("Invalid external address %q", value)
+		}
+
+		// The address maps to a single internal address, so it can be translated without connection tracking.
+		if err := n.ovnnb.CreateLogicalRouterNAT(
+			ctx,
+			n.getRouterName(),
+			"snat",
+			intNet,
+			extIP,
+			nil,
+			util.IsTrue(n.config["security.stateless"]),
+			true,
+		); err != nil {
+			return "", nil, fmt.Errorf("Failed to add SNAT %q: %w", value, err)
 		}
 
 		reverter.Add(func() {
-			_ = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-				return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), forwardID)
-			})
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
 
-			_ = n.ovnnb.DeleteLoadBalancer(context.TODO(), n.getLoadBalancerName(forward.ListenAddress))
-			_ = n.forwardBGPSetupPrefixes()
+			_ = n.ovnnb.DeleteLogicalRouterNAT(
+				ctx,
+				n.getRouterName(),
+				"snat",
+				false,
+				extIP,
+			)
 		})
+	}
 
-		vips := n.forwardFlattenVIPs(net.ParseIP(forward.ListenAddress), net.ParseIP(forward.Config["target_address"]), portMaps)
-
-		err = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(forward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
-		if err != nil {
-			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
+	// Get dynamic IPs for switch port if any IPs not assigned statically.
+	if (ipv4 != "none" && dnsIPv4 == nil) || (ipv6 != "none" && dnsIPv6 == nil) {
+		// Wait for OVN to allocate the dynamic IPs of the port.
+		waitCtx, waitCancel := context.WithTimeout(ctx, n.dynamicAddressTimeout())
+		dynamicIPs, err := n.ovnnb.WaitLogicalSwitchPortDynamicIPs(waitCtx, instancePortName)
+		waitCancel()
+		if err != nil && (!errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil) {
+			return "", nil, err
 		}
 
-		// Add internal static route to the network forward (helps with OVN IC).
-		var nexthop net.IP
-		if listenAddressNet.IP.To4() == nil {
-			routerV6, _, err := n.parseRouterIntPortIPv6Net()
-			if err == nil {
-				nexthop = routerV6
-			}
-		} else {
-			routerV4, _, err := n.parseRouterIntPortIPv4Net()
-			if err == nil {
-				nexthop = routerV4
-			}
+		for _, dynamicIP := range dynamicIPs {
+			// Try and find the first IPv4 and IPv6 addresses from the dynamic address list.
+			checkAndStoreIP(dynamicIP)
 		}
 
-		if nexthop != nil {
-			err = n.ovnnb.CreateLogicalRouterRoute(context.TODO(), n.getRouterName(), true, networkOVN.OVNRouterRoute{NextHop: nexthop, Prefix: *listenAddressNet})
-			if err != nil {
-				return err
+		// Check, after considering all dynamic IPs, whether we have got the required ones.
+		if (ipv4 != "none" && dnsIPv4 == nil && dhcpv4Subnet != nil) || (ipv6 != "none" && dnsIPv6 == nil && dhcpv6Subnet != nil) {
+			if n.config["dynamic_addresses.on_failure"] != "continue" {
+				return "", nil, errors.New("Insufficient dynamic addresses allocated")
 			}
 
-			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), *listenAddressNet)
-			})
-		}
-
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
-		if err != nil {
-			return err
+			n.logger.Warn("Starting port without the dynamic addresses which couldn't be allocated", logger.Ctx{"port": instancePortName, "ips": dnsIPs})
 		}
+	}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).CreateNetworkForward(n.name, forward)
-		})
-		if err != nil {
-			return err
-		}
+	dnsName, err := n.switchPortDNSName(ctx, instancePortName, opts)
+	if err != nil {
+		return "", nil, err
 	}
 
-	// Refresh exported BGP prefixes on local member.
-	err := n.forwardBGPSetupPrefixes()
+	dnsUUID, err := n.ovnnb.UpdateLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), instancePortName, dnsName, dnsIPs)
 	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+		return "", nil, fmt.Errorf("Failed setting DNS for %q: %w", dnsName, err)
 	}
 
-	reverter.Success()
-	return nil
-}
-
-// ForwardUpdate updates a network forward.
-func (n *ovn) ForwardUpdate(listenAddress string, req api.NetworkForwardPut, clientType request.ClientType) error {
-	reverter := revert.New()
-	defer reverter.Fail()
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
 
-	if clientType == request.ClientTypeNormal {
-		var curForwardID int64
-		var curForward *api.NetworkForward
+		_ = n.ovnnb.DeleteLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), dnsUUID, false)
+		_ = n.switchPortDNSNameShare(ctx, dnsName)
+	})
 
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			var err error
+	err = n.switchPortDNSNameShare(ctx, dnsName)
+	if err != nil {
+		return "", nil, err
+	}
 
-			// No memberSpecific filtering needed because OVN doesn't support per-member-forwards
-			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
+	// If NIC has static IPv4 address then ensure a DHCPv4 reservation exists.
+	// Do this at start time as well as add time in case an instance was copied (causing a duplicate address
+	// conflict at add time) which is later resolved by deleting the original instance, meaning a reservation needs to
+	// be added when the copied instance next starts.
+	if opts.DeviceConfig["ipv4.address"] != "" && dnsIPv4 != nil {
+		if !n.hasDHCPv4Reservation(dhcpReservations, dnsIPv4) {
+			dhcpReservations = append(dhcpReservations, iprange.Range{Start: dnsIPv4})
+			err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName(), dhcpReservations)
 			if err != nil {
-				return err
+				return "", nil, fmt.Errorf("Failed adding DHCPv4 reservation for %q: %w", dnsIPv4.String(), err)
 			}
+		}
+	}
 
-			curForwardID = dbRecord.ID
-			curForward, err = dbRecord.ToAPI(ctx, tx.Tx())
+	// Same for the DHCPv6 reservation of a static IPv6 address.
+	if opts.DeviceConfig["ipv6.address"] != "" && dnsIPv6 != nil {
+		err = n.dhcpv6ReservationAdd(ctx, dnsIPv6)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	// Pre-created ports only get their addresses, the rest is set up once the NIC starts.
+	if opts.Precreate {
+		reverter.Success()
+
+		return instancePortName, dnsIPs, nil
+	}
+
+	// Publish NIC's IPs on uplink network if NAT is disabled and using l2proxy ingress mode on uplink.
+	if slices.Contains([]string{"l2proxy", ""}, opts.UplinkConfig["ovn.ingress_mode"]) {
+		for _, ip := range natPublishedIPs(n.config, opts.DeviceConfig, dnsIPv4, dnsIPv6) {
+			err = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "dnat_and_snat", nil, ip, ip, true, true)
 			if err != nil {
-				return err
+				return "", nil, err
 			}
 
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
 
-		portMaps, err := n.forwardValidate(net.ParseIP(curForward.ListenAddress), &req)
-		if err != nil {
-			return err
+				_ = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "dnat_and_snat", false, ip)
+			})
 		}
+	}
 
-		curForwardEtagHash, err := localUtil.EtagHash(curForward.Etag())
-		if err != nil {
-			return err
-		}
+	var routes []networkOVN.OVNRouterRoute
 
-		newForward := api.NetworkForward{
-			ListenAddress:     curForward.ListenAddress,
-			NetworkForwardPut: req,
-		}
+	// In l3only mode and for routed NICs we add the instance port's IPs as static routes to the router.
+	if (util.IsTrue(n.config["ipv4.l3only"]) || routed) && dnsIPv4 != nil {
+		ipNet := IPToNet(dnsIPv4)
+		internalRoutes = append(internalRoutes, &ipNet)
+	}
 
-		newForwardEtagHash, err := localUtil.EtagHash(newForward.Etag())
-		if err != nil {
-			return err
-		}
+	if (util.IsTrue(n.config["ipv6.l3only"]) || routed) && dnsIPv6 != nil {
+		ipNet := IPToNet(dnsIPv6)
+		internalRoutes = append(internalRoutes, &ipNet)
+	}
 
-		if curForwardEtagHash == newForwardEtagHash {
-			return nil // Nothing has changed.
+	// Add each internal route (using the IPs set for DNS as target).
+	for _, internalRoute := range internalRoutes {
+		targetIP := dnsIPv4
+		if internalRoute.IP.To4() == nil {
+			targetIP = dnsIPv6
 		}
 
-		vips := n.forwardFlattenVIPs(net.ParseIP(newForward.ListenAddress), net.ParseIP(newForward.Config["target_address"]), portMaps)
-		err = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(newForward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
-		if err != nil {
-			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
+		if targetIP == nil {
+			return "", nil, fmt.Errorf("Cannot add static route for %q as target IP is not set", internalRoute.String())
 		}
 
-		reverter.Add(func() {
-			// Apply old settings to OVN on failure.
-			portMaps, err := n.forwardValidate(net.ParseIP(curForward.ListenAddress), &curForward.NetworkForwardPut)
-			if err == nil {
-				vips := n.forwardFlattenVIPs(net.ParseIP(curForward.ListenAddress), net.ParseIP(curForward.Config["target_address"]), portMaps)
-				_ = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(curForward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
-				_ = n.forwardBGPSetupPrefixes()
-			}
+		routes = append(routes, networkOVN.OVNRouterRoute{
+			Prefix:  *internalRoute,
+			NextHop: targetIP,
+			Port:    n.getRouterIntPortName(),
 		})
+	}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			fwd := dbCluster.NetworkForward{
-				NetworkID:     n.ID(),
-				ListenAddress: listenAddress,
-				Description:   newForward.Description,
-				Ports:         newForward.Ports,
-			}
-
-			err = dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress, fwd)
-			if err != nil {
-				return err
-			}
-
-			err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), curForwardID, newForward.Config)
-			if err != nil {
-				return err
-			}
+	// Add each external route (using the IPs set for DNS as target).
+	for _, externalRoute := range externalRoutes {
+		targetIP := dnsIPv4
+		if externalRoute.IP.To4() == nil {
+			targetIP = dnsIPv6
+		}
 
-			return nil
-		})
-		if err != nil {
-			return err
+		if targetIP == nil {
+			return "", nil, fmt.Errorf("Cannot add static route for %q as target IP is not set", externalRoute.String())
 		}
 
-		reverter.Add(func() {
-			_ = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-				fwd := dbCluster.NetworkForward{
-					NetworkID:     n.ID(),
-					ListenAddress: listenAddress,
-					Description:   curForward.Description,
-					Ports:         curForward.Ports,
-				}
+		routes = append(routes, networkOVN.OVNRouterRoute{
+			Prefix:  *externalRoute,
+			NextHop: targetIP,
+			Port:    n.getRouterIntPortName(),
+		})
 
-				err = dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress, fwd)
+		// When using l2proxy ingress mode on uplink, in order to advertise the external route to the
+		// uplink network using proxy ARP/NDP we need to add a stateless dnat_and_snat rule (as to my
+		// knowledge this is the only way to get the OVN router to respond to ARP/NDP requests for IPs that
+		// it doesn't actually have). However we have to add each IP in the external route individually as
+		// DNAT doesn't support whole subnets.
+		// If the uplink allows it, the whole route is instead added to the ARP/NDP proxy of the router port.
+		if slices.Contains([]string{"l2proxy", ""}, opts.UplinkConfig["ovn.ingress_mode"]) {
+			if util.IsTrue(opts.UplinkConfig["ovn.l2proxy.aggregate"]) {
+				err = n.ovnnb.UpdateLogicalSwitchPortARPProxyAdd(ctx, n.getExtSwitchRouterPortName(), *externalRoute)
 				if err != nil {
-					return err
+					return "", nil, fmt.Errorf("Failed adding external route %q to ARP/NDP proxy: %w", externalRoute.String(), err)
 				}
 
-				err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), curForwardID, curForward.Config)
+				reverter.Add(func() {
+					ctx, cancel := ovnRevertContext(ctx)
+					defer cancel()
+
+					_ = n.ovnnb.UpdateLogicalSwitchPortARPProxyRemove(ctx, n.getExtSwitchRouterPortName(), *externalRoute)
+				})
+
+				continue
+			}
+
+			err = SubnetIterate(externalRoute, func(ip net.IP) error {
+				err = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "dnat_and_snat", nil, ip, ip, true, true)
 				if err != nil {
 					return err
 				}
 
+				reverter.Add(func() {
+					ctx, cancel := ovnRevertContext(ctx)
+					defer cancel()
+
+					_ = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "dnat_and_snat", false, ip)
+				})
+
 				return nil
 			})
-		})
+			if err != nil {
+				return "", nil, err
+			}
+		}
+	}
 
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+	if len(routes) > 0 {
+		// Add routes to local router.
+		err = n.ovnnb.CreateLogicalRouterRoute(ctx, n.getRouterName(), true, routes...)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).UpdateNetworkForward(n.name, curForward.ListenAddress, req, "")
+		routePrefixes := make([]net.IPNet, 0, len(routes))
+		for _, route := range routes {
+			routePrefixes = append(routePrefixes, route.Prefix)
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), routePrefixes...)
 		})
+
+		// Add routes to internal switch's address set for ACL usage.
+		err = n.ovnnb.UpdateAddressSetAdd(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), routePrefixes...)
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Failed adding switch address set entries: %w", err)
 		}
-	}
 
-	// Refresh exported BGP prefixes on local member.
-	err := n.forwardBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
-	}
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
 
-	reverter.Success()
-	return nil
-}
+			_ = n.ovnnb.UpdateAddressSetRemove(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), routePrefixes...)
+		})
 
-// ForwardDelete deletes a network forward.
-func (n *ovn) ForwardDelete(listenAddress string, clientType request.ClientType) error {
-	if clientType == request.ClientTypeNormal {
-		var forwardID int64
-		var forward *api.NetworkForward
+		routerIntPortIPv4, _, err := n.parseRouterIntPortIPv4Net()
+		if err != nil {
+			return "", nil, fmt.Errorf("Failed parsing local router's peering port IPv4 Net: %w", err)
+		}
 
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			var err error
+		routerIntPortIPv6, _, err := n.parseRouterIntPortIPv6Net()
+		if err != nil {
+			return "", nil, fmt.Errorf("Failed parsing local router's peering port IPv6 Net: %w", err)
+		}
 
-			// No memberSpecific filtering needed because OVN doesn't support per-member-forwards
-			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
-			if err != nil {
-				return err
+		// Add routes to peer routers, and security policies for each peer port on local router.
+		err = n.forPeers(ctx, func(targetOVNNet *ovn) error {
+			targetRouterName := targetOVNNet.getRouterName()
+			targetRouterPort := targetOVNNet.getLogicalRouterPeerPortName(n.ID())
+			targetRouterRoutes := make([]networkOVN.OVNRouterRoute, 0, len(routes))
+			for _, route := range routes {
+				nexthop := routerIntPortIPv4
+				if route.Prefix.IP.To4() == nil {
+					nexthop = routerIntPortIPv6
+				}
+
+				if nexthop == nil {
+					continue // Skip routes that cannot be supported by local router.
+				}
+
+				targetRouterRoutes = append(targetRouterRoutes, networkOVN.OVNRouterRoute{
+					Prefix:  route.Prefix,
+					NextHop: nexthop,
+					Port:    targetRouterPort,
+				})
 			}
 
-			forwardID = dbRecord.ID
-			forward, err = dbRecord.ToAPI(ctx, tx.Tx())
+			err = n.ovnnb.CreateLogicalRouterRoute(ctx, targetRouterName, true, targetRouterRoutes...)
 			if err != nil {
-				return err
+				return fmt.Errorf("Failed adding static routes to peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
 			}
 
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, targetRouterName, routePrefixes...)
+			})
+
 			return nil
 		})
 		if err != nil {
-			return err
+			return "", nil, err
 		}
+	}
 
-		// Delete the network forward itself.
-		err = n.ovnnb.DeleteLoadBalancer(context.TODO(), n.getLoadBalancerName(forward.ListenAddress))
-		if err != nil {
-			return fmt.Errorf("Failed deleting OVN load balancer: %w", err)
-		}
+	// Merge network and NIC assigned security ACL lists.
+	netACLNames, err := n.securityACLs(ctx, n.config)
+	if err != nil {
+		return "", nil, err
+	}
 
-		// Delete static route to network forward if present.
-		vip, err := ParseIPToNet(forward.ListenAddress)
-		if err != nil {
-			return err
-		}
-
-		_ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), *vip)
-
-		// Delete the database records.
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), forwardID)
-		})
-		if err != nil {
-			return err
-		}
-
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
-		if err != nil {
-			return err
-		}
+	nicACLNames := util.SplitNTrimSpace(opts.DeviceConfig["security.acls"], ",", -1, true)
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).DeleteNetworkForward(n.name, forward.ListenAddress)
-		})
-		if err != nil {
-			return err
+	if util.IsTrue(opts.DeviceConfig["security.acls.exclusive"]) {
+		// Leave a trace of the network ACLs being bypassed, as this NIC can see traffic they would block.
+		if len(netACLNames) > 0 {
+			n.logger.Warn("Instance NIC ignores network ACLs", logger.Ctx{"port": instancePortName, "ignoredACLs": netACLNames})
 		}
-	}
-
-	// Refresh exported BGP prefixes on local member.
-	err := n.forwardBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
-	}
-
-	return nil
-}
-
-// loadBalancerFlattenVIPs flattens port maps into format compatible with OVN load balancers.
-func (n *ovn) loadBalancerFlattenVIPs(listenAddress net.IP, portMaps []*loadBalancerPortMap) []networkOVN.OVNLoadBalancerVIP {
-	var vips []networkOVN.OVNLoadBalancerVIP
-
-	for _, portMap := range portMaps {
-		for i, lp := range portMap.listenPorts {
-			vip := networkOVN.OVNLoadBalancerVIP{
-				ListenAddress: listenAddress,
-				Protocol:      portMap.protocol,
-				ListenPort:    lp,
-			}
-
-			for _, target := range portMap.targets {
-				targetPort := lp // Default to using same port as listen port for target port.
-				targetPortsLen := len(target.ports)
-
-				if targetPortsLen == 1 {
-					// If a single target port is specified, forward all listen ports to it.
-					targetPort = target.ports[0]
-				} else if targetPortsLen > 1 {
-					// If more than 1 target port specified, use listen port index to get the
-					// target port to use.
-					targetPort = target.ports[i]
-				}
-
-				vip.Targets = append(vip.Targets, networkOVN.OVNLoadBalancerTarget{
-					Address: target.address,
-					Port:    targetPort,
-				})
+	} else {
+		for _, aclName := range netACLNames {
+			if !slices.Contains(nicACLNames, aclName) {
+				nicACLNames = append(nicACLNames, aclName)
 			}
-
-			vips = append(vips, vip)
 		}
 	}
 
-	return vips
-}
+	// Apply Security ACL port group settings.
+	addChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
+	removeChangeSet := map[networkOVN.OVNPortGroup][]networkOVN.OVNSwitchPortUUID{}
 
-// LoadBalancerCreate creates a network load balancer.
-func (n *ovn) LoadBalancerCreate(loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) error {
-	if n.config["network"] == "none" {
-		return errors.New("Isolated OVN network cannot use network load balancers")
+	// Get logical port UUID.
+	portUUID, err := n.ovnnb.GetLogicalSwitchPortUUID(ctx, instancePortName)
+	if err != nil || portUUID == "" {
+		return "", nil, fmt.Errorf("Failed getting logical port UUID for security ACL removal: %w", err)
 	}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+	// Add NIC port to network port group (this includes the port in the @internal subject for ACL rules).
+	acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, acl.OVNIntSwitchPortGroupName(n.ID()))
+	n.logger.Debug("Scheduled logical port for network port group addition", logger.Ctx{"portGroup": acl.OVNIntSwitchPortGroupName(n.ID()), "port": instancePortName})
 
-	if clientType == request.ClientTypeNormal {
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Check if there is an existing load balancer using the same listen address.
-			_, err := dbCluster.GetNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), loadBalancer.ListenAddress)
+	if len(nicACLNames) > 0 || len(securityACLsRemove) > 0 {
+		var aclNameIDs map[string]int64
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// Get map of ACL names to DB IDs (used for generating OVN port group names).
+			acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &n.project})
 			if err != nil {
 				return err
 			}
 
+			aclNameIDs = make(map[string]int64, len(acls))
+			for _, acl := range acls {
+				aclNameIDs[acl.Name] = int64(acl.ID)
+			}
+
 			return nil
 		})
-		if err == nil {
-			return api.StatusErrorf(http.StatusConflict, "A load balancer for that listen address already exists")
-		}
-
-		// Convert listen address to subnet so we can check its valid and can be used.
-		listenAddressNet, err := ParseIPToNet(loadBalancer.ListenAddress)
-		if err != nil {
-			return fmt.Errorf("Failed parsing %q: %w", loadBalancer.ListenAddress, err)
-		}
-
-		portMaps, err := n.loadBalancerValidate(listenAddressNet.IP, &loadBalancer.NetworkLoadBalancerPut)
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Failed getting network ACL IDs for security ACL setup: %w", err)
 		}
 
-		// Load the project to get uplink network restrictions.
-		var p *api.Project
-		var uplink *api.Network
-
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
-			if err != nil {
-				return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		// Add port to ACLs requested.
+		if len(nicACLNames) > 0 {
+			// Request our network is setup with the specified ACLs.
+			aclNets := map[string]acl.NetworkACLUsage{
+				n.Name(): {Name: n.Name(), Type: n.Type(), ID: n.ID(), Config: n.Config()},
 			}
 
-			p, err = project.ToAPI(ctx, tx.Tx())
+			cleanup, err := addressset.OVNEnsureAddressSetsViaACLs(n.state, n.logger, n.ovnnb, n.Project(), nicACLNames)
 			if err != nil {
-				return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+				return "", nil, fmt.Errorf("Failed ensuring address sets for nic ACLs are configured in OVN for network: %w", err)
 			}
 
-			// Get uplink routes.
-			_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+			reverter.Add(cleanup)
+			cleanup, err = acl.OVNEnsureACLs(n.state, n.logger, n.ovnnb, n.Project(), aclNameIDs, aclNets, nicACLNames, false)
 			if err != nil {
-				return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+				return "", nil, fmt.Errorf("Failed ensuring security ACLs are configured in OVN for instance: %w", err)
 			}
 
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-
-		// Get project restricted routes.
-		projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, n.config["network"])
-		if err != nil {
-			return err
-		}
-
-		externalSubnetsInUse, err := n.getExternalSubnetInUse(n.config["network"])
-		if err != nil {
-			return err
-		}
-
-		// Check the listen address subnet is allowed within both the uplink's external routes and any
-		// project restricted subnets.
-		err = n.validateExternalSubnet(uplink, projectRestrictedSubnets, listenAddressNet)
-		if err != nil {
-			return err
-		}
+			reverter.Add(cleanup)
 
-		// Check the listen address subnet doesn't fall within any existing OVN network external subnets.
-		for _, externalSubnetUser := range externalSubnetsInUse {
-			// Check if usage is from our own network.
-			if externalSubnetUser.networkProject == n.project && externalSubnetUser.networkName == n.name {
-				// Skip checking conflict with our own network's subnet or SNAT address.
-				// But do not allow other conflict with other usage types within our own network.
-				if externalSubnetUser.usageType == subnetUsageNetwork || externalSubnetUser.usageType == subnetUsageNetworkSNAT {
-					continue
+			for _, aclName := range nicACLNames {
+				aclID, found := aclNameIDs[aclName]
+				if !found {
+					return "", nil, fmt.Errorf("Cannot find security ACL ID for %q", aclName)
 				}
-			}
 
-			if SubnetContains(&externalSubnetUser.subnet, listenAddressNet) || SubnetContains(listenAddressNet, &externalSubnetUser.subnet) {
-				// This error is purposefully vague so that it doesn't reveal any names of
-				// resources potentially outside of the network's project.
-				return fmt.Errorf("Load balancer listen address %q overlaps with another network or NIC", listenAddressNet.String())
+				// Add NIC port to ACL port group.
+				portGroupName := acl.OVNACLPortGroupName(aclID)
+				acl.OVNPortGroupInstanceNICSchedule(portUUID, addChangeSet, portGroupName)
+				n.logger.Debug("Scheduled logical port for ACL port group addition", logger.Ctx{"networkACL": aclName, "portGroup": portGroupName, "port": instancePortName})
 			}
 		}
 
-		var loadBalancerID int64
-
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Create load balancer DB record.
-			lb := dbCluster.NetworkLoadBalancer{
-				NetworkID:     n.ID(),
-				ListenAddress: loadBalancer.ListenAddress,
-				Description:   loadBalancer.Description,
-				Backends:      loadBalancer.Backends,
-				Ports:         loadBalancer.Ports,
-			}
-
-			loadBalancerID, err = dbCluster.CreateNetworkLoadBalancer(ctx, tx.Tx(), lb)
-			if err != nil {
-				return err
+		// Remove port from ACLs requested.
+		for _, aclName := range securityACLsRemove {
+			// Don't remove ACLs that are in the add ACLs list (there are possibly added from
+			// the network assigned ACLs).
+			if slices.Contains(nicACLNames, aclName) {
+				continue
 			}
 
-			// Save the load balancer configuration.
-			err = dbCluster.CreateNetworkLoadBalancerConfig(ctx, tx.Tx(), loadBalancerID, loadBalancer.Config)
-			if err != nil {
-				return err
+			aclID, found := aclNameIDs[aclName]
+			if !found {
+				return "", nil, fmt.Errorf("Cannot find security ACL ID for %q", aclName)
 			}
 
-			return nil
-		})
-		if err != nil {
-			return err
+			// Remove NIC port from ACL port group.
+			portGroupName := acl.OVNACLPortGroupName(aclID)
+			acl.OVNPortGroupInstanceNICSchedule(portUUID, removeChangeSet, portGroupName)
+			n.logger.Debug("Scheduled logical port for ACL port group removal", logger.Ctx{"networkACL": aclName, "portGroup": portGroupName, "port": instancePortName})
 		}
+	}
 
-		reverter.Add(func() {
-			_ = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-				return dbCluster.DeleteNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), loadBalancerID)
-			})
-
-			_ = n.ovnnb.DeleteLoadBalancer(context.TODO(), n.getLoadBalancerName(loadBalancer.ListenAddress))
-			_ = n.loadBalancerBGPSetupPrefixes()
-		})
-
-		vips := n.loadBalancerFlattenVIPs(net.ParseIP(loadBalancer.ListenAddress), portMaps)
-
-		// Look at health checking configuration.
-		healthCheck, err := n.getHealthCheck(loadBalancer.NetworkLoadBalancerPut)
-		if err != nil {
-			return err
-		}
+	// Add instance NIC switch port to port groups required. Always run this as the addChangeSet should always
+	// be populated even if no ACLs being applied, because the NIC port needs to be added to the network level
+	// port group.
+	n.logger.Debug("Applying instance NIC port group member change sets")
+	err = n.ovnnb.UpdatePortGroupMembers(ctx, addChangeSet, removeChangeSet)
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed applying OVN port group member change sets for instance NIC: %w", err)
+	}
 
-		if healthCheck != nil {
-			for i := range vips {
-				vips[i].HealthCheck = healthCheck
-			}
-		}
+	// Set the automatic default ACL rule for the port.
+	if len(nicACLNames) > 0 {
+		ingressAction, ingressLogged := n.instanceDeviceACLDefaults(opts.DeviceConfig, "ingress")
+		egressAction, egressLogged := n.instanceDeviceACLDefaults(opts.DeviceConfig, "egress")
 
-		err = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(loadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
+		err = acl.OVNApplyInstanceNICDefaultRules(n.ovnnb, acl.OVNIntSwitchPortGroupName(n.ID()), logPrefix, instancePortName, ingressAction, ingressLogged, egressAction, egressLogged)
 		if err != nil {
-			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
-		}
-
-		// Add internal static route to the load-balancer (helps with OVN IC).
-		var nexthop net.IP
-		if listenAddressNet.IP.To4() == nil {
-			routerV6, _, err := n.parseRouterIntPortIPv6Net()
-			if err == nil {
-				nexthop = routerV6
-			}
-		} else {
-			routerV4, _, err := n.parseRouterIntPortIPv4Net()
-			if err == nil {
-				nexthop = routerV4
-			}
-		}
-
-		if nexthop != nil {
-			err = n.ovnnb.CreateLogicalRouterRoute(context.TODO(), n.getRouterName(), true, networkOVN.OVNRouterRoute{NextHop: nexthop, Prefix: *listenAddressNet})
-			if err != nil {
-				return err
-			}
-
-			reverter.Add(func() {
-				_ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), *listenAddressNet)
-			})
+			return "", nil, fmt.Errorf("Failed applying OVN default ACL rules for instance NIC: %w", err)
 		}
 
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		n.logger.Debug("Set NIC default rule", logger.Ctx{"port": instancePortName, "ingressAction": ingressAction, "ingressLogged": ingressLogged, "egressAction": egressAction, "egressLogged": egressLogged})
+	} else {
+		err = n.ovnnb.ClearPortGroupPortACLRules(ctx, acl.OVNIntSwitchPortGroupName(n.ID()), instancePortName)
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Failed clearing OVN default ACL rules for instance NIC: %w", err)
 		}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).CreateNetworkLoadBalancer(n.name, loadBalancer)
-		})
+		err := addressset.OVNAddressSetsDeleteIfUnused(n.state, n.logger, n.ovnnb, n.Project())
 		if err != nil {
-			return err
+			return "", nil, fmt.Errorf("Failed removing unused OVN address sets: %w", err)
 		}
-	}
 
-	// Refresh exported BGP prefixes on local member.
-	err := n.loadBalancerBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+		n.logger.Debug("Cleared NIC default rule", logger.Ctx{"port": instancePortName})
 	}
 
 	reverter.Success()
-	return nil
+	return instancePortName, dnsIPs, nil
 }
 
-// LoadBalancerUpdate updates a network load balancer.
-func (n *ovn) LoadBalancerUpdate(listenAddress string, req api.NetworkLoadBalancerPut, clientType request.ClientType) error {
-	reverter := revert.New()
-	defer reverter.Fail()
-
-	if clientType == request.ClientTypeNormal {
-		var curLoadBalancer *api.NetworkLoadBalancer
-		var curLoadBalancerID int64
+// instanceDeviceACLDefaults returns the action and logging mode to use for the specified direction's default rule.
+// If the security.acls.default.{in,e}gress.action or security.acls.default.{in,e}gress.logged settings are not
+// specified in the NIC device config, then the settings on the network are used, and if not specified there then
+// it returns "reject" and false respectively. Allow actions are stateless on stateless networks.
+func (n *ovn) instanceDeviceACLDefaults(deviceConfig deviceConfig.Device, direction string) (string, bool) {
+	defaults := map[string]string{
+		fmt.Sprintf("security.acls.default.%s.action", direction): "reject",
+		fmt.Sprintf("security.acls.default.%s.logged", direction): "false",
+	}
 
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			networkID := n.ID()
+	for k := range defaults {
+		if deviceConfig[k] != "" {
+			defaults[k] = deviceConfig[k]
+		} else if n.config[k] != "" {
+			defaults[k] = n.config[k]
+		}
+	}
 
-			// Get the load balancer.
-			dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
-				NetworkID:     &networkID,
-				ListenAddress: &listenAddress,
-			})
-			if err != nil {
-				return err
-			}
+	action := acl.OVNACLAction(defaults[fmt.Sprintf("security.acls.default.%s.action", direction)], util.IsTrue(n.config["security.stateless"]))
 
-			if len(dbLoadBalancers) != 1 {
-				return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
-			}
+	return action, util.IsTrue(defaults[fmt.Sprintf("security.acls.default.%s.logged", direction)])
+}
 
-			// Get the API struct.
-			curLoadBalancer, err = dbLoadBalancers[0].ToAPI(ctx, tx.Tx())
-			if err != nil {
-				return err
-			}
+// InstanceDevicePortIPs returns the allocated IPs for a device port.
+func (n *ovn) InstanceDevicePortIPs(instanceUUID string, deviceName string) ([]net.IP, error) {
+	if instanceUUID == "" {
+		return nil, errors.New("Instance UUID is required")
+	}
 
-			curLoadBalancerID = dbLoadBalancers[0].ID
+	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+
+	devIPs, err := n.ovnnb.GetLogicalSwitchPortIPs(context.TODO(), instancePortName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get OVN switch port IPs: %w", err)
+	}
+
+	return devIPs, nil
+}
+
+// InstanceDevicePortState returns the effective DHCP and router advertisement settings offered to the instance
+// device port along with its binding state.
+func (n *ovn) InstanceDevicePortState(instanceUUID string, deviceName string) (*api.InstanceStateNetworkOVN, error) {
+	if instanceUUID == "" {
+		return nil, errors.New("Instance UUID is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+
+	dhcpv4Option, dhcpv6Option, err := n.ovnnb.GetLogicalSwitchPortDHCPOptions(ctx, instancePortName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN switch port DHCP options: %w", err)
+	}
+
+	state := &api.InstanceStateNetworkOVN{
+		DNSServers:   []string{},
+		DNSSearch:    []string{},
+		PortSecurity: []string{},
+	}
+
+	// Get whether the port is up and where it's bound.
+	binding, err := n.ovnsb.GetLogicalSwitchPortBinding(ctx, instancePortName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN port binding: %w", err)
+	}
 
+	if binding != nil {
+		state.PortUp = binding.Up
+		state.Chassis = binding.Chassis
+		state.ChassisHostname = binding.Hostname
+
+		if binding.PortSecurity != nil {
+			state.PortSecurity = binding.PortSecurity
+		}
+	}
+
+	// splitOptionList splits an OVN option list value such as {a,b} or "a,b" into its entries.
+	splitOptionList := func(value string) []string {
+		value = strings.Trim(value, `{}"`)
+		if value == "" {
 			return nil
-		})
-		if err != nil {
-			return err
 		}
 
-		portMaps, err := n.loadBalancerValidate(net.ParseIP(curLoadBalancer.ListenAddress), &req)
-		if err != nil {
-			return err
+		return util.SplitNTrimSpace(value, ",", -1, true)
+	}
+
+	// appendUnique adds the entries not yet present to the list, as DHCPv4, DHCPv6 and router advertisements
+	// usually carry the same search domains.
+	appendUnique := func(list []string, entries []string) []string {
+		for _, entry := range entries {
+			if !slices.Contains(list, entry) {
+				list = append(list, entry)
+			}
 		}
 
-		curEtagHash, err := localUtil.EtagHash(curLoadBalancer.Etag())
-		if err != nil {
-			return err
+		return list
+	}
+
+	if dhcpv4Option != nil {
+		state.DHCPv4OptionsUUID = dhcpv4Option.UUID
+
+		leaseTime, err := strconv.ParseInt(dhcpv4Option.Options["lease_time"], 10, 64)
+		if err == nil {
+			state.LeaseTime = leaseTime
 		}
 
-		newLoadBalancer := api.NetworkLoadBalancer{
-			ListenAddress:          curLoadBalancer.ListenAddress,
-			NetworkLoadBalancerPut: req,
+		mtu, err := strconv.ParseInt(dhcpv4Option.Options["mtu"], 10, 64)
+		if err == nil {
+			state.MTU = mtu
 		}
 
-		newLoadBalancerEtagHash, err := localUtil.EtagHash(newLoadBalancer.Etag())
-		if err != nil {
-			return err
+		state.DNSServers = appendUnique(state.DNSServers, splitOptionList(dhcpv4Option.Options["dns_server"]))
+		state.DNSSearch = appendUnique(state.DNSSearch, splitOptionList(dhcpv4Option.Options["domain_search_list"]))
+	}
+
+	if dhcpv6Option != nil {
+		state.DHCPv6OptionsUUID = dhcpv6Option.UUID
+		state.DNSServers = appendUnique(state.DNSServers, splitOptionList(dhcpv6Option.Options["dns_server"]))
+		state.DNSSearch = appendUnique(state.DNSSearch, splitOptionList(dhcpv6Option.Options["domain_search"]))
+	}
+
+	// Router advertisements also carry the MTU and DNS settings for IPv6.
+	routerPort, err := n.ovnnb.GetLogicalRouterPort(ctx, n.getRouterIntPortName())
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN router port: %w", err)
+	}
+
+	if routerPort != nil && routerPort.Ipv6RaConfigs != nil {
+		if state.MTU == 0 {
+			mtu, err := strconv.ParseInt(routerPort.Ipv6RaConfigs["mtu"], 10, 64)
+			if err == nil {
+				state.MTU = mtu
+			}
 		}
 
-		if curEtagHash == newLoadBalancerEtagHash {
-			return nil // Nothing has changed.
+		state.DNSServers = appendUnique(state.DNSServers, splitOptionList(routerPort.Ipv6RaConfigs["rdnss"]))
+		state.DNSSearch = appendUnique(state.DNSSearch, splitOptionList(routerPort.Ipv6RaConfigs["dnssl"]))
+	}
+
+	return state, nil
+}
+
+// InstanceDevicePortDiff compares the expected configuration of an instance device port with its records in the OVN
+// northbound and southbound databases. The running argument indicates whether the port is expected to be up.
+func (n *ovn) InstanceDevicePortDiff(ctx context.Context, instanceUUID string, deviceName string, deviceConfig deviceConfig.Device, running bool) (*api.InstanceInterfaceOVN, error) {
+	if instanceUUID == "" {
+		return nil, errors.New("Instance UUID is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ovnPortOperationTimeout)
+	defer cancel()
+
+	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+
+	diff := &api.InstanceInterfaceOVN{
+		LogicalSwitchPort: string(instancePortName),
+		Consistent:        true,
+		Checks:            []api.InstanceInterfaceOVNCheck{},
+	}
+
+	// addCheck compares the expected and actual values, regardless of their order.
+	addCheck := func(name string, expected []string, actual []string, message string, skipped bool) {
+		check := api.InstanceInterfaceOVNCheck{Name: name, Status: "ok", Expected: []string{}, Actual: []string{}}
+		check.Expected = append(check.Expected, expected...)
+		check.Actual = append(check.Actual, actual...)
+		slices.Sort(check.Expected)
+		slices.Sort(check.Actual)
+
+		if skipped {
+			check.Status = "skipped"
+		} else if !slices.Equal(check.Expected, check.Actual) {
+			check.Status = "mismatch"
+			check.Message = message
+			diff.Consistent = false
 		}
 
-		vips := n.loadBalancerFlattenVIPs(net.ParseIP(newLoadBalancer.ListenAddress), portMaps)
+		diff.Checks = append(diff.Checks, check)
+	}
 
-		// Look at health checking configuration.
-		healthCheck, err := n.getHealthCheck(newLoadBalancer.NetworkLoadBalancerPut)
-		if err != nil {
-			return err
+	lsp, err := n.ovnnb.GetLogicalSwitchPort(ctx, instancePortName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN switch port: %w", err)
+	}
+
+	// Stop there if the port doesn't exist, it only does while the instance is running.
+	if lsp == nil {
+		addCheck("port", []string{string(instancePortName)}, nil, "Logical switch port is missing", !running)
+		return diff, nil
+	}
+
+	addCheck("port", []string{string(instancePortName)}, []string{lsp.Name}, "", false)
+
+	// Compare the MAC address and static addresses of the port.
+	var portMAC string
+	var portStaticIPs []string
+	portIPs := []net.IP{}
+
+	addresses := slices.Clone(lsp.Addresses)
+	if lsp.DynamicAddresses != nil {
+		addresses = append(addresses, *lsp.DynamicAddresses)
+	}
+
+	for i, address := range addresses {
+		fields := strings.Fields(address)
+		if len(fields) == 0 {
+			continue
 		}
 
-		if healthCheck != nil {
-			for i := range vips {
-				vips[i].HealthCheck = healthCheck
+		mac, err := net.ParseMAC(fields[0])
+		if err == nil && portMAC == "" {
+			portMAC = mac.String()
+		}
+
+		for _, field := range fields[1:] {
+			ip := net.ParseIP(field)
+			if ip == nil {
+				continue
 			}
+
+			// The dynamic addresses come last.
+			if i < len(lsp.Addresses) {
+				portStaticIPs = append(portStaticIPs, ip.String())
+			}
+
+			portIPs = append(portIPs, ip)
 		}
+	}
 
-		err = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(newLoadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
-		if err != nil {
-			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
+	var expectedMAC string
+	mac, err := net.ParseMAC(deviceConfig["hwaddr"])
+	if err == nil {
+		expectedMAC = mac.String()
+	}
+
+	addCheck("mac_address", []string{expectedMAC}, []string{portMAC}, "Logical switch port doesn't use the NIC's MAC address", expectedMAC == "")
+
+	expectedIPs := []string{}
+	for _, key := range []string{"ipv4.address", "ipv6.address"} {
+		ip := net.ParseIP(deviceConfig[key])
+		if ip != nil {
+			expectedIPs = append(expectedIPs, ip.String())
 		}
+	}
 
-		reverter.Add(func() {
-			// Apply old settings to OVN on failure.
-			portMaps, err := n.loadBalancerValidate(net.ParseIP(curLoadBalancer.ListenAddress), &curLoadBalancer.NetworkLoadBalancerPut)
-			if err == nil {
-				vips := n.loadBalancerFlattenVIPs(net.ParseIP(curLoadBalancer.ListenAddress), portMaps)
-				_ = n.ovnnb.CreateLoadBalancer(context.TODO(), n.getLoadBalancerName(curLoadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), vips...)
-				_ = n.forwardBGPSetupPrefixes()
-			}
-		})
+	// Only static addresses can be compared, dynamic ones being allocated by OVN.
+	actualIPs := []string{}
+	for _, ip := range portStaticIPs {
+		if slices.Contains(expectedIPs, ip) {
+			actualIPs = append(actualIPs, ip)
+		}
+	}
 
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			lb := dbCluster.NetworkLoadBalancer{
-				NetworkID:     n.ID(),
-				ListenAddress: listenAddress,
-				Description:   newLoadBalancer.Description,
-				Backends:      newLoadBalancer.Backends,
-				Ports:         newLoadBalancer.Ports,
+	addCheck("addresses", expectedIPs, actualIPs, "Logical switch port doesn't have the NIC's static addresses", len(expectedIPs) == 0)
+
+	// Compare the DHCP options of the port with those of the network.
+	dhcpV4UUID, dhcpV6UUID, err := n.getDhcpOptionUUIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedDHCP := []string{}
+	if n.DHCPv4Subnet() != nil && deviceConfig["mode"] != "routed" && dhcpV4UUID != "" {
+		expectedDHCP = append(expectedDHCP, string(dhcpV4UUID))
+	}
+
+	if n.DHCPv6Subnet() != nil && deviceConfig["ipv6.address"] != "none" && dhcpV6UUID != "" {
+		expectedDHCP = append(expectedDHCP, string(dhcpV6UUID))
+	}
+
+	actualDHCP := []string{}
+	if lsp.Dhcpv4Options != nil && deviceConfig["mode"] != "routed" {
+		actualDHCP = append(actualDHCP, *lsp.Dhcpv4Options)
+	}
+
+	if lsp.Dhcpv6Options != nil {
+		actualDHCP = append(actualDHCP, *lsp.Dhcpv6Options)
+	}
+
+	addCheck("dhcp_options", expectedDHCP, actualDHCP, "Logical switch port doesn't use the DHCP options of the network", false)
+
+	// Compare the port groups of the port with the network's and those of the security ACLs.
+	netACLNames, err := n.securityACLs(ctx, n.config)
+	if err != nil {
+		return nil, err
+	}
+
+	aclNames := util.SplitNTrimSpace(deviceConfig["security.acls"], ",", -1, true)
+	if util.IsFalseOrEmpty(deviceConfig["security.acls.exclusive"]) {
+		for _, aclName := range netACLNames {
+			if !slices.Contains(aclNames, aclName) {
+				aclNames = append(aclNames, aclName)
 			}
+		}
+	}
 
-			err = dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress, lb)
+	expectedPortGroups := []string{string(acl.OVNIntSwitchPortGroupName(n.ID()))}
+	if len(aclNames) > 0 {
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			acls, err := dbCluster.GetNetworkACLs(ctx, tx.Tx(), dbCluster.NetworkACLFilter{Project: &n.project})
 			if err != nil {
 				return err
 			}
 
-			err = dbCluster.UpdateNetworkLoadBalancerConfig(ctx, tx.Tx(), curLoadBalancerID, newLoadBalancer.Config)
-			if err != nil {
-				return err
+			for _, networkACL := range acls {
+				if slices.Contains(aclNames, networkACL.Name) {
+					expectedPortGroups = append(expectedPortGroups, string(acl.OVNACLPortGroupName(int64(networkACL.ID))))
+				}
 			}
 
 			return nil
 		})
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("Failed getting network ACL IDs: %w", err)
 		}
+	}
 
-		reverter.Add(func() {
-			_ = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-				lb := dbCluster.NetworkLoadBalancer{
-					NetworkID:     n.ID(),
-					ListenAddress: listenAddress,
-					Description:   curLoadBalancer.Description,
-					Backends:      curLoadBalancer.Backends,
-					Ports:         curLoadBalancer.Ports,
-				}
+	portGroups, err := n.ovnnb.GetLogicalSwitchPortGroups(ctx, instancePortName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN port groups: %w", err)
+	}
 
-				err = dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress, lb)
-				if err != nil {
-					return err
-				}
+	actualPortGroups := make([]string, 0, len(portGroups))
+	for _, portGroup := range portGroups {
+		actualPortGroups = append(actualPortGroups, string(portGroup))
+	}
 
-				err = dbCluster.UpdateNetworkLoadBalancerConfig(ctx, tx.Tx(), curLoadBalancerID, curLoadBalancer.Config)
-				if err != nil {
-					return err
-				}
+	addCheck("port_groups", expectedPortGroups, actualPortGroups, "Logical switch port isn't in the port groups of the network and its security ACLs", false)
 
-				return nil
-			})
-		})
+	// Compare the routes of the NIC with the router's static routes going through the port's addresses.
+	internalRoutes, externalRoutes, err := n.instanceDevicePortRoutesParse(deviceConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing NIC device routes: %w", err)
+	}
 
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
-		if err != nil {
-			return err
+	expectedRoutes := []string{}
+	for _, route := range append(internalRoutes, externalRoutes...) {
+		expectedRoutes = append(expectedRoutes, route.String())
+	}
+
+	routed := deviceConfig["mode"] == "routed"
+	for _, ip := range portIPs {
+		if (ip.To4() != nil && (util.IsTrue(n.config["ipv4.l3only"]) || routed)) || (ip.To4() == nil && (util.IsTrue(n.config["ipv6.l3only"]) || routed)) {
+			ipNet := IPToNet(ip)
+			expectedRoutes = append(expectedRoutes, ipNet.String())
+		}
+	}
+
+	routerRoutes, err := n.ovnnb.GetLogicalRouterRoutes(ctx, n.getRouterName())
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN router routes: %w", err)
+	}
+
+	actualRoutes := []string{}
+	for _, route := range routerRoutes {
+		if route.NextHop != nil && IPInSlice(route.NextHop, portIPs) {
+			actualRoutes = append(actualRoutes, route.Prefix.String())
+		}
+	}
+
+	addCheck("routes", expectedRoutes, actualRoutes, "Logical router routes don't match the NIC's routes", false)
+
+	// Compare the port binding state with the instance state.
+	binding, err := n.ovnsb.GetLogicalSwitchPortBinding(ctx, instancePortName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting OVN port binding: %w", err)
+	}
+
+	actualBinding := []string{"down"}
+	if binding != nil && binding.Up {
+		actualBinding = []string{"up"}
+	}
+
+	addCheck("binding", []string{"up"}, actualBinding, "Logical switch port isn't up in the southbound database", !running)
+
+	return diff, nil
+}
+
+// InstanceDevicePortStop deletes an instance device port from the internal logical switch.
+func (n *ovn) InstanceDevicePortStop(ovsExternalOVNPort networkOVN.OVNSwitchPort, opts *OVNInstanceNICStopOpts) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	return n.switchPortStop(ctx, ovsExternalOVNPort, opts)
+}
+
+// switchPortStop deletes the logical switch port provided by OVS or derived from the instance device from the
+// internal logical switch.
+func (n *ovn) switchPortStop(ctx context.Context, ovsExternalOVNPort networkOVN.OVNSwitchPort, opts *OVNInstanceNICStopOpts) error {
+	// Decide whether to use OVS provided OVN port name or internally derived OVN port name.
+	instancePortName := ovsExternalOVNPort
+	source := "OVS"
+	if ovsExternalOVNPort == "" {
+		if opts.InstanceUUID == "" {
+			return errors.New("Instance UUID is required")
 		}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).UpdateNetworkLoadBalancer(n.name, curLoadBalancer.ListenAddress, req, "")
-		})
-		if err != nil {
-			return err
-		}
+		instancePortName = n.getInstanceDevicePortName(opts.InstanceUUID, opts.DeviceName)
+		source = "internal"
+	}
+
+	portLocation, err := n.ovnnb.GetLogicalSwitchPortLocation(ctx, instancePortName)
+	if err != nil {
+		return fmt.Errorf("Failed getting instance switch port options: %w", err)
+	}
+
+	// Don't delete logical switch port if already active on another chassis (i.e during live cluster move).
+	if portLocation != "" && portLocation != n.state.ServerName {
+		return nil
+	}
+
+	n.logger.Debug("Deleting instance port", logger.Ctx{"port": instancePortName, "source": source})
+
+	internalRoutes, externalRoutes, err := n.instanceDevicePortRoutesParse(opts.DeviceConfig)
+	if err != nil {
+		return fmt.Errorf("Failed parsing NIC device routes: %w", err)
+	}
+
+	var uplink *api.Network
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		// Load uplink network config.
+		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+	}
+
+	// Get DNS records.
+	dnsUUID, dnsName, dnsIPs, err := n.ovnnb.GetLogicalSwitchPortDNS(ctx, instancePortName)
+	if err != nil {
+		return err
+	}
+
+	// Cleanup logical switch port and associated config.
+	err = n.ovnnb.CleanupLogicalSwitchPort(ctx, instancePortName, n.getIntSwitchName(), acl.OVNIntSwitchPortGroupName(n.ID()), dnsUUID)
+	if err != nil {
+		return err
+	}
+
+	err = n.switchPortDNSNameShare(ctx, dnsName)
+	if err != nil {
+		return err
+	}
+
+	// Remove static neighbors no longer owned by any other port.
+	neighbors, err := n.instanceDevicePortNeighborsParse(opts.DeviceConfig, nil)
+	if err != nil {
+		return fmt.Errorf("Failed parsing NIC device static neighbors: %w", err)
+	}
+
+	neighborIPs := make([]net.IP, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		neighborIPs = append(neighborIPs, neighbor.IP)
+	}
+
+	err = n.staticNeighborsRemove(ctx, instancePortName, neighborIPs)
+	if err != nil {
+		return err
+	}
+
+	var removeRoutes []net.IPNet
+	var removeNATIPs []net.IP
+
+	if len(dnsIPs) > 0 {
+		// When using l3only mode the instance port's IPs are added as static routes to the router.
+		// So try and remove these in case l3only is (or was) being used.
+		for _, dnsIP := range dnsIPs {
+			removeRoutes = append(removeRoutes, IPToNet(dnsIP))
+		}
+
+		// Delete any associated external IP DNAT rules for the DNS IPs.
+		removeNATIPs = append(removeNATIPs, dnsIPs...)
+	}
+
+	// Delete internal routes.
+	if len(internalRoutes) > 0 {
+		for _, internalRoute := range internalRoutes {
+			removeRoutes = append(removeRoutes, *internalRoute)
+		}
+	}
+
+	// Delete external routes.
+	var removeARPProxy []net.IPNet
+	for _, externalRoute := range externalRoutes {
+		removeRoutes = append(removeRoutes, *externalRoute)
+
+		// Remove the DNAT rules (or ARP/NDP proxy entries) when using l2proxy ingress mode on uplink.
+		if slices.Contains([]string{"l2proxy", ""}, uplink.Config["ovn.ingress_mode"]) {
+			if util.IsTrue(uplink.Config["ovn.l2proxy.aggregate"]) {
+				removeARPProxy = append(removeARPProxy, *externalRoute)
+				continue
+			}
+
+			err = SubnetIterate(externalRoute, func(ip net.IP) error {
+				removeNATIPs = append(removeNATIPs, ip)
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(removeRoutes) > 0 {
+		// Delete routes from local router.
+		err = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), removeRoutes...)
+		if err != nil {
+			return err
+		}
+
+		// Delete routes from switch address set.
+		err = n.ovnnb.UpdateAddressSetRemove(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), removeRoutes...)
+		if err != nil {
+			return fmt.Errorf("Failed deleting switch address set entries: %w", err)
+		}
+
+		// Delete routes from peer routers.
+		err = n.forPeers(ctx, func(targetOVNNet *ovn) error {
+			targetRouterName := targetOVNNet.getRouterName()
+			err = n.ovnnb.DeleteLogicalRouterRoute(ctx, targetRouterName, removeRoutes...)
+			if err != nil {
+				return fmt.Errorf("Failed deleting static routes from peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(removeNATIPs) > 0 {
+		err = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "dnat_and_snat", false, removeNATIPs...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(removeARPProxy) > 0 {
+		err = n.ovnnb.UpdateLogicalSwitchPortARPProxyRemove(ctx, n.getExtSwitchRouterPortName(), removeARPProxy...)
+		if err != nil {
+			return fmt.Errorf("Failed removing external routes from ARP/NDP proxy: %w", err)
+		}
+	}
+
+	// Tear down per‑NIC egress SNAT rules (ipv4/ipv6.address.external)
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		// Check if the address is present.
+		value := opts.DeviceConfig[fmt.Sprintf("%s.address.external", keyPrefix)]
+		if value == "" {
+			continue
+		}
+
+		// Validate the address.
+		extIP := net.ParseIP(value)
+		if extIP == nil {
+			return fmt.Errorf("Invalid external address %q", value)
+		}
+
+		// Remove the SNAT entry.
+		err := n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", false, extIP)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instanceDevicePortExternalIntNet returns the internal address of the port for the family of keyPrefix, as
+// translated by the SNAT rule of the device's external address. Returns nil if the port has no such address.
+func (n *ovn) instanceDevicePortExternalIntNet(portIPs []net.IP, keyPrefix string) *net.IPNet {
+	bits := 128
+	if keyPrefix == "ipv4" {
+		bits = 32
+	}
+
+	for _, portIP := range portIPs {
+		if (portIP.To4() != nil) != (keyPrefix == "ipv4") {
+			continue
+		}
+
+		mask := net.CIDRMask(bits, bits)
+
+		return &net.IPNet{IP: portIP.Mask(mask), Mask: mask}
+	}
+
+	return nil
+}
+
+// instanceDevicePortExternalSNATRefresh recreates the SNAT rules of the external addresses of an instance device
+// port so that they match the stateless mode of the network.
+func (n *ovn) instanceDevicePortExternalSNATRefresh(ctx context.Context, reverter *revert.Reverter, instancePortName networkOVN.OVNSwitchPort, deviceConfig map[string]string) error {
+	portIPs, err := n.ovnnb.GetLogicalSwitchPortIPs(ctx, instancePortName)
+	if err != nil {
+		return fmt.Errorf("Failed getting OVN switch port IPs: %w", err)
+	}
+
+	stateless := util.IsTrue(n.config["security.stateless"])
+
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		extIP := net.ParseIP(deviceConfig[fmt.Sprintf("%s.address.external", keyPrefix)])
+		if extIP == nil {
+			continue
+		}
+
+		intNet := n.instanceDevicePortExternalIntNet(portIPs, keyPrefix)
+		if intNet == nil {
+			continue
+		}
+
+		err = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", false, extIP)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Failed removing SNAT %q: %w", extIP.String(), err)
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", false, extIP)
+			_ = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "snat", intNet, extIP, nil, !stateless, true)
+		})
+
+		err = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "snat", intNet, extIP, nil, stateless, true)
+		if err != nil {
+			return fmt.Errorf("Failed adding SNAT %q: %w", extIP.String(), err)
+		}
+	}
+
+	return nil
+}
+
+// InstanceDevicePortExternalAddressesUpdate replaces the SNAT rules of the instance device port whose external
+// address (ipv4.address.external or ipv6.address.external) changed, without restarting the port.
+func (n *ovn) InstanceDevicePortExternalAddressesUpdate(instanceUUID string, deviceName string, oldConfig deviceConfig.Device, newConfig deviceConfig.Device) error {
+	if instanceUUID == "" {
+		return errors.New("Instance UUID is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+
+	portIPs, err := n.ovnnb.GetLogicalSwitchPortIPs(ctx, instancePortName)
+	if err != nil {
+		return fmt.Errorf("Failed getting OVN switch port IPs: %w", err)
+	}
+
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		key := fmt.Sprintf("%s.address.external", keyPrefix)
+		if oldConfig[key] == newConfig[key] {
+			continue
+		}
+
+		// Find the internal address of the port for the family, the rules only exist if it has one.
+		intNet := n.instanceDevicePortExternalIntNet(portIPs, keyPrefix)
+		if intNet == nil {
+			continue
+		}
+
+		stateless := util.IsTrue(n.config["security.stateless"])
+
+		// Remove the rule of the old address.
+		oldExtIP := net.ParseIP(oldConfig[key])
+		if oldExtIP != nil {
+			err = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", false, oldExtIP)
+			if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+				return fmt.Errorf("Failed removing SNAT %q: %w", oldExtIP.String(), err)
+			}
+
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "snat", intNet, oldExtIP, nil, stateless, true)
+			})
+		}
+
+		// Add the rule of the new address.
+		newExtIP := net.ParseIP(newConfig[key])
+		if newExtIP != nil {
+			err = n.ovnnb.CreateLogicalRouterNAT(ctx, n.getRouterName(), "snat", intNet, newExtIP, nil, stateless, true)
+			if err != nil {
+				return fmt.Errorf("Failed adding SNAT %q: %w", newExtIP.String(), err)
+			}
+
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterNAT(ctx, n.getRouterName(), "snat", false, newExtIP)
+			})
+		}
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// InstanceDevicePortRemove unregisters the NIC device in the OVN database by removing the DNS entry that should
+// have been created during InstanceDevicePortAdd(). If the DNS record exists at remove time then this indicates
+// the NIC device was successfully added and this function also clears any DHCP reservations for the NIC's IPs.
+func (n *ovn) InstanceDevicePortRemove(instanceUUID string, deviceName string, deviceConfig deviceConfig.Device) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	instancePortName := n.getInstanceDevicePortName(instanceUUID, deviceName)
+
+	// Delete the port if it was pre-created and the NIC never started.
+	enabled, err := n.ovnnb.GetLogicalSwitchPortEnabled(ctx, instancePortName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return err
+	}
+
+	if err == nil && !enabled {
+		err = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), instancePortName)
+		if err != nil {
+			return fmt.Errorf("Failed deleting pre-created logical switch port: %w", err)
+		}
+	}
+
+	return n.switchPortRemove(ctx, instancePortName, deviceConfig)
+}
+
+// switchPortDNSName returns the DNS name to register for the logical switch port, applying the network's policy for
+// names already registered by other ports.
+func (n *ovn) switchPortDNSName(ctx context.Context, portName networkOVN.OVNSwitchPort, opts *OVNInstanceNICSetupOpts) (string, error) {
+	dnsName := fmt.Sprintf("%s.%s", opts.DNSName, n.getDomainName())
+
+	policy := n.config["dns.conflicts"]
+	if !slices.Contains([]string{"reject", "suffix"}, policy) {
+		return dnsName, nil
+	}
+
+	dnsNames, err := n.ovnnb.GetLogicalSwitchDNSNames(ctx, n.getIntSwitchName())
+	if err != nil {
+		return "", fmt.Errorf("Failed getting DNS names: %w", err)
+	}
+
+	conflicts := slices.DeleteFunc(slices.Clone(dnsNames[strings.ToLower(dnsName)]), func(conflictPortName networkOVN.OVNSwitchPort) bool {
+		return conflictPortName == portName
+	})
+
+	if len(conflicts) == 0 {
+		return dnsName, nil
+	}
+
+	if policy == "reject" {
+		return "", api.StatusErrorf(http.StatusConflict, "DNS name %q is already in use by port %q", dnsName, conflicts[0])
+	}
+
+	return fmt.Sprintf("%s-%s.%s", opts.DNSName, opts.DeviceName, n.getDomainName()), nil
+}
+
+// switchPortDNSNameShare makes the DNS name resolve to all the ports registering it when the network is configured
+// to answer conflicting names in a round-robin fashion.
+func (n *ovn) switchPortDNSNameShare(ctx context.Context, dnsName string) error {
+	if dnsName == "" || n.config["dns.conflicts"] != "round-robin" {
+		return nil
+	}
+
+	err := n.ovnnb.UpdateLogicalSwitchDNSNameShared(ctx, n.getIntSwitchName(), dnsName)
+	if err != nil {
+		return fmt.Errorf("Failed updating the addresses of DNS name %q: %w", dnsName, err)
+	}
+
+	return nil
+}
+
+// dnsConflicts returns the DNS names registered by more than one port of the network along with the instances
+// using them.
+func (n *ovn) dnsConflicts(ctx context.Context) ([]api.NetworkStateOVNDNSConflict, error) {
+	dnsNames, err := n.ovnnb.GetLogicalSwitchDNSNames(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting DNS names: %w", err)
+	}
+
+	conflictPorts := map[networkOVN.OVNSwitchPort]string{}
+	for dnsName, portNames := range dnsNames {
+		if len(portNames) < 2 {
+			continue
+		}
+
+		for _, portName := range portNames {
+			conflictPorts[portName] = dnsName
+		}
+	}
+
+	if len(conflictPorts) == 0 {
+		return nil, nil
+	}
+
+	// Find the instances owning the ports.
+	usedBy := map[string][]string{}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		instanceUUID := inst.Config["volatile.uuid"]
+		if instanceUUID == "" {
+			return nil
+		}
+
+		portName := n.getInstanceDevicePortName(instanceUUID, nicName)
+		dnsName, found := conflictPorts[portName]
+		if !found {
+			return nil
+		}
+
+		delete(conflictPorts, portName)
+		usedBy[dnsName] = append(usedBy[dnsName], api.NewURL().Path(version.APIVersion, "instances", inst.Name).Project(inst.Project).String())
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ports not belonging to an instance are reported by name.
+	for portName, dnsName := range conflictPorts {
+		usedBy[dnsName] = append(usedBy[dnsName], string(portName))
+	}
+
+	conflicts := make([]api.NetworkStateOVNDNSConflict, 0, len(usedBy))
+	for dnsName, entries := range usedBy {
+		slices.Sort(entries)
+		conflicts = append(conflicts, api.NetworkStateOVNDNSConflict{
+			Name:   dnsName,
+			UsedBy: entries,
+		})
+	}
+
+	slices.SortFunc(conflicts, func(a api.NetworkStateOVNDNSConflict, b api.NetworkStateOVNDNSConflict) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return conflicts, nil
+}
+
+// switchPortRemove removes the DNS entry and any static DHCP reservations of the named logical switch port.
+func (n *ovn) switchPortRemove(ctx context.Context, instancePortName networkOVN.OVNSwitchPort, deviceConfig deviceConfig.Device) error {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Get DNS records.
+	dnsUUID, dnsName, _, err := n.ovnnb.GetLogicalSwitchPortDNS(ctx, instancePortName)
+	if err != nil {
+		return err
+	}
+
+	// Remove DNS record if exists.
+	if dnsUUID != "" {
+		// If NIC has static IPv4 address then remove the DHCPv4 reservation.
+		if deviceConfig["ipv4.address"] != "" {
+			ip := net.ParseIP(deviceConfig["ipv4.address"])
+			if ip != nil {
+				dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName())
+				if err != nil {
+					return fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
+				}
+
+				dhcpReservations = append(dhcpReservations, iprange.Range{Start: ip})
+				dhcpReservationsNew := make([]iprange.Range, 0, len(dhcpReservations))
+
+				found := false
+				for _, dhcpReservation := range dhcpReservations {
+					if dhcpReservation.Start.Equal(ip) && dhcpReservation.End == nil {
+						found = true
+						continue
+					}
+
+					dhcpReservationsNew = append(dhcpReservationsNew, dhcpReservation)
+				}
+
+				if found {
+					err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName(), dhcpReservationsNew)
+					if err != nil {
+						return fmt.Errorf("Failed removing DHCPv4 reservation for %q: %w", ip.String(), err)
+					}
+				}
+			}
+		}
+
+		// If NIC has static IPv6 address then remove the DHCPv6 reservation.
+		if deviceConfig["ipv6.address"] != "" {
+			ip := net.ParseIP(deviceConfig["ipv6.address"])
+			if ip != nil && ip.To4() == nil {
+				err = n.dhcpv6ReservationRemove(ctx, ip)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err = n.ovnnb.DeleteLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), dnsUUID, true)
+		if err != nil {
+			return fmt.Errorf("Failed deleting DNS record: %w", err)
+		}
+
+		err = n.switchPortDNSNameShare(ctx, dnsName)
+		if err != nil {
+			return err
+		}
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// DHCPv4Subnet returns the DHCPv4 subnet (if DHCP is enabled on network).
+func (n *ovn) DHCPv4Subnet() *net.IPNet {
+	// DHCP is disabled on this network (an empty ipv4.dhcp setting indicates enabled by default).
+	if util.IsFalse(n.config["ipv4.dhcp"]) {
+		return nil
+	}
+
+	_, subnet, err := n.parseRouterIntPortIPv4Net()
+	if err != nil {
+		return nil
+	}
+
+	return subnet
+}
+
+// DHCPv6Subnet returns the DHCPv6 subnet (if DHCP or SLAAC is enabled on network).
+func (n *ovn) DHCPv6Subnet() *net.IPNet {
+	// DHCP is disabled on this network (an empty ipv6.dhcp setting indicates enabled by default).
+	if util.IsFalse(n.config["ipv6.dhcp"]) {
+		return nil
+	}
+
+	_, subnet, err := n.parseRouterIntPortIPv6Net()
+	if err != nil {
+		return nil
+	}
+
+	if subnet != nil {
+		ones, _ := subnet.Mask.Size()
+		if ones < 64 {
+			return nil // OVN only supports DHCPv6 allocated using EUI64 (which needs at least a /64).
+		}
+	}
+
+	return subnet
+}
+
+// ovnSubnetSupportsEUI64 returns whether OVN can derive addresses from MAC addresses within the IPv6 subnet.
+func ovnSubnetSupportsEUI64(subnet *net.IPNet) bool {
+	if subnet == nil {
+		return false
+	}
+
+	ones, _ := subnet.Mask.Size()
+
+	return ones <= 64
+}
+
+// instancePortStaticIPs picks static addresses for an instance port on a network whose IPv6 subnet is too small
+// for OVN's dynamic allocation. A previously used IPv6 address of the port is kept when still free, otherwise the
+// first address that's neither in use on the internal switch nor reserved is used. A dynamic IPv4 address is
+// picked the same way from the DHCPv4 ranges.
+func (n *ovn) instancePortStaticIPs(ctx context.Context, ipv4 string, lastStateIPs []net.IP) (string, string, error) {
+	routerIntPortIPv6, routerIntPortIPv6Net, err := n.parseRouterIntPortIPv6Net()
+	if err != nil {
+		return "", "", err
+	}
+
+	existingPortIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return "", "", fmt.Errorf("Failed getting existing switch port IPs: %w", err)
+	}
+
+	var usedIPs []net.IP
+	for _, ips := range existingPortIPs {
+		usedIPs = append(usedIPs, ips...)
+	}
+
+	// Reserve the router address, the services subnet, the failover addresses and the static addresses of
+	// the instance NICs which may not be running.
+	reservedIPv6s := []iprange.Range{{Start: routerIntPortIPv6}}
+
+	_, servicesNet, err := net.ParseCIDR(n.config["ipv6.services"])
+	if err == nil {
+		reservedIPv6s = append(reservedIPv6s, iprange.Range{Start: servicesNet.IP, End: dhcpalloc.GetIP(servicesNet, -1)})
+	}
+
+	for _, failover := range n.failoverAddresses(n.config) {
+		if failover.address.To4() == nil {
+			reservedIPv6s = append(reservedIPv6s, iprange.Range{Start: failover.address})
+		}
+	}
+
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		ip := net.ParseIP(nicConfig["ipv6.address"])
+		if ip != nil {
+			reservedIPv6s = append(reservedIPv6s, iprange.Range{Start: ip})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	// Also skip the DHCPv6 reservations, which cover the static addresses of ports not backed by an instance NIC.
+	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return "", "", fmt.Errorf("Failed getting DHCPv6 reservations: %w", err)
+	}
+
+	for _, ip := range dhcpReservations {
+		reservedIPv6s = append(reservedIPv6s, iprange.Range{Start: ip})
+	}
+
+	var ipv6 net.IP
+	for _, ip := range lastStateIPs {
+		if ip.To4() == nil && routerIntPortIPv6Net.Contains(ip) && !IPInSlice(ip, usedIPs) && !ipInRanges(ip, reservedIPv6s) {
+			ipv6 = ip
+			break
+		}
+	}
+
+	if ipv6 == nil {
+		ipv6, err = ovnSubnetFreeIP(routerIntPortIPv6Net, dhcpalloc.GetIP(routerIntPortIPv6Net, -1), usedIPs, reservedIPv6s)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if ipv4 == "" && n.DHCPv4Subnet() != nil {
+		_, routerIntPortIPv4Net, err := n.parseRouterIntPortIPv4Net()
+		if err != nil {
+			return "", "", err
+		}
+
+		reservedIPv4s, err := n.getDHCPv4Reservations()
+		if err != nil {
+			return "", "", err
+		}
+
+		// Skip the broadcast address.
+		ip, err := ovnSubnetFreeIP(routerIntPortIPv4Net, dhcpalloc.GetIP(routerIntPortIPv4Net, -2), usedIPs, reservedIPv4s)
+		if err != nil {
+			return "", "", err
+		}
+
+		ipv4 = ip.String()
+	}
+
+	return ipv4, ipv6.String(), nil
+}
+
+// ovnSubnetFreeIP returns the first address of the subnet, after the network address and up to lastIP, which is
+// neither used nor reserved.
+func ovnSubnetFreeIP(subnet *net.IPNet, lastIP net.IP, usedIPs []net.IP, reserved []iprange.Range) (net.IP, error) {
+	startIP, _ := netip.AddrFromSlice(dhcpalloc.GetIP(subnet, 1))
+	endIP, _ := netip.AddrFromSlice(lastIP)
+
+	for ip := startIP.Unmap(); ip.IsValid() && ip.Compare(endIP.Unmap()) <= 0; ip = ip.Next() {
+		// Use the 16-byte form so that the reserved ranges (holding parsed addresses) can be compared against.
+		candidate := net.IP(ip.AsSlice()).To16()
+		if !IPInSlice(candidate, usedIPs) && !ipInRanges(candidate, reserved) {
+			return candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No free address left in %q", subnet.String())
+}
+
+// ovnNetworkExternalSubnets returns a list of external subnets used by OVN networks using the same uplink as this
+// OVN network. OVN networks are considered to be using external subnets for their ipv4.address and/or ipv6.address
+// if they have NAT disabled, and/or if they have external NAT addresses specified.
+func (n *ovn) ovnNetworkExternalSubnets(ovnProjectNetworksWithOurUplink map[string][]*api.Network) ([]externalSubnetUsage, error) {
+	externalSubnets := make([]externalSubnetUsage, 0)
+	for netProject, networks := range ovnProjectNetworksWithOurUplink {
+		for _, netInfo := range networks {
+			for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+				// If NAT is disabled, then network subnet is an external subnet.
+				if util.IsFalseOrEmpty(netInfo.Config[fmt.Sprintf("%s.nat", keyPrefix)]) {
+					key := fmt.Sprintf("%s.address", keyPrefix)
+
+					_, ipNet, err := net.ParseCIDR(netInfo.Config[key])
+					if err != nil {
+						continue // Skip invalid/unspecified network addresses.
+					}
+
+					externalSubnets = append(externalSubnets, externalSubnetUsage{
+						subnet:         *ipNet,
+						networkProject: netProject,
+						networkName:    netInfo.Name,
+						usageType:      subnetUsageNetwork,
+					})
+				}
+
+				// Find any external subnets used for network SNAT.
+				if netInfo.Config[fmt.Sprintf("%s.nat.address", keyPrefix)] != "" {
+					key := fmt.Sprintf("%s.nat.address", keyPrefix)
+
+					subnetSize := 128
+					if keyPrefix == "ipv4" {
+						subnetSize = 32
+					}
+
+					_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", netInfo.Config[key], subnetSize))
+					if err != nil {
+						return nil, fmt.Errorf("Failed parsing %q of %q in project %q: %w", key, netInfo.Name, netProject, err)
+					}
+
+					externalSubnets = append(externalSubnets, externalSubnetUsage{
+						subnet:         *ipNet,
+						networkProject: netProject,
+						networkName:    netInfo.Name,
+						usageType:      subnetUsageNetworkSNAT,
+					})
+				}
+			}
+
+			// Find any external addresses used by the network NAT policies.
+			for _, policy := range n.natPolicies(netInfo.Config) {
+				if policy.address == nil {
+					continue
+				}
+
+				externalSubnets = append(externalSubnets, externalSubnetUsage{
+					subnet:         IPToNet(policy.address),
+					networkProject: netProject,
+					networkName:    netInfo.Name,
+					usageType:      subnetUsageNetworkSNAT,
+				})
+			}
+		}
+	}
+
+	return externalSubnets, nil
+}
+
+// ovnNICExternalRoutes returns a list of external routes currently used by OVN NICs that are connected to OVN
+// networks that share the same uplink as this network uses.
+func (n *ovn) ovnNICExternalRoutes(ctx context.Context, ovnProjectNetworksWithOurUplink map[string][]*api.Network) ([]externalSubnetUsage, error) {
+	externalRoutes := make([]externalSubnetUsage, 0)
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.InstanceList(ctx, func(inst db.InstanceArgs, p api.Project) error {
+			// Get the instance's effective network project name.
+			instNetworkProject := project.NetworkProjectFromRecord(&p)
+			devices := db.ExpandInstanceDevices(inst.Devices.Clone(), inst.Profiles)
+
+			// Iterate through each of the instance's devices, looking for OVN NICs that are linked to networks
+			// that use our uplink.
+			for devName, devConfig := range devices {
+				if devConfig["type"] != "nic" {
+					continue
+				}
+
+				// Check whether the NIC device references one of the OVN networks supplied.
+				if !NICUsesNetwork(devConfig, ovnProjectNetworksWithOurUplink[instNetworkProject]...) {
+					continue
+				}
+
+				// For OVN NICs that are connected to networks that use the same uplink as we do, check
+				// if they have any external routes configured, and if so add them to the list to return.
+				for _, key := range []string{"ipv4.routes.external", "ipv6.routes.external"} {
+					for _, cidr := range util.SplitNTrimSpace(devConfig[key], ",", -1, true) {
+						_, ipNet, _ := net.ParseCIDR(cidr)
+						if ipNet == nil {
+							// Sip if NIC device doesn't have a valid route.
+							continue
+						}
+
+						externalRoutes = append(externalRoutes, externalSubnetUsage{
+							subnet:          *ipNet,
+							networkProject:  instNetworkProject,
+							networkName:     devConfig["network"],
+							instanceProject: inst.Project,
+							instanceName:    inst.Name,
+							instanceDevice:  devName,
+							usageType:       subnetUsageInstance,
+						})
+					}
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return externalRoutes, nil
+}
+
+// ovnProjectNetworksWithUplink accepts a map of all networks in all projects and returns a filtered map of OVN
+// networks that use the uplink specified.
+func (n *ovn) ovnProjectNetworksWithUplink(uplink string, projectNetworks map[string]map[int64]api.Network) map[string][]*api.Network {
+	ovnProjectNetworksWithOurUplink := make(map[string][]*api.Network)
+	for netProject, networks := range projectNetworks {
+		for _, ni := range networks {
+			network := ni // Local var creating pointer to rather than iterator.
+
+			// Skip non-OVN networks or those networks that don't use the uplink specified.
+			if network.Type != "ovn" || network.Config["network"] != uplink {
+				continue
+			}
+
+			if ovnProjectNetworksWithOurUplink[netProject] == nil {
+				ovnProjectNetworksWithOurUplink[netProject] = []*api.Network{&network}
+			} else {
+				ovnProjectNetworksWithOurUplink[netProject] = append(ovnProjectNetworksWithOurUplink[netProject], &network)
+			}
+		}
+	}
+
+	return ovnProjectNetworksWithOurUplink
+}
+
+// uplinkHasIngressRoutedAnycastIPv4 returns true if the uplink network has IPv4 routed ingress anycast enabled.
+func (n *ovn) uplinkHasIngressRoutedAnycastIPv4(uplink *api.Network) bool {
+	return util.IsTrue(uplink.Config["ipv4.routes.anycast"]) && uplink.Config["ovn.ingress_mode"] == "routed"
+}
+
+// uplinkHasIngressRoutedAnycastIPv6 returns true if the uplink network has routed IPv6 ingress anycast enabled.
+func (n *ovn) uplinkHasIngressRoutedAnycastIPv6(uplink *api.Network) bool {
+	return util.IsTrue(uplink.Config["ipv6.routes.anycast"]) && uplink.Config["ovn.ingress_mode"] == "routed"
+}
+
+// handleDependencyChange applies changes from uplink network if specific watched keys have changed.
+func (n *ovn) handleDependencyChange(uplinkName string, uplinkConfig map[string]string, changedKeys []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	// Detect changes that need to be applied to the network.
+	for _, k := range []string{"dns.nameservers", "ipv4.gateway", "ipv6.gateway", "ipv4.gateway.hwaddr", "ipv6.gateway.hwaddr", "ipv4.ovn.unnumbered", "ipv6.ovn.unnumbered"} {
+		if slices.Contains(changedKeys, k) {
+			n.logger.Debug("Applying changes from uplink network", logger.Ctx{"uplink": uplinkName})
+
+			// Re-setup logical network in order to apply uplink changes.
+			// Only unnumbered uplinks change the addresses the router SNAT rules translate to.
+			setupKeys := []string{}
+			if slices.Contains(changedKeys, "ipv4.ovn.unnumbered") || slices.Contains(changedKeys, "ipv6.ovn.unnumbered") {
+				setupKeys = nil
+			}
+
+			err := n.setup(ctx, true, setupKeys)
+			if err != nil {
+				return err
+			}
+
+			// Propagate the uplink's DNS servers if the network doesn't define its own.
+			if slices.Contains(changedKeys, "dns.nameservers") && n.config["dns.nameservers"] == "" {
+				err = n.nameserversRefresh(ctx)
+				if err != nil {
+					return err
+				}
+			}
+
+			// The router's uplink addresses may have been replaced, re-export the prefixes with the new next-hops.
+			if slices.Contains(changedKeys, "ipv4.ovn.unnumbered") || slices.Contains(changedKeys, "ipv6.ovn.unnumbered") {
+				err = n.bgpSetup(n.config)
+				if err != nil {
+					return err
+				}
+			}
+
+			break // Only run setup once per notification (all changes will be applied).
+		}
+	}
+
+	// Re-apply the local chassis group entry and uplink port if the members with access to the uplink changed.
+	if slices.Contains(changedKeys, "ovn.cluster_groups") && n.LocalStatus() == api.NetworkStatusCreated {
+		err := n.chassisGroupEntryRefresh(ctx, n.config)
+		if err != nil {
+			return err
+		}
+
+		var uplinkLocal bool
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			uplinkLocal, err = n.uplinkLocal(ctx, tx)
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if uplinkLocal {
+			err = n.startUplinkPort(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Add or remove the instance NIC l2proxy DNAT_AND_SNAT rules if uplink's ovn.ingress_mode has changed.
+	if slices.Contains(changedKeys, "ovn.ingress_mode") || slices.Contains(changedKeys, "ovn.l2proxy.aggregate") {
+		n.logger.Debug("Applying ingress mode changes from uplink network to instance NICs", logger.Ctx{"uplink": uplinkName})
+
+		// Only DNAT_AND_SNAT rules are used for this feature, so they all go away when not using l2proxy.
+		var natIPs []net.IP
+		var prefixes []net.IPNet
+
+		if slices.Contains([]string{"l2proxy", ""}, uplinkConfig["ovn.ingress_mode"]) {
+			var err error
+
+			natIPs, prefixes, err = n.l2proxyPublished(ctx, uplinkConfig)
+			if err != nil {
+				return fmt.Errorf("Failed getting instance NIC ingress mode l2proxy addresses: %w", err)
+			}
+		}
+
+		// Swap the rules and ARP/NDP proxy entries at once so the instances stay reachable.
+		err := n.ovnnb.UpdateLogicalRouterL2Proxy(ctx, n.getRouterName(), n.getExtSwitchRouterPortName(), natIPs, prefixes)
+		if err != nil {
+			return fmt.Errorf("Failed applying instance NIC ingress mode l2proxy rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// l2proxyPublished returns the addresses needing a DNAT_AND_SNAT rule and the prefixes needing an ARP/NDP proxy
+// entry for the started instance NICs to be reachable from the uplink in l2proxy ingress mode.
+func (n *ovn) l2proxyPublished(ctx context.Context, uplinkConfig map[string]string) ([]net.IP, []net.IPNet, error) {
+	natIPs := []net.IP{}
+	prefixes := []net.IPNet{}
+
+	// Get the addresses of the active switch ports (avoids repeated querying of OVN NB).
+	activePorts, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed getting active ports: %w", err)
+	}
+
+	// Find all instance NICs that use this network.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.InstanceList(ctx, func(inst db.InstanceArgs, p api.Project) error {
+			// Skip instances who's effective network project doesn't match this network's project.
+			if n.Project() != project.NetworkProjectFromRecord(&p) {
+				return nil
+			}
+
+			devices := db.ExpandInstanceDevices(inst.Devices.Clone(), inst.Profiles)
+
+			for devName, devConfig := range devices {
+				if devConfig["type"] != "nic" || n.Name() != devConfig["network"] {
+					continue
+				}
+
+				portIPs, found := activePorts[n.getInstanceDevicePortName(inst.Config["volatile.uuid"], devName)]
+				if !found {
+					continue // The port isn't started, its rules get added when it is.
+				}
+
+				// Pick the port's addresses the same way as when starting it, static addresses first.
+				var ipv4, ipv6 net.IP
+				for _, ip := range append([]net.IP{net.ParseIP(devConfig["ipv4.address"]), net.ParseIP(devConfig["ipv6.address"])}, portIPs...) {
+					if ip == nil {
+						continue
+					}
+
+					if ip.To4() != nil && ipv4 == nil {
+						ipv4 = ip
+					} else if ip.To4() == nil && ipv6 == nil {
+						ipv6 = ip
+					}
+				}
+
+				natIPs = append(natIPs, natPublishedIPs(n.config, devConfig, ipv4, ipv6)...)
+
+				_, externalRoutes, err := n.instanceDevicePortRoutesParse(devConfig)
+				if err != nil {
+					return err
+				}
+
+				for _, externalRoute := range externalRoutes {
+					if util.IsTrue(uplinkConfig["ovn.l2proxy.aggregate"]) {
+						prefixes = append(prefixes, *externalRoute)
+						continue
+					}
+
+					err = SubnetIterate(externalRoute, func(ip net.IP) error {
+						natIPs = append(natIPs, ip)
+
+						return nil
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return natIPs, prefixes, nil
+}
+
+// forwardFlattenVIPs flattens forwards into format compatible with OVN load balancers.
+func (n *ovn) forwardFlattenVIPs(ctx context.Context, listenAddress net.IP, defaultTargetAddress net.IP, portMaps []*forwardPortMap) ([]networkOVN.OVNLoadBalancerVIP, error) {
+	var vips []networkOVN.OVNLoadBalancerVIP
+
+	if defaultTargetAddress != nil {
+		vips = append(vips, networkOVN.OVNLoadBalancerVIP{
+			ListenAddress: listenAddress,
+			Targets:       []networkOVN.OVNLoadBalancerTarget{{Address: defaultTargetAddress}},
+		})
+	}
+
+	for _, portMap := range portMaps {
+		targetAddresses, err := n.forwardTargetAddresses(ctx, listenAddress, portMap.target)
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip target groups without any member of the listen address family.
+		if len(targetAddresses) == 0 {
+			continue
+		}
+
+		targetPortsLen := len(portMap.target.ports)
+
+		for i, lp := range portMap.listenPorts {
+			targetPort := lp // Default to using same port as listen port for target port.
+
+			if targetPortsLen == 1 {
+				// If a single target port is specified, forward all listen ports to it.
+				targetPort = portMap.target.ports[0]
+			} else if targetPortsLen > 1 {
+				// If more than 1 target port specified, use listen port index to get the
+				// target port to use.
+				targetPort = portMap.target.ports[i]
+			}
+
+			for _, protocol := range forwardPortProtocols(portMap.protocol) {
+				vip := networkOVN.OVNLoadBalancerVIP{
+					ListenAddress: listenAddress,
+					Protocol:      protocol,
+					ListenPort:    lp,
+				}
+
+				for _, targetAddress := range targetAddresses {
+					vip.Targets = append(vip.Targets, networkOVN.OVNLoadBalancerTarget{
+						Address: targetAddress,
+						Port:    targetPort,
+					})
+				}
+
+				vips = append(vips, vip)
+			}
+		}
+	}
+
+	return vips, nil
+}
+
+// forwardTargetAddresses returns the addresses of a forward or load balancer target for the family of the listen
+// address, resolving its network target group if it has one.
+func (n *ovn) forwardTargetAddresses(ctx context.Context, listenAddress net.IP, target forwardTarget) ([]net.IP, error) {
+	if target.group == "" {
+		return []net.IP{target.address}, nil
+	}
+
+	return n.targetGroupAddresses(ctx, target.group, listenAddress.To4() != nil)
+}
+
+// targetGroupAddresses returns the addresses of the members of a network target group for one IP family.
+// Instance members are resolved to the addresses of their NICs connected to the network.
+func (n *ovn) targetGroupAddresses(ctx context.Context, groupName string, ipv4 bool) ([]net.IP, error) {
+	var group *api.NetworkTargetGroup
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		dbGroup, err := dbCluster.GetNetworkTargetGroup(ctx, tx.Tx(), n.project, groupName)
+		if err != nil {
+			return err
+		}
+
+		group, err = dbGroup.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network target group %q: %w", groupName, err)
+	}
+
+	var addresses []net.IP
+	addAddress := func(ip net.IP) {
+		if ip == nil || (ip.To4() != nil) != ipv4 || !ip.IsGlobalUnicast() || slices.ContainsFunc(addresses, ip.Equal) {
+			return
+		}
+
+		addresses = append(addresses, ip)
+	}
+
+	for _, address := range group.Addresses {
+		addAddress(net.ParseIP(address))
+	}
+
+	if len(group.Instances) == 0 {
+		return addresses, nil
+	}
+
+	err = UsedByInstanceDevices(n.state, n.project, n.name, n.netType, func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		if inst.Project != n.project || !slices.Contains(group.Instances, inst.Name) {
+			return nil
+		}
+
+		// Static addresses are known even while the instance is stopped.
+		addAddress(net.ParseIP(nicConfig["ipv4.address"]))
+		addAddress(net.ParseIP(nicConfig["ipv6.address"]))
+
+		devIPs, err := n.ovnnb.GetLogicalSwitchPortIPs(ctx, n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName))
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Failed getting addresses of instance %q: %w", inst.Name, err)
+		}
+
+		for _, ip := range devIPs {
+			addAddress(ip)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed resolving instances of network target group %q: %w", groupName, err)
+	}
+
+	return addresses, nil
+}
+
+// allocateServicesAddress allocates a free internal listen address from the network's services subnet.
+func (n *ovn) allocateServicesAddress(ctx context.Context, ipv4 bool) (net.IP, error) {
+	keyPrefix := "ipv6"
+	if ipv4 {
+		keyPrefix = "ipv4"
+	}
+
+	servicesKey := fmt.Sprintf("%s.services", keyPrefix)
+	_, servicesNet, err := net.ParseCIDR(n.config[servicesKey])
+	if err != nil {
+		return nil, api.StatusErrorf(http.StatusBadRequest, "Automatic allocation of internal listen addresses requires %q to be set", servicesKey)
+	}
+
+	// Never hand out the network's own address.
+	var allocated []net.IP
+	netIP, _, err := net.ParseCIDR(n.config[fmt.Sprintf("%s.address", keyPrefix)])
+	if err == nil {
+		allocated = append(allocated, netIP)
+	}
+
+	// Get the listen addresses already in use on the network.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		forwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, forward := range forwards {
+			allocated = append(allocated, net.ParseIP(forward.ListenAddress))
+		}
+
+		loadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, loadBalancer := range loadBalancers {
+			allocated = append(allocated, net.ParseIP(loadBalancer.ListenAddress))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading listen addresses in use: %w", err)
+	}
+
+	// Skip the addresses used by the network's ports.
+	portAddresses, err := n.portAddresses(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for address := range portAddresses {
+		allocated = append(allocated, net.ParseIP(address))
+	}
+
+	servicesRange := &iprange.Range{Start: servicesNet.IP, End: dhcpalloc.GetIP(servicesNet, -1)}
+
+	ip, err := n.uplinkAllocateIP([]*iprange.Range{servicesRange}, allocated)
+	if err != nil {
+		return nil, fmt.Errorf("Failed allocating internal listen address from %q: %w", servicesKey, err)
+	}
+
+	return ip, nil
+}
+
+// allocateExternalAddress allocates a free external listen address from the uplink routes, or from the project's
+// allowed external addresses if restricted.
+func (n *ovn) allocateExternalAddress(ctx context.Context, ipv4 bool) (net.IP, error) {
+	var p *api.Project
+	var uplink *api.Network
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		}
+
+		p, err = project.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		}
+
+		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+		if err != nil {
+			return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, "restricted.networks.subnets", n.config["network"])
+	if err != nil {
+		return nil, err
+	}
+
+	// Allocate from the project's allowed external addresses if restricted, otherwise from the uplink routes.
+	pools, err := n.projectRestrictedSubnets(p, "restricted.networks.external_ips", n.config["network"])
+	if err != nil {
+		return nil, err
+	}
+
+	if pools == nil {
+		pools, err = n.uplinkRoutes(uplink)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	externalSubnetsInUse, err := n.getExternalSubnetInUse(ctx, n.config["network"])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		if (pool.IP.To4() != nil) != ipv4 {
+			continue
+		}
+
+		startIP, _ := netip.AddrFromSlice(pool.IP)
+		endIP, _ := netip.AddrFromSlice(dhcpalloc.GetIP(pool, -1))
+
+		for ip := startIP.Unmap(); ip.IsValid() && ip.Compare(endIP.Unmap()) <= 0; ip = ip.Next() {
+			ipNet := &net.IPNet{IP: net.IP(ip.AsSlice()), Mask: net.CIDRMask(ip.BitLen(), ip.BitLen())}
+
+			inUse := slices.ContainsFunc(externalSubnetsInUse, func(externalSubnetUser externalSubnetUsage) bool {
+				return SubnetContains(&externalSubnetUser.subnet, ipNet)
+			})
+
+			if inUse || n.validateExternalSubnet(uplink, projectRestrictedSubnets, ipNet) != nil {
+				continue
+			}
+
+			return ipNet.IP, nil
+		}
+	}
+
+	return nil, api.StatusErrorf(http.StatusServiceUnavailable, "No free external listen address available")
+}
+
+// portAddresses returns the addresses used by the ports of the network, mapped to the name of the owning port.
+// This covers the addresses allocated to the logical switch ports (static, DHCP and SLAAC), the static addresses of
+// instance NICs whose port doesn't exist yet and, on SLAAC subnets, the EUI64 addresses derived from their MAC.
+// The port named skipPort is ignored so a port can be checked against the others.
+func (n *ovn) portAddresses(ctx context.Context, skipPort networkOVN.OVNSwitchPort) (map[string]string, error) {
+	portIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting port addresses: %w", err)
+	}
+
+	owners := map[string]string{}
+	for portName, ips := range portIPs {
+		if portName == skipPort {
+			continue
+		}
+
+		for _, ip := range ips {
+			owners[ip.String()] = string(portName)
+		}
+	}
+
+	var eui64Subnet *net.IPNet
+	if util.IsFalseOrEmpty(n.config["ipv6.dhcp.stateful"]) {
+		_, subnet, err := net.ParseCIDR(n.config["ipv6.address"])
+		if err == nil && ovnSubnetSupportsEUI64(subnet) {
+			eui64Subnet = subnet
+		}
+	}
+
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		portName := n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+		if portName == skipPort {
+			return nil
+		}
+
+		for _, key := range []string{"ipv4.address", "ipv6.address"} {
+			ip := net.ParseIP(nicConfig[key])
+			if ip != nil {
+				owners[ip.String()] = string(portName)
+			}
+		}
+
+		if eui64Subnet == nil || nicConfig["ipv6.address"] != "" {
+			return nil
+		}
+
+		hwaddr := nicConfig["hwaddr"]
+		if hwaddr == "" {
+			hwaddr = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+		}
+
+		mac, err := net.ParseMAC(hwaddr)
+		if err != nil {
+			return nil
+		}
+
+		eui64IP, err := eui64.ParseMAC(eui64Subnet.IP, mac)
+		if err == nil {
+			owners[eui64IP.String()] = string(portName)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting instance NIC addresses: %w", err)
+	}
+
+	return owners, nil
+}
+
+// listenAddressValidate checks that a network forward or load balancer listen address is available.
+// External listen addresses must be allowed by the uplink and project restrictions and must not overlap with
+// anything else using the uplink. Internal listen addresses only need to be unused within the network.
+func (n *ovn) listenAddressValidate(ctx context.Context, listenAddressNet *net.IPNet, scope string, kind string) error {
+	if scope == listenScopeInternal {
+		portAddresses, err := n.portAddresses(ctx, "")
+		if err != nil {
+			return err
+		}
+
+		owner, found := portAddresses[listenAddressNet.IP.String()]
+		if found {
+			return api.StatusErrorf(http.StatusConflict, "%s listen address %q is already used by port %q", kind, listenAddressNet.IP.String(), owner)
+		}
+
+		return n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			listenAddress := listenAddressNet.IP.String()
+
+			_, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
+			if err == nil {
+				return api.StatusErrorf(http.StatusConflict, "%s listen address %q is already used by a network forward", kind, listenAddress)
+			}
+
+			_, err = dbCluster.GetNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress)
+			if err == nil {
+				return api.StatusErrorf(http.StatusConflict, "%s listen address %q is already used by a network load balancer", kind, listenAddress)
+			}
+
+			return nil
+		})
+	}
+
+	// Load the project to get uplink network restrictions.
+	var p *api.Project
+	var uplink *api.Network
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		}
+
+		p, err = project.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		}
+
+		// Get uplink routes.
+		_, uplink, _, err = tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+		if err != nil {
+			return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Get project restricted routes.
+	projectRestrictedSubnets, err := n.projectRestrictedSubnets(p, "restricted.networks.subnets", n.config["network"])
+	if err != nil {
+		return err
+	}
+
+	externalSubnetsInUse, err := n.getExternalSubnetInUse(ctx, n.config["network"])
+	if err != nil {
+		return err
+	}
+
+	// Check the listen address subnet is allowed within both the uplink's external routes and any
+	// project restricted subnets.
+	err = n.validateExternalSubnet(uplink, projectRestrictedSubnets, listenAddressNet)
+	if err != nil {
+		return err
+	}
+
+	// Check the listen address is within the project's allowed external addresses if restricted.
+	projectExternalIPs, err := n.projectRestrictedSubnets(p, "restricted.networks.external_ips", n.config["network"])
+	if err != nil {
+		return err
+	}
+
+	if projectExternalIPs != nil {
+		allowed := slices.ContainsFunc(projectExternalIPs, func(externalIPs *net.IPNet) bool {
+			return SubnetContains(externalIPs, listenAddressNet)
+		})
+
+		if !allowed {
+			return api.StatusErrorf(http.StatusForbidden, "Project doesn't contain %q in its allowed external addresses", listenAddressNet.String())
+		}
+	}
+
+	// Check the listen address subnet doesn't fall within any existing OVN network external subnets.
+	for _, externalSubnetUser := range externalSubnetsInUse {
+		// Check if usage is from our own network.
+		if externalSubnetUser.networkProject == n.project && externalSubnetUser.networkName == n.name {
+			// Skip checking conflict with our own network's subnet or SNAT address.
+			// But do not allow other conflict with other usage types within our own network.
+			if externalSubnetUser.usageType == subnetUsageNetwork || externalSubnetUser.usageType == subnetUsageNetworkSNAT {
+				continue
+			}
+		}
+
+		if SubnetContains(&externalSubnetUser.subnet, listenAddressNet) || SubnetContains(listenAddressNet, &externalSubnetUser.subnet) {
+			// This error is purposefully vague so that it doesn't reveal any names of
+			// resources potentially outside of the network's project.
+			return fmt.Errorf("%s listen address %q overlaps with another network or NIC", kind, listenAddressNet.String())
+		}
+	}
+
+	return nil
+}
+
+// listenAddressMove sets up the OVN load balancer and internal static route of a network forward or load
+// balancer on a new listen address. Returns a revert hook removing them again.
+func (n *ovn) listenAddressMove(ctx context.Context, listenAddressNet *net.IPNet, config map[string]string, vips []networkOVN.OVNLoadBalancerVIP) (revert.Hook, error) {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	lbName := n.getLoadBalancerName(listenAddressNet.IP.String())
+
+	err := n.ovnnb.CreateLoadBalancer(ctx, lbName, n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(config), vips...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed applying OVN load balancer: %w", err)
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteLoadBalancer(ctx, lbName)
+	})
+
+	// Add internal static route to the listen address (helps with OVN IC).
+	var nexthop net.IP
+	if listenAddressNet.IP.To4() == nil {
+		routerV6, _, err := n.parseRouterIntPortIPv6Net()
+		if err == nil {
+			nexthop = routerV6
+		}
+	} else {
+		routerV4, _, err := n.parseRouterIntPortIPv4Net()
+		if err == nil {
+			nexthop = routerV4
+		}
+	}
+
+	// Internal listen addresses are already within the network's subnet so don't need one.
+	if nexthop != nil && listenScope(config) != listenScopeInternal {
+		err = n.ovnnb.CreateLogicalRouterRoute(ctx, n.getRouterName(), true, networkOVN.OVNRouterRoute{NextHop: nexthop, Prefix: *listenAddressNet})
+		if err != nil {
+			return nil, err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *listenAddressNet)
+		})
+	}
+
+	cleanup := reverter.Clone().Fail
+	reverter.Success()
+
+	return cleanup, nil
+}
+
+// listenAddressRelease removes the OVN load balancer and internal static route of a network forward or load
+// balancer from its previous listen address.
+func (n *ovn) listenAddressRelease(ctx context.Context, listenAddress string) error {
+	err := n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(listenAddress))
+	if err != nil {
+		return fmt.Errorf("Failed deleting OVN load balancer: %w", err)
+	}
+
+	// Delete static route to the listen address if present.
+	vip, err := ParseIPToNet(listenAddress)
+	if err != nil {
+		return err
+	}
+
+	_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *vip)
+
+	return nil
+}
+
+// ForwardCreate creates a network forward.
+func (n *ovn) ForwardCreate(ctx context.Context, forward api.NetworkForwardsPost, clientType request.ClientType) (_ net.IP, err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Prevent forwards and load balancers from starting to use target groups while they are being changed.
+	unlockTargetGroups, err := locking.Lock(ctx, TargetGroupLockName(n.project))
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlockTargetGroups()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlock()
+
+	if n.listenAddressDisabled(forward.Config) {
+		return nil, errors.New("Isolated OVN network can only use internal network forwards")
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		memberSpecific := false // OVN doesn't support per-member forwards.
+
+		// Allocate a listen address from the services subnet or the uplink routes if an unspecified one was
+		// requested.
+		listenAddress := net.ParseIP(forward.ListenAddress)
+		if listenAddress != nil && listenAddress.IsUnspecified() {
+			var allocatedAddress net.IP
+			if listenScope(forward.Config) == listenScopeInternal {
+				allocatedAddress, err = n.allocateServicesAddress(ctx, listenAddress.To4() != nil)
+			} else {
+				allocatedAddress, err = n.allocateExternalAddress(ctx, listenAddress.To4() != nil)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			forward.ListenAddress = allocatedAddress.String()
+		}
+
+		var existingForwardID int64
+		var existingForward *api.NetworkForward
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// Check if there is an existing forward using the same listen address.
+			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), forward.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			existingForwardID = dbRecord.ID
+			existingForward, err = dbRecord.ToAPI(ctx, tx.Tx())
+
+			return err
+		})
+		if err == nil {
+			lbExists, err := n.loadBalancerExists(ctx, forward.ListenAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			if lbExists {
+				// Creating the exact same forward again is a no-op so that clients can safely retry.
+				if existingForward.Description != forward.Description || !maps.Equal(existingForward.Config, forward.Config) || !slices.Equal(existingForward.Ports, forward.Ports) {
+					return nil, api.StatusErrorf(http.StatusConflict, "A forward for that listen address already exists")
+				}
+
+				reverter.Success()
+				return net.ParseIP(forward.ListenAddress), nil
+			}
+
+			// The record was left behind by a failed creation, drop it so the forward gets created again.
+			n.logger.Warn("Replacing partially created network forward", logger.Ctx{"listenAddress": forward.ListenAddress})
+
+			err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), existingForwardID)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Convert listen address to subnet so we can check its valid and can be used.
+		listenAddressNet, err := ParseIPToNet(forward.ListenAddress)
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing %q: %w", forward.ListenAddress, err)
+		}
+
+		portMaps, err := n.forwardValidate(ctx, listenAddressNet.IP, &forward.NetworkForwardPut)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.listenAddressValidate(ctx, listenAddressNet, listenScope(forward.Config), "Forward")
+		if err != nil {
+			return nil, err
+		}
+
+		var forwardID int64
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// Create forward DB record.
+			nodeID := sql.NullInt64{
+				Valid: memberSpecific,
+				Int64: tx.GetNodeID(),
+			}
+
+			dbRecord := dbCluster.NetworkForward{
+				NetworkID:     n.ID(),
+				NodeID:        nodeID,
+				ListenAddress: forward.ListenAddress,
+				Description:   forward.Description,
+				Ports:         forward.Ports,
+			}
+
+			if forward.Ports == nil {
+				dbRecord.Ports = []api.NetworkForwardPort{}
+			}
+
+			forwardID, err = dbCluster.CreateNetworkForward(ctx, tx.Tx(), dbRecord)
+			if err != nil {
+				return err
+			}
+
+			err = dbCluster.CreateNetworkForwardConfig(ctx, tx.Tx(), forwardID, forward.Config)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), forwardID)
+			})
+
+			_ = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(forward.ListenAddress))
+			_ = n.forwardBGPSetupPrefixes()
+		})
+
+		listenIP := net.ParseIP(forward.ListenAddress)
+		vips, err := n.forwardFlattenVIPs(ctx, listenIP, forwardDefaultTargetAddress(listenIP, forward.Config), portMaps)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(forward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(forward.Config), vips...)
+		if err != nil {
+			return nil, fmt.Errorf("Failed applying OVN load balancer: %w", err)
+		}
+
+		// Add internal static route to the network forward (helps with OVN IC).
+		var nexthop net.IP
+		if listenAddressNet.IP.To4() == nil {
+			routerV6, _, err := n.parseRouterIntPortIPv6Net()
+			if err == nil {
+				nexthop = routerV6
+			}
+		} else {
+			routerV4, _, err := n.parseRouterIntPortIPv4Net()
+			if err == nil {
+				nexthop = routerV4
+			}
+		}
+
+		// Internal listen addresses are already within the network's subnet so don't need one.
+		if nexthop != nil && listenScope(forward.Config) != listenScopeInternal {
+			err = n.ovnnb.CreateLogicalRouterRoute(ctx, n.getRouterName(), true, networkOVN.OVNRouterRoute{NextHop: nexthop, Prefix: *listenAddressNet})
+			if err != nil {
+				return nil, err
+			}
+
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *listenAddressNet)
+			})
+		}
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return nil, err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).CreateNetworkForward(n.name, forward)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.forwardBGPSetupPrefixes()
+	if err != nil {
+		return nil, fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+	}
+
+	reverter.Success()
+	return net.ParseIP(forward.ListenAddress), nil
+}
+
+// ForwardUpdate updates a network forward.
+func (n *ovn) ForwardUpdate(ctx context.Context, listenAddress string, req api.NetworkForwardPut, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Prevent forwards and load balancers from starting to use target groups while they are being changed.
+	unlockTargetGroups, err := locking.Lock(ctx, TargetGroupLockName(n.project))
+	if err != nil {
+		return err
+	}
+
+	defer unlockTargetGroups()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		var curForwardID int64
+		var curForward *api.NetworkForward
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			var err error
+
+			// No memberSpecific filtering needed because OVN doesn't support per-member-forwards
+			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
+			if err != nil {
+				return err
+			}
+
+			curForwardID = dbRecord.ID
+			curForward, err = dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if n.listenAddressDisabled(curForward.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Network forward is disabled while the network has no uplink")
+		}
+
+		portMaps, err := n.forwardValidate(ctx, net.ParseIP(curForward.ListenAddress), &req)
+		if err != nil {
+			return err
+		}
+
+		if listenScope(req.Config) != listenScope(curForward.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Listen address scope cannot be changed")
+		}
+
+		curForwardEtagHash, err := localUtil.EtagHash(curForward.Etag())
+		if err != nil {
+			return err
+		}
+
+		newForward := api.NetworkForward{
+			ListenAddress:     curForward.ListenAddress,
+			NetworkForwardPut: req,
+		}
+
+		newForwardEtagHash, err := localUtil.EtagHash(newForward.Etag())
+		if err != nil {
+			return err
+		}
+
+		if curForwardEtagHash == newForwardEtagHash {
+			lbExists, err := n.loadBalancerExists(ctx, curForward.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			// Nothing has changed, unless a previous attempt failed before applying the forward to OVN.
+			if lbExists {
+				return nil
+			}
+		}
+
+		listenIP := net.ParseIP(newForward.ListenAddress)
+		vips, err := n.forwardFlattenVIPs(ctx, listenIP, forwardDefaultTargetAddress(listenIP, newForward.Config), portMaps)
+		if err != nil {
+			return err
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(newForward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(newForward.Config), vips...)
+		if err != nil {
+			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			// Apply old settings to OVN on failure.
+			portMaps, err := n.forwardValidate(ctx, net.ParseIP(curForward.ListenAddress), &curForward.NetworkForwardPut)
+			if err == nil {
+				listenIP := net.ParseIP(curForward.ListenAddress)
+				vips, err := n.forwardFlattenVIPs(ctx, listenIP, forwardDefaultTargetAddress(listenIP, curForward.Config), portMaps)
+				if err == nil {
+					_ = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(curForward.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(curForward.Config), vips...)
+				}
+				_ = n.forwardBGPSetupPrefixes()
+			}
+		})
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			fwd := dbCluster.NetworkForward{
+				NetworkID:     n.ID(),
+				ListenAddress: listenAddress,
+				Description:   newForward.Description,
+				Ports:         newForward.Ports,
+			}
+
+			err = dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress, fwd)
+			if err != nil {
+				return err
+			}
+
+			err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), curForwardID, newForward.Config)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				fwd := dbCluster.NetworkForward{
+					NetworkID:     n.ID(),
+					ListenAddress: listenAddress,
+					Description:   curForward.Description,
+					Ports:         curForward.Ports,
+				}
+
+				err = dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress, fwd)
+				if err != nil {
+					return err
+				}
+
+				err = dbCluster.UpdateNetworkForwardConfig(ctx, tx.Tx(), curForwardID, curForward.Config)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			})
+		})
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).UpdateNetworkForward(n.name, curForward.ListenAddress, req, "")
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.forwardBGPSetupPrefixes()
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// ForwardRename moves a network forward to another listen address, keeping its configuration and ports.
+func (n *ovn) ForwardRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		var forward *api.NetworkForward
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// No memberSpecific filtering needed because OVN doesn't support per-member-forwards
+			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
+			if err != nil {
+				return err
+			}
+
+			forward, err = dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if n.listenAddressDisabled(forward.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Network forward is disabled while the network has no uplink")
+		}
+
+		listenAddress = forward.ListenAddress
+
+		newListenAddressNet, err := ParseIPToNet(newListenAddress)
+		if err != nil {
+			return api.StatusErrorf(http.StatusBadRequest, "Failed parsing %q: %w", newListenAddress, err)
+		}
+
+		if newListenAddressNet.IP.Equal(net.ParseIP(listenAddress)) {
+			return nil // Nothing has changed.
+		}
+
+		newListenAddress = newListenAddressNet.IP.String()
+
+		portMaps, err := n.forwardValidate(ctx, newListenAddressNet.IP, &forward.NetworkForwardPut)
+		if err != nil {
+			return err
+		}
+
+		err = n.listenAddressValidate(ctx, newListenAddressNet, listenScope(forward.Config), "Forward")
+		if err != nil {
+			return err
+		}
+
+		// Set up the forward on its new listen address alongside the existing one.
+		vips, err := n.forwardFlattenVIPs(ctx, newListenAddressNet.IP, forwardDefaultTargetAddress(newListenAddressNet.IP, forward.Config), portMaps)
+		if err != nil {
+			return err
+		}
+
+		cleanup, err := n.listenAddressMove(ctx, newListenAddressNet, forward.Config, vips)
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(cleanup)
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			fwd := dbCluster.NetworkForward{
+				NetworkID:     n.ID(),
+				ListenAddress: newListenAddress,
+				Description:   forward.Description,
+				Ports:         forward.Ports,
+			}
+
+			return dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress, fwd)
+		})
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				fwd := dbCluster.NetworkForward{
+					NetworkID:     n.ID(),
+					ListenAddress: listenAddress,
+					Description:   forward.Description,
+					Ports:         forward.Ports,
+				}
+
+				return dbCluster.UpdateNetworkForward(ctx, tx.Tx(), n.ID(), newListenAddress, fwd)
+			})
+
+			_ = n.forwardBGPSetupPrefixes()
+		})
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).RenameNetworkForward(n.name, listenAddress, api.NetworkForwardPost{ListenAddress: newListenAddress})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.forwardBGPSetupPrefixes()
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+	}
+
+	// Now that the new listen address is in use everywhere, remove the previous one.
+	if clientType == request.ClientTypeNormal {
+		err = n.listenAddressRelease(ctx, listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// ForwardDelete deletes a network forward.
+func (n *ovn) ForwardDelete(ctx context.Context, listenAddress string, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	if clientType == request.ClientTypeNormal {
+		var forwardID int64
+		var forward *api.NetworkForward
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			var err error
+
+			// No memberSpecific filtering needed because OVN doesn't support per-member-forwards
+			dbRecord, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), listenAddress)
+			if err != nil {
+				return err
+			}
+
+			forwardID = dbRecord.ID
+			forward, err = dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			if !api.StatusErrorCheck(err, http.StatusNotFound) {
+				return err
+			}
+
+			// Clean up any load balancer left behind by a failed creation or deletion.
+			lbExists, lbErr := n.loadBalancerExists(ctx, listenAddress)
+			if lbErr != nil || !lbExists {
+				return err
+			}
+
+			n.logger.Warn("Removing leftover network forward load balancer", logger.Ctx{"listenAddress": listenAddress})
+
+			forward = &api.NetworkForward{ListenAddress: listenAddress}
+		}
+
+		// Delete the network forward itself.
+		err = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(forward.ListenAddress))
+		if err != nil {
+			return fmt.Errorf("Failed deleting OVN load balancer: %w", err)
+		}
+
+		// Delete static route to network forward if present.
+		vip, err := ParseIPToNet(forward.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *vip)
+
+		// Delete the database records.
+		if forwardID > 0 {
+			err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return dbCluster.DeleteNetworkForward(ctx, tx.Tx(), n.ID(), forwardID)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).DeleteNetworkForward(n.name, forward.ListenAddress)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.forwardBGPSetupPrefixes()
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+	}
+
+	return nil
+}
+
+// loadBalancerFlattenVIPs flattens port maps into format compatible with OVN load balancers.
+func (n *ovn) loadBalancerFlattenVIPs(ctx context.Context, listenAddress net.IP, portMaps []*loadBalancerPortMap) ([]networkOVN.OVNLoadBalancerVIP, error) {
+	var vips []networkOVN.OVNLoadBalancerVIP
+
+	for _, portMap := range portMaps {
+		targetAddresses := make([][]net.IP, 0, len(portMap.targets))
+		for _, target := range portMap.targets {
+			addresses, err := n.forwardTargetAddresses(ctx, listenAddress, target)
+			if err != nil {
+				return nil, err
+			}
+
+			targetAddresses = append(targetAddresses, addresses)
+		}
+
+		for i, lp := range portMap.listenPorts {
+			vip := networkOVN.OVNLoadBalancerVIP{
+				ListenAddress: listenAddress,
+				Protocol:      portMap.protocol,
+				ListenPort:    lp,
+			}
+
+			for targetID, target := range portMap.targets {
+				targetPort := lp // Default to using same port as listen port for target port.
+				targetPortsLen := len(target.ports)
+
+				if targetPortsLen == 1 {
+					// If a single target port is specified, forward all listen ports to it.
+					targetPort = target.ports[0]
+				} else if targetPortsLen > 1 {
+					// If more than 1 target port specified, use listen port index to get the
+					// target port to use.
+					targetPort = target.ports[i]
+				}
+
+				for _, targetAddress := range targetAddresses[targetID] {
+					vip.Targets = append(vip.Targets, networkOVN.OVNLoadBalancerTarget{
+						Address: targetAddress,
+						Port:    targetPort,
+					})
+				}
+			}
+
+			// Skip ports whose backends are all empty target groups.
+			if len(vip.Targets) == 0 {
+				continue
+			}
+
+			vips = append(vips, vip)
+		}
+	}
+
+	return vips, nil
+}
+
+// LoadBalancerCreate creates a network load balancer.
+func (n *ovn) LoadBalancerCreate(ctx context.Context, loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) (_ net.IP, err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Prevent forwards and load balancers from starting to use target groups while they are being changed.
+	unlockTargetGroups, err := locking.Lock(ctx, TargetGroupLockName(n.project))
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlockTargetGroups()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlock()
+
+	if n.listenAddressDisabled(loadBalancer.Config) {
+		return nil, errors.New("Isolated OVN network can only use internal network load balancers")
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		// Allocate a listen address from the services subnet or the uplink routes if an unspecified one was
+		// requested.
+		listenAddress := net.ParseIP(loadBalancer.ListenAddress)
+		if listenAddress != nil && listenAddress.IsUnspecified() {
+			var allocatedAddress net.IP
+			if listenScope(loadBalancer.Config) == listenScopeInternal {
+				allocatedAddress, err = n.allocateServicesAddress(ctx, listenAddress.To4() != nil)
+			} else {
+				allocatedAddress, err = n.allocateExternalAddress(ctx, listenAddress.To4() != nil)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			loadBalancer.ListenAddress = allocatedAddress.String()
+		}
+
+		var existingLoadBalancerID int64
+		var existingLoadBalancer *api.NetworkLoadBalancer
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// Check if there is an existing load balancer using the same listen address.
+			dbRecord, err := dbCluster.GetNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), loadBalancer.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			existingLoadBalancerID = dbRecord.ID
+			existingLoadBalancer, err = dbRecord.ToAPI(ctx, tx.Tx())
+
+			return err
+		})
+		if err == nil {
+			lbExists, err := n.loadBalancerExists(ctx, loadBalancer.ListenAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			if lbExists {
+				existingEtagHash, err := localUtil.EtagHash(existingLoadBalancer.Etag())
+				if err != nil {
+					return nil, err
+				}
+
+				newLoadBalancer := api.NetworkLoadBalancer{
+					ListenAddress:          loadBalancer.ListenAddress,
+					NetworkLoadBalancerPut: loadBalancer.NetworkLoadBalancerPut,
+				}
+
+				newEtagHash, err := localUtil.EtagHash(newLoadBalancer.Etag())
+				if err != nil {
+					return nil, err
+				}
+
+				// Creating the exact same load balancer again is a no-op so that clients can safely retry.
+				if existingEtagHash != newEtagHash {
+					return nil, api.StatusErrorf(http.StatusConflict, "A load balancer for that listen address already exists")
+				}
+
+				reverter.Success()
+				return net.ParseIP(loadBalancer.ListenAddress), nil
+			}
+
+			// The record was left behind by a failed creation, drop it so the load balancer gets created again.
+			n.logger.Warn("Replacing partially created network load balancer", logger.Ctx{"listenAddress": loadBalancer.ListenAddress})
+
+			err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return dbCluster.DeleteNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), existingLoadBalancerID)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Convert listen address to subnet so we can check its valid and can be used.
+		listenAddressNet, err := ParseIPToNet(loadBalancer.ListenAddress)
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing %q: %w", loadBalancer.ListenAddress, err)
+		}
+
+		portMaps, err := n.loadBalancerValidate(ctx, listenAddressNet.IP, &loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.loadBalancerNetworksValidate(loadBalancer.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.listenAddressValidate(ctx, listenAddressNet, listenScope(loadBalancer.Config), "Load balancer")
+		if err != nil {
+			return nil, err
+		}
+
+		var loadBalancerID int64
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			// Create load balancer DB record.
+			lb := dbCluster.NetworkLoadBalancer{
+				NetworkID:     n.ID(),
+				ListenAddress: loadBalancer.ListenAddress,
+				Description:   loadBalancer.Description,
+				Backends:      loadBalancer.Backends,
+				Ports:         loadBalancer.Ports,
+			}
+
+			loadBalancerID, err = dbCluster.CreateNetworkLoadBalancer(ctx, tx.Tx(), lb)
+			if err != nil {
+				return err
+			}
+
+			// Save the load balancer configuration.
+			err = dbCluster.CreateNetworkLoadBalancerConfig(ctx, tx.Tx(), loadBalancerID, loadBalancer.Config)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return dbCluster.DeleteNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), loadBalancerID)
+			})
+
+			_ = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(loadBalancer.ListenAddress))
+			_ = n.loadBalancerBGPSetupPrefixes(ctx)
+		})
+
+		vips, err := n.loadBalancerFlattenVIPs(ctx, net.ParseIP(loadBalancer.ListenAddress), portMaps)
+		if err != nil {
+			return nil, err
+		}
+
+		// Look at health checking configuration.
+		healthCheck, err := n.getHealthCheck(loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return nil, err
+		}
+
+		if healthCheck != nil {
+			for i := range vips {
+				vips[i].HealthCheck = healthCheck
+			}
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(loadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(loadBalancer.Config), vips...)
+		if err != nil {
+			return nil, fmt.Errorf("Failed applying OVN load balancer: %w", err)
+		}
+
+		err = n.loadBalancerShare(ctx, loadBalancer.ListenAddress, loadBalancer.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add internal static route to the load-balancer (helps with OVN IC).
+		var nexthop net.IP
+		if listenAddressNet.IP.To4() == nil {
+			routerV6, _, err := n.parseRouterIntPortIPv6Net()
+			if err == nil {
+				nexthop = routerV6
+			}
+		} else {
+			routerV4, _, err := n.parseRouterIntPortIPv4Net()
+			if err == nil {
+				nexthop = routerV4
+			}
+		}
+
+		// Internal listen addresses are already within the network's subnet so don't need one.
+		if nexthop != nil && listenScope(loadBalancer.Config) != listenScopeInternal {
+			err = n.ovnnb.CreateLogicalRouterRoute(ctx, n.getRouterName(), true, networkOVN.OVNRouterRoute{NextHop: nexthop, Prefix: *listenAddressNet})
+			if err != nil {
+				return nil, err
+			}
+
+			reverter.Add(func() {
+				ctx, cancel := ovnRevertContext(ctx)
+				defer cancel()
+
+				_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *listenAddressNet)
+			})
+		}
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return nil, err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).CreateNetworkLoadBalancer(n.name, loadBalancer)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+	}
+
+	reverter.Success()
+	return net.ParseIP(loadBalancer.ListenAddress), nil
+}
+
+// LoadBalancerUpdate updates a network load balancer.
+func (n *ovn) LoadBalancerUpdate(ctx context.Context, listenAddress string, req api.NetworkLoadBalancerPut, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Prevent forwards and load balancers from starting to use target groups while they are being changed.
+	unlockTargetGroups, err := locking.Lock(ctx, TargetGroupLockName(n.project))
+	if err != nil {
+		return err
+	}
+
+	defer unlockTargetGroups()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		var curLoadBalancer *api.NetworkLoadBalancer
+		var curLoadBalancerID int64
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			networkID := n.ID()
+
+			// Get the load balancer.
+			dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+				NetworkID:     &networkID,
+				ListenAddress: &listenAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(dbLoadBalancers) != 1 {
+				return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
+			}
+
+			// Get the API struct.
+			curLoadBalancer, err = dbLoadBalancers[0].ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			curLoadBalancerID = dbLoadBalancers[0].ID
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if n.listenAddressDisabled(curLoadBalancer.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Network load balancer is disabled while the network has no uplink")
+		}
+
+		portMaps, err := n.loadBalancerValidate(ctx, net.ParseIP(curLoadBalancer.ListenAddress), &req)
+		if err != nil {
+			return err
+		}
+
+		err = n.loadBalancerNetworksValidate(req.Config)
+		if err != nil {
+			return err
+		}
+
+		if listenScope(req.Config) != listenScope(curLoadBalancer.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Listen address scope cannot be changed")
+		}
+
+		curEtagHash, err := localUtil.EtagHash(curLoadBalancer.Etag())
+		if err != nil {
+			return err
+		}
+
+		newLoadBalancer := api.NetworkLoadBalancer{
+			ListenAddress:          curLoadBalancer.ListenAddress,
+			NetworkLoadBalancerPut: req,
+		}
+
+		newLoadBalancerEtagHash, err := localUtil.EtagHash(newLoadBalancer.Etag())
+		if err != nil {
+			return err
+		}
+
+		if curEtagHash == newLoadBalancerEtagHash {
+			lbExists, err := n.loadBalancerExists(ctx, curLoadBalancer.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			// Nothing has changed, unless a previous attempt failed before applying the load balancer to OVN.
+			if lbExists {
+				return nil
+			}
+		}
+
+		vips, err := n.loadBalancerFlattenVIPs(ctx, net.ParseIP(newLoadBalancer.ListenAddress), portMaps)
+		if err != nil {
+			return err
+		}
+
+		// Look at health checking configuration.
+		healthCheck, err := n.getHealthCheck(newLoadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return err
+		}
+
+		if healthCheck != nil {
+			for i := range vips {
+				vips[i].HealthCheck = healthCheck
+			}
+		}
+
+		err = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(newLoadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(newLoadBalancer.Config), vips...)
+		if err != nil {
+			return fmt.Errorf("Failed applying OVN load balancer: %w", err)
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			// Apply old settings to OVN on failure.
+			portMaps, err := n.loadBalancerValidate(ctx, net.ParseIP(curLoadBalancer.ListenAddress), &curLoadBalancer.NetworkLoadBalancerPut)
+			if err == nil {
+				vips, err := n.loadBalancerFlattenVIPs(ctx, net.ParseIP(curLoadBalancer.ListenAddress), portMaps)
+				if err == nil {
+					_ = n.ovnnb.CreateLoadBalancer(ctx, n.getLoadBalancerName(curLoadBalancer.ListenAddress), n.getRouterName(), n.getIntSwitchName(), n.loadBalancerSwitchAttach(curLoadBalancer.Config), vips...)
+					_ = n.loadBalancerShare(ctx, curLoadBalancer.ListenAddress, curLoadBalancer.Config)
+				}
+				_ = n.forwardBGPSetupPrefixes()
+			}
+		})
+
+		err = n.loadBalancerShare(ctx, newLoadBalancer.ListenAddress, newLoadBalancer.Config)
+		if err != nil {
+			return err
+		}
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			lb := dbCluster.NetworkLoadBalancer{
+				NetworkID:     n.ID(),
+				ListenAddress: listenAddress,
+				Description:   newLoadBalancer.Description,
+				Backends:      newLoadBalancer.Backends,
+				Ports:         newLoadBalancer.Ports,
+			}
+
+			err = dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress, lb)
+			if err != nil {
+				return err
+			}
+
+			err = dbCluster.UpdateNetworkLoadBalancerConfig(ctx, tx.Tx(), curLoadBalancerID, newLoadBalancer.Config)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				lb := dbCluster.NetworkLoadBalancer{
+					NetworkID:     n.ID(),
+					ListenAddress: listenAddress,
+					Description:   curLoadBalancer.Description,
+					Backends:      curLoadBalancer.Backends,
+					Ports:         curLoadBalancer.Ports,
+				}
+
+				err = dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress, lb)
+				if err != nil {
+					return err
+				}
+
+				err = dbCluster.UpdateNetworkLoadBalancerConfig(ctx, tx.Tx(), curLoadBalancerID, curLoadBalancer.Config)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			})
+		})
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).UpdateNetworkLoadBalancer(n.name, curLoadBalancer.ListenAddress, req, "")
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// LoadBalancerRename moves a network load balancer to another listen address, keeping its configuration,
+// backends and ports.
+func (n *ovn) LoadBalancerRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	if clientType == request.ClientTypeNormal {
+		var loadBalancer *api.NetworkLoadBalancer
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			networkID := n.ID()
+
+			// Get the load balancer.
+			dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+				NetworkID:     &networkID,
+				ListenAddress: &listenAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(dbLoadBalancers) != 1 {
+				return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
+			}
+
+			// Get the API struct.
+			loadBalancer, err = dbLoadBalancers[0].ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if n.listenAddressDisabled(loadBalancer.Config) {
+			return api.StatusErrorf(http.StatusBadRequest, "Network load balancer is disabled while the network has no uplink")
+		}
+
+		listenAddress = loadBalancer.ListenAddress
+
+		newListenAddressNet, err := ParseIPToNet(newListenAddress)
+		if err != nil {
+			return api.StatusErrorf(http.StatusBadRequest, "Failed parsing %q: %w", newListenAddress, err)
+		}
+
+		if newListenAddressNet.IP.Equal(net.ParseIP(listenAddress)) {
+			return nil // Nothing has changed.
+		}
+
+		newListenAddress = newListenAddressNet.IP.String()
+
+		portMaps, err := n.loadBalancerValidate(ctx, newListenAddressNet.IP, &loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return err
+		}
+
+		err = n.listenAddressValidate(ctx, newListenAddressNet, listenScope(loadBalancer.Config), "Load balancer")
+		if err != nil {
+			return err
+		}
+
+		vips, err := n.loadBalancerFlattenVIPs(ctx, newListenAddressNet.IP, portMaps)
+		if err != nil {
+			return err
+		}
+
+		// Look at health checking configuration.
+		healthCheck, err := n.getHealthCheck(loadBalancer.NetworkLoadBalancerPut)
+		if err != nil {
+			return err
+		}
+
+		if healthCheck != nil {
+			for i := range vips {
+				vips[i].HealthCheck = healthCheck
+			}
+		}
+
+		// Set up the load balancer on its new listen address alongside the existing one.
+		cleanup, err := n.listenAddressMove(ctx, newListenAddressNet, loadBalancer.Config, vips)
+		if err != nil {
+			return err
+		}
+
+		err = n.loadBalancerShare(ctx, newListenAddress, loadBalancer.Config)
+		if err != nil {
+			cleanup()
+			return err
+		}
+
+		reverter.Add(cleanup)
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			lb := dbCluster.NetworkLoadBalancer{
+				NetworkID:     n.ID(),
+				ListenAddress: newListenAddress,
+				Description:   loadBalancer.Description,
+				Backends:      loadBalancer.Backends,
+				Ports:         loadBalancer.Ports,
+			}
+
+			return dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), listenAddress, lb)
+		})
+		if err != nil {
+			return err
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+				lb := dbCluster.NetworkLoadBalancer{
+					NetworkID:     n.ID(),
+					ListenAddress: listenAddress,
+					Description:   loadBalancer.Description,
+					Backends:      loadBalancer.Backends,
+					Ports:         loadBalancer.Ports,
+				}
+
+				return dbCluster.UpdateNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), newListenAddress, lb)
+			})
+
+			_ = n.loadBalancerBGPSetupPrefixes(ctx)
+		})
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).RenameNetworkLoadBalancer(n.name, listenAddress, api.NetworkLoadBalancerPost{ListenAddress: newListenAddress})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+	}
+
+	// Now that the new listen address is in use everywhere, remove the previous one.
+	if clientType == request.ClientTypeNormal {
+		err = n.listenAddressRelease(ctx, listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// LoadBalancerState returns the current state of the load balancer.
+func (n *ovn) LoadBalancerState(ctx context.Context, lb api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error) {
+	lbState := &api.NetworkLoadBalancerState{}
+
+	if util.IsTrue(lb.Config["healthcheck"]) {
+		lbState.BackendHealth = map[string]api.NetworkLoadBalancerStateBackendHealth{}
+
+		listenIsIP4 := net.ParseIP(lb.ListenAddress).To4() != nil
+
+		for _, backend := range lb.Backends {
+			// Backends using a target group report the health of each member of the group.
+			addresses := []string{backend.TargetAddress}
+			if backend.TargetGroup != "" {
+				groupAddresses, err := n.targetGroupAddresses(ctx, backend.TargetGroup, listenIsIP4)
+				if err != nil {
+					return nil, err
+				}
+
+				addresses = make([]string, 0, len(groupAddresses))
+				for _, groupAddress := range groupAddresses {
+					addresses = append(addresses, groupAddress.String())
+				}
+			}
+
+			for _, address := range addresses {
+				backendHealth := api.NetworkLoadBalancerStateBackendHealth{}
+				backendHealth.Address = address
+				backendHealth.Ports = []api.NetworkLoadBalancerStateBackendHealthPort{}
+
+				for _, lbPort := range lb.Ports {
+					if !slices.Contains(lbPort.TargetBackend, backend.Name) {
+						continue
+					}
+
+					// Check valid listen port(s) supplied.
+					listenPortRanges := util.SplitNTrimSpace(lbPort.ListenPort, ",", -1, true)
+					if len(listenPortRanges) <= 0 {
+						return nil, fmt.Errorf("Missing listen port in port specification %q", lbPort.ListenPort)
+					}
+
+					for _, pr := range listenPortRanges {
+						portFirst, portRange, err := ParsePortRange(pr)
+						if err != nil {
+							return nil, fmt.Errorf("Invalid listen port in port specification %q: %w", lbPort.ListenPort, err)
+						}
+
+						for i := range portRange {
+							port := portFirst + i
+
+							// UDP backends are checked by our own probes when enabled.
+							status, probed := "", false
+							if lbPort.Protocol == "udp" {
+								status, probed = n.loadBalancerProbeStatus(lb.ListenAddress, backend.Name)
+							}
+
+							if !probed {
+								status, err = n.ovnsb.GetServiceHealth(ctx, address, lbPort.Protocol, int(port))
+								if err != nil {
+									return nil, fmt.Errorf("Failed retrieving OVN load-balancer health: %w", err)
+								}
+							}
+
+							portHealth := api.NetworkLoadBalancerStateBackendHealthPort{
+								Protocol: lbPort.Protocol,
+								Port:     int(port),
+								Status:   status,
+							}
+
+							backendHealth.Ports = append(backendHealth.Ports, portHealth)
+						}
+					}
+				}
+
+				backendName := backend.Name
+				if backend.TargetGroup != "" {
+					backendName = fmt.Sprintf("%s/%s", backend.Name, address)
+				}
+
+				lbState.BackendHealth[backendName] = backendHealth
+			}
+		}
+	}
+
+	return lbState, nil
+}
+
+// LoadBalancerDelete deletes a network load balancer.
+func (n *ovn) LoadBalancerDelete(ctx context.Context, listenAddress string, clientType request.ClientType) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	if clientType == request.ClientTypeNormal {
+		var lb *dbCluster.NetworkLoadBalancer
+
+		err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			networkID := n.ID()
+
+			dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+				NetworkID:     &networkID,
+				ListenAddress: &listenAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(dbLoadBalancers) != 1 {
+				return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
+			}
+
+			lb = &dbLoadBalancers[0]
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Delete the load balancer itself.
+		err = n.ovnnb.DeleteLoadBalancer(ctx, n.getLoadBalancerName(lb.ListenAddress))
+		if err != nil {
+			return fmt.Errorf("Failed deleting OVN load balancer: %w", err)
+		}
+
+		// Delete static route to load-balancer if present.
+		vip, err := ParseIPToNet(lb.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		_ = n.ovnnb.DeleteLogicalRouterRoute(ctx, n.getRouterName(), *vip)
+
+		// Delete the database records.
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			return dbCluster.DeleteNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), lb.ID)
+		})
+		if err != nil {
+			return err
+		}
+
+		// Notify all other members to refresh their BGP prefixes.
+		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		if err != nil {
+			return err
+		}
+
+		err = notifier(func(client incus.InstanceServer) error {
+			return client.UseProject(n.project).DeleteNetworkLoadBalancer(n.name, lb.ListenAddress)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh exported BGP prefixes on local member.
+	err = n.loadBalancerBGPSetupPrefixes(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
+	}
+
+	return nil
+}
+
+// getLoadBalancerProbePortName returns the OVN logical switch port name used by this member to probe the UDP
+// backends of the load balancers.
+func (n *ovn) getLoadBalancerProbePortName() networkOVN.OVNSwitchPort {
+	return networkOVN.OVNSwitchPort(fmt.Sprintf("%s-lb-probe-%s", n.getNetworkPrefix(), n.state.ServerName))
+}
+
+// getLoadBalancerProbeNetns returns the name of the network namespace the load balancer probes are sent from.
+func (n *ovn) getLoadBalancerProbeNetns() string {
+	return fmt.Sprintf("%s-lb-probe", n.getNetworkPrefix())
+}
+
+// getLoadBalancerProbeInterface returns the name of the host side of the load balancer probe interface.
+func (n *ovn) getLoadBalancerProbeInterface() string {
+	return fmt.Sprintf("incusprb%d", n.id)
+}
+
+// getLoadBalancerProbeMAC returns the MAC address of the load balancer probe port of this member.
+// Uses a stable seed so that the port keeps its MAC (and so its dynamic addresses) across restarts.
+func (n *ovn) getLoadBalancerProbeMAC() (net.HardwareAddr, error) {
+	cert, err := internalUtil.LoadCert(n.state.OS.VarDir)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := fmt.Sprintf("%s.%d.%s.lb-probe", cert.Fingerprint(), n.ID(), n.state.ServerName)
+	r, err := localUtil.GetStableRandomGenerator(seed)
+	if err != nil {
+		return nil, fmt.Errorf("Failed generating stable random probe MAC: %w", err)
+	}
+
+	return net.ParseMAC(randomHwaddr(n.state, r))
+}
+
+// loadBalancerProbeSettings returns the interval and timeout of the UDP probes as well as the number of
+// consecutive successes and failures needed to change the status of a backend.
+// Defaults match those of the OVN health checks.
+func loadBalancerProbeSettings(config map[string]string) (time.Duration, time.Duration, int, int) {
+	getInt := func(key string, defaultValue int) int {
+		value, err := strconv.Atoi(config[key])
+		if err != nil || value <= 0 {
+			return defaultValue
+		}
+
+		return value
+	}
+
+	interval := time.Duration(getInt("healthcheck.interval", 10)) * time.Second
+	timeout := time.Duration(getInt("healthcheck.timeout", 30)) * time.Second
+
+	// Don't let probes overlap with the next ones.
+	timeout = min(timeout, interval)
+
+	return interval, timeout, getInt("healthcheck.success_count", 3), getInt("healthcheck.failure_count", 3)
+}
+
+// loadBalancerProbeTargets returns the target of the UDP probes for each backend of the load balancer that is
+// used by a UDP port. Targets are prefixed with the probe type so that they change along with it.
+func loadBalancerProbeTargets(lb api.NetworkLoadBalancer) map[string]string {
+	probe := lb.Config["healthcheck.udp.probe"]
+
+	// firstPort returns the first port of a port specification.
+	firstPort := func(portSpec string) string {
+		portSpecs := util.SplitNTrimSpace(portSpec, ",", -1, true)
+		if len(portSpecs) == 0 {
+			return ""
+		}
+
+		port, _, err := ParsePortRange(portSpecs[0])
+		if err != nil {
+			return ""
+		}
+
+		return strconv.FormatInt(port, 10)
+	}
+
+	targets := map[string]string{}
+	for _, lbPort := range lb.Ports {
+		if lbPort.Protocol != "udp" {
+			continue
+		}
+
+		for _, backend := range lb.Backends {
+			// Target group backends can't be probed, see loadBalancerValidate.
+			if !slices.Contains(lbPort.TargetBackend, backend.Name) || targets[backend.Name] != "" || backend.TargetGroup != "" {
+				continue
+			}
+
+			if probe == "icmp" {
+				targets[backend.Name] = fmt.Sprintf("%s/%s", probe, backend.TargetAddress)
+				continue
+			}
+
+			port := lb.Config["healthcheck.udp.port"]
+			if port == "" {
+				port = firstPort(backend.TargetPort)
+			}
+
+			if port == "" {
+				port = firstPort(lbPort.ListenPort)
+			}
+
+			targets[backend.Name] = fmt.Sprintf("%s/%s", probe, net.JoinHostPort(backend.TargetAddress, port))
+		}
+	}
+
+	return targets
+}
+
+// loadBalancerProbeOnline returns whether the UDP backends of the load balancer are online according to the
+// probes run by this member. Load balancers without probes are assumed to be online.
+func (n *ovn) loadBalancerProbeOnline(listenAddress string) bool {
+	ovnLoadBalancerProbesMu.Lock()
+	defer ovnLoadBalancerProbesMu.Unlock()
+
+	probe := ovnLoadBalancerProbes[n.id][listenAddress]
+	if probe == nil {
+		return true
+	}
+
+	return probe.online()
+}
+
+// loadBalancerProbeStatus returns the status of a load balancer backend according to the UDP probes run by this
+// member, and whether the backend is being probed at all.
+func (n *ovn) loadBalancerProbeStatus(listenAddress string, backendName string) (string, bool) {
+	ovnLoadBalancerProbesMu.Lock()
+	defer ovnLoadBalancerProbesMu.Unlock()
+
+	probe := ovnLoadBalancerProbes[n.id][listenAddress]
+	if probe == nil {
+		return "", false
+	}
+
+	backend := probe.backends[backendName]
+	if backend == nil {
+		return "", false
+	}
+
+	return backend.status, true
+}
+
+// LoadBalancerProbe runs the due UDP probes of the network's load balancers from this member and refreshes the
+// exported BGP prefixes when the availability of a load balancer changes.
+func (n *ovn) LoadBalancerProbe() error {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	var loadBalancers []api.NetworkLoadBalancer
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			lb, err := dbLoadBalancer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			if !util.IsTrue(lb.Config["healthcheck"]) || validate.IsOneOf("none", "")(lb.Config["healthcheck.udp.probe"]) == nil {
+				continue
+			}
+
+			loadBalancers = append(loadBalancers, *lb)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading network load balancers: %w", err)
+	}
+
+	// Sync the probe state with the load balancer configuration.
+	now := time.Now()
+	changed := []string{}
+
+	type probeRun struct {
+		lb      api.NetworkLoadBalancer
+		targets map[string]string
+	}
+
+	runs := []probeRun{}
+
+	ovnLoadBalancerProbesMu.Lock()
+
+	probes := map[string]*ovnLoadBalancerProbe{}
+	for _, lb := range loadBalancers {
+		targets := loadBalancerProbeTargets(lb)
+		if len(targets) == 0 {
+			continue
+		}
+
+		probe := ovnLoadBalancerProbes[n.id][lb.ListenAddress]
+		if probe == nil {
+			probe = &ovnLoadBalancerProbe{}
+		}
+
+		backends := make(map[string]*ovnLoadBalancerProbeBackend, len(targets))
+		for backendName, target := range targets {
+			backend := probe.backends[backendName]
+			if backend == nil || backend.target != target {
+				backend = &ovnLoadBalancerProbeBackend{target: target, status: "unknown"}
+			}
+
+			backends[backendName] = backend
+		}
+
+		wasOnline := probe.online()
+		probe.backends = backends
+		if wasOnline != probe.online() {
+			changed = append(changed, lb.ListenAddress)
+		}
+
+		probes[lb.ListenAddress] = probe
+
+		if now.After(probe.nextRun) {
+			interval, _, _, _ := loadBalancerProbeSettings(lb.Config)
+			probe.nextRun = now.Add(interval)
+			runs = append(runs, probeRun{lb: lb, targets: targets})
+		}
+	}
+
+	// Load balancers no longer probed are assumed to be online again.
+	for listenAddress, probe := range ovnLoadBalancerProbes[n.id] {
+		if probes[listenAddress] == nil && !probe.online() {
+			changed = append(changed, listenAddress)
+		}
+	}
+
+	if len(probes) > 0 {
+		ovnLoadBalancerProbes[n.id] = probes
+	} else {
+		delete(ovnLoadBalancerProbes, n.id)
+	}
+
+	ovnLoadBalancerProbesMu.Unlock()
+
+	if len(probes) == 0 {
+		// Nothing to probe, so release the probe port of this member if it has one.
+		err = n.loadBalancerProbeTeardown(ctx)
+		if err != nil {
+			return err
+		}
+	} else if len(runs) > 0 {
+		err = n.loadBalancerProbeSetup(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed setting up load balancer probe port: %w", err)
+		}
+
+		// Run the probes in parallel and record their results.
+		nsName := n.getLoadBalancerProbeNetns()
+		wg := sync.WaitGroup{}
+
+		for _, run := range runs {
+			_, timeout, successCount, failureCount := loadBalancerProbeSettings(run.lb.Config)
+
+			for backendName, target := range run.targets {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					probeErr := loadBalancerProbeRun(nsName, run.lb.Config, target, timeout)
+
+					ovnLoadBalancerProbesMu.Lock()
+					defer ovnLoadBalancerProbesMu.Unlock()
+
+					probe := ovnLoadBalancerProbes[n.id][run.lb.ListenAddress]
+					if probe == nil {
+						return
+					}
+
+					backend := probe.backends[backendName]
+					if backend == nil || backend.target != target {
+						return // Configuration changed while probing.
+					}
+
+					wasOnline := probe.online()
+
+					if probeErr == nil {
+						backend.failures = 0
+						backend.successes++
+						if backend.successes >= successCount {
+							backend.status = "online"
+						}
+					} else {
+						backend.successes = 0
+						backend.failures++
+						if backend.failures >= failureCount {
+							if backend.status != "offline" {
+								n.logger.Warn("Load balancer backend failed UDP probes", logger.Ctx{"listenAddress": run.lb.ListenAddress, "backend": backendName, "err": probeErr})
+							}
+
+							backend.status = "offline"
+						}
+					}
+
+					if wasOnline != probe.online() && !slices.Contains(changed, run.lb.ListenAddress) {
+						changed = append(changed, run.lb.ListenAddress)
+					}
+				}()
+			}
+		}
+
+		wg.Wait()
+	}
+
+	// Update the BGP advertisement of the load balancers whose health changed.
+	for _, listenAddress := range changed {
+		n.loadBalancerBGPHealthChanged(ctx, net.ParseIP(listenAddress))
+	}
+
+	return nil
+}
+
+// loadBalancerProbeRun runs a single UDP probe of the load balancer against the target.
+func loadBalancerProbeRun(nsName string, config map[string]string, target string, timeout time.Duration) error {
+	probe, address, _ := strings.Cut(target, "/")
+
+	switch probe {
+	case "udp":
+		payload, err := hex.DecodeString(config["healthcheck.udp.payload"])
+		if err != nil {
+			return err
+		}
+
+		return probeUDP(nsName, address, payload, timeout)
+	case "dns":
+		return probeDNS(nsName, address, config["healthcheck.udp.payload"], timeout)
+	case "icmp":
+		return probeICMP(nsName, net.ParseIP(address), timeout)
+	}
+
+	return fmt.Errorf("Unknown probe %q", probe)
+}
+
+// getProjectConfig returns the config of the network's project.
+// It is loaded once per network instance, so repeated port operations don't each need a database transaction.
+func (n *ovn) getProjectConfig(ctx context.Context) (map[string]string, error) {
+	n.projectConfigMu.Lock()
+	defer n.projectConfigMu.Unlock()
+
+	if n.projectConfig != nil {
+		return n.projectConfig, nil
+	}
+
+	var projectConfig map[string]string
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
+		if err != nil {
+			return err
+		}
+
+		projectConfig, err = dbCluster.GetProjectConfig(ctx, tx.Tx(), project.ID)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading config of project %q: %w", n.project, err)
+	}
+
+	n.projectConfig = projectConfig
+
+	return n.projectConfig, nil
+}
+
+// getIntegrationBridge returns the OVS integration bridge used by the network's project.
+func (n *ovn) getIntegrationBridge(ctx context.Context) (string, error) {
+	projectConfig, err := n.getProjectConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return OVNIntegrationBridge(n.state, projectConfig), nil
+}
+
+// IntegrationBridge returns the OVS integration bridge used by the network's project.
+// This is the network's project rather than the instance's, which differ for projects without features.networks.
+func (n *ovn) IntegrationBridge() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	return n.getIntegrationBridge(ctx)
+}
+
+// loadBalancerProbeSetup connects this member to the network through a dedicated logical switch port, living in
+// its own network namespace on the host, from which the UDP probes of the load balancers get sent.
+func (n *ovn) loadBalancerProbeSetup(ctx context.Context) error {
+	hostName := n.getLoadBalancerProbeInterface()
+	if InterfaceExists(hostName) {
+		return nil // Already set up.
+	}
+
+	// Probes can only be run from members with the OVN dataplane.
+	chassisID, err := n.localChassisID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if chassisID == "" {
+		return nil
+	}
+
+	integrationBridge, err := n.getIntegrationBridge(ctx)
+	if err != nil {
+		return err
+	}
+
+	mac, err := n.getLoadBalancerProbeMAC()
+	if err != nil {
+		return err
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Let OVN allocate the addresses of the port, like for instance NICs without static addresses.
+	portName := n.getLoadBalancerProbePortName()
+
+	err = n.ovnnb.CreateLogicalSwitchPort(ctx, n.getIntSwitchName(), portName, &networkOVN.OVNSwitchPortOpts{
+		MAC:      mac,
+		Location: n.state.ServerName,
+	}, true)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), portName)
+	})
+
+	// Wait for OVN to allocate the dynamic IPs of the port.
+	waitCtx, waitCancel := context.WithTimeout(ctx, n.dynamicAddressTimeout())
+	dynamicIPs, err := n.ovnnb.WaitLogicalSwitchPortDynamicIPs(waitCtx, portName)
+	waitCancel()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return errors.New("No dynamic addresses allocated")
+		}
+
+		return err
+	}
+
+	_, ipv4Net, err := n.parseRouterIntPortIPv4Net()
+	if err != nil {
+		return err
+	}
+
+	_, ipv6Net, err := n.parseRouterIntPortIPv6Net()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]*net.IPNet, 0, len(dynamicIPs))
+	for _, dynamicIP := range dynamicIPs {
+		if dynamicIP.To4() != nil && ipv4Net != nil {
+			addresses = append(addresses, &net.IPNet{IP: dynamicIP, Mask: ipv4Net.Mask})
+		} else if dynamicIP.To4() == nil && ipv6Net != nil {
+			addresses = append(addresses, &net.IPNet{IP: dynamicIP, Mask: ipv6Net.Mask})
+		}
+	}
+
+	nsName := n.getLoadBalancerProbeNetns()
+
+	err = probeNetnsSetup(nsName, hostName, mac, n.getBridgeMTU(), addresses)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() { _ = probeNetnsTeardown(nsName, hostName) })
+
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	err = vswitch.CreateBridgePort(ctx, integrationBridge, hostName, true)
+	if err != nil {
+		return fmt.Errorf("Failed adding interface %q to OVS bridge %q: %w", hostName, integrationBridge, err)
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = vswitch.DeleteBridgePort(ctx, integrationBridge, hostName)
+	})
+
+	err = vswitch.AssociateInterfaceOVNSwitchPort(ctx, hostName, string(portName))
+	if err != nil {
+		return err
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// loadBalancerProbeTeardown removes the load balancer probe port of this member and forgets the probe state.
+func (n *ovn) loadBalancerProbeTeardown(ctx context.Context) error {
+	ovnLoadBalancerProbesMu.Lock()
+	delete(ovnLoadBalancerProbes, n.id)
+	ovnLoadBalancerProbesMu.Unlock()
+
+	hostName := n.getLoadBalancerProbeInterface()
+	nsName := n.getLoadBalancerProbeNetns()
+
+	if !InterfaceExists(hostName) && !util.PathExists(fmt.Sprintf("/run/netns/%s", nsName)) {
+		return nil // Nothing to remove.
+	}
+
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	integrationBridge, err := n.getIntegrationBridge(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = vswitch.DeleteBridgePort(ctx, integrationBridge, hostName)
+	if err != nil {
+		return fmt.Errorf("Failed removing interface %q from OVS bridge %q: %w", hostName, integrationBridge, err)
+	}
+
+	err = probeNetnsTeardown(nsName, hostName)
+	if err != nil {
+		return err
+	}
+
+	err = n.ovnnb.DeleteLogicalSwitchPort(ctx, n.getIntSwitchName(), n.getLoadBalancerProbePortName())
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return fmt.Errorf("Failed deleting load balancer probe port: %w", err)
+	}
+
+	return nil
+}
+
+func (n *ovn) getHealthCheck(loadBalancer api.NetworkLoadBalancerPut) (*networkOVN.OVNLoadBalancerHealthCheck, error) {
+	// Check if load-balancer is enabled.
+	if !util.IsTrue(loadBalancer.Config["healthcheck"]) {
+		return nil, nil
+	}
+
+	// Get IPv4 checker.
+	var checkerIPV4 net.IP
+	_, ipv4Net, err := n.parseRouterIntPortIPv4Net()
+	if err == nil && ipv4Net != nil {
+		checkerIPV4 = dhcpalloc.GetIP(ipv4Net, -2)
+	}
+
+	// Get IPv6 checker.
+	var checkerIPV6 net.IP
+	_, ipv6Net, err := n.parseRouterIntPortIPv6Net()
+	if err == nil && ipv6Net != nil {
+		checkerIPV6 = dhcpalloc.GetIP(ipv6Net, -2)
+	}
+
+	// Parse the healthcheck options.
+	hcInterval, err := strconv.Atoi(loadBalancer.Config["healthcheck.interval"])
+	if err != nil && loadBalancer.Config["healthcheck.interval"] != "" {
+		return nil, err
+	}
+
+	hcTimeout, err := strconv.Atoi(loadBalancer.Config["healthcheck.timeout"])
+	if err != nil && loadBalancer.Config["healthcheck.timeout"] != "" {
+		return nil, err
+	}
+
+	hcFailureCount, err := strconv.Atoi(loadBalancer.Config["healthcheck.failure_count"])
+	if err != nil && loadBalancer.Config["healthcheck.failure_count"] != "" {
+		return nil, err
+	}
+
+	hcSuccessCount, err := strconv.Atoi(loadBalancer.Config["healthcheck.success_count"])
+	if err != nil && loadBalancer.Config["healthcheck.success_count"] != "" {
+		return nil, err
+	}
+
+	// Prepare the load-balancer health check.
+	healthCheck := &networkOVN.OVNLoadBalancerHealthCheck{
+		CheckerIPV4: checkerIPV4,
+		CheckerIPV6: checkerIPV6,
+
+		Interval:     hcInterval,
+		Timeout:      hcTimeout,
+		FailureCount: hcFailureCount,
+		SuccessCount: hcSuccessCount,
+	}
+
+	return healthCheck, nil
+}
+
+// externalPortValidate validates the configuration of an external port.
+func (n *ovn) externalPortValidate(config map[string]string) error {
+	rules := map[string]func(value string) error{
+		// gendoc:generate(entity=network_external_port, group=common, key=interface)
+		//
+		// ---
+		//  type: string
+		//  required: yes
+		//  shortdesc: Name of the interface to attach, either an existing port of the OVS integration bridge or a host interface
+		"interface": validate.IsInterfaceName,
+
+		// gendoc:generate(entity=network_external_port, group=common, key=hwaddr)
+		//
+		// ---
+		//  type: string
+		//  required: yes
+		//  shortdesc: MAC address used by the attached interface
+		"hwaddr": validate.IsNetworkMAC,
+
+		// gendoc:generate(entity=network_external_port, group=common, key=ipv4.address)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: An IPv4 address to assign to the port through DHCP (can be `none` to restrict all IPv4 traffic)
+		//  defaultdesc: dynamically allocated
+		"ipv4.address": validate.Optional(validate.Or(validate.IsNetworkAddressV4, validate.IsOneOf("none"))),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=ipv6.address)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: An IPv6 address to assign to the port through DHCP (can be `none` to restrict all IPv6 traffic)
+		//  defaultdesc: dynamically allocated
+		"ipv6.address": validate.Optional(validate.Or(validate.IsNetworkAddressV6, validate.IsOneOf("none"))),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=security.acls)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Comma-separated list of network ACLs to apply to the port
+		"security.acls": validate.IsAny,
+
+		// gendoc:generate(entity=network_external_port, group=common, key=security.acls.default.ingress.action)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Action to use for ingress traffic that doesn't match any ACL rule
+		//  defaultdesc: `reject`
+		"security.acls.default.ingress.action": validate.Optional(validate.IsOneOf(acl.ValidActions...)),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=security.acls.default.egress.action)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: Action to use for egress traffic that doesn't match any ACL rule
+		//  defaultdesc: `reject`
+		"security.acls.default.egress.action": validate.Optional(validate.IsOneOf(acl.ValidActions...)),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=security.acls.default.ingress.logged)
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether to log ingress traffic that doesn't match any ACL rule
+		//  defaultdesc: `false`
+		"security.acls.default.ingress.logged": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=security.acls.default.egress.logged)
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether to log egress traffic that doesn't match any ACL rule
+		//  defaultdesc: `false`
+		"security.acls.default.egress.logged": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_external_port, group=common, key=volatile.interface.attached)
+		//
+		// ---
+		//  type: bool
+		//  shortdesc: Whether the interface was added to the OVS integration bridge by Incus (read-only)
+		"volatile.interface.attached": validate.Optional(validate.IsBool),
+	}
+
+	for k, v := range config {
+		// User keys are not validated.
+
+		// gendoc:generate(entity=network_external_port, group=common, key=user.*)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: User defined key/value configuration
+		if internalInstance.IsUserConfig(k) {
+			continue
+		}
+
+		checker, ok := rules[k]
+		if !ok {
+			return fmt.Errorf("Invalid option %q", k)
+		}
+
+		err := checker(v)
+		if err != nil {
+			return fmt.Errorf("Invalid value for option %q: %w", k, err)
+		}
+	}
+
+	for _, k := range []string{"interface", "hwaddr"} {
+		if config[k] == "" {
+			return fmt.Errorf("Missing required option %q", k)
+		}
+	}
+
+	// Check the static addresses are within the network's subnets.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		value := config[fmt.Sprintf("%s.address", keyPrefix)]
+		if value == "" || value == "none" {
+			continue
+		}
+
+		var subnet *net.IPNet
+		var err error
+		if keyPrefix == "ipv4" {
+			_, subnet, err = n.parseRouterIntPortIPv4Net()
+		} else {
+			_, subnet, err = n.parseRouterIntPortIPv6Net()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if subnet == nil || !SubnetContainsIP(subnet, net.ParseIP(value)) {
+			return fmt.Errorf("Port %s address %q not within network subnet", keyPrefix, value)
+		}
+	}
+
+	// Check the security ACLs exist.
+	aclNames := util.SplitNTrimSpace(config["security.acls"], ",", -1, true)
+	if len(aclNames) > 0 {
+		err := acl.Exists(n.state, n.Project(), aclNames...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// externalPortSetup sets up the logical switch port of an external port and binds its interface to it.
+// Accepts a list of ACLs being removed from the port (if called as part of an update).
+// Returns whether the interface had to be added to the OVS integration bridge.
+func (n *ovn) externalPortSetup(ctx context.Context, portName string, config map[string]string, securityACLsRemove []string) (bool, error) {
+	chassisID, err := n.localChassisID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if chassisID == "" {
+		return false, api.StatusErrorf(http.StatusBadRequest, "External ports can't be set up on member %q as it doesn't run the OVN dataplane", n.state.ServerName)
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	var uplinkConfig map[string]string
+	var uplinkID int64
+	var uplinkType string
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		if n.config["network"] != "none" {
+			id, uplink, _, err := tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+			if err != nil {
+				return fmt.Errorf("Failed to load uplink network %q: %w", n.config["network"], err)
+			}
+
+			uplinkConfig = uplink.Config
+			uplinkID = id
+			uplinkType = uplink.Type
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// Check the interface isn't one the network relies on.
+	reserved := ovnExternalPortReservedInterfaces(n.config, n.config["network"], uplinkID, uplinkType, uplinkConfig)
+	if slices.Contains(reserved, config["interface"]) {
+		return false, api.StatusErrorf(http.StatusBadRequest, "Interface %q is used by the network or its uplink", config["interface"])
+	}
+
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return false, fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	// Check the interface isn't already bound to another logical switch port.
+	portLSPName := n.getExternalPortName(portName)
+	integrationBridge, err := n.getIntegrationBridge(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	bridgePorts, err := vswitch.GetBridgePorts(ctx, integrationBridge)
+	if err != nil {
+		return false, fmt.Errorf("Failed getting ports of OVS bridge %q: %w", integrationBridge, err)
+	}
+
+	// Interfaces which aren't ports of the integration bridge yet are host interfaces which must be added to it.
+	hostInterface := !slices.Contains(bridgePorts, config["interface"])
+	if !hostInterface {
+		ifaceID, err := vswitch.GetInterfaceAssociatedOVNSwitchPort(ctx, config["interface"])
+		if err != nil {
+			return false, fmt.Errorf("Failed getting OVS interface %q: %w", config["interface"], err)
+		}
+
+		if ifaceID != "" && ifaceID != string(portLSPName) {
+			return false, api.StatusErrorf(http.StatusConflict, "Interface %q is already in use", config["interface"])
+		}
+	} else if !InterfaceExists(config["interface"]) {
+		return false, api.StatusErrorf(http.StatusNotFound, "Interface %q not found on OVS bridge %q or on the host", config["interface"], integrationBridge)
+	} else {
+		// Check the host isn't using the interface itself.
+		link, err := ip.LinkByName(config["interface"])
+		if err != nil {
+			return false, fmt.Errorf("Failed getting interface %q: %w", config["interface"], err)
+		}
+
+		if link.Master != "" {
+			return false, api.StatusErrorf(http.StatusConflict, "Interface %q is already in use by %q", config["interface"], link.Master)
+		}
+
+		addresses, _, err := InterfaceStatus(config["interface"])
+		if err != nil {
+			return false, err
+		}
+
+		if len(addresses) > 0 {
+			return false, api.StatusErrorf(http.StatusConflict, "Interface %q is in use by the host as it has IP addresses configured on it", config["interface"])
+		}
+	}
+
+	// Create the logical switch port.
+	opts := &OVNInstanceNICSetupOpts{
+		DeviceName:   portName,
+		DeviceConfig: config,
+		UplinkConfig: uplinkConfig,
+		DNSName:      portName,
+	}
+
+	_, _, err = n.switchPortStart(ctx, portLSPName, fmt.Sprintf("external-%s", portName), opts, securityACLsRemove)
+	if err != nil {
+		return false, fmt.Errorf("Failed setting up logical switch port: %w", err)
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.switchPortStop(ctx, portLSPName, &OVNInstanceNICStopOpts{DeviceName: portName, DeviceConfig: config})
+		_ = n.switchPortRemove(ctx, portLSPName, config)
+	})
+
+	// Add a host interface to the integration bridge.
+	if hostInterface {
+		err = vswitch.CreateBridgePort(ctx, integrationBridge, config["interface"], false)
+		if err != nil {
+			return false, fmt.Errorf("Failed adding interface %q to OVS bridge %q: %w", config["interface"], integrationBridge, err)
+		}
+
+		reverter.Add(func() {
+			ctx, cancel := ovnRevertContext(ctx)
+			defer cancel()
+
+			_ = vswitch.DeleteBridgePort(ctx, integrationBridge, config["interface"])
+		})
+
+		link := &ip.Link{Name: config["interface"]}
+		err = link.SetUp()
+		if err != nil {
+			return false, fmt.Errorf("Failed to bring up the host interface %q: %w", config["interface"], err)
+		}
+	}
+
+	// Link OVS interface to OVN logical port.
+	err = vswitch.AssociateInterfaceOVNSwitchPort(ctx, config["interface"], string(portLSPName))
+	if err != nil {
+		return false, err
+	}
+
+	reverter.Success()
+
+	return hostInterface, nil
+}
+
+// ovnExternalPortReservedInterfaces returns the host interfaces an OVN network and its uplink rely on and which
+// therefore can't be used by external ports.
+func ovnExternalPortReservedInterfaces(netConfig map[string]string, uplinkName string, uplinkID int64, uplinkType string, uplinkConfig map[string]string) []string {
+	reserved := []string{}
+
+	// Entries are either an interface name or name/parent/vlan.
+	addExternalInterfaces := func(value string) {
+		for _, entry := range util.SplitNTrimSpace(value, ",", -1, true) {
+			ifName, _, _ := strings.Cut(entry, "/")
+			reserved = append(reserved, strings.TrimSpace(ifName))
+		}
+	}
+
+	addExternalInterfaces(netConfig["bridge.external_interfaces"])
+
+	switch uplinkType {
+	case "bridge":
+		reserved = append(reserved, uplinkName)
+		addExternalInterfaces(uplinkConfig["bridge.external_interfaces"])
+	case "physical":
+		reserved = append(reserved, uplinkConfig["parent"])
+		if uplinkConfig["vlan"] != "" {
+			reserved = append(reserved, GetHostDevice(uplinkConfig["parent"], uplinkConfig["vlan"]))
+		}
+	default:
+		return reserved
+	}
+
+	// Interfaces used to connect the uplink to OVN.
+	ovsBridge := fmt.Sprintf("incusovn%d", uplinkID)
+
+	return append(reserved, ovsBridge, fmt.Sprintf("%sa", ovsBridge), fmt.Sprintf("%sb", ovsBridge))
+}
+
+// externalPortTeardown unbinds the interface of an external port and removes its logical switch port.
+func (n *ovn) externalPortTeardown(ctx context.Context, portName string, config map[string]string) error {
+	err := n.externalPortDetach(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	portLSPName := n.getExternalPortName(portName)
+
+	err = n.switchPortStop(ctx, portLSPName, &OVNInstanceNICStopOpts{DeviceName: portName, DeviceConfig: config})
+	if err != nil {
+		return fmt.Errorf("Failed removing logical switch port: %w", err)
+	}
+
+	return n.switchPortRemove(ctx, portLSPName, config)
+}
+
+// externalPortDetach removes the OVN association of an external port's interface, as well as the interface
+// itself from the OVS integration bridge if it was added by us.
+func (n *ovn) externalPortDetach(ctx context.Context, config map[string]string) error {
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	if util.IsTrue(config["volatile.interface.attached"]) {
+		integrationBridge, err := n.getIntegrationBridge(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = vswitch.DeleteBridgePort(ctx, integrationBridge, config["interface"])
+		if err != nil {
+			return fmt.Errorf("Failed removing interface %q from OVS bridge %q: %w", config["interface"], integrationBridge, err)
+		}
+
+		return nil
+	}
+
+	err = vswitch.DisassociateInterfaceOVNSwitchPort(ctx, config["interface"])
+	if err != nil {
+		return fmt.Errorf("Failed unbinding OVS interface %q: %w", config["interface"], err)
+	}
+
+	return nil
+}
+
+// externalPortLoad returns the DB record and config of an external port located on this member.
+func (n *ovn) externalPortLoad(ctx context.Context, portName string) (*dbCluster.NetworkExternalPort, map[string]string, error) {
+	var record *dbCluster.NetworkExternalPort
+	var config map[string]string
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		record, err = dbCluster.GetNetworkExternalPort(ctx, tx.Tx(), n.ID(), portName)
+		if err != nil {
+			return err
+		}
+
+		if record.NodeID != tx.GetNodeID() {
+			return api.StatusErrorf(http.StatusBadRequest, "External port %q is located on another cluster member", portName)
+		}
+
+		config, err = dbCluster.GetNetworkExternalPortConfig(ctx, tx.Tx(), int(record.ID))
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return record, config, nil
+}
+
+// ExternalPortCreate attaches an interface of this member to the network as an external port.
+func (n *ovn) ExternalPortCreate(port api.NetworkExternalPortsPost) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	err = validate.IsHostname(port.Name)
+	if err != nil {
+		return api.StatusErrorf(http.StatusBadRequest, "Invalid external port name %q: %v", port.Name, err)
+	}
+
+	for k := range port.Config {
+		if strings.HasPrefix(k, "volatile.") {
+			return api.StatusErrorf(http.StatusBadRequest, "Volatile option %q cannot be set", k)
+		}
+	}
+
+	err = n.externalPortValidate(port.Config)
+	if err != nil {
+		return api.StatusErrorf(http.StatusBadRequest, "%v", err)
+	}
+
+	// Look for an existing entry.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := dbCluster.GetNetworkExternalPort(ctx, tx.Tx(), n.ID(), port.Name)
+
+		return err
+	})
+	if err == nil {
+		return api.StatusErrorf(http.StatusConflict, "An external port with that name already exists")
+	} else if !api.StatusErrorCheck(err, http.StatusNotFound) {
+		return err
+	}
+
+	attached, err := n.externalPortSetup(ctx, port.Name, port.Config, nil)
+	if err != nil {
+		return err
+	}
+
+	if attached {
+		port.Config["volatile.interface.attached"] = "true"
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.externalPortTeardown(ctx, port.Name, port.Config)
+	})
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		id, err := dbCluster.CreateNetworkExternalPort(ctx, tx.Tx(), dbCluster.NetworkExternalPort{
+			NetworkID:   n.ID(),
+			Name:        port.Name,
+			NodeID:      tx.GetNodeID(),
+			Description: port.Description,
+		})
+		if err != nil {
+			return err
+		}
+
+		return dbCluster.CreateNetworkExternalPortConfig(ctx, tx.Tx(), id, port.Config)
+	})
+	if err != nil {
+		return err
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// ExternalPortUpdate updates the configuration of an external port located on this member.
+func (n *ovn) ExternalPortUpdate(portName string, newPort api.NetworkExternalPortPut) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	record, oldConfig, err := n.externalPortLoad(ctx, portName)
+	if err != nil {
+		return err
+	}
+
+	// Volatile keys are managed internally.
+	for k := range newPort.Config {
+		if strings.HasPrefix(k, "volatile.") {
+			delete(newPort.Config, k)
+		}
+	}
+
+	for k, v := range oldConfig {
+		if strings.HasPrefix(k, "volatile.") {
+			newPort.Config[k] = v
+		}
+	}
+
+	if newPort.Config["interface"] != oldConfig["interface"] {
+		return api.StatusErrorf(http.StatusBadRequest, "The interface of an external port cannot be changed")
+	}
+
+	if newPort.Description == record.Description && maps.Equal(newPort.Config, oldConfig) {
+		return nil // Nothing has changed.
+	}
+
+	err = n.externalPortValidate(newPort.Config)
+	if err != nil {
+		return api.StatusErrorf(http.StatusBadRequest, "%v", err)
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Recreate the logical switch port with the new config, removing it from the ACLs no longer in use.
+	oldACLs := util.SplitNTrimSpace(oldConfig["security.acls"], ",", -1, true)
+	newACLs := util.SplitNTrimSpace(newPort.Config["security.acls"], ",", -1, true)
+	removedACLs := []string{}
+	for _, oldACL := range oldACLs {
+		if !slices.Contains(newACLs, oldACL) {
+			removedACLs = append(removedACLs, oldACL)
+		}
+	}
+
+	portLSPName := n.getExternalPortName(portName)
+
+	err = n.switchPortStop(ctx, portLSPName, &OVNInstanceNICStopOpts{DeviceName: portName, DeviceConfig: oldConfig})
+	if err != nil {
+		return fmt.Errorf("Failed removing logical switch port: %w", err)
+	}
+
+	err = n.switchPortRemove(ctx, portLSPName, oldConfig)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.switchPortStop(ctx, portLSPName, &OVNInstanceNICStopOpts{DeviceName: portName, DeviceConfig: newPort.Config})
+		_ = n.switchPortRemove(ctx, portLSPName, newPort.Config)
+		_, _ = n.externalPortSetup(ctx, portName, oldConfig, nil)
+	})
+
+	_, err = n.externalPortSetup(ctx, portName, newPort.Config, removedACLs)
+	if err != nil {
+		return err
+	}
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		record.Description = newPort.Description
+
+		err := dbCluster.UpdateNetworkExternalPort(ctx, tx.Tx(), n.ID(), portName, *record)
+		if err != nil {
+			return err
+		}
+
+		return dbCluster.UpdateNetworkExternalPortConfig(ctx, tx.Tx(), record.ID, newPort.Config)
+	})
+	if err != nil {
+		return err
+	}
+
+	reverter.Success()
+
+	return nil
+}
+
+// ExternalPortDelete detaches an external port located on this member from the network.
+func (n *ovn) ExternalPortDelete(portName string) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnPortOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	_, config, err := n.externalPortLoad(ctx, portName)
+	if err != nil {
+		return err
+	}
+
+	err = n.externalPortTeardown(ctx, portName, config)
+	if err != nil {
+		return err
+	}
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		return dbCluster.DeleteNetworkExternalPort(ctx, tx.Tx(), n.ID(), portName)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Health runs a set of checks against the OVN objects backing the network and returns their results.
+func (n *ovn) Health(ctx context.Context) (*api.NetworkHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	health := &api.NetworkHealth{Healthy: true, Checks: []api.NetworkHealthCheck{}}
+
+	addCheck := func(name string, err error, skipped bool) {
+		check := api.NetworkHealthCheck{Name: name, Status: "ok"}
+		if skipped {
+			check.Status = "skipped"
+		} else if err != nil {
+			check.Status = "failed"
+			check.Message = err.Error()
+			health.Healthy = false
+		}
+
+		health.Checks = append(health.Checks, check)
+	}
+
+	hasUplink := n.config["network"] != "none"
+	hasRouter := hasUplink || n.config["ipv4.address"] != "none" || n.config["ipv6.address"] != "none"
+
+	// Check the logical router.
+	_, err := n.ovnnb.GetLogicalRouter(ctx, n.getRouterName())
+	if err != nil {
+		err = fmt.Errorf("Logical router %q: %w", n.getRouterName(), err)
+	}
+
+	addCheck("logical_router", err, !hasRouter)
+
+	// Check the logical switches.
+	_, err = n.ovnnb.GetLogicalSwitch(ctx, n.getIntSwitchName())
+	if err != nil {
+		err = fmt.Errorf("Logical switch %q: %w", n.getIntSwitchName(), err)
+	}
+
+	addCheck("internal_switch", err, false)
+
+	_, err = n.ovnnb.GetLogicalSwitch(ctx, n.getExtSwitchName())
+	if err != nil {
+		err = fmt.Errorf("Logical switch %q: %w", n.getExtSwitchName(), err)
+	}
+
+	addCheck("external_switch", err, !hasUplink)
+
+	// Check that a chassis is hosting the router's uplink port.
+	chassis, err := n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
+	if err == nil && chassis == "" {
+		err = errors.New("No chassis is currently bound to the uplink router port")
+	}
+
+	addCheck("chassis", err, !hasUplink)
+
+	// Check the uplink port of the external switch is enabled and mapped to a bridge on the gateway chassis.
+	if hasUplink {
+		err = n.uplinkPortCheck(ctx, chassis)
+	}
+
+	addCheck("uplink_port", err, !hasUplink)
+
+	// Check the DHCP options are present if DHCP is enabled.
+	dhcpEnabled := (n.config["ipv4.address"] != "none" && util.IsTrueOrEmpty(n.config["ipv4.dhcp"])) || (n.config["ipv6.address"] != "none" && util.IsTrueOrEmpty(n.config["ipv6.dhcp"]))
+	dhcpOpts, err := n.ovnnb.GetLogicalSwitchDHCPOptions(ctx, n.getIntSwitchName())
+	if err == nil && len(dhcpOpts) == 0 {
+		err = errors.New("No DHCP options are defined on the internal switch")
+	}
+
+	addCheck("dhcp_options", err, !dhcpEnabled)
+
+	// Check the load balancer backends.
+	var loadBalancers []*api.NetworkLoadBalancer
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			loadBalancer, err := dbLoadBalancer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			loadBalancers = append(loadBalancers, loadBalancer)
+		}
+
+		return nil
+	})
+
+	healthChecked := false
+	var lbErr error
+	if err != nil {
+		healthChecked = true
+		lbErr = fmt.Errorf("Failed loading network load balancers: %w", err)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		if util.IsFalseOrEmpty(loadBalancer.Config["healthcheck"]) {
+			continue
+		}
+
+		healthChecked = true
+
+		lbState, err := n.LoadBalancerState(ctx, *loadBalancer)
+		if err != nil {
+			lbErr = fmt.Errorf("Load balancer %q: %w", loadBalancer.ListenAddress, err)
+			break
+		}
+
+		for backendName, backendHealth := range lbState.BackendHealth {
+			for _, port := range backendHealth.Ports {
+				if port.Status == "offline" {
+					lbErr = fmt.Errorf("Load balancer %q backend %q is offline on port %s/%d", loadBalancer.ListenAddress, backendName, port.Protocol, port.Port)
+					break
+				}
+			}
+		}
+
+		if lbErr != nil {
+			break
+		}
+	}
+
+	addCheck("load_balancers", lbErr, !healthChecked)
+
+	// Check the BGP sessions of the uplink network.
+	var bgpErr error
+	var bgpPeers []string
+
+	if hasUplink {
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			_, uplink, _, err := tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, n.config["network"])
+			if err != nil {
+				return err
+			}
+
+			bgpPeers = n.bgpGetPeers(uplink.Config)
+
+			return nil
+		})
+		if err != nil {
+			bgpErr = fmt.Errorf("Failed loading uplink network %q: %w", n.config["network"], err)
+		}
+	}
+
+	for _, peer := range bgpPeers {
+		fields := strings.Split(peer, ",")
+
+		state, err := n.state.BGP.PeerState(net.ParseIP(fields[0]))
+		if err != nil {
+			bgpErr = fmt.Errorf("BGP peer %q: %w", fields[0], err)
+			break
+		}
+
+		if state != "established" {
+			bgpErr = fmt.Errorf("BGP session with peer %q is %s", fields[0], state)
+			break
+		}
+	}
+
+	addCheck("bgp_sessions", bgpErr, bgpErr == nil && len(bgpPeers) == 0)
+
+	return health, nil
+}
+
+// uplinkPortCheck checks that the uplink port of the external switch is enabled, has been bound to its datapath
+// and that its provider network is mapped to a bridge on the gateway chassis.
+func (n *ovn) uplinkPortCheck(ctx context.Context, chassis string) error {
+	portName := n.getExtSwitchProviderPortName()
+
+	lsp, err := n.ovnnb.GetLogicalSwitchPort(ctx, portName)
+	if err != nil {
+		if errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Uplink port %q is missing", portName)
+		}
+
+		return fmt.Errorf("Failed getting uplink port %q: %w", portName, err)
+	}
+
+	if lsp.Enabled != nil && !*lsp.Enabled {
+		return fmt.Errorf("Uplink port %q is disabled", portName)
+	}
+
+	_, err = n.ovnsb.GetLogicalSwitchPortBinding(ctx, portName)
+	if err != nil {
+		if errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Uplink port %q isn't bound to its datapath", portName)
+		}
+
+		return fmt.Errorf("Failed getting the binding of uplink port %q: %w", portName, err)
+	}
+
+	if chassis == "" {
+		return nil // Already reported by the chassis check.
+	}
+
+	providerNetworks, err := n.ovnsb.GetChassisBridgeMappings(ctx, chassis)
+	if err != nil {
+		return fmt.Errorf("Failed getting the bridge mappings of chassis %q: %w", chassis, err)
+	}
+
+	if !slices.Contains(providerNetworks, lsp.Options["network_name"]) {
+		return fmt.Errorf("Uplink %q isn't mapped to a bridge on chassis %q", lsp.Options["network_name"], chassis)
+	}
+
+	return nil
+}
+
+// AddressConflicts compares the addresses the network owns on its uplink (router addresses, forwards, load balancers
+// and NAT addresses) with the neighbour table of the local uplink interface and, when the router's uplink port is
+// hosted on the local chassis, with the MAC bindings learned by OVN.
+// Any of those addresses resolving to a MAC address other than the one answering for it in OVN is reported.
+func (n *ovn) AddressConflicts() ([]AddressConflict, error) {
+	if n.config["network"] == "none" {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	routerMAC, err := n.getRouterMAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type ownedAddress struct {
+		owner string
+		mac   net.HardwareAddr
+	}
+
+	owned := map[string]ownedAddress{}
+
+	for _, key := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
+		ip := net.ParseIP(n.config[key])
+		if ip != nil {
+			owned[ip.String()] = ownedAddress{owner: "router", mac: routerMAC}
+		}
+	}
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		dbForwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network forwards: %w", err)
+		}
+
+		forwardConfigs, err := networkForwardConfigs(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("Failed loading network forward configs: %w", err)
+		}
+
+		for _, dbForward := range dbForwards {
+			ip := net.ParseIP(dbForward.ListenAddress)
+			if ip != nil && listenScope(forwardConfigs[int(dbForward.ID)]) != listenScopeInternal {
+				owned[ip.String()] = ownedAddress{owner: "forward", mac: routerMAC}
+			}
+		}
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancers: %w", err)
+		}
+
+		loadBalancerConfigs, err := networkLoadBalancerConfigs(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancer configs: %w", err)
+		}
+
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			ip := net.ParseIP(dbLoadBalancer.ListenAddress)
+			if ip != nil && listenScope(loadBalancerConfigs[int(dbLoadBalancer.ID)]) != listenScopeInternal {
+				owned[ip.String()] = ownedAddress{owner: "load balancer", mac: routerMAC}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Distributed NAT rules are answered for by the instance's own MAC address rather than the router's.
+	natRules, err := n.ovnnb.GetLogicalRouterNATs(ctx, n.getRouterName())
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed loading router NAT rules: %w", err)
+	}
+
+	for _, natRule := range natRules {
+		ip := net.ParseIP(natRule.ExternalIP)
+		if ip == nil {
+			continue
+		}
+
+		_, found := owned[ip.String()]
+		if found {
+			continue
+		}
+
+		mac := routerMAC
+		if natRule.ExternalMAC != nil {
+			mac, err = net.ParseMAC(*natRule.ExternalMAC)
+			if err != nil {
+				continue
+			}
+		}
+
+		owned[ip.String()] = ownedAddress{owner: "SNAT", mac: mac}
+	}
+
+	if len(owned) == 0 {
+		return nil, nil
+	}
+
+	conflicts := []AddressConflict{}
+
+	addConflict := func(ip net.IP, mac net.HardwareAddr, source string) {
+		address, found := owned[ip.String()]
+		if !found || len(mac) == 0 || bytes.Equal(mac, address.mac) {
+			return
+		}
+
+		conflicts = append(conflicts, AddressConflict{Address: ip, Owner: address.owner, MAC: mac, Source: source})
+	}
+
+	// Check the neighbour table of the uplink interface on this member.
+	uplinkNet, err := LoadByName(n.state, api.ProjectDefaultName, n.config["network"])
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading uplink network %q: %w", n.config["network"], err)
+	}
+
+	uplinkInterface := uplinkNet.Name()
+	if uplinkNet.Type() == "physical" {
+		uplinkInterface = GetHostDevice(uplinkNet.Config()["parent"], uplinkNet.Config()["vlan"])
+	}
+
+	if InterfaceExists(uplinkInterface) {
+		neighbours, err := (&ip.Neigh{DevName: uplinkInterface}).Show()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, neighbour := range neighbours {
+			if slices.Contains([]ip.NeighbourIPState{ip.NeighbourIPStateNone, ip.NeighbourIPStateIncomplete, ip.NeighbourIPStateFailed}, neighbour.State) {
+				continue
+			}
+
+			addConflict(neighbour.Addr, neighbour.MAC, fmt.Sprintf("neighbour table of %q", uplinkInterface))
+		}
+	}
+
+	// Check the MAC bindings learned by OVN from the uplink, only from the member hosting the router's uplink
+	// port to avoid reporting them from every member.
+	chassis, err := n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
+	hostname, _ := os.Hostname()
+	if err == nil && chassis != "" && chassis == hostname {
+		ips := make([]net.IP, 0, len(owned))
+		for address := range owned {
+			ips = append(ips, net.ParseIP(address))
+		}
+
+		macBindings, err := n.ovnsb.GetMACBindings(ctx, ips...)
+		if err != nil {
+			return nil, fmt.Errorf("Failed loading OVN MAC bindings: %w", err)
+		}
+
+		for _, macBinding := range macBindings {
+			mac, err := net.ParseMAC(macBinding.MAC)
+			if err != nil {
+				continue
+			}
+
+			addConflict(net.ParseIP(macBinding.IP), mac, fmt.Sprintf("MAC binding on %q", macBinding.LogicalPort))
+		}
+	}
+
+	return conflicts, nil
+}
+
+// Routes returns the static routes and routing policies of the network's router, attributing each of them to the
+// configuration, instance NIC, forward, load balancer or peering it comes from.
+func (n *ovn) Routes() (*api.NetworkRoutes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	objects, err := n.OVN()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.NetworkRoutes{
+		Routes:   []api.NetworkRoute{},
+		Policies: []api.NetworkRoutePolicy{},
+	}
+
+	if objects.LogicalRouter == nil {
+		return resp, nil
+	}
+
+	type routeSource struct {
+		source string
+		url    string
+	}
+
+	networkURL := api.NewURL().Path(version.APIVersion, "networks", n.name).Project(n.project).String()
+	configSource := routeSource{source: api.NetworkRouteSourceConfig, url: networkURL}
+
+	// hostPrefix returns the single address prefix of the listen address.
+	hostPrefix := func(listenAddress string) string {
+		ip := net.ParseIP(listenAddress)
+		if ip == nil {
+			return ""
+		}
+
+		if ip.To4() == nil {
+			return fmt.Sprintf("%s/128", ip.String())
+		}
+
+		return fmt.Sprintf("%s/32", ip.String())
+	}
+
+	prefixSources := map[string]routeSource{}
+	portSources := map[string]routeSource{}
+	nextHopSources := map[string]routeSource{}
+
+	// Attribute the routes to the listen addresses of forwards and load balancers and to the peering ports.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		forwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network forwards: %w", err)
+		}
+
+		for _, forward := range forwards {
+			prefixSources[hostPrefix(forward.ListenAddress)] = routeSource{
+				source: api.NetworkRouteSourceForward,
+				url:    api.NewURL().Path(version.APIVersion, "networks", n.name, "forwards", forward.ListenAddress).Project(n.project).String(),
+			}
+		}
+
+		loadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancers: %w", err)
+		}
+
+		for _, loadBalancer := range loadBalancers {
+			prefixSources[hostPrefix(loadBalancer.ListenAddress)] = routeSource{
+				source: api.NetworkRouteSourceLoadBalancer,
+				url:    api.NewURL().Path(version.APIVersion, "networks", n.name, "load-balancers", loadBalancer.ListenAddress).Project(n.project).String(),
+			}
+		}
+
+		peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network peers: %w", err)
+		}
+
+		for _, peer := range peers {
+			if !peer.TargetNetworkID.Valid {
+				continue
+			}
+
+			portSources[string(n.getLogicalRouterPeerPortName(peer.TargetNetworkID.Int64))] = routeSource{
+				source: api.NetworkRouteSourcePeer,
+				url:    api.NewURL().Path(version.APIVersion, "networks", n.name, "peers", peer.Name).Project(n.project).String(),
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Attribute the routes to the instance NICs, either through their routed subnets or their addresses.
+	portIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting logical switch port IPs: %w", err)
+	}
+
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		nicSource := routeSource{
+			source: api.NetworkRouteSourceNIC,
+			url:    api.NewURL().Path(version.APIVersion, "instances", inst.Name).Project(inst.Project).String(),
+		}
+
+		for _, route := range n.instanceNICGetRoutes(nicConfig) {
+			prefixSources[route.String()] = nicSource
+		}
+
+		for _, ip := range portIPs[n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)] {
+			nextHopSources[ip.String()] = nicSource
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting instance NIC routes: %w", err)
+	}
+
+	for _, route := range objects.LogicalRouter.StaticRoutes {
+		source, found := portSources[route.OutputPort]
+		if !found {
+			source, found = prefixSources[route.Prefix]
+		}
+
+		if !found {
+			source, found = nextHopSources[route.NextHop]
+		}
+
+		// The default routes through the uplink and the discard routes of layer 3 only subnets.
+		if !found && (route.OutputPort == string(n.getRouterExtPortName()) || route.NextHop == "discard") {
+			source, found = configSource, true
+		}
+
+		if !found {
+			source = routeSource{source: api.NetworkRouteSourceUnknown}
+		}
+
+		resp.Routes = append(resp.Routes, api.NetworkRoute{
+			Prefix:     route.Prefix,
+			NextHop:    route.NextHop,
+			OutputPort: route.OutputPort,
+			RouteTable: route.RouteTable,
+			Source:     source.source,
+			SourceURL:  source.url,
+		})
+	}
+
+	// The policies of peerings are tagged with the peering port, the other ones protect the internal subnets.
+	for _, policy := range objects.LogicalRouter.Policies {
+		source := configSource
+
+		_, comment, found := strings.Cut(policy.Match, "// ")
+		if found {
+			source, found = portSources[strings.TrimSpace(comment)]
+			if !found {
+				source = routeSource{source: api.NetworkRouteSourceUnknown}
+			}
+		}
+
+		resp.Policies = append(resp.Policies, api.NetworkRoutePolicy{
+			Priority:  policy.Priority,
+			Match:     policy.Match,
+			Action:    policy.Action,
+			NextHops:  policy.NextHops,
+			Source:    source.source,
+			SourceURL: source.url,
+		})
+	}
+
+	return resp, nil
+}
+
+// OVN returns the OVN northbound database objects backing the network.
+func (n *ovn) OVN() (*api.NetworkOVN, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	objects, err := n.ovnnb.GetNetworkObjects(ctx, n.getRouterName(), []networkOVN.OVNSwitch{n.getExtSwitchName(), n.getIntSwitchName()}, n.getIntSwitchName(), fmt.Sprintf("%s-lb-", n.getNetworkPrefix()), fmt.Sprintf("%s_", acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID())))
+	if err != nil {
+		return nil, ovnStatusError(fmt.Errorf("Failed getting OVN objects: %w", err))
+	}
+
+	derefString := func(value *string) string {
+		if value == nil {
+			return ""
+		}
+
+		return *value
+	}
+
+	resp := &api.NetworkOVN{
+		LogicalSwitches: []api.NetworkOVNLogicalSwitch{},
+		DHCPOptions:     []api.NetworkOVNDHCPOptions{},
+		LoadBalancers:   []api.NetworkOVNLoadBalancer{},
+		PortGroups:      []api.NetworkOVNPortGroup{},
+		AddressSets:     []api.NetworkOVNAddressSet{},
+	}
+
+	// Logical router.
+	if objects.Router != nil {
+		lr := &api.NetworkOVNLogicalRouter{
+			UUID:         objects.Router.UUID,
+			Name:         objects.Router.Name,
+			Options:      objects.Router.Options,
+			Ports:        []api.NetworkOVNLogicalRouterPort{},
+			NAT:          []api.NetworkOVNNAT{},
+			StaticRoutes: []api.NetworkOVNStaticRoute{},
+			Policies:     []api.NetworkOVNRouterPolicy{},
+		}
+
+		for _, lrp := range objects.RouterPorts {
+			lr.Ports = append(lr.Ports, api.NetworkOVNLogicalRouterPort{
+				UUID:     lrp.UUID,
+				Name:     lrp.Name,
+				MAC:      lrp.MAC,
+				Networks: lrp.Networks,
+				Peer:     derefString(lrp.Peer),
+			})
+		}
+
+		for _, nat := range objects.RouterNAT {
+			lr.NAT = append(lr.NAT, api.NetworkOVNNAT{
+				UUID:        nat.UUID,
+				Type:        nat.Type,
+				ExternalIP:  nat.ExternalIP,
+				LogicalIP:   nat.LogicalIP,
+				LogicalPort: derefString(nat.LogicalPort),
+			})
+		}
+
+		for _, route := range objects.RouterRoutes {
+			lr.StaticRoutes = append(lr.StaticRoutes, api.NetworkOVNStaticRoute{
+				UUID:       route.UUID,
+				Prefix:     route.IPPrefix,
+				NextHop:    route.Nexthop,
+				OutputPort: derefString(route.OutputPort),
+				RouteTable: route.RouteTable,
+			})
+		}
+
+		for _, policy := range objects.RouterPolicies {
+			lr.Policies = append(lr.Policies, api.NetworkOVNRouterPolicy{
+				UUID:     policy.UUID,
+				Priority: policy.Priority,
+				Match:    policy.Match,
+				Action:   policy.Action,
+				NextHops: policy.Nexthops,
+			})
+		}
+
+		slices.SortFunc(lr.Policies, func(a api.NetworkOVNRouterPolicy, b api.NetworkOVNRouterPolicy) int {
+			return b.Priority - a.Priority
+		})
+
+		resp.LogicalRouter = lr
+	}
+
+	// Logical switches.
+	portNames := map[string]string{}
+	for _, ls := range objects.Switches {
+		sw := api.NetworkOVNLogicalSwitch{
+			UUID:        ls.UUID,
+			Name:        ls.Name,
+			OtherConfig: ls.OtherConfig,
+			Ports:       []api.NetworkOVNLogicalSwitchPort{},
+		}
+
+		for _, lsp := range objects.SwitchPorts[networkOVN.OVNSwitch(ls.Name)] {
+			portNames[lsp.UUID] = lsp.Name
+
+			sw.Ports = append(sw.Ports, api.NetworkOVNLogicalSwitchPort{
+				UUID:             lsp.UUID,
+				Name:             lsp.Name,
+				Type:             lsp.Type,
+				Addresses:        lsp.Addresses,
+				DynamicAddresses: derefString(lsp.DynamicAddresses),
+				Up:               lsp.Up != nil && *lsp.Up,
+			})
+		}
+
+		slices.SortFunc(sw.Ports, func(a api.NetworkOVNLogicalSwitchPort, b api.NetworkOVNLogicalSwitchPort) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		resp.LogicalSwitches = append(resp.LogicalSwitches, sw)
+	}
+
+	// DHCP options.
+	for _, dhcpOpts := range objects.DHCPOptions {
+		resp.DHCPOptions = append(resp.DHCPOptions, api.NetworkOVNDHCPOptions{
+			UUID:    dhcpOpts.UUID,
+			CIDR:    dhcpOpts.Cidr,
+			Options: dhcpOpts.Options,
+		})
+	}
+
+	slices.SortFunc(resp.DHCPOptions, func(a api.NetworkOVNDHCPOptions, b api.NetworkOVNDHCPOptions) int {
+		return strings.Compare(a.CIDR, b.CIDR)
+	})
+
+	// Load balancers.
+	for _, lb := range objects.LoadBalancers {
+		resp.LoadBalancers = append(resp.LoadBalancers, api.NetworkOVNLoadBalancer{
+			UUID:     lb.UUID,
+			Name:     lb.Name,
+			Protocol: derefString(lb.Protocol),
+			VIPs:     lb.Vips,
+		})
+	}
+
+	slices.SortFunc(resp.LoadBalancers, func(a api.NetworkOVNLoadBalancer, b api.NetworkOVNLoadBalancer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// Port groups.
+	for _, pg := range objects.PortGroups {
+		ports := make([]string, 0, len(pg.Ports))
+		for _, portUUID := range pg.Ports {
+			portName, found := portNames[portUUID]
+			if !found {
+				portName = portUUID
+			}
+
+			ports = append(ports, portName)
+		}
+
+		sort.Strings(ports)
+
+		resp.PortGroups = append(resp.PortGroups, api.NetworkOVNPortGroup{
+			UUID:  pg.UUID,
+			Name:  pg.Name,
+			Ports: ports,
+			ACLs:  len(pg.ACLs),
+		})
+	}
+
+	slices.SortFunc(resp.PortGroups, func(a api.NetworkOVNPortGroup, b api.NetworkOVNPortGroup) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// Address sets.
+	for _, as := range objects.AddressSets {
+		resp.AddressSets = append(resp.AddressSets, api.NetworkOVNAddressSet{
+			UUID:      as.UUID,
+			Name:      as.Name,
+			Addresses: as.Addresses,
+		})
+	}
+
+	slices.SortFunc(resp.AddressSets, func(a api.NetworkOVNAddressSet, b api.NetworkOVNAddressSet) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return resp, nil
+}
+
+// PruneStaleRecords removes the DNS records and DHCP reservations of the internal switch which no longer belong
+// to an instance NIC or external port of the network. This can happen when the cluster member hosting an instance
+// is lost before its NICs are removed. When dryRun is true, the stale records are only returned.
+// Returns a description of each stale record.
+func (n *ovn) PruneStaleRecords(dryRun bool) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ovnOperationTimeout)
+	defer cancel()
+
+	// Get the records from OVN before loading the expected ones from the database, so that records created for
+	// NICs or external ports added in the meantime are never considered stale.
+	dnsRecords, err := n.ovnnb.GetLogicalSwitchDNSRecords(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting DNS records: %w", err)
+	}
+
+	dhcpReservations, err := n.ovnnb.GetLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
+	}
+
+	dhcpv6Reservations, err := n.ovnnb.GetLogicalSwitchDHCPv6Reservations(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting DHCPv6 reservations: %w", err)
+	}
+
+	// Get the ports and reservations which are expected to exist.
+	expectedPorts := map[networkOVN.OVNSwitchPort]struct{}{}
+	expectedIPv6Reservations := []net.IP{}
+
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		expectedPorts[n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)] = struct{}{}
+
+		ip := net.ParseIP(nicConfig["ipv6.address"])
+		if ip != nil {
+			expectedIPv6Reservations = append(expectedIPv6Reservations, ip)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting instance NICs using network: %w", err)
+	}
+
+	expectedReservations, err := n.getDHCPv4Reservations()
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+		records, err := dbCluster.GetNetworkExternalPorts(ctx, tx.Tx(), dbCluster.NetworkExternalPortFilter{NetworkID: &networkID})
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			expectedPorts[n.getExternalPortName(record.Name)] = struct{}{}
+
+			config, err := dbCluster.GetNetworkExternalPortConfig(ctx, tx.Tx(), int(record.ID))
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(config["ipv4.address"])
+			if ip != nil {
+				expectedReservations = append(expectedReservations, iprange.Range{Start: ip})
+			}
+
+			ip = net.ParseIP(config["ipv6.address"])
+			if ip != nil {
+				expectedIPv6Reservations = append(expectedIPv6Reservations, ip)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network external ports: %w", err)
+	}
+
+	var stale []string
+
+	// Remove the DNS records of ports which no longer exist.
+	for portName, dnsUUID := range dnsRecords {
+		_, found := expectedPorts[portName]
+		if found {
+			continue
+		}
+
+		stale = append(stale, fmt.Sprintf("DNS record of port %q", portName))
+
+		if dryRun {
+			continue
+		}
+
+		err = n.ovnnb.DeleteLogicalSwitchPortDNS(ctx, n.getIntSwitchName(), dnsUUID, true)
+		if err != nil {
+			return nil, fmt.Errorf("Failed deleting DNS record of port %q: %w", portName, err)
+		}
+	}
+
+	// Remove the static DHCPv4 reservations which no longer belong to any NIC or external port.
+	staleIPs := []net.IP{}
+	for _, dhcpReservation := range dhcpReservations {
+		if dhcpReservation.End != nil || ipInRanges(dhcpReservation.Start, expectedReservations) {
+			continue
+		}
+
+		staleIPs = append(staleIPs, dhcpReservation.Start)
+		stale = append(stale, fmt.Sprintf("DHCPv4 reservation %q", dhcpReservation.Start.String()))
+	}
+
+	if !dryRun && len(staleIPs) > 0 {
+		// Reload the reservations to avoid dropping any added since they were first read.
+		dhcpReservations, err = n.ovnnb.GetLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName())
+		if err != nil {
+			return nil, fmt.Errorf("Failed getting DHCPv4 reservations: %w", err)
+		}
+
+		dhcpReservationsNew := make([]iprange.Range, 0, len(dhcpReservations))
+		for _, dhcpReservation := range dhcpReservations {
+			if dhcpReservation.End == nil && slices.ContainsFunc(staleIPs, dhcpReservation.Start.Equal) {
+				continue
+			}
+
+			dhcpReservationsNew = append(dhcpReservationsNew, dhcpReservation)
+		}
+
+		err = n.ovnnb.UpdateLogicalSwitchDHCPv4Revervations(ctx, n.getIntSwitchName(), dhcpReservationsNew)
+		if err != nil {
+			return nil, fmt.Errorf("Failed removing stale DHCPv4 reservations: %w", err)
+		}
+	}
+
+	// Remove the static DHCPv6 reservations which no longer belong to any NIC or external port.
+	for _, ip := range dhcpv6Reservations {
+		if IPInSlice(ip, expectedIPv6Reservations) {
+			continue
+		}
+
+		stale = append(stale, fmt.Sprintf("DHCPv6 reservation %q", ip.String()))
+
+		if dryRun {
+			continue
+		}
+
+		err = n.dhcpv6ReservationRemove(ctx, ip)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(stale)
+
+	return stale, nil
+}
+
+// LocalTraffic returns the traffic counters of the network's ports on the local member.
+// Instance and external ports are measured on their OVS interfaces. Gateway and NAT traffic is measured on the
+// integration bridge side of the uplink patch port, which only exists on chassis forwarding traffic to the uplink.
+func (n *ovn) LocalTraffic(ctx context.Context) ([]TrafficCounter, error) {
+	integrationBridge, err := n.getIntegrationBridge(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members without the OVN dataplane don't carry any of the network's traffic.
+	chassisID, err := n.localChassisID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if chassisID == "" {
+		return []TrafficCounter{}, nil
+	}
+
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	portStats, err := vswitch.GetOVNSwitchPortStatistics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get OVS interface statistics: %w", err)
+	}
+
+	counters := []TrafficCounter{}
+
+	// What the OVS interface receives was sent by the instance, so the directions are swapped.
+	portPrefix := fmt.Sprintf("%s-", n.getNetworkPrefix())
+	for portName, stats := range portStats {
+		if !strings.HasPrefix(portName, portPrefix) || portName == string(n.getLoadBalancerProbePortName()) {
+			continue
+		}
+
+		counters = append(counters, TrafficCounter{
+			Name: portName,
+			Counters: api.NetworkTrafficCounters{
+				IngressBytes:   stats.TxBytes,
+				IngressPackets: stats.TxPackets,
+				EgressBytes:    stats.RxBytes,
+				EgressPackets:  stats.RxPackets,
+			},
+		})
+	}
+
+	if n.config["network"] == "none" {
+		return counters, nil
+	}
+
+	// The patch port towards the uplink is created by ovn-controller, named after the localnet port.
+	patchPortName := fmt.Sprintf("patch-%s-to-%s", integrationBridge, n.getExtSwitchProviderPortName())
+
+	stats, err := vswitch.GetInterfaceStatistics(ctx, patchPortName)
+	if err != nil {
+		if errors.Is(err, ovs.ErrNotFound) {
+			return counters, nil
+		}
+
+		return nil, fmt.Errorf("Failed to get statistics of uplink patch port %q: %w", patchPortName, err)
+	}
+
+	counters = append(counters, TrafficCounter{
+		Name:   patchPortName,
+		Uplink: true,
+		Counters: api.NetworkTrafficCounters{
+			IngressBytes:   stats.RxBytes,
+			IngressPackets: stats.RxPackets,
+			EgressBytes:    stats.TxBytes,
+			EgressPackets:  stats.TxPackets,
+		},
+	})
+
+	return counters, nil
+}
+
+// Leases returns a list of leases for the OVN network. Those are directly extracted from the OVN database.
+func (n *ovn) Leases(ctx context.Context, projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
+	var err error
+	leases := []api.NetworkLease{}
+
+	// If requested project matches network's project then include gateway IPs.
+	if projectName == n.project {
+		// Add our own gateway IPs.
+		for _, addr := range []string{n.config["ipv4.address"], n.config["ipv6.address"]} {
+			ip, _, _ := net.ParseCIDR(addr)
+			if ip != nil {
+				leases = append(leases, api.NetworkLease{
+					Hostname: fmt.Sprintf("%s.gw", n.Name()),
+					Address:  ip.String(),
+					Type:     "gateway",
+					State:    "bound",
+				})
+			}
+		}
+	}
+
+	// Get the IPs of all ports on the internal switch in a single query rather than one per NIC.
+	portIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN switch port IPs: %w", err)
+	}
+
+	// Get which of those ports are currently bound to a chassis.
+	boundPorts, err := n.ovnsb.GetBoundLogicalSwitchPorts(ctx, slices.Collect(maps.Keys(portIPs))...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN port bindings: %w", err)
+	}
+
+	// Get all the instances in the requested project that are connected to this network.
+	filter := dbCluster.InstanceFilter{Project: &projectName}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		// Get the instance UUID needed for OVN port name generation.
+		instanceUUID := inst.Config["volatile.uuid"]
+		if instanceUUID == "" {
+			return nil
+		}
+
+		instancePortName := n.getInstanceDevicePortName(instanceUUID, nicName)
+		devIPs, found := portIPs[instancePortName]
+		if !found {
+			return nil // There is no active port and so no leases.
+		}
+
+		// OVN's DHCP server is stateless so there is no lease expiry to report, only whether the port is up.
+		leaseState := "configured"
+		if boundPorts[instancePortName] {
+			leaseState = "bound"
+		}
+
+		// Fill in the hwaddr from volatile.
+		if nicConfig["hwaddr"] == "" {
+			nicConfig["hwaddr"] = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+		}
+
+		// Parse the MAC.
+		hwAddr, _ := net.ParseMAC(nicConfig["hwaddr"])
+
+		// Add the leases.
+		for _, ip := range devIPs {
+			leaseType := "dynamic"
+			if nicConfig["ipv4.address"] == ip.String() || nicConfig["ipv6.address"] == ip.String() {
+				leaseType = "static"
+			}
+
+			leases = append(leases, api.NetworkLease{
+				Hostname: inst.Name,
+				Address:  ip.String(),
+				Hwaddr:   hwAddr.String(),
+				Type:     leaseType,
+				Location: inst.Node,
+				State:    leaseState,
+			})
+		}
+
+		return nil
+	}, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+// InstanceAddresses returns the URLs of the instances owning the addresses of the network's ports which are bound
+// to a chassis, keyed by address.
+func (n *ovn) InstanceAddresses(ctx context.Context) (map[string]string, error) {
+	portIPs, err := n.ovnnb.GetLogicalSwitchIPs(ctx, n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN switch port IPs: %w", err)
+	}
+
+	boundPorts, err := n.ovnsb.GetBoundLogicalSwitchPorts(ctx, slices.Collect(maps.Keys(portIPs))...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN port bindings: %w", err)
+	}
+
+	addresses := map[string]string{}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		instanceUUID := inst.Config["volatile.uuid"]
+		if instanceUUID == "" {
+			return nil
+		}
+
+		instancePortName := n.getInstanceDevicePortName(instanceUUID, nicName)
+		if !boundPorts[instancePortName] {
+			return nil
+		}
+
+		instanceURL := api.NewURL().Path(version.APIVersion, "instances", inst.Name).Project(inst.Project).String()
+		for _, ip := range portIPs[instancePortName] {
+			addresses[ip.String()] = instanceURL
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// localPeerCreate creates a network peering with another local network.
+func (n *ovn) localPeerCreate(ctx context.Context, peer api.NetworkPeersPost) error {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Get the peer DB record.
+	var peerInfo *api.NetworkPeer
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		// Load peering to get mutual peering info.
+		dbPeer, err := dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peer.Name)
+		if err != nil {
+			return fmt.Errorf("Failed getting network peer DB object: %w", err)
+		}
+
+		var apiErr error
+		peerInfo, apiErr = dbPeer.ToAPI(ctx, tx.Tx())
+		if apiErr != nil {
+			return fmt.Errorf("Failed converting network peer DB object to API object: %w", apiErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Validate the peer.
+	if peerInfo.Status != api.NetworkStatusCreated {
+		return fmt.Errorf("Only peerings in %q state can be setup", api.NetworkStatusCreated)
+	}
+
+	// Apply router security policies.
+	// Should have been done during network setup, but ensure its done here anyway.
+	err = n.logicalRouterPolicySetup(ctx, n.ovnnb)
+	if err != nil {
+		return fmt.Errorf("Failed applying local router security policy: %w", err)
+	}
+
+	activeLocalNICPorts, err := n.ovnnb.GetLogicalSwitchPorts(ctx, n.getIntSwitchName())
+	if err != nil {
+		return fmt.Errorf("Failed getting active NIC ports: %w", err)
+	}
+
+	var localNICRoutes []net.IPNet
+
+	// Get routes on instance NICs connected to local network to be added as routes to target network.
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		instancePortName := n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+		_, found := activeLocalNICPorts[instancePortName]
+		if !found {
+			return nil // Don't add config for instance NICs that aren't started.
+		}
+
+		localNICRoutes = append(localNICRoutes, n.instanceNICGetRoutes(nicConfig)...)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed getting instance NIC routes on local network: %w", err)
+	}
+
+	targetNet, err := LoadByName(n.state, peer.TargetProject, peer.TargetNetwork)
+	if err != nil {
+		return fmt.Errorf("Failed loading target network: %w", err)
+	}
+
+	targetOVNNet, ok := targetNet.(*ovn)
+	if !ok {
+		return errors.New("Target network is not ovn interface type")
+	}
+
+	opts, err := n.peerGetLocalOpts(ctx, localNICRoutes)
+	if err != nil {
+		return err
+	}
+
+	// Ensure local subnets and all active NIC routes are present in internal switch's address set.
+	err = n.ovnnb.UpdateAddressSetAdd(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), opts.TargetRouterRoutes...)
+	if err != nil {
+		return fmt.Errorf("Failed adding active NIC routes to switch address set: %w", err)
+	}
+
+	err = n.peerSetup(ctx, n.ovnnb, targetOVNNet, *opts)
+	if err != nil {
+		return err
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// remotePeerCreate creates a network peering with an OVN-IC through the named integration.
+func (n *ovn) remotePeerCreate(ctx context.Context, peer api.NetworkPeersPost, integrationName string) error {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Load the project.
+	var p *api.Project
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
+		if err != nil {
+			return err
+		}
+
+		p, err = project.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+	}
+
+	// Validate restrictions.
+	if !project.NetworkIntegrationAllowed(p.Config, integrationName) {
+		return api.StatusErrorf(http.StatusForbidden, "Project isn't allowed to use this network integration")
+	}
+
+	// Load the integration.
+	var integration *api.NetworkIntegration
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), integrationName)
+		if err != nil {
+			return err
+		}
+
+		integration, err = entry.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load network integration %q: %w", integrationName, err)
+	}
+
+	// Get ICNB.
+	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		return err
+	}
+
+	// Get ICSB.
+	icsb, err := networkOVN.NewICSB(integration.Config["ovn.southbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		return err
+	}
+
+	// Get the OVN AZ name.
+	azName, err := n.ovnnb.GetName(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get the list of interconnect gateways.
+	gateways, err := icsb.GetGateways(ctx, azName)
+	if err != nil {
+		return err
+	}
+
+	if len(gateways) == 0 {
+		return errors.New("No chassis gateways available for interconnect")
+	}
+
+	// Determine the transit switch name.
+	tsNameRendered, err := integrationTransitSwitchName(integration, n.project, n.name, peer.Name)
+	if err != nil {
+		return err
+	}
+
+	tsName := networkOVN.OVNSwitch(tsNameRendered)
+
+	// Determine the chassis group name.
+	cgName := networkOVN.OVNChassisGroup(tsName)
+
+	// Create the chassis group.
+	err = n.ovnnb.CreateChassisGroup(ctx, cgName, false)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteChassisGroup(ctx, cgName)
+	})
+
+	// Seed the stable random number generator with the transit switch name.
+	// This should cause a reasonable spread of networks on the available IC gateway chassis.
+	r, err := localUtil.GetStableRandomGenerator(tsNameRendered)
+	if err != nil {
+		return fmt.Errorf("Failed generating stable random chassis group priority: %w", err)
+	}
+
+	// Gateways which already handle the network's uplink traffic are the local ones.
+	var localGateways []string
+	if util.IsTrue(integration.Config["ovn.gateways.prefer_local"]) {
+		networkChassis, err := n.ovnnb.GetChassisGroupPriorities(ctx, n.getChassisGroupName())
+		if err != nil {
+			return fmt.Errorf("Failed getting network chassis group: %w", err)
+		}
+
+		for chassis := range networkChassis {
+			localGateways = append(localGateways, chassis)
+		}
+	}
+
+	// Assign the priorities.
+	priorities := icGatewayPriorities(gateways, localGateways, integration.Config, r)
+	if len(priorities) == 0 {
+		return errors.New("All the interconnect chassis gateways are excluded")
+	}
+
+	for gateway, priority := range priorities {
+		err = n.ovnnb.SetChassisGroupPriority(ctx, cgName, gateway, priority)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create the transit switch if it doesn't exist already.
+	err = icnb.CreateTransitSwitch(ctx, string(tsName), true)
+	if err != nil {
+		return err
+	}
+
+	// Check that the switch appeared on the local OVN.
+	found := false
+	for range 10 {
+		// Try to get the switch.
+		logicalSwitch, err := n.ovnnb.GetLogicalSwitch(ctx, tsName)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return err
+		}
+
+		if logicalSwitch != nil {
+			found = true
+			break
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	if !found {
+		return errors.New("New transit switch didn't appear within 10s")
+	}
+
+	// Get router MAC address.
+	routerMAC, err := n.getRouterMAC(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get bridge MTU.
+	bridgeMTU := int(n.getBridgeMTU())
+	if bridgeMTU == 0 {
+		bridgeMTU = 1500
+	}
+
+	// Get peering addresses.
+	ipv4Net, ipv6Net, err := icnb.CreateTransitSwitchAllocation(ctx, string(tsName), azName)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_, _ = icnb.ReleaseTransitSwitch(ctx, string(tsName), azName)
+	})
+
+	// Determine logical router port name.
+	lrpName := networkOVN.OVNRouterPort(tsName)
+
+	// Create the logical router port.
+	err = n.ovnnb.CreateLogicalRouterPort(ctx, n.getRouterName(), lrpName, routerMAC, uint32(bridgeMTU), []*net.IPNet{ipv4Net, ipv6Net}, cgName, false)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), lrpName)
+	})
+
+	// Restrict the routes exchanged over the interconnection.
+	err = n.ovnnb.SetLogicalRouterPortOptions(ctx, lrpName, remotePeerRouteFilterOptions(peer.Config))
+	if err != nil {
+		return fmt.Errorf("Failed setting route filters: %w", err)
+	}
+
+	// Create the logical switch port.
+	lspOpts := &networkOVN.OVNSwitchPortOpts{RouterPort: lrpName}
+	err = n.ovnnb.CreateLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)), lspOpts, false)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)))
+	})
+
+	reverter.Success()
+	return nil
+}
+
+// PeerCreate creates a network peering.
+func (n *ovn) PeerCreate(ctx context.Context, peer api.NetworkPeersPost) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Default type is local.
+	if peer.Type == "" {
+		peer.Type = "local"
+	}
+
+	// Perform create-time validation.
+	if peer.Type == "local" {
+		// Default to network's project if target project not specified.
+		if peer.TargetProject == "" {
+			peer.TargetProject = n.Project()
+		}
+
+		// Target network name is required.
+		if peer.TargetNetwork == "" {
+			return api.StatusErrorf(http.StatusBadRequest, "Target network is required")
+		}
+	} else if peer.Type == "remote" {
+		// Target integration name is required.
+		if peer.TargetIntegration == "" {
+			return api.StatusErrorf(http.StatusBadRequest, "Target integration is required")
+		}
+	}
+
+	// Look for an existing entry.
+	var peers map[int64]*api.NetworkPeer
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		// Use generated function to get peers.
+		netID := n.ID()
+		filter := dbCluster.NetworkPeerFilter{NetworkID: &netID}
+		dbPeers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), filter)
+		if err != nil {
+			return fmt.Errorf("Failed loading network peer DB objects: %w", err)
+		}
+
+		// Convert DB objects to API objects and build the map.
+		peers = make(map[int64]*api.NetworkPeer, len(dbPeers))
+		for _, dbPeer := range dbPeers {
+			peer, err := dbPeer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
+			}
+
+			peers[dbPeer.ID] = peer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, existingPeer := range peers {
+		if peer.Name == existingPeer.Name {
+			return api.StatusErrorf(http.StatusConflict, "A peer for that name already exists")
+		}
+
+		if peer.Type == "local" && peer.TargetProject == existingPeer.TargetProject && peer.TargetNetwork == existingPeer.TargetNetwork {
+			return api.StatusErrorf(http.StatusConflict, "A peer for that target network already exists")
+		}
+	}
+
+	// Perform general (create and update) validation.
+	err = n.peerValidate(peer.Name, &peer.NetworkPeerPut)
+	if err != nil {
+		return err
+	}
+
+	if peer.Type == "remote" && peer.Config["limits.bandwidth"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Bandwidth limits are only supported on local peers")
+	}
+
+	if peer.Type == "remote" {
+		_, err = n.peerBackupIntegrations(ctx, peer.TargetIntegration, peer.Config)
+		if err != nil {
+			return err
+		}
+	} else if peer.Config["target_integration.backups"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Backup integrations are only supported on remote peers")
+	} else if peer.Config["routes.export"] != "" || peer.Config["routes.import"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Route filters are only supported on remote peers")
+	}
+
+	var peerID int64
+	var mutualExists bool
+
+	// Peerings between projects only exchange routes once the target side approved them.
+	approvalRequired := peer.Type == "local" && peer.TargetProject != n.Project()
+	approval := ""
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error { // Create peer DB record.
+		record := dbCluster.NetworkPeer{
+			NetworkID:   n.ID(),
+			Name:        peer.Name,
+			Description: peer.Description,
+			Type:        dbCluster.NetworkPeerTypes[peer.Type],
+		}
+
+		switch peer.Type {
+		case "remote":
+			integrationID, err := dbCluster.GetNetworkIntegrationID(ctx, tx.Tx(), peer.TargetIntegration)
+			if err != nil {
+				return err
+			}
+
+			id := sql.NullInt64{}
+			err = id.Scan(integrationID)
+			if err != nil {
+				return err
+			}
+
+			record.TargetNetworkIntegrationID = id
+
+		case "local":
+			// Check if target peer already exists.
+			peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{
+				Type:                 &record.Type,
+				TargetNetworkProject: &n.project,
+				TargetNetworkName:    &n.name,
+			})
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+
+			var targetNetworkID int64
+			var targetNetwork *api.Network
+
+			if len(peers) == 0 && approvalRequired {
+				targetNetworkID, targetNetwork, _, err = tx.GetNetworkInAnyState(ctx, peer.TargetProject, peer.TargetNetwork)
+				if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+					return err
+				}
+
+				// A request of the target network which was rejected is still linked to this network.
+				if targetNetwork != nil {
+					peers, err = dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &targetNetworkID, TargetNetworkID: &n.id})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			mutualApproval := peerApprovalAbsent
+			if len(peers) == 1 {
+				config, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(peers[0].ID))
+				if err != nil {
+					return err
+				}
+
+				mutualApproval = config[dbCluster.NetworkPeerApprovalKey]
+			}
+
+			if approvalRequired {
+				approval, mutualApproval, err = peerApprovalTransition("create", peerApprovalAbsent, mutualApproval)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(peers) == 1 {
+				// Update the target peer.
+				peer := peers[0]
+
+				empty := sql.NullString{}
+				peer.TargetNetworkProject = empty
+				peer.TargetNetworkName = empty
+
+				targetID := sql.NullInt64{}
+				err = targetID.Scan(n.id)
+				if err != nil {
+					return err
+				}
+
+				peer.TargetNetworkID = targetID
+
+				err = dbCluster.UpdateNetworkPeer(ctx, tx.Tx(), peer.NetworkID, peer.Name, peer)
+				if err != nil {
+					return err
+				}
+
+				// Set our target network ID to match.
+				id := sql.NullInt64{}
+				err = id.Scan(peer.NetworkID)
+				if err != nil {
+					return err
+				}
+
+				record.TargetNetworkID = id
+
+				mutualExists = true
+
+				if approvalRequired {
+					err = n.peerSetApproval(ctx, tx, peer.ID, mutualApproval)
+					if err != nil {
+						return err
+					}
+				}
+			} else if len(peers) == 0 && targetNetwork != nil && targetNetwork.Type == "ovn" && mutualApproval == dbCluster.NetworkPeerApprovalPending {
+				// Add the request to the target network, for its owner to approve or reject.
+				exists, err := dbCluster.NetworkPeerExists(ctx, tx.Tx(), targetNetworkID, peer.Name)
+				if err != nil {
+					return err
+				}
+
+				if exists {
+					return api.StatusErrorf(http.StatusConflict, "A peer for that name already exists on the target network")
+				}
+
+				id := sql.NullInt64{}
+				err = id.Scan(n.id)
+				if err != nil {
+					return err
+				}
+
+				requestPeerID, err := dbCluster.CreateNetworkPeer(ctx, tx.Tx(), dbCluster.NetworkPeer{
+					NetworkID:       targetNetworkID,
+					Name:            peer.Name,
+					Description:     peer.Description,
+					Type:            record.Type,
+					TargetNetworkID: id,
+				})
+				if err != nil {
+					return err
+				}
+
+				err = dbCluster.CreateNetworkPeerConfig(ctx, tx.Tx(), requestPeerID, map[string]string{dbCluster.NetworkPeerApprovalKey: mutualApproval})
+				if err != nil {
+					return err
+				}
+
+				// Link our peer to the request.
+				err = id.Scan(targetNetworkID)
+				if err != nil {
+					return err
+				}
+
+				record.TargetNetworkID = id
+			} else if len(peers) == 0 {
+				networkProjectName := sql.NullString{}
+				err = networkProjectName.Scan(peer.TargetProject)
+				if err != nil {
+					return err
+				}
+
+				networkName := sql.NullString{}
+				err = networkName.Scan(peer.TargetNetwork)
+				if err != nil {
+					return err
+				}
+
+				record.TargetNetworkProject = networkProjectName
+				record.TargetNetworkName = networkName
+			} else {
+				return errors.New("More than one matching network peer was found")
+			}
+		}
+
+		peerID, err = dbCluster.CreateNetworkPeer(ctx, tx.Tx(), record)
+		if err != nil {
+			return err
+		}
+
+		config := maps.Clone(peer.Config)
+		if approval != "" {
+			if config == nil {
+				config = map[string]string{}
+			}
+
+			config[dbCluster.NetworkPeerApprovalKey] = approval
+		}
+
+		err = dbCluster.CreateNetworkPeerConfig(ctx, tx.Tx(), peerID, config)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			err := dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), n.ID(), peerID)
+			if errors.Is(err, dbCluster.ErrNotFound) {
+				return nil
+			}
+
+			return err
+		})
+	})
+
+	// Apply the OVN configuration.
+	if peer.Type == "local" && mutualExists {
+		err := n.localPeerCreate(ctx, peer)
+		if err != nil {
+			return err
+		}
+	} else if peer.Type == "remote" {
+		err := n.remotePeerCreate(ctx, peer, peer.TargetIntegration)
+		if err != nil {
+			return err
+		}
+	}
+
+	reverter.Success()
+	return nil
+}
+
+// peerGetLocalOpts returns peering options prefilled with local router and local NIC routes config.
+// It can then be modified with the target peering network options.
+func (n *ovn) peerGetLocalOpts(ctx context.Context, localNICRoutes []net.IPNet) (*networkOVN.OVNRouterPeering, error) {
+	localRouterPortMAC, err := n.getRouterMAC(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting router MAC address: %w", err)
+	}
+
+	opts := networkOVN.OVNRouterPeering{
+		LocalRouter:        n.getRouterName(),
+		LocalRouterPortMAC: localRouterPortMAC,
+		TargetRouterRoutes: localNICRoutes, // Pre-fill with local NIC routes.
+	}
+
+	routerIntPortIPv4, routerIntPortIPv4Net, err := n.parseRouterIntPortIPv4Net()
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing local router's peering port IPv4 net: %w", err)
+	}
+
+	if routerIntPortIPv4 != nil && routerIntPortIPv4Net != nil {
+		// Add a copy of the CIDR subnet to the target router's routes.
+		opts.TargetRouterRoutes = append(opts.TargetRouterRoutes, *routerIntPortIPv4Net)
+
+		// Convert the IPNet to include the specific router IP with a single host subnet.
+		routerIntPortIPv4Net.IP = routerIntPortIPv4
+		routerIntPortIPv4Net.Mask = net.CIDRMask(32, 32)
+		opts.LocalRouterPortIPs = append(opts.LocalRouterPortIPs, *routerIntPortIPv4Net)
+	}
+
+	routerIntPortIPv6, routerIntPortIPv6Net, err := n.parseRouterIntPortIPv6Net()
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing local router's peering port IPv6 net: %w", err)
+	}
+
+	if routerIntPortIPv6 != nil && routerIntPortIPv6Net != nil {
+		// Add a copy of the CIDR subnet to the target router's routers.
+		opts.TargetRouterRoutes = append(opts.TargetRouterRoutes, *routerIntPortIPv6Net)
+
+		// Convert the IPNet to include the specific router IP with a single host subnet.
+		routerIntPortIPv6Net.IP = routerIntPortIPv6
+		routerIntPortIPv6Net.Mask = net.CIDRMask(128, 128)
+		opts.LocalRouterPortIPs = append(opts.LocalRouterPortIPs, *routerIntPortIPv6Net)
+	}
+
+	return &opts, err
+}
+
+// peerSetup applies the network peering configuration to both networks.
+// Accepts an OVN client, a target OVN network, and a set of OVNRouterPeering options pre-filled with local config.
+func (n *ovn) peerSetup(ctx context.Context, ovnnb *networkOVN.NB, targetOVNNet *ovn, opts networkOVN.OVNRouterPeering) error {
+	targetRouterMAC, err := targetOVNNet.getRouterMAC(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed getting target router MAC address: %w", err)
+	}
+
+	// Populate config based on target network.
+	opts.LocalRouterPort = n.getLogicalRouterPeerPortName(targetOVNNet.ID())
+	opts.TargetRouter = targetOVNNet.getRouterName()
+	opts.TargetRouterPort = targetOVNNet.getLogicalRouterPeerPortName(n.ID())
+	opts.TargetRouterPortMAC = targetRouterMAC
+
+	routerIntPortIPv4, routerIntPortIPv4Net, err := targetOVNNet.parseRouterIntPortIPv4Net()
+	if err != nil {
+		return fmt.Errorf("Failed parsing target router's peering port IPv4 net: %w", err)
+	}
+
+	if routerIntPortIPv4 != nil && routerIntPortIPv4Net != nil {
+		// Add a copy of the CIDR subnet to the local router's routers.
+		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, *routerIntPortIPv4Net)
+
+		// Convert the IPNet to include the specific router IP with a single host subnet.
+		routerIntPortIPv4Net.IP = routerIntPortIPv4
+		routerIntPortIPv4Net.Mask = net.CIDRMask(32, 32)
+		opts.TargetRouterPortIPs = append(opts.TargetRouterPortIPs, *routerIntPortIPv4Net)
+	}
+
+	routerIntPortIPv6, routerIntPortIPv6Net, err := targetOVNNet.parseRouterIntPortIPv6Net()
+	if err != nil {
+		return fmt.Errorf("Failed parsing target router's peering port IPv6 net: %w", err)
+	}
+
+	if routerIntPortIPv6 != nil && routerIntPortIPv6Net != nil {
+		// Add a copy of the CIDR subnet to the local router's routers.
+		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, *routerIntPortIPv6Net)
+
+		// Convert the IPNet to include the specific router IP with a single host subnet.
+		routerIntPortIPv6Net.IP = routerIntPortIPv6
+		routerIntPortIPv6Net.Mask = net.CIDRMask(128, 128)
+		opts.TargetRouterPortIPs = append(opts.TargetRouterPortIPs, *routerIntPortIPv6Net)
 	}
 
-	// Refresh exported BGP prefixes on local member.
-	err := n.loadBalancerBGPSetupPrefixes()
+	// Get list of active switch ports (avoids repeated querying of OVN NB).
+	activeTargetNICPorts, err := n.ovnnb.GetLogicalSwitchPorts(ctx, targetOVNNet.getIntSwitchName())
 	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for load balancers: %w", err)
+		return fmt.Errorf("Failed getting active NIC ports: %w", err)
 	}
 
-	reverter.Success()
-	return nil
-}
+	// Get routes on instance NICs connected to target network to be added as routes to local network.
+	err = UsedByInstanceDevices(n.state, targetOVNNet.Project(), targetOVNNet.Name(), targetOVNNet.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		instancePortName := targetOVNNet.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
+		_, found := activeTargetNICPorts[instancePortName]
+		if !found {
+			return nil // Don't add config for instance NICs that aren't started.
+		}
 
-// LoadBalancerState returns the current state of the load balancer.
-func (n *ovn) LoadBalancerState(lb api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error) {
-	lbState := &api.NetworkLoadBalancerState{}
+		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, n.instanceNICGetRoutes(nicConfig)...)
 
-	if util.IsTrue(lb.Config["healthcheck"]) {
-		lbState.BackendHealth = map[string]api.NetworkLoadBalancerStateBackendHealth{}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Failed getting instance NIC routes on target network: %w", err)
+	}
 
-		for _, backend := range lb.Backends {
-			backendHealth := api.NetworkLoadBalancerStateBackendHealth{}
-			backendHealth.Address = backend.TargetAddress
-			backendHealth.Ports = []api.NetworkLoadBalancerStateBackendHealthPort{}
+	// Ensure routes are added to target switch address sets.
+	err = n.ovnnb.UpdateAddressSetAdd(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(targetOVNNet.ID()), opts.LocalRouterRoutes...)
+	if err != nil {
+		return fmt.Errorf("Failed adding target switch subnet address set entries: %w", err)
+	}
 
-			for _, lbPort := range lb.Ports {
-				if !slices.Contains(lbPort.TargetBackend, backend.Name) {
-					continue
-				}
+	err = targetOVNNet.logicalRouterPolicySetup(ctx, n.ovnnb)
+	if err != nil {
+		return fmt.Errorf("Failed applying target router security policy: %w", err)
+	}
 
-				// Check valid listen port(s) supplied.
-				listenPortRanges := util.SplitNTrimSpace(lbPort.ListenPort, ",", -1, true)
-				if len(listenPortRanges) <= 0 {
-					return nil, fmt.Errorf("Missing listen port in port specification %q", lbPort.ListenPort)
-				}
+	err = n.ovnnb.CreateLogicalRouterPeering(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("Failed applying OVN network peering: %w", err)
+	}
 
-				for _, pr := range listenPortRanges {
-					portFirst, portRange, err := ParsePortRange(pr)
-					if err != nil {
-						return nil, fmt.Errorf("Invalid listen port in port specification %q: %w", lbPort.ListenPort, err)
-					}
+	err = n.peerQoSSetup(ctx, targetOVNNet)
+	if err != nil {
+		return err
+	}
 
-					for i := range portRange {
-						port := portFirst + i
+	err = targetOVNNet.peerQoSSetup(ctx, n)
+	if err != nil {
+		return err
+	}
 
-						status, err := n.ovnsb.GetServiceHealth(context.TODO(), backend.TargetAddress, lbPort.Protocol, int(port))
-						if err != nil {
-							return nil, fmt.Errorf("Failed retrieving OVN load-balancer health: %w", err)
-						}
+	err = n.dnsForwardSetup(ctx)
+	if err != nil {
+		return err
+	}
 
-						portHealth := api.NetworkLoadBalancerStateBackendHealthPort{
-							Protocol: lbPort.Protocol,
-							Port:     int(port),
-							Status:   status,
-						}
+	err = targetOVNNet.dnsForwardSetup(ctx)
+	if err != nil {
+		return err
+	}
 
-						backendHealth.Ports = append(backendHealth.Ports, portHealth)
-					}
-				}
-			}
+	err = n.loadBalancersShare(ctx)
+	if err != nil {
+		return err
+	}
 
-			lbState.BackendHealth[backend.Name] = backendHealth
-		}
+	err = targetOVNNet.loadBalancersShare(ctx)
+	if err != nil {
+		return err
 	}
 
-	return lbState, nil
+	return nil
 }
 
-// LoadBalancerDelete deletes a network load balancer.
-func (n *ovn) LoadBalancerDelete(listenAddress string, clientType request.ClientType) error {
-	if clientType == request.ClientTypeNormal {
-		var lb *dbCluster.NetworkLoadBalancer
-
-		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			networkID := n.ID()
+// dnsForwardSetup makes the DNS records of the networks listed in dns.forward.networks resolvable on the network.
+// Only the listed networks the network is peered with are considered, minus the excluded peer network IDs.
+func (n *ovn) dnsForwardSetup(ctx context.Context, excludePeers ...int64) error {
+	forwardNetworks := util.SplitNTrimSpace(n.config["dns.forward.networks"], ",", -1, true)
+	remoteSwitches := []networkOVN.OVNSwitch{}
 
-			dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
-				NetworkID:     &networkID,
-				ListenAddress: &listenAddress,
-			})
-			if err != nil {
-				return err
+	if len(forwardNetworks) > 0 {
+		err := n.forPeers(ctx, func(targetOVNNet *ovn) error {
+			if targetOVNNet.Project() != n.Project() || !slices.Contains(forwardNetworks, targetOVNNet.Name()) || slices.Contains(excludePeers, targetOVNNet.ID()) {
+				return nil
 			}
 
-			if len(dbLoadBalancers) != 1 {
-				return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
+			if !slices.Contains(remoteSwitches, targetOVNNet.getIntSwitchName()) {
+				remoteSwitches = append(remoteSwitches, targetOVNNet.getIntSwitchName())
 			}
 
-			lb = &dbLoadBalancers[0]
-
 			return nil
 		})
 		if err != nil {
 			return err
 		}
+	}
 
-		// Delete the load balancer itself.
-		err = n.ovnnb.DeleteLoadBalancer(context.TODO(), n.getLoadBalancerName(lb.ListenAddress))
-		if err != nil {
-			return fmt.Errorf("Failed deleting OVN load balancer: %w", err)
-		}
-
-		// Delete static route to load-balancer if present.
-		vip, err := ParseIPToNet(lb.ListenAddress)
-		if err != nil {
-			return err
-		}
+	err := n.ovnnb.UpdateLogicalSwitchDNSForwarding(ctx, n.getIntSwitchName(), remoteSwitches...)
+	if err != nil {
+		return fmt.Errorf("Failed applying DNS forwarding: %w", err)
+	}
 
-		_ = n.ovnnb.DeleteLogicalRouterRoute(context.TODO(), n.getRouterName(), *vip)
+	return nil
+}
 
-		// Delete the database records.
-		err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			return dbCluster.DeleteNetworkLoadBalancer(ctx, tx.Tx(), n.ID(), lb.ID)
-		})
-		if err != nil {
-			return err
+// loadBalancerNetworksValidate checks that the networks listed in the networks option of a load balancer are other
+// OVN networks of the same project.
+func (n *ovn) loadBalancerNetworksValidate(config map[string]string) error {
+	for _, sharedNetwork := range util.SplitNTrimSpace(config["networks"], ",", -1, true) {
+		if sharedNetwork == n.name {
+			return fmt.Errorf("Load balancer can't be shared with its own network %q", n.name)
 		}
 
-		// Notify all other members to refresh their BGP prefixes.
-		notifier, err := cluster.NewNotifier(n.state, n.state.Endpoints.NetworkCert(), n.state.ServerCert(), cluster.NotifyAll)
+		sharedNet, err := LoadByName(n.state, n.project, sharedNetwork)
 		if err != nil {
-			return err
+			return fmt.Errorf("Failed loading network %q: %w", sharedNetwork, err)
 		}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.project).DeleteNetworkLoadBalancer(n.name, lb.ListenAddress)
-		})
-		if err != nil {
-			return err
+		if sharedNet.Type() != "ovn" {
+			return fmt.Errorf("Network %q isn't an OVN network", sharedNetwork)
 		}
 	}
 
-	// Refresh exported BGP prefixes on local member.
-	err := n.loadBalancerBGPSetupPrefixes()
-	if err != nil {
-		return fmt.Errorf("Failed applying BGP prefixes for address forwards: %w", err)
-	}
-
 	return nil
 }
 
-func (n *ovn) getHealthCheck(loadBalancer api.NetworkLoadBalancerPut) (*networkOVN.OVNLoadBalancerHealthCheck, error) {
-	// Check if load-balancer is enabled.
-	if !util.IsTrue(loadBalancer.Config["healthcheck"]) {
-		return nil, nil
-	}
-
-	// Get IPv4 checker.
-	var checkerIPV4 net.IP
-	_, ipv4Net, err := n.parseRouterIntPortIPv4Net()
-	if err == nil && ipv4Net != nil {
-		checkerIPV4 = dhcpalloc.GetIP(ipv4Net, -2)
-	}
-
-	// Get IPv6 checker.
-	var checkerIPV6 net.IP
-	_, ipv6Net, err := n.parseRouterIntPortIPv6Net()
-	if err == nil && ipv6Net != nil {
-		checkerIPV6 = dhcpalloc.GetIP(ipv6Net, -2)
-	}
-
-	// Parse the healthcheck options.
-	hcInterval, err := strconv.Atoi(loadBalancer.Config["healthcheck.interval"])
-	if err != nil && loadBalancer.Config["healthcheck.interval"] != "" {
-		return nil, err
-	}
+// loadBalancerShare applies the OVN load balancer of the listen address on the internal switches of the networks
+// listed in its networks option. Only the listed networks the network is peered with are considered, minus the
+// excluded peer network IDs.
+func (n *ovn) loadBalancerShare(ctx context.Context, listenAddress string, config map[string]string, excludePeers ...int64) error {
+	sharedNetworks := util.SplitNTrimSpace(config["networks"], ",", -1, true)
+	sharedSwitches := []networkOVN.OVNSwitch{}
 
-	hcTimeout, err := strconv.Atoi(loadBalancer.Config["healthcheck.timeout"])
-	if err != nil && loadBalancer.Config["healthcheck.timeout"] != "" {
-		return nil, err
-	}
+	if len(sharedNetworks) > 0 {
+		err := n.forPeers(ctx, func(targetOVNNet *ovn) error {
+			if targetOVNNet.Project() != n.Project() || !slices.Contains(sharedNetworks, targetOVNNet.Name()) || slices.Contains(excludePeers, targetOVNNet.ID()) {
+				return nil
+			}
 
-	hcFailureCount, err := strconv.Atoi(loadBalancer.Config["healthcheck.failure_count"])
-	if err != nil && loadBalancer.Config["healthcheck.failure_count"] != "" {
-		return nil, err
-	}
+			if !slices.Contains(sharedSwitches, targetOVNNet.getIntSwitchName()) {
+				sharedSwitches = append(sharedSwitches, targetOVNNet.getIntSwitchName())
+			}
 
-	hcSuccessCount, err := strconv.Atoi(loadBalancer.Config["healthcheck.success_count"])
-	if err != nil && loadBalancer.Config["healthcheck.success_count"] != "" {
-		return nil, err
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// Prepare the load-balancer health check.
-	healthCheck := &networkOVN.OVNLoadBalancerHealthCheck{
-		CheckerIPV4: checkerIPV4,
-		CheckerIPV6: checkerIPV6,
-
-		Interval:     hcInterval,
-		Timeout:      hcTimeout,
-		FailureCount: hcFailureCount,
-		SuccessCount: hcSuccessCount,
+	err := n.ovnnb.UpdateLoadBalancerSharedSwitches(ctx, n.getLoadBalancerName(listenAddress), n.getIntSwitchName(), sharedSwitches...)
+	if err != nil {
+		return fmt.Errorf("Failed sharing OVN load balancer %q: %w", listenAddress, err)
 	}
 
-	return healthCheck, nil
+	return nil
 }
 
-// Leases returns a list of leases for the OVN network. Those are directly extracted from the OVN database.
-func (n *ovn) Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
-	var err error
-	leases := []api.NetworkLease{}
-
-	// If requested project matches network's project then include gateway IPs.
-	if projectName == n.project {
-		// Add our own gateway IPs.
-		for _, addr := range []string{n.config["ipv4.address"], n.config["ipv6.address"]} {
-			ip, _, _ := net.ParseCIDR(addr)
-			if ip != nil {
-				leases = append(leases, api.NetworkLease{
-					Hostname: fmt.Sprintf("%s.gw", n.Name()),
-					Address:  ip.String(),
-					Type:     "gateway",
-				})
-			}
-		}
-	}
+// loadBalancersShare re-applies the sharing of all the network's load balancers with the networks it's peered with,
+// minus the excluded peer network IDs.
+func (n *ovn) loadBalancersShare(ctx context.Context, excludePeers ...int64) error {
+	var loadBalancers []*api.NetworkLoadBalancer
 
-	// Get all the instances in the requested project that are connected to this network.
-	filter := dbCluster.InstanceFilter{Project: &projectName}
-	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
-		// Get the instance UUID needed for OVN port name generation.
-		instanceUUID := inst.Config["volatile.uuid"]
-		if instanceUUID == "" {
-			return nil
-		}
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
 
-		devIPs, err := n.InstanceDevicePortIPs(instanceUUID, nicName)
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID: &networkID,
+		})
 		if err != nil {
-			return nil // There is likely no active port and so no leases.
-		}
-
-		// Fill in the hwaddr from volatile.
-		if nicConfig["hwaddr"] == "" {
-			nicConfig["hwaddr"] = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+			return err
 		}
 
-		// Parse the MAC.
-		hwAddr, _ := net.ParseMAC(nicConfig["hwaddr"])
-
-		// Add the leases.
-		for _, ip := range devIPs {
-			leaseType := "dynamic"
-			if nicConfig["ipv4.address"] == ip.String() || nicConfig["ipv6.address"] == ip.String() {
-				leaseType = "static"
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			loadBalancer, err := dbLoadBalancer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
 			}
 
-			leases = append(leases, api.NetworkLease{
-				Hostname: inst.Name,
-				Address:  ip.String(),
-				Hwaddr:   hwAddr.String(),
-				Type:     leaseType,
-				Location: inst.Node,
-			})
+			loadBalancers = append(loadBalancers, loadBalancer)
 		}
 
 		return nil
-	}, filter)
+	})
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("Failed loading network load balancers: %w", err)
 	}
 
-	return leases, nil
-}
+	for _, loadBalancer := range loadBalancers {
+		if loadBalancer.Config["networks"] == "" {
+			continue
+		}
 
-// localPeerCreate creates a network peering with another local network.
-func (n *ovn) localPeerCreate(peer api.NetworkPeersPost) error {
-	ctx := context.TODO()
+		err = n.loadBalancerShare(ctx, loadBalancer.ListenAddress, loadBalancer.Config, excludePeers...)
+		if err != nil {
+			return err
+		}
+	}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+	return nil
+}
+
+// peerQoSSetup applies the bandwidth limit of the network's peering with the target network (if any) to the
+// traffic exchanged with it. The limit applies to each direction separately.
+func (n *ovn) peerQoSSetup(ctx context.Context, targetOVNNet *ovn) error {
+	var limit string
 
-	// Get the peer DB record.
-	var peerInfo *api.NetworkPeer
 	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		var err error
-
-		// Load peering to get mutual peering info.
-		dbPeer, err := dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peer.Name)
+		targetID := targetOVNNet.ID()
+		peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &n.id, TargetNetworkID: &targetID})
 		if err != nil {
-			return fmt.Errorf("Failed getting network peer DB object: %w", err)
+			return fmt.Errorf("Failed loading network peer DB objects: %w", err)
 		}
 
-		var apiErr error
-		peerInfo, apiErr = dbPeer.ToAPI(ctx, tx.Tx())
-		if apiErr != nil {
-			return fmt.Errorf("Failed converting network peer DB object to API object: %w", apiErr)
+		for _, peer := range peers {
+			config, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(peer.ID))
+			if err != nil {
+				return err
+			}
+
+			limit = config["limits.bandwidth"]
 		}
 
 		return nil
@@ -6611,657 +15075,766 @@ func (n *ovn) localPeerCreate(peer api.NetworkPeersPost) error {
 		return err
 	}
 
-	// Validate the peer.
-	if peerInfo.Status != api.NetworkStatusCreated {
-		return fmt.Errorf("Only peerings in %q state can be setup", api.NetworkStatusCreated)
+	var rules []networkOVN.OVNQoSRule
+	if limit != "" {
+		rate, err := units.ParseBitSizeString(limit)
+		if err != nil {
+			return err
+		}
+
+		// Traffic to the peer enters the switch from the instance ports and traffic from the peer from the
+		// router port, so both directions are matched as it enters the switch.
+		addrSet := acl.OVNIntSwitchPortGroupAddressSetPrefix(targetOVNNet.ID())
+		for _, field := range []string{"dst", "src"} {
+			rules = append(rules, networkOVN.OVNQoSRule{
+				Direction: "from-lport",
+				Match:     fmt.Sprintf("ip4.%s == $%s_ip4 || ip6.%s == $%s_ip6", field, addrSet, field, addrSet),
+				Priority:  ovnQoSPeerPriority,
+				Rate:      int(rate / 1000),
+			})
+		}
 	}
 
-	// Apply router security policies.
-	// Should have been done during network setup, but ensure its done here anyway.
-	err = n.logicalRouterPolicySetup(n.ovnnb)
+	err = n.ovnnb.UpdateLogicalSwitchQoSRules(ctx, n.getIntSwitchName(), string(n.getLogicalRouterPeerPortName(targetOVNNet.ID())), rules...)
 	if err != nil {
-		return fmt.Errorf("Failed applying local router security policy: %w", err)
+		return fmt.Errorf("Failed applying peering bandwidth limit: %w", err)
 	}
 
-	activeLocalNICPorts, err := n.ovnnb.GetLogicalSwitchPorts(context.TODO(), n.getIntSwitchName())
+	return nil
+}
+
+// peerRoutesReconcile syncs the routes to this network's instance NICs on the peer routers with the NICs whose
+// logical switch port is currently up on a chassis. Routes for NICs which were stopped abnormally (e.g. because
+// their host went away) are withdrawn so peered networks stop sending traffic into a blackhole, and are restored
+// once the port is bound again.
+func (n *ovn) peerRoutesReconcile(ctx context.Context) error {
+	activePorts, err := n.ovnnb.GetLogicalSwitchPorts(ctx, n.getIntSwitchName())
 	if err != nil {
-		return fmt.Errorf("Failed getting active NIC ports: %w", err)
+		return fmt.Errorf("Failed getting active ports: %w", err)
 	}
 
-	var localNICRoutes []net.IPNet
+	boundPorts, err := n.ovnsb.GetBoundLogicalSwitchPorts(ctx, slices.Collect(maps.Keys(activePorts))...)
+	if err != nil {
+		return fmt.Errorf("Failed getting bound ports: %w", err)
+	}
 
-	// Get routes on instance NICs connected to local network to be added as routes to target network.
+	var nicRoutes []net.IPNet
 	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
 		instancePortName := n.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
-		_, found := activeLocalNICPorts[instancePortName]
-		if !found {
-			return nil // Don't add config for instance NICs that aren't started.
+		if boundPorts[instancePortName] {
+			nicRoutes = append(nicRoutes, n.instanceNICGetRoutes(nicConfig)...)
 		}
 
-		localNICRoutes = append(localNICRoutes, n.instanceNICGetRoutes(nicConfig)...)
-
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Failed getting instance NIC routes on local network: %w", err)
+		return fmt.Errorf("Failed getting instance NIC routes: %w", err)
 	}
 
-	targetNet, err := LoadByName(n.state, peer.TargetProject, peer.TargetNetwork)
+	// The peering itself routes the network's subnets through the same port, those must be kept.
+	opts, err := n.peerGetLocalOpts(ctx, nicRoutes)
 	if err != nil {
-		return fmt.Errorf("Failed loading target network: %w", err)
+		return err
 	}
 
-	targetOVNNet, ok := targetNet.(*ovn)
-	if !ok {
-		return errors.New("Target network is not ovn interface type")
+	keepRoutes := make(map[string]bool, len(opts.TargetRouterRoutes))
+	for _, route := range opts.TargetRouterRoutes {
+		keepRoutes[route.String()] = true
 	}
 
-	opts, err := n.peerGetLocalOpts(localNICRoutes)
+	routerIntPortIPv4, _, err := n.parseRouterIntPortIPv4Net()
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed parsing local router's peering port IPv4 Net: %w", err)
 	}
 
-	// Ensure local subnets and all active NIC routes are present in internal switch's address set.
-	err = n.ovnnb.UpdateAddressSetAdd(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(n.ID()), opts.TargetRouterRoutes...)
+	routerIntPortIPv6, _, err := n.parseRouterIntPortIPv6Net()
 	if err != nil {
-		return fmt.Errorf("Failed adding active NIC routes to switch address set: %w", err)
+		return fmt.Errorf("Failed parsing local router's peering port IPv6 Net: %w", err)
 	}
 
-	err = n.peerSetup(n.ovnnb, targetOVNNet, *opts)
-	if err != nil {
-		return err
-	}
+	return n.forPeers(ctx, func(targetOVNNet *ovn) error {
+		targetRouterName := targetOVNNet.getRouterName()
+		targetRouterPort := targetOVNNet.getLogicalRouterPeerPortName(n.ID())
 
-	reverter.Success()
-	return nil
-}
+		routes, err := n.ovnnb.GetLogicalRouterRoutes(ctx, targetRouterName)
+		if err != nil {
+			return fmt.Errorf("Failed getting static routes of peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+		}
 
-// remotePeerCreate creates a network peering with an OVN-IC.
-func (n *ovn) remotePeerCreate(peer api.NetworkPeersPost) error {
-	ctx := context.TODO()
+		existingRoutes := make(map[string]bool, len(routes))
+		staleRoutes := []net.IPNet{}
+		for _, route := range routes {
+			if route.Port != targetRouterPort {
+				continue // Not routed to this network.
+			}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+			existingRoutes[route.Prefix.String()] = true
+			if !keepRoutes[route.Prefix.String()] {
+				staleRoutes = append(staleRoutes, route.Prefix)
+			}
+		}
 
-	// Load the project.
-	var p *api.Project
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		project, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
-		if err != nil {
-			return err
+		if len(staleRoutes) > 0 {
+			err = n.ovnnb.DeleteLogicalRouterRoute(ctx, targetRouterName, staleRoutes...)
+			if err != nil {
+				return fmt.Errorf("Failed deleting static routes from peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+			}
+
+			n.logger.Warn("Withdrew peer routes to inactive instance NICs", logger.Ctx{"peerNetwork": targetOVNNet.Name(), "peerProject": targetOVNNet.Project(), "routes": staleRoutes})
 		}
 
-		p, err = project.ToAPI(ctx, tx.Tx())
+		missingRoutes := []networkOVN.OVNRouterRoute{}
+		for _, route := range nicRoutes {
+			if existingRoutes[route.String()] {
+				continue
+			}
 
-		return err
+			nexthop := routerIntPortIPv4
+			if route.IP.To4() == nil {
+				nexthop = routerIntPortIPv6
+			}
+
+			if nexthop == nil {
+				continue // Skip routes that cannot be supported by local router.
+			}
+
+			missingRoutes = append(missingRoutes, networkOVN.OVNRouterRoute{
+				Prefix:  route,
+				NextHop: nexthop,
+				Port:    targetRouterPort,
+			})
+		}
+
+		if len(missingRoutes) > 0 {
+			err = n.ovnnb.CreateLogicalRouterRoute(ctx, targetRouterName, true, missingRoutes...)
+			if err != nil {
+				return fmt.Errorf("Failed adding static routes to peer network %q in project %q: %w", targetOVNNet.Name(), targetOVNNet.Project(), err)
+			}
+		}
+
+		return nil
 	})
+}
+
+// PeerUpdate updates a network peering.
+func (n *ovn) PeerUpdate(ctx context.Context, peerName string, req api.NetworkPeerPut) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
 	if err != nil {
-		return fmt.Errorf("Failed to load network restrictions from project %q: %w", n.project, err)
+		return err
 	}
 
-	// Validate restrictions.
-	if !project.NetworkIntegrationAllowed(p.Config, peer.TargetIntegration) {
-		return api.StatusErrorf(http.StatusForbidden, "Project isn't allowed to use this network integration")
-	}
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	var curPeer *api.NetworkPeer
+	var dbCurPeer *dbCluster.NetworkPeer
 
-	// Load the integration.
-	var integration *api.NetworkIntegration
 	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), peer.TargetIntegration)
+		var err error
+
+		dbCurPeer, err = dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
 		if err != nil {
-			return err
+			return fmt.Errorf("Failed getting network peer DB object: %w", err)
 		}
 
-		integration, err = entry.ToAPI(ctx, tx.Tx())
+		curPeer, err = dbCurPeer.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
+		}
 
-		return err
+		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Failed to load network integration %q: %w", peer.TargetIntegration, err)
+		return err
 	}
 
-	// Get ICNB.
-	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	err = n.peerValidate(peerName, &req)
 	if err != nil {
 		return err
 	}
 
-	// Get ICSB.
-	icsb, err := networkOVN.NewICSB(integration.Config["ovn.southbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
-	if err != nil {
-		return err
+	if curPeer.Type == "remote" && req.Config["limits.bandwidth"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Bandwidth limits are only supported on local peers")
 	}
 
-	// Get the OVN AZ name.
-	azName, err := n.ovnnb.GetName(ctx)
-	if err != nil {
-		return err
+	if curPeer.Type == "remote" {
+		backups, err := n.peerBackupIntegrations(ctx, curPeer.TargetIntegration, req.Config)
+		if err != nil {
+			return err
+		}
+
+		if curPeer.ActiveIntegration != curPeer.TargetIntegration && !slices.Contains(backups, curPeer.ActiveIntegration) {
+			return api.StatusErrorf(http.StatusBadRequest, "Backup integration %q is currently carrying the peering and can't be removed", curPeer.ActiveIntegration)
+		}
+	} else if req.Config["target_integration.backups"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Backup integrations are only supported on remote peers")
+	} else if req.Config["routes.export"] != "" || req.Config["routes.import"] != "" {
+		return api.StatusErrorf(http.StatusBadRequest, "Route filters are only supported on remote peers")
 	}
 
-	// Get the list of interconnect gateways.
-	gateways, err := icsb.GetGateways(ctx, azName)
+	curPeerEtagHash, err := localUtil.EtagHash(curPeer.Etag())
 	if err != nil {
 		return err
 	}
 
-	if len(gateways) == 0 {
-		return errors.New("No chassis gateways available for interconnect")
-	}
-
-	// Determine the transit switch name.
-	pattern := integration.Config["ovn.transit.pattern"]
-	if pattern == "" {
-		pattern = "ts-incus-{{ integrationName }}-{{ projectName }}-{{ networkName }}"
+	newPeer := api.NetworkPeer{
+		Name:           curPeer.Name,
+		NetworkPeerPut: req,
 	}
 
-	tsNameRendered, err := internalUtil.RenderTemplate(pattern, pongo2.Context{
-		"projectName":     n.project,
-		"networkName":     n.name,
-		"integrationName": integration.Name,
-		"peerName":        peer.Name,
-	})
+	newPeerEtagHash, err := localUtil.EtagHash(newPeer.Etag())
 	if err != nil {
 		return err
 	}
 
-	tsName := networkOVN.OVNSwitch(tsNameRendered)
+	if curPeerEtagHash == newPeerEtagHash {
+		return nil // Nothing has changed.
+	}
 
-	// Determine the chassis group name.
-	cgName := networkOVN.OVNChassisGroup(tsName)
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		// Update the description field from the input.
+		dbCurPeer.Description = newPeer.Description
 
-	// Create the chassis group.
-	err = n.ovnnb.CreateChassisGroup(ctx, cgName, false)
+		// Update the main peer object.
+		err = dbCluster.UpdateNetworkPeer(ctx, tx.Tx(), n.id, dbCurPeer.Name, *dbCurPeer)
+		if err != nil {
+			return fmt.Errorf("Failed to update network peer: %w", err)
+		}
+
+		// Update the peer configuration, keeping its approval state.
+		curConfig, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(dbCurPeer.ID))
+		if err != nil {
+			return fmt.Errorf("Failed to get network peer config: %w", err)
+		}
+
+		config := maps.Clone(newPeer.Config)
+		if config == nil {
+			config = map[string]string{}
+		}
+
+		for _, key := range []string{dbCluster.NetworkPeerApprovalKey, dbCluster.NetworkPeerActiveIntegrationKey} {
+			if curConfig[key] != "" {
+				config[key] = curConfig[key]
+			}
+		}
+
+		err = dbCluster.UpdateNetworkPeerConfig(ctx, tx.Tx(), dbCurPeer.ID, config)
+		if err != nil {
+			return fmt.Errorf("Failed to update network peer config: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	reverter.Add(func() { _ = n.ovnnb.DeleteChassisGroup(ctx, cgName) })
+	// Apply the new route filters to remote peerings.
+	if curPeer.Type == "remote" && (curPeer.Config["routes.export"] != newPeer.Config["routes.export"] || curPeer.Config["routes.import"] != newPeer.Config["routes.import"]) {
+		lrpName, err := n.remotePeerRouterPortName(ctx, curPeer)
+		if err != nil {
+			return err
+		}
 
-	// Seed the stable random number generator with the transit switch name.
-	// This should cause a reasonable spread of networks on the available IC gateway chassis.
-	r, err := localUtil.GetStableRandomGenerator(tsNameRendered)
-	if err != nil {
-		return fmt.Errorf("Failed generating stable random chassis group priority: %w", err)
+		err = n.ovnnb.SetLogicalRouterPortOptions(ctx, lrpName, remotePeerRouteFilterOptions(newPeer.Config))
+		if err != nil {
+			return fmt.Errorf("Failed setting route filters: %w", err)
+		}
 	}
 
-	// Assign some priorities.
-	for _, gateway := range gateways {
-		err = n.ovnnb.SetChassisGroupPriority(ctx, cgName, gateway, r.Intn(ovnChassisPriorityMax+1))
+	// Apply the new bandwidth limit to active peerings.
+	if curPeer.Type == "local" && curPeer.Status == api.NetworkStatusCreated && curPeer.Config["limits.bandwidth"] != newPeer.Config["limits.bandwidth"] {
+		targetNet, err := LoadByName(n.state, curPeer.TargetProject, curPeer.TargetNetwork)
+		if err != nil {
+			return fmt.Errorf("Failed loading target network: %w", err)
+		}
+
+		targetOVNNet, ok := targetNet.(*ovn)
+		if !ok {
+			return errors.New("Target network is not ovn interface type")
+		}
+
+		err = n.peerQoSSetup(ctx, targetOVNNet)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Create the transit switch if it doesn't exist already.
-	err = icnb.CreateTransitSwitch(ctx, string(tsName), true)
+	reverter.Success()
+	return nil
+}
+
+// localPeerDelete deletes a network peering with another local network.
+func (n *ovn) localPeerDelete(ctx context.Context, peer *api.NetworkPeer) error {
+	targetNet, err := LoadByName(n.state, peer.TargetProject, peer.TargetNetwork)
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed loading target network: %w", err)
 	}
 
-	// Check that the switch appeared on the local OVN.
-	found := false
-	for range 10 {
-		// Try to get the switch.
-		logicalSwitch, err := n.ovnnb.GetLogicalSwitch(ctx, tsName)
-		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
-			return err
-		}
+	targetOVNNet, ok := targetNet.(*ovn)
+	if !ok {
+		return errors.New("Target network is not ovn interface type")
+	}
 
-		if logicalSwitch != nil {
-			found = true
-			break
-		}
+	opts := networkOVN.OVNRouterPeering{
+		LocalRouter:      n.getRouterName(),
+		LocalRouterPort:  n.getLogicalRouterPeerPortName(targetOVNNet.ID()),
+		TargetRouter:     targetOVNNet.getRouterName(),
+		TargetRouterPort: targetOVNNet.getLogicalRouterPeerPortName(n.ID()),
+	}
 
-		time.Sleep(time.Second)
+	err = n.ovnnb.DeleteLogicalRouterPeering(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("Failed deleting OVN network peering: %w", err)
 	}
 
-	if !found {
-		return errors.New("New transit switch didn't appear within 10s")
+	err = n.ovnnb.UpdateLogicalSwitchQoSRules(ctx, n.getIntSwitchName(), string(opts.LocalRouterPort))
+	if err != nil {
+		return fmt.Errorf("Failed removing peering bandwidth limit: %w", err)
 	}
 
-	// Get router MAC address.
-	routerMAC, err := n.getRouterMAC()
+	err = n.ovnnb.UpdateLogicalSwitchQoSRules(ctx, targetOVNNet.getIntSwitchName(), string(opts.TargetRouterPort))
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed removing target peering bandwidth limit: %w", err)
 	}
 
-	// Get bridge MTU.
-	bridgeMTU := int(n.getBridgeMTU())
-	if bridgeMTU == 0 {
-		bridgeMTU = 1500
+	err = n.logicalRouterPolicySetup(ctx, n.ovnnb, targetOVNNet.ID())
+	if err != nil {
+		return fmt.Errorf("Failed applying local router security policy: %w", err)
+	}
+
+	err = targetOVNNet.logicalRouterPolicySetup(ctx, n.ovnnb, n.ID())
+	if err != nil {
+		return fmt.Errorf("Failed applying target router security policy: %w", err)
+	}
+
+	err = n.dnsForwardSetup(ctx, targetOVNNet.ID())
+	if err != nil {
+		return err
 	}
 
-	// Get peering addresses.
-	ipv4Net, ipv6Net, err := icnb.CreateTransitSwitchAllocation(ctx, string(tsName), azName)
+	err = targetOVNNet.dnsForwardSetup(ctx, n.ID())
 	if err != nil {
 		return err
 	}
 
-	// Determine logical router port name.
-	lrpName := networkOVN.OVNRouterPort(tsName)
-
-	// Create the logical router port.
-	err = n.ovnnb.CreateLogicalRouterPort(ctx, n.getRouterName(), lrpName, routerMAC, uint32(bridgeMTU), []*net.IPNet{ipv4Net, ipv6Net}, cgName, false)
+	err = n.loadBalancersShare(ctx, targetOVNNet.ID())
 	if err != nil {
 		return err
 	}
 
-	reverter.Add(func() { _ = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), lrpName) })
-
-	// Create the logical switch port.
-	lspOpts := &networkOVN.OVNSwitchPortOpts{RouterPort: lrpName}
-	err = n.ovnnb.CreateLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)), lspOpts, false)
+	err = targetOVNNet.loadBalancersShare(ctx, n.ID())
 	if err != nil {
 		return err
 	}
 
-	reverter.Add(func() {
-		_ = n.ovnnb.DeleteLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)))
-	})
-
-	reverter.Success()
 	return nil
 }
 
-// PeerCreate creates a network peering.
-func (n *ovn) PeerCreate(peer api.NetworkPeersPost) error {
-	reverter := revert.New()
-	defer reverter.Fail()
-
-	// Default type is local.
-	if peer.Type == "" {
-		peer.Type = "local"
+// remotePeerRouteFilterOptions returns the router port options restricting the routes advertised to and learned
+// from the interconnection, as set by the routes.export and routes.import options of a remote peer.
+func remotePeerRouteFilterOptions(config map[string]string) map[string]string {
+	return map[string]string{
+		"ic-route-filter-adv":   strings.Join(util.SplitNTrimSpace(config["routes.export"], ",", -1, true), ","),
+		"ic-route-filter-learn": strings.Join(util.SplitNTrimSpace(config["routes.import"], ",", -1, true), ","),
 	}
+}
 
-	// Perform create-time validation.
-	if peer.Type == "local" {
-		// Default to network's project if target project not specified.
-		if peer.TargetProject == "" {
-			peer.TargetProject = n.Project()
+// remotePeerRouterPortName returns the name of the router port connecting a remote peer to its transit switch.
+func (n *ovn) remotePeerRouterPortName(ctx context.Context, peer *api.NetworkPeer) (networkOVN.OVNRouterPort, error) {
+	var integration *api.NetworkIntegration
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), peer.ActiveIntegration)
+		if err != nil {
+			return err
 		}
 
-		// Target network name is required.
-		if peer.TargetNetwork == "" {
-			return api.StatusErrorf(http.StatusBadRequest, "Target network is required")
-		}
-	} else if peer.Type == "remote" {
-		// Target integration name is required.
-		if peer.TargetIntegration == "" {
-			return api.StatusErrorf(http.StatusBadRequest, "Target integration is required")
-		}
+		integration, err = entry.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed to load network integration %q: %w", peer.ActiveIntegration, err)
 	}
 
-	// Look for an existing entry.
-	var peers map[int64]*api.NetworkPeer
+	tsName, err := integrationTransitSwitchName(integration, n.project, n.name, peer.Name)
+	if err != nil {
+		return "", err
+	}
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		var err error
+	return networkOVN.OVNRouterPort(networkOVN.OVNSwitch(tsName)), nil
+}
 
-		// Use generated function to get peers.
-		netID := n.ID()
-		filter := dbCluster.NetworkPeerFilter{NetworkID: &netID}
-		dbPeers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), filter)
+// PeerRoutes returns the routes a remote peer advertises to the interconnection, along with the ones held back
+// by its routes.export filter.
+func (n *ovn) PeerRoutes(ctx context.Context, peerName string) (*api.NetworkPeerRoutes, error) {
+	var peer *api.NetworkPeer
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		dbPeer, err := dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
 		if err != nil {
-			return fmt.Errorf("Failed loading network peer DB objects: %w", err)
+			return err
 		}
 
-		// Convert DB objects to API objects and build the map.
-		peers = make(map[int64]*api.NetworkPeer, len(dbPeers))
-		for _, dbPeer := range dbPeers {
-			peer, err := dbPeer.ToAPI(ctx, tx.Tx())
-			if err != nil {
-				return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
-			}
-
-			peers[dbPeer.ID] = peer
-		}
+		peer, err = dbPeer.ToAPI(ctx, tx.Tx())
 
-		return nil
+		return err
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, existingPeer := range peers {
-		if peer.Name == existingPeer.Name {
-			return api.StatusErrorf(http.StatusConflict, "A peer for that name already exists")
+	if peer.Type != "remote" {
+		return nil, api.StatusErrorf(http.StatusBadRequest, "Route listing is only supported on remote peers")
+	}
+
+	// The interconnection advertises the subnets of the router and its static routes.
+	var routes []net.IPNet
+	for _, parse := range []func() (net.IP, *net.IPNet, error){n.parseRouterIntPortIPv4Net, n.parseRouterIntPortIPv6Net} {
+		_, subnet, err := parse()
+		if err != nil {
+			return nil, err
 		}
 
-		if peer.Type == "local" && peer.TargetProject == existingPeer.TargetProject && peer.TargetNetwork == existingPeer.TargetNetwork {
-			return api.StatusErrorf(http.StatusConflict, "A peer for that target network already exists")
+		if subnet != nil {
+			routes = append(routes, *subnet)
 		}
 	}
 
-	// Perform general (create and update) validation.
-	err = n.peerValidate(peer.Name, &peer.NetworkPeerPut)
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		routes = append(routes, n.instanceNICGetRoutes(nicConfig)...)
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("Failed getting instance NIC routes: %w", err)
 	}
 
-	var peerID int64
-	var mutualExists bool
-
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error { // Create peer DB record.
-		record := dbCluster.NetworkPeer{
-			NetworkID:   n.ID(),
-			Name:        peer.Name,
-			Description: peer.Description,
-			Type:        dbCluster.NetworkPeerTypes[peer.Type],
-		}
-
-		switch peer.Type {
-		case "remote":
-			integrationID, err := dbCluster.GetNetworkIntegrationID(ctx, tx.Tx(), peer.TargetIntegration)
-			if err != nil {
-				return err
-			}
-
-			id := sql.NullInt64{}
-			err = id.Scan(integrationID)
-			if err != nil {
-				return err
-			}
-
-			record.TargetNetworkIntegrationID = id
-
-		case "local":
-			// Check if target peer already exists.
-			peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{
-				Type:                 &record.Type,
-				TargetNetworkProject: &n.project,
-				TargetNetworkName:    &n.name,
-			})
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
-				return err
-			}
-
-			if len(peers) == 1 {
-				// Update the target peer.
-				peer := peers[0]
-
-				empty := sql.NullString{}
-				peer.TargetNetworkProject = empty
-				peer.TargetNetworkName = empty
-
-				targetID := sql.NullInt64{}
-				err = targetID.Scan(n.id)
-				if err != nil {
-					return err
-				}
-
-				peer.TargetNetworkID = targetID
+	exported, filtered := filterRoutesByPrefixes(routes, peer.Config["routes.export"])
 
-				err = dbCluster.UpdateNetworkPeer(ctx, tx.Tx(), peer.NetworkID, peer.Name, peer)
-				if err != nil {
-					return err
-				}
+	peerRoutes := &api.NetworkPeerRoutes{
+		Exported: make([]string, 0, len(exported)),
+		Filtered: make([]string, 0, len(filtered)),
+	}
 
-				// Set our target network ID to match.
-				id := sql.NullInt64{}
-				err = id.Scan(peer.NetworkID)
-				if err != nil {
-					return err
-				}
+	for _, route := range exported {
+		peerRoutes.Exported = append(peerRoutes.Exported, route.String())
+	}
 
-				record.TargetNetworkID = id
+	for _, route := range filtered {
+		peerRoutes.Filtered = append(peerRoutes.Filtered, route.String())
+	}
 
-				mutualExists = true
-			} else if len(peers) == 0 {
-				networkProjectName := sql.NullString{}
-				err = networkProjectName.Scan(peer.TargetProject)
-				if err != nil {
-					return err
-				}
+	return peerRoutes, nil
+}
 
-				networkName := sql.NullString{}
-				err = networkName.Scan(peer.TargetNetwork)
-				if err != nil {
-					return err
-				}
+// peerBackupIntegrations returns the backup integrations of a remote peer in order of preference, checking that
+// they exist and that the project is allowed to use them.
+func (n *ovn) peerBackupIntegrations(ctx context.Context, targetIntegration string, config map[string]string) ([]string, error) {
+	backups := util.SplitNTrimSpace(config["target_integration.backups"], ",", -1, true)
+	if len(backups) == 0 {
+		return nil, nil
+	}
 
-				record.TargetNetworkProject = networkProjectName
-				record.TargetNetworkName = networkName
-			} else {
-				return errors.New("More than one matching network peer was found")
-			}
+	for i, name := range backups {
+		if name == targetIntegration {
+			return nil, api.StatusErrorf(http.StatusBadRequest, "The target integration %q can't also be a backup integration", name)
 		}
 
-		peerID, err = dbCluster.CreateNetworkPeer(ctx, tx.Tx(), record)
-		if err != nil {
-			return err
+		if slices.Contains(backups[:i], name) {
+			return nil, api.StatusErrorf(http.StatusBadRequest, "Duplicate backup integration %q", name)
 		}
-
-		return nil
-	})
-	if err != nil {
-		return err
 	}
 
-	reverter.Add(func() {
-		_ = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			err := dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), n.ID(), peerID)
-			if errors.Is(err, dbCluster.ErrNotFound) {
-				return nil
-			}
-
-			return err
-		})
-	})
-
-	// Apply the OVN configuration.
-	if peer.Type == "local" && mutualExists {
-		err := n.localPeerCreate(peer)
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		dbProject, err := dbCluster.GetProject(ctx, tx.Tx(), n.project)
 		if err != nil {
 			return err
 		}
-	} else if peer.Type == "remote" {
-		err := n.remotePeerCreate(peer)
+
+		p, err := dbProject.ToAPI(ctx, tx.Tx())
 		if err != nil {
 			return err
 		}
-	}
-
-	reverter.Success()
-	return nil
-}
-
-// peerGetLocalOpts returns peering options prefilled with local router and local NIC routes config.
-// It can then be modified with the target peering network options.
-func (n *ovn) peerGetLocalOpts(localNICRoutes []net.IPNet) (*networkOVN.OVNRouterPeering, error) {
-	localRouterPortMAC, err := n.getRouterMAC()
-	if err != nil {
-		return nil, fmt.Errorf("Failed getting router MAC address: %w", err)
-	}
-
-	opts := networkOVN.OVNRouterPeering{
-		LocalRouter:        n.getRouterName(),
-		LocalRouterPortMAC: localRouterPortMAC,
-		TargetRouterRoutes: localNICRoutes, // Pre-fill with local NIC routes.
-	}
 
-	routerIntPortIPv4, routerIntPortIPv4Net, err := n.parseRouterIntPortIPv4Net()
-	if err != nil {
-		return nil, fmt.Errorf("Failed parsing local router's peering port IPv4 net: %w", err)
-	}
+		for _, name := range backups {
+			if !project.NetworkIntegrationAllowed(p.Config, name) {
+				return api.StatusErrorf(http.StatusForbidden, "Project isn't allowed to use network integration %q", name)
+			}
 
-	if routerIntPortIPv4 != nil && routerIntPortIPv4Net != nil {
-		// Add a copy of the CIDR subnet to the target router's routes.
-		opts.TargetRouterRoutes = append(opts.TargetRouterRoutes, *routerIntPortIPv4Net)
+			_, err = dbCluster.GetNetworkIntegrationID(ctx, tx.Tx(), name)
+			if err != nil {
+				if errors.Is(err, dbCluster.ErrNotFound) {
+					return api.StatusErrorf(http.StatusBadRequest, "Backup integration %q doesn't exist", name)
+				}
 
-		// Convert the IPNet to include the specific router IP with a single host subnet.
-		routerIntPortIPv4Net.IP = routerIntPortIPv4
-		routerIntPortIPv4Net.Mask = net.CIDRMask(32, 32)
-		opts.LocalRouterPortIPs = append(opts.LocalRouterPortIPs, *routerIntPortIPv4Net)
-	}
+				return err
+			}
+		}
 
-	routerIntPortIPv6, routerIntPortIPv6Net, err := n.parseRouterIntPortIPv6Net()
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Failed parsing local router's peering port IPv6 net: %w", err)
+		return nil, err
 	}
 
-	if routerIntPortIPv6 != nil && routerIntPortIPv6Net != nil {
-		// Add a copy of the CIDR subnet to the target router's routers.
-		opts.TargetRouterRoutes = append(opts.TargetRouterRoutes, *routerIntPortIPv6Net)
+	return backups, nil
+}
 
-		// Convert the IPNet to include the specific router IP with a single host subnet.
-		routerIntPortIPv6Net.IP = routerIntPortIPv6
-		routerIntPortIPv6Net.Mask = net.CIDRMask(128, 128)
-		opts.LocalRouterPortIPs = append(opts.LocalRouterPortIPs, *routerIntPortIPv6Net)
-	}
+// remotePeerDelete deletes a network peering with an OVN-IC through the named integration.
+// The local OVN objects are removed even if the interconnection database can't be reached.
+func (n *ovn) remotePeerDelete(ctx context.Context, peer *api.NetworkPeer, integrationName string) error {
+	// Load the integration.
+	var integration *api.NetworkIntegration
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), integrationName)
+		if err != nil {
+			return err
+		}
 
-	return &opts, err
-}
+		integration, err = entry.ToAPI(ctx, tx.Tx())
 
-// peerSetup applies the network peering configuration to both networks.
-// Accepts an OVN client, a target OVN network, and a set of OVNRouterPeering options pre-filled with local config.
-func (n *ovn) peerSetup(ovnnb *networkOVN.NB, targetOVNNet *ovn, opts networkOVN.OVNRouterPeering) error {
-	targetRouterMAC, err := targetOVNNet.getRouterMAC()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Failed getting target router MAC address: %w", err)
+		return fmt.Errorf("Failed to load network integration %q: %w", integrationName, err)
 	}
 
-	// Populate config based on target network.
-	opts.LocalRouterPort = n.getLogicalRouterPeerPortName(targetOVNNet.ID())
-	opts.TargetRouter = targetOVNNet.getRouterName()
-	opts.TargetRouterPort = targetOVNNet.getLogicalRouterPeerPortName(n.ID())
-	opts.TargetRouterPortMAC = targetRouterMAC
+	// Get the OVN AZ name.
+	azName, err := n.ovnnb.GetName(ctx)
+	if err != nil {
+		return err
+	}
 
-	routerIntPortIPv4, routerIntPortIPv4Net, err := targetOVNNet.parseRouterIntPortIPv4Net()
+	// Determine the transit switch name.
+	tsNameRendered, err := integrationTransitSwitchName(integration, n.project, n.name, peer.Name)
 	if err != nil {
-		return fmt.Errorf("Failed parsing target router's peering port IPv4 net: %w", err)
+		return err
 	}
 
-	if routerIntPortIPv4 != nil && routerIntPortIPv4Net != nil {
-		// Add a copy of the CIDR subnet to the local router's routers.
-		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, *routerIntPortIPv4Net)
+	tsName := networkOVN.OVNSwitch(tsNameRendered)
 
-		// Convert the IPNet to include the specific router IP with a single host subnet.
-		routerIntPortIPv4Net.IP = routerIntPortIPv4
-		routerIntPortIPv4Net.Mask = net.CIDRMask(32, 32)
-		opts.TargetRouterPortIPs = append(opts.TargetRouterPortIPs, *routerIntPortIPv4Net)
+	// Delete logical switch port
+	err = n.ovnnb.DeleteLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)))
+	if err != nil {
+		return err
 	}
 
-	routerIntPortIPv6, routerIntPortIPv6Net, err := targetOVNNet.parseRouterIntPortIPv6Net()
+	// Determine logical router port name.
+	lrpName := networkOVN.OVNRouterPort(tsName)
+
+	// Delete logical router port
+	err = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), lrpName)
 	if err != nil {
-		return fmt.Errorf("Failed parsing target router's peering port IPv6 net: %w", err)
+		return err
 	}
 
-	if routerIntPortIPv6 != nil && routerIntPortIPv6Net != nil {
-		// Add a copy of the CIDR subnet to the local router's routers.
-		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, *routerIntPortIPv6Net)
+	// Determine the chassis group name.
+	cgName := networkOVN.OVNChassisGroup(tsName)
 
-		// Convert the IPNet to include the specific router IP with a single host subnet.
-		routerIntPortIPv6Net.IP = routerIntPortIPv6
-		routerIntPortIPv6Net.Mask = net.CIDRMask(128, 128)
-		opts.TargetRouterPortIPs = append(opts.TargetRouterPortIPs, *routerIntPortIPv6Net)
+	// Delete chassis group.
+	err = n.ovnnb.DeleteChassisGroup(ctx, cgName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotManaged) {
+		return err
 	}
 
-	// Get list of active switch ports (avoids repeated querying of OVN NB).
-	activeTargetNICPorts, err := n.ovnnb.GetLogicalSwitchPorts(context.TODO(), targetOVNNet.getIntSwitchName())
+	// Get ICNB.
+	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
 	if err != nil {
-		return fmt.Errorf("Failed getting active NIC ports: %w", err)
+		return err
 	}
 
-	// Get routes on instance NICs connected to target network to be added as routes to local network.
-	err = UsedByInstanceDevices(n.state, targetOVNNet.Project(), targetOVNNet.Name(), targetOVNNet.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
-		instancePortName := targetOVNNet.getInstanceDevicePortName(inst.Config["volatile.uuid"], nicName)
-		_, found := activeTargetNICPorts[instancePortName]
-		if !found {
-			return nil // Don't add config for instance NICs that aren't started.
-		}
+	// Release the peering addresses, deleting the transit switch if no other availability zone uses it.
+	deleted, err := icnb.ReleaseTransitSwitch(ctx, string(tsName), azName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotManaged) {
+		return err
+	}
 
-		opts.LocalRouterRoutes = append(opts.LocalRouterRoutes, n.instanceNICGetRoutes(nicConfig)...)
+	if deleted {
+		n.logger.Debug("Deleted unused transit switch", logger.Ctx{"switch": tsName, "integration": integration.Name})
+	}
 
-		return nil
-	})
+	// Clean up transit switches left behind by availability zones which are no longer connected.
+	icsb, err := networkOVN.NewICSB(integration.Config["ovn.southbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
 	if err != nil {
-		return fmt.Errorf("Failed getting instance NIC routes on target network: %w", err)
+		n.logger.Warn("Failed to connect to the interconnect southbound database, skipping transit switch cleanup", logger.Ctx{"integration": integration.Name, "err": err})
+		return nil
 	}
 
-	// Ensure routes are added to target switch address sets.
-	err = n.ovnnb.UpdateAddressSetAdd(context.TODO(), acl.OVNIntSwitchPortGroupAddressSetPrefix(targetOVNNet.ID()), opts.LocalRouterRoutes...)
+	activeAZs, err := icsb.GetAvailabilityZones(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed adding target switch subnet address set entries: %w", err)
+		n.logger.Warn("Failed to list interconnect availability zones, skipping transit switch cleanup", logger.Ctx{"integration": integration.Name, "err": err})
+		return nil
 	}
 
-	err = targetOVNNet.logicalRouterPolicySetup(n.ovnnb)
+	deletedSwitches, err := icnb.DeleteUnusedTransitSwitches(ctx, activeAZs)
 	if err != nil {
-		return fmt.Errorf("Failed applying target router security policy: %w", err)
+		n.logger.Warn("Failed to clean up unused transit switches", logger.Ctx{"integration": integration.Name, "err": err})
+		return nil
 	}
 
-	err = n.ovnnb.CreateLogicalRouterPeering(context.TODO(), opts)
-	if err != nil {
-		return fmt.Errorf("Failed applying OVN network peering: %w", err)
+	if len(deletedSwitches) > 0 {
+		n.logger.Info("Deleted unused transit switches", logger.Ctx{"switches": deletedSwitches, "integration": integration.Name})
 	}
 
 	return nil
 }
 
-// PeerUpdate updates a network peering.
-func (n *ovn) PeerUpdate(peerName string, req api.NetworkPeerPut) error {
-	reverter := revert.New()
-	defer reverter.Fail()
+// peerApprovalAbsent stands for a peer which doesn't exist (or must be removed) in peerApprovalTransition.
+const peerApprovalAbsent = "absent"
 
-	var curPeer *api.NetworkPeer
-	var dbCurPeer *dbCluster.NetworkPeer
+// peerApprovalTransition returns the approval states of a peer between projects and of its mutual peer on the
+// target network once the action (create, approve, reject or delete) is applied to the peer.
+// An empty state means that the peering is active.
+//
+// Creating the first side of a peering requests it from the target network, where a pending peer is added for its
+// owner to approve or reject. Creating the second side instead activates the peering, as both owners consented.
+func peerApprovalTransition(action string, approval string, mutualApproval string) (string, string, error) {
+	switch action {
+	case "create":
+		if approval != peerApprovalAbsent {
+			return "", "", api.StatusErrorf(http.StatusConflict, "A peer for that target network already exists")
+		}
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		var err error
+		switch mutualApproval {
+		case peerApprovalAbsent:
+			return dbCluster.NetworkPeerApprovalRequested, dbCluster.NetworkPeerApprovalPending, nil
+		case dbCluster.NetworkPeerApprovalPending:
+			return "", "", api.StatusErrorf(http.StatusConflict, "The target network is already awaiting approval of this peering")
+		default:
+			return "", "", nil
+		}
 
-		dbCurPeer, err = dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
-		if err != nil {
-			return fmt.Errorf("Failed getting network peer DB object: %w", err)
+	case "approve", "reject":
+		if approval != dbCluster.NetworkPeerApprovalPending {
+			return "", "", api.StatusErrorf(http.StatusBadRequest, "Peering isn't awaiting approval from this network")
 		}
 
-		curPeer, err = dbCurPeer.ToAPI(ctx, tx.Tx())
-		if err != nil {
-			return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
+		if mutualApproval == peerApprovalAbsent {
+			return "", "", api.StatusErrorf(http.StatusNotFound, "The peering request was withdrawn")
 		}
 
-		return nil
-	})
-	if err != nil {
-		return err
-	}
+		if action == "approve" {
+			return "", "", nil
+		}
 
-	err = n.peerValidate(peerName, &req)
-	if err != nil {
-		return err
-	}
+		return peerApprovalAbsent, dbCluster.NetworkPeerApprovalRejected, nil
 
-	curPeerEtagHash, err := localUtil.EtagHash(curPeer.Etag())
-	if err != nil {
-		return err
-	}
+	case "delete":
+		if mutualApproval == peerApprovalAbsent {
+			return peerApprovalAbsent, peerApprovalAbsent, nil
+		}
 
-	newPeer := api.NetworkPeer{
-		Name:           curPeer.Name,
-		NetworkPeerPut: req,
+		switch approval {
+		case dbCluster.NetworkPeerApprovalPending:
+			// Deleting a pending request rejects it.
+			return peerApprovalAbsent, dbCluster.NetworkPeerApprovalRejected, nil
+		case dbCluster.NetworkPeerApprovalRequested, dbCluster.NetworkPeerApprovalRejected:
+			// Withdrawing a request removes it from the target network.
+			return peerApprovalAbsent, peerApprovalAbsent, nil
+		default:
+			return peerApprovalAbsent, mutualApproval, nil
+		}
 	}
 
-	newPeerEtagHash, err := localUtil.EtagHash(newPeer.Etag())
+	return "", "", fmt.Errorf("Unknown peer action %q", action)
+}
+
+// PeerApprove approves or rejects a peering requested by a network of another project.
+// Rejecting it removes the request from this network and marks the requesting peer as rejected.
+func (n *ovn) PeerApprove(ctx context.Context, peerName string, approve bool) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
 	if err != nil {
 		return err
 	}
 
-	if curPeerEtagHash == newPeerEtagHash {
-		return nil // Nothing has changed.
+	defer unlock()
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	var peer *api.NetworkPeer
+	var peerIDs map[int64]string
+
+	action := "reject"
+	if approve {
+		action = "approve"
 	}
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Update the description field from the input.
-		dbCurPeer.Description = newPeer.Description
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		dbPeer, err := dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
+		if err != nil {
+			return fmt.Errorf("Failed getting network peer DB object: %w", err)
+		}
 
-		// Update the main peer object.
-		err = dbCluster.UpdateNetworkPeer(ctx, tx.Tx(), n.id, dbCurPeer.Name, *dbCurPeer)
+		config, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(dbPeer.ID))
 		if err != nil {
-			return fmt.Errorf("Failed to update network peer: %w", err)
+			return err
 		}
 
-		// Update the peer configuration.
-		err = dbCluster.UpdateNetworkPeerConfig(ctx, tx.Tx(), dbCurPeer.ID, newPeer.Config)
+		peer, err = dbPeer.ToAPI(ctx, tx.Tx())
 		if err != nil {
-			return fmt.Errorf("Failed to update network peer config: %w", err)
+			return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
+		}
+
+		peerIDs = map[int64]string{dbPeer.ID: config[dbCluster.NetworkPeerApprovalKey]}
+
+		// Load the peer of the network which requested the peering.
+		var requestPeer *dbCluster.NetworkPeer
+		requestApproval := peerApprovalAbsent
+
+		if dbPeer.TargetNetworkID.Valid {
+			targetPeers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &dbPeer.TargetNetworkID.Int64, TargetNetworkID: &n.id})
+			if err != nil {
+				return err
+			}
+
+			if len(targetPeers) == 1 {
+				requestPeer = &targetPeers[0]
+
+				requestConfig, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(requestPeer.ID))
+				if err != nil {
+					return err
+				}
+
+				requestApproval = requestConfig[dbCluster.NetworkPeerApprovalKey]
+				peerIDs[requestPeer.ID] = requestApproval
+			}
+		}
+
+		approval, requestApproval, err := peerApprovalTransition(action, config[dbCluster.NetworkPeerApprovalKey], requestApproval)
+		if err != nil {
+			return err
+		}
+
+		// Record the decision on both sides of the peering.
+		if approval == peerApprovalAbsent {
+			err = dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), n.id, dbPeer.ID)
+		} else {
+			err = n.peerSetApproval(ctx, tx, dbPeer.ID, approval)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if requestPeer != nil {
+			err = n.peerSetApproval(ctx, tx, requestPeer.ID, requestApproval)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -7270,143 +15843,240 @@ func (n *ovn) PeerUpdate(peerName string, req api.NetworkPeerPut) error {
 		return err
 	}
 
+	reverter.Add(func() {
+		ctx, cancel := ovnRevertContext(ctx)
+		defer cancel()
+
+		_ = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			for peerID, approval := range peerIDs {
+				_ = n.peerSetApproval(ctx, tx, peerID, approval)
+			}
+
+			return nil
+		})
+	})
+
+	if approve {
+		err = n.localPeerCreate(ctx, api.NetworkPeersPost{Name: peer.Name, TargetProject: peer.TargetProject, TargetNetwork: peer.TargetNetwork})
+		if err != nil {
+			return err
+		}
+	}
+
 	reverter.Success()
 	return nil
 }
 
-// localPeerDelete deletes a network peering with another local network.
-func (n *ovn) localPeerDelete(peer *api.NetworkPeer) error {
-	targetNet, err := LoadByName(n.state, peer.TargetProject, peer.TargetNetwork)
+// peerSetApproval sets the approval state in the config of a peer, leaving its other keys untouched.
+// An empty state clears it.
+func (n *ovn) peerSetApproval(ctx context.Context, tx *db.ClusterTx, peerID int64, approval string) error {
+	return n.peerSetConfigKey(ctx, tx, peerID, dbCluster.NetworkPeerApprovalKey, approval)
+}
+
+// peerSetConfigKey sets a single key in the config of a peer, leaving its other keys untouched.
+// An empty value clears it.
+func (n *ovn) peerSetConfigKey(ctx context.Context, tx *db.ClusterTx, peerID int64, key string, value string) error {
+	config, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(peerID))
 	if err != nil {
-		return fmt.Errorf("Failed loading target network: %w", err)
+		return fmt.Errorf("Failed getting network peer config: %w", err)
 	}
 
-	targetOVNNet, ok := targetNet.(*ovn)
-	if !ok {
-		return errors.New("Target network is not ovn interface type")
+	if config[key] == value {
+		return nil
 	}
 
-	opts := networkOVN.OVNRouterPeering{
-		LocalRouter:      n.getRouterName(),
-		LocalRouterPort:  n.getLogicalRouterPeerPortName(targetOVNNet.ID()),
-		TargetRouter:     targetOVNNet.getRouterName(),
-		TargetRouterPort: targetOVNNet.getLogicalRouterPeerPortName(n.ID()),
+	if config == nil {
+		config = map[string]string{}
+	}
+
+	if value == "" {
+		delete(config, key)
+	} else {
+		config[key] = value
 	}
 
-	err = n.ovnnb.DeleteLogicalRouterPeering(context.TODO(), opts)
+	err = dbCluster.UpdateNetworkPeerConfig(ctx, tx.Tx(), peerID, config)
 	if err != nil {
-		return fmt.Errorf("Failed deleting OVN network peering: %w", err)
+		return fmt.Errorf("Failed updating network peer config: %w", err)
 	}
 
-	err = n.logicalRouterPolicySetup(n.ovnnb, targetOVNNet.ID())
+	return nil
+}
+
+// integrationReachable returns whether both interconnection databases of the named integration can be reached.
+func (n *ovn) integrationReachable(ctx context.Context, integrationName string) bool {
+	var integration *api.NetworkIntegration
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), integrationName)
+		if err != nil {
+			return err
+		}
+
+		integration, err = entry.ToAPI(ctx, tx.Tx())
+
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Failed applying local router security policy: %w", err)
+		return false
+	}
+
+	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		return false
+	}
+
+	icnb.Close()
+
+	icsb, err := networkOVN.NewICSB(integration.Config["ovn.southbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		return false
 	}
 
-	err = targetOVNNet.logicalRouterPolicySetup(n.ovnnb, n.ID())
+	icsb.Close()
+
+	return true
+}
+
+// failoverPeers returns the remote peers of the network which may need to be moved to another integration.
+func (n *ovn) failoverPeers(ctx context.Context) (map[int64]*api.NetworkPeer, error) {
+	var peers map[int64]*api.NetworkPeer
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		netID := n.ID()
+		dbPeers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &netID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network peer DB objects: %w", err)
+		}
+
+		peers = make(map[int64]*api.NetworkPeer, len(dbPeers))
+		for _, dbPeer := range dbPeers {
+			if dbPeer.Type != dbCluster.NetworkPeerTypeRemote {
+				continue
+			}
+
+			peer, err := dbPeer.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
+			}
+
+			// Skip peers without backups that are already on their target integration.
+			if len(n.peerIntegrations(peer)) == 1 && peer.ActiveIntegration == peer.TargetIntegration {
+				continue
+			}
+
+			peers[dbPeer.ID] = peer
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Failed applying target router security policy: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return peers, nil
 }
 
-// remotePeerDelete deletes a network peering with an OVN-IC.
-func (n *ovn) remotePeerDelete(peer *api.NetworkPeer) error {
-	ctx := context.TODO()
-
-	// Load the integration.
-	var integration *api.NetworkIntegration
-	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), peer.TargetIntegration)
-		if err != nil {
-			return err
-		}
+// peerIntegrations returns the integrations a remote peer may use, from the most to the least preferred.
+func (n *ovn) peerIntegrations(peer *api.NetworkPeer) []string {
+	return append([]string{peer.TargetIntegration}, util.SplitNTrimSpace(peer.Config["target_integration.backups"], ",", -1, true)...)
+}
 
-		integration, err = entry.ToAPI(ctx, tx.Tx())
+// PeerFailover moves the remote peerings having backup integrations to the most preferred integration which can
+// be reached, failing over when the active one is unreachable and failing back once the target one recovers.
+func (n *ovn) PeerFailover(ctx context.Context) (err error) {
+	defer func() { err = ovnStatusError(err) }()
 
-		return err
-	})
-	if err != nil {
-		return fmt.Errorf("Failed to load network integration %q: %w", peer.TargetIntegration, err)
-	}
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
 
-	// Get ICNB.
-	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	// Check the peers first so networks without any backup integrations don't take the operation lock.
+	peers, err := n.failoverPeers(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Get the OVN AZ name.
-	azName, err := n.ovnnb.GetName(ctx)
-	if err != nil {
-		return err
+	if len(peers) == 0 {
+		return nil
 	}
 
-	// Determine the transit switch name.
-	pattern := integration.Config["ovn.transit.pattern"]
-	if pattern == "" {
-		pattern = "ts-incus-{{ integrationName }}-{{ projectName }}-{{ networkName }}"
-	}
+	// Pick the most preferred integration which can be reached by each peer.
+	// This is done before locking as probing unreachable integrations can be slow.
+	reachable := map[string]bool{}
+	moves := map[int64]string{}
 
-	tsNameRendered, err := internalUtil.RenderTemplate(pattern, pongo2.Context{
-		"projectName":     n.project,
-		"networkName":     n.name,
-		"integrationName": integration.Name,
-		"peerName":        peer.Name,
-	})
-	if err != nil {
-		return err
-	}
+	for peerID, peer := range peers {
+		newIntegration := ""
+		for _, integrationName := range n.peerIntegrations(peer) {
+			ok, found := reachable[integrationName]
+			if !found {
+				ok = n.integrationReachable(ctx, integrationName)
+				reachable[integrationName] = ok
+			}
 
-	tsName := networkOVN.OVNSwitch(tsNameRendered)
+			if ok {
+				newIntegration = integrationName
+				break
+			}
+		}
 
-	// Delete logical switch port
-	err = n.ovnnb.DeleteLogicalSwitchPort(ctx, tsName, networkOVN.OVNSwitchPort(fmt.Sprintf("%s-%s", tsName, azName)))
-	if err != nil {
-		return err
+		if newIntegration == "" {
+			n.logger.Warn("No reachable integration for network peer", logger.Ctx{"peer": peer.Name})
+			continue
+		}
+
+		if newIntegration != peer.ActiveIntegration {
+			moves[peerID] = newIntegration
+		}
 	}
 
-	// Determine logical router port name.
-	lrpName := networkOVN.OVNRouterPort(tsName)
+	if len(moves) == 0 {
+		return nil
+	}
 
-	// Delete logical router port
-	err = n.ovnnb.DeleteLogicalRouterPort(ctx, n.getRouterName(), lrpName)
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
 	if err != nil {
 		return err
 	}
 
-	// Determine the chassis group name.
-	cgName := networkOVN.OVNChassisGroup(tsName)
-
-	// Delete chassis group.
-	err = n.ovnnb.DeleteChassisGroup(ctx, cgName)
-	if err != nil && !errors.Is(err, networkOVN.ErrNotManaged) {
-		return err
-	}
+	defer unlock()
 
-	// Delete transit switch if empty
-	icSwitch, err := n.ovnnb.GetLogicalSwitch(ctx, tsName)
+	// Reload the peers as they may have changed while probing.
+	peers, err = n.failoverPeers(ctx)
 	if err != nil {
 		return err
 	}
 
-	if len(icSwitch.Ports) == 0 {
-		err = icnb.DeleteTransitSwitch(ctx, string(tsName), false)
-		if err != nil && !errors.Is(err, networkOVN.ErrNotManaged) {
-			return err
+	for peerID, newIntegration := range moves {
+		peer, found := peers[peerID]
+		if !found || newIntegration == peer.ActiveIntegration || !slices.Contains(n.peerIntegrations(peer), newIntegration) {
+			continue
 		}
-	} else {
-		// Get the OVN AZ name.
-		azName, err := n.ovnnb.GetName(ctx)
+
+		n.logger.Info("Moving network peer to another integration", logger.Ctx{"peer": peer.Name, "from": peer.ActiveIntegration, "to": newIntegration})
+
+		// Tear down the peering on the previous integration, which may well be unreachable.
+		err = n.remotePeerDelete(ctx, peer, peer.ActiveIntegration)
 		if err != nil {
-			return err
+			n.logger.Warn("Failed cleaning up network peer on previous integration", logger.Ctx{"peer": peer.Name, "integration": peer.ActiveIntegration, "err": err})
 		}
 
-		// Release peering addresses.
-		err = icnb.DeleteTransitSwitchAllocation(ctx, string(tsName), azName)
+		// Re-establish it on the new one.
+		err = n.remotePeerCreate(ctx, api.NetworkPeersPost{NetworkPeerPut: peer.NetworkPeerPut, Name: peer.Name, Type: peer.Type, TargetIntegration: peer.TargetIntegration}, newIntegration)
 		if err != nil {
-			return err
+			n.logger.Error("Failed moving network peer to another integration", logger.Ctx{"peer": peer.Name, "integration": newIntegration, "err": err})
+			continue
+		}
+
+		err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			activeIntegration := newIntegration
+			if activeIntegration == peer.TargetIntegration {
+				activeIntegration = ""
+			}
+
+			return n.peerSetConfigKey(ctx, tx, peerID, dbCluster.NetworkPeerActiveIntegrationKey, activeIntegration)
+		})
+		if err != nil {
+			return fmt.Errorf("Failed recording active integration of network peer %q: %w", peer.Name, err)
 		}
 	}
 
@@ -7414,17 +16084,31 @@ func (n *ovn) remotePeerDelete(peer *api.NetworkPeer) error {
 }
 
 // PeerDelete deletes a network peering.
-func (n *ovn) PeerDelete(peerName string) error {
-	var peerID int64
+func (n *ovn) PeerDelete(ctx context.Context, peerName string) (err error) {
+	defer func() { err = ovnStatusError(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, ovnOperationTimeout)
+	defer cancel()
+
+	// Serialize management operations on the network so they don't interleave their changes.
+	unlock, err := locking.Lock(ctx, n.operationLockName())
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	var dbPeer *dbCluster.NetworkPeer
 	var peer *api.NetworkPeer
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		dbPeer, err := dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		dbPeer, err = dbCluster.GetNetworkPeer(ctx, tx.Tx(), n.id, peerName)
 		if err != nil {
 			return fmt.Errorf("Failed getting network peer DB object: %w", err)
 		}
 
-		peerID = dbPeer.ID
 		peer, err = dbPeer.ToAPI(ctx, tx.Tx())
 		if err != nil {
 			return fmt.Errorf("Failed converting network peer DB object to API object: %w", err)
@@ -7447,38 +16131,70 @@ func (n *ovn) PeerDelete(peerName string) error {
 
 	if peer.Status == api.NetworkStatusCreated {
 		if peer.Type == "local" {
-			err := n.localPeerDelete(peer)
+			err := n.localPeerDelete(ctx, peer)
 			if err != nil {
 				return err
 			}
 		} else if peer.Type == "remote" {
-			err := n.remotePeerDelete(peer)
+			err := n.remotePeerDelete(ctx, peer, peer.ActiveIntegration)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
-	err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Deactivate any existing peer.
-		if peer.Type == "local" {
-			peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{TargetNetworkID: &n.id})
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		// Deactivate the mutual peer, or remove it when it's a request that was never approved.
+		if peer.Type == "local" && dbPeer.TargetNetworkID.Valid {
+			peers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx(), dbCluster.NetworkPeerFilter{NetworkID: &dbPeer.TargetNetworkID.Int64, TargetNetworkID: &n.id})
 			if err != nil && !errors.Is(err, sql.ErrNoRows) {
 				return err
 			}
 
+			config, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(dbPeer.ID))
+			if err != nil {
+				return err
+			}
+
 			for _, peer := range peers {
-				peer.TargetNetworkID = sql.NullInt64{}
+				mutualConfig, err := dbCluster.GetNetworkPeerConfig(ctx, tx.Tx(), int(peer.ID))
+				if err != nil {
+					return err
+				}
+
+				_, mutualApproval, err := peerApprovalTransition("delete", config[dbCluster.NetworkPeerApprovalKey], mutualConfig[dbCluster.NetworkPeerApprovalKey])
+				if err != nil {
+					return err
+				}
+
+				if mutualApproval == peerApprovalAbsent {
+					err = dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), peer.NetworkID, peer.ID)
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				// Rejected requests stay linked so that they're reported as such.
+				if mutualApproval != dbCluster.NetworkPeerApprovalRejected {
+					peer.TargetNetworkID = sql.NullInt64{}
+				}
 
 				err = dbCluster.UpdateNetworkPeer(ctx, tx.Tx(), peer.NetworkID, peer.Name, peer)
 				if err != nil {
 					return err
 				}
+
+				err = n.peerSetApproval(ctx, tx, peer.ID, mutualApproval)
+				if err != nil {
+					return err
+				}
 			}
 		}
 
 		// Delete the peer.
-		err := dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), n.id, peerID)
+		err := dbCluster.DeleteNetworkPeer(ctx, tx.Tx(), n.id, dbPeer.ID)
 		if err != nil {
 			return err
 		}
@@ -7493,10 +16209,10 @@ func (n *ovn) PeerDelete(peerName string) error {
 }
 
 // forPeers runs f for each target peer network that this network is connected to.
-func (n *ovn) forPeers(f func(targetOVNNet *ovn) error) error {
+func (n *ovn) forPeers(ctx context.Context, f func(targetOVNNet *ovn) error) error {
 	var peers map[int64]*api.NetworkPeer
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		var err error
 
 		// Use generated function to get peers.
@@ -7554,11 +16270,255 @@ func (n *ovn) forPeers(f func(targetOVNNet *ovn) error) error {
 	return nil
 }
 
+// loadBalancerBGPClearPrefixes withdraws all of the exported load balancer prefixes of the network.
+func (n *ovn) loadBalancerBGPClearPrefixes() error {
+	return n.state.BGP.RemovePrefixByOwner(fmt.Sprintf("network_%d_load_balancer", n.id))
+}
+
+// uplinkLost returns whether the network has an uplink whose router port currently isn't hosted by any chassis.
+func (n *ovn) uplinkLost(ctx context.Context) bool {
+	if n.config["network"] == "none" {
+		return false
+	}
+
+	chassis, err := n.ovnsb.GetLogicalRouterPortActiveChassisHostname(ctx, n.getRouterExtPortName())
+
+	return err != nil || chassis == ""
+}
+
+// loadBalancerOnline returns whether any of the backends of the load balancer on the listen address is online.
+func (n *ovn) loadBalancerOnline(ctx context.Context, listenAddr net.IP) bool {
+	for _, protocol := range []string{"tcp", "udp"} {
+		lb, err := n.ovnnb.GetLoadBalancer(ctx, networkOVN.OVNLoadBalancer(fmt.Sprintf("%s-%s", n.getLoadBalancerName(listenAddr.String()), protocol)))
+		if err != nil {
+			continue
+		}
+
+		// OVN can't check UDP backends, so rely on our own probes and otherwise assume they are online.
+		if protocol == "udp" {
+			if n.loadBalancerProbeOnline(listenAddr.String()) {
+				return true
+			}
+
+			continue
+		}
+
+		lbOnline, err := n.ovnsb.CheckLoadBalancerOnline(ctx, *lb)
+		if err != nil {
+			continue
+		}
+
+		if lbOnline {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadBalancerConfig returns the config of the load balancer on the listen address.
+func (n *ovn) loadBalancerConfig(ctx context.Context, listenAddress string) (map[string]string, error) {
+	var config map[string]string
+
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
+			NetworkID:     &networkID,
+			ListenAddress: &listenAddress,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(dbLoadBalancers) != 1 {
+			return api.StatusErrorf(http.StatusNotFound, "Network load balancer not found")
+		}
+
+		config, err = dbCluster.GetNetworkLoadBalancerConfig(ctx, tx.Tx(), int(dbLoadBalancers[0].ID))
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// loadBalancerBGPHealthChanged updates the BGP advertisement of the load balancer on the listen address after the
+// health of its backends has changed.
+func (n *ovn) loadBalancerBGPHealthChanged(ctx context.Context, listenAddr net.IP) {
+	// Skip load balancers which aren't exported.
+	config, err := n.loadBalancerConfig(ctx, listenAddr.String())
+	if err != nil || !listenAdvertised(config) {
+		return
+	}
+
+	// Check for status of all backends on this load-balancer.
+	online := n.loadBalancerOnline(ctx, listenAddr)
+
+	// Prepare advertisement.
+	ipVersion := uint(4)
+	if listenAddr.To4() == nil {
+		ipVersion = 6
+	}
+
+	nextHopAddr := n.bgpNextHopAddress(ipVersion)
+	natEnabled := util.IsTrue(n.config[fmt.Sprintf("ipv%d.nat", ipVersion)])
+	_, netSubnet, _ := net.ParseCIDR(n.config[fmt.Sprintf("ipv%d.address", ipVersion)])
+
+	routeSubnetSize := 128
+	if ipVersion == 4 {
+		routeSubnetSize = 32
+	}
+
+	// Don't export internal address forwards (those inside the NAT enabled network's subnet).
+	if natEnabled && netSubnet != nil && netSubnet.Contains(listenAddr) {
+		return
+	}
+
+	_, ipRouteSubnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", listenAddr.String(), routeSubnetSize))
+	if err != nil {
+		return
+	}
+
+	// Update the BGP state.
+	n.loadBalancerBGPUpdate(listenAddr, *ipRouteSubnet, nextHopAddr, online && !n.uplinkLost(ctx), config)
+}
+
+// loadBalancerBGPUpdate advertises or withdraws the listen address of a load balancer following a change of its
+// health. To limit route churn from flapping backends, the change is only applied once the load balancer has been
+// in its new state for bgp.advertise.delay or bgp.withdraw.delay seconds, and going back to the current state
+// cancels it.
+func (n *ovn) loadBalancerBGPUpdate(listenAddr net.IP, prefix net.IPNet, nextHopAddr net.IP, advertise bool, config map[string]string) {
+	bgpOwner := fmt.Sprintf("network_%d_load_balancer", n.id)
+
+	apply := func() {
+		if advertise {
+			_ = n.state.BGP.AddPrefix(prefix, nextHopAddr, bgpOwner)
+		} else {
+			_ = n.state.BGP.RemovePrefix(prefix, nextHopAddr)
+		}
+	}
+
+	delayKey := "bgp.withdraw.delay"
+	if advertise {
+		delayKey = "bgp.advertise.delay"
+	}
+
+	delay, _ := strconv.ParseUint(config[delayKey], 10, 32)
+
+	ovnLoadBalancerBGPHoldsMu.Lock()
+	defer ovnLoadBalancerBGPHoldsMu.Unlock()
+
+	if ovnLoadBalancerBGPHolds[n.id] == nil {
+		ovnLoadBalancerBGPHolds[n.id] = map[string]*ovnLoadBalancerBGPHold{}
+	}
+
+	hold := ovnLoadBalancerBGPHolds[n.id][listenAddr.String()]
+	if hold == nil {
+		hold = &ovnLoadBalancerBGPHold{}
+		ovnLoadBalancerBGPHolds[n.id][listenAddr.String()] = hold
+	}
+
+	// Cancel any pending change, the load balancer changed state again before it was applied.
+	if hold.timer != nil {
+		hold.timer.Stop()
+		hold.timer = nil
+	}
+
+	if hold.advertised == advertise {
+		return
+	}
+
+	if delay == 0 {
+		apply()
+		hold.advertised = advertise
+
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Duration(delay)*time.Second, func() {
+		ctx, cancel := context.WithTimeout(n.state.ShutdownCtx, ovnOperationTimeout)
+		defer cancel()
+
+		// Check the load balancer is still in the same state.
+		online := n.loadBalancerOnline(ctx, listenAddr) && !n.uplinkLost(ctx)
+
+		ovnLoadBalancerBGPHoldsMu.Lock()
+		defer ovnLoadBalancerBGPHoldsMu.Unlock()
+
+		// Skip changes which have been cancelled or replaced in the meantime.
+		if hold.timer != timer {
+			return
+		}
+
+		hold.timer = nil
+
+		if online != advertise {
+			return
+		}
+
+		apply()
+		hold.advertised = advertise
+	})
+
+	hold.timer = timer
+}
+
+// loadBalancerBGPHoldSync records whether the load balancer on the listen address is exported after a full refresh
+// of the prefixes. Returns whether it should be exported, which is its current state if a change is pending.
+func (n *ovn) loadBalancerBGPHoldSync(listenAddress string, online bool) bool {
+	ovnLoadBalancerBGPHoldsMu.Lock()
+	defer ovnLoadBalancerBGPHoldsMu.Unlock()
+
+	if ovnLoadBalancerBGPHolds[n.id] == nil {
+		ovnLoadBalancerBGPHolds[n.id] = map[string]*ovnLoadBalancerBGPHold{}
+	}
+
+	hold := ovnLoadBalancerBGPHolds[n.id][listenAddress]
+	if hold == nil {
+		hold = &ovnLoadBalancerBGPHold{}
+		ovnLoadBalancerBGPHolds[n.id][listenAddress] = hold
+	}
+
+	if hold.timer == nil {
+		hold.advertised = online
+	}
+
+	return hold.advertised
+}
+
+// loadBalancerBGPHoldsReset cancels the pending advertisement changes of the network's load balancers and forgets
+// their state, or only those of the load balancers not in keepAddresses if provided.
+func (n *ovn) loadBalancerBGPHoldsReset(keepAddresses ...string) {
+	ovnLoadBalancerBGPHoldsMu.Lock()
+	defer ovnLoadBalancerBGPHoldsMu.Unlock()
+
+	for listenAddress, hold := range ovnLoadBalancerBGPHolds[n.id] {
+		if slices.Contains(keepAddresses, listenAddress) {
+			continue
+		}
+
+		if hold.timer != nil {
+			hold.timer.Stop()
+		}
+
+		delete(ovnLoadBalancerBGPHolds[n.id], listenAddress)
+	}
+
+	if len(ovnLoadBalancerBGPHolds[n.id]) == 0 {
+		delete(ovnLoadBalancerBGPHolds, n.id)
+	}
+}
+
 // loadBalancerBGPSetupPrefixes exports external load balancer addresses as prefixes.
-func (n *ovn) loadBalancerBGPSetupPrefixes() error {
+func (n *ovn) loadBalancerBGPSetupPrefixes(ctx context.Context) error {
 	listenAddresses := []string{}
 
-	err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+	err := n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		networkID := n.ID()
 
 		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{
@@ -7568,7 +16528,18 @@ func (n *ovn) loadBalancerBGPSetupPrefixes() error {
 			return err
 		}
 
+		configs, err := networkLoadBalancerConfigs(ctx, tx)
+		if err != nil {
+			return err
+		}
+
 		for _, lb := range dbLoadBalancers {
+			// Internal load balancers are only reachable from within the network so are never exported, and
+			// others can be kept off the routing fabric individually.
+			if !listenAdvertised(configs[int(lb.ID)]) {
+				continue
+			}
+
 			listenAddresses = append(listenAddresses, lb.ListenAddress)
 		}
 
@@ -7596,11 +16567,21 @@ func (n *ovn) loadBalancerBGPSetupPrefixes() error {
 	bgpOwner := fmt.Sprintf("network_%d_load_balancer", n.id)
 
 	// Clear existing address load balancer prefixes for network.
-	err = n.state.BGP.RemovePrefixByOwner(bgpOwner)
+	err = n.loadBalancerBGPClearPrefixes()
 	if err != nil {
 		return err
 	}
 
+	// Don't export anything while the uplink is lost, the uplink port event handler restores the prefixes.
+	if n.uplinkLost(ctx) {
+		n.loadBalancerBGPHoldsReset()
+
+		return nil
+	}
+
+	// Forget the state of the load balancers which aren't exported anymore.
+	n.loadBalancerBGPHoldsReset(listenAddresses...)
+
 	// Add the new prefixes.
 	for _, ipVersion := range []uint{4, 6} {
 		nextHopAddr := n.bgpNextHopAddress(ipVersion)
@@ -7621,26 +16602,8 @@ func (n *ovn) loadBalancerBGPSetupPrefixes() error {
 				continue
 			}
 
-			// Check health of load-balancer (if enabled).
-			online := false
-			for _, protocol := range []string{"tcp", "udp"} {
-				lb, err := n.ovnnb.GetLoadBalancer(context.TODO(), networkOVN.OVNLoadBalancer(fmt.Sprintf("incus-net%d-lb-%s-%s", n.id, listenAddr.String(), protocol)))
-				if err != nil {
-					continue
-				}
-
-				lbOnline, err := n.ovnsb.CheckLoadBalancerOnline(context.TODO(), *lb)
-				if err != nil {
-					continue
-				}
-
-				if lbOnline {
-					online = true
-					break
-				}
-			}
-
-			if !online {
+			// Check health of load-balancer (if enabled), keeping the current state while a change is pending.
+			if !n.loadBalancerBGPHoldSync(listenAddress, n.loadBalancerOnline(ctx, listenAddr)) {
 				continue
 			}
 
@@ -0,0 +1,255 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lxc/incus/v6/internal/server/bgp"
+	"github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/endpoints"
+	networkOVN "github.com/lxc/incus/v6/internal/server/network/ovn"
+	"github.com/lxc/incus/v6/internal/server/node"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/shared/api"
+	localtls "github.com/lxc/incus/v6/shared/tls"
+)
+
+// newTestOVN returns an isolated OVN network of a standalone test server, backed by the in-memory OVN database.
+// The network is recorded as created in the database but hasn't been set up in OVN yet.
+func newTestOVN(t *testing.T) *ovn {
+	s, cleanup := state.NewTestState(t)
+	t.Cleanup(cleanup)
+
+	// Network changes notify the other cluster members and refresh the exported BGP prefixes.
+	err := s.DB.Node.Transaction(context.Background(), func(ctx context.Context, tx *db.NodeTx) error {
+		var err error
+
+		s.LocalConfig, err = node.ConfigLoad(ctx, tx)
+
+		return err
+	})
+	require.NoError(t, err)
+
+	s.Endpoints = &endpoints.Endpoints{}
+	s.ServerCert = func() *localtls.CertInfo { return nil }
+	s.BGP = bgp.NewServer()
+
+	err = s.DB.Cluster.Transaction(context.Background(), func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID, err := tx.CreateNetwork(ctx, api.ProjectDefaultName, "ovn0", "", db.NetworkTypeOVN, map[string]string{
+			"network":      "none",
+			"ipv4.address": "10.10.10.1/24",
+			"ipv6.address": "fd42:10::1/64",
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.NetworkNodeCreated(networkID)
+	})
+	require.NoError(t, err)
+
+	n, err := LoadByName(s, api.ProjectDefaultName, "ovn0")
+	require.NoError(t, err)
+
+	return n.(*ovn)
+}
+
+// Creating the network sets up its logical router and internal switch and records the derived settings.
+func TestOVN_Setup(t *testing.T) {
+	n := newTestOVN(t)
+	ctx := context.Background()
+
+	require.NoError(t, n.Create(request.ClientTypeNormal))
+
+	// Without the OVN dataplane, the bridge MTU fits a 1500 underlay with IPv6 geneve encapsulation.
+	assert.Equal(t, "1422", n.config["bridge.mtu"])
+	assert.NotEmpty(t, n.config[ovnVolatileRouterHwaddr])
+
+	_, err := n.ovnnb.GetLogicalRouter(ctx, n.getRouterName())
+	require.NoError(t, err)
+
+	_, err = n.ovnnb.GetLogicalSwitch(ctx, n.getIntSwitchName())
+	require.NoError(t, err)
+
+	routerPort, err := n.ovnnb.GetLogicalRouterPort(ctx, n.getRouterIntPortName())
+	require.NoError(t, err)
+	assert.Equal(t, n.config[ovnVolatileRouterHwaddr], routerPort.MAC)
+	assert.ElementsMatch(t, []string{"10.10.10.1/24", "fd42:10::1/64"}, routerPort.Networks)
+
+	dhcpOpts, err := n.ovnnb.GetLogicalSwitchDHCPOptions(ctx, n.getIntSwitchName())
+	require.NoError(t, err)
+
+	subnets := []string{}
+	for _, opts := range dhcpOpts {
+		subnets = append(subnets, opts.CIDR.String())
+	}
+
+	assert.ElementsMatch(t, []string{"10.10.10.0/24", "fd42:10::/64"}, subnets)
+
+	// The derived settings are stored so that they stay stable.
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		_, dbNetwork, _, err := tx.GetNetworkInAnyState(ctx, api.ProjectDefaultName, "ovn0")
+		if err != nil {
+			return err
+		}
+
+		assert.Equal(t, n.config["bridge.mtu"], dbNetwork.Config["bridge.mtu"])
+		assert.Equal(t, n.config[ovnVolatileRouterHwaddr], dbNetwork.Config[ovnVolatileRouterHwaddr])
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// Updating the network re-applies the changed settings to the existing logical network.
+func TestOVN_Update(t *testing.T) {
+	n := newTestOVN(t)
+	ctx := context.Background()
+
+	require.NoError(t, n.Create(request.ClientTypeNormal))
+
+	routerMAC := n.config[ovnVolatileRouterHwaddr]
+
+	newNetwork := api.NetworkPut{Description: n.description, Config: maps.Clone(n.config)}
+	newNetwork.Config["ipv4.address"] = "10.20.20.1/24"
+
+	require.NoError(t, n.Update(newNetwork, "", request.ClientTypeNormal))
+
+	routerPort, err := n.ovnnb.GetLogicalRouterPort(ctx, n.getRouterIntPortName())
+	require.NoError(t, err)
+	assert.Equal(t, routerMAC, routerPort.MAC)
+	assert.ElementsMatch(t, []string{"10.20.20.1/24", "fd42:10::1/64"}, routerPort.Networks)
+
+	dhcpOpts, err := n.ovnnb.GetLogicalSwitchDHCPOptions(ctx, n.getIntSwitchName())
+	require.NoError(t, err)
+
+	subnets := []string{}
+	for _, opts := range dhcpOpts {
+		subnets = append(subnets, opts.CIDR.String())
+	}
+
+	assert.ElementsMatch(t, []string{"10.20.20.0/24", "fd42:10::/64"}, subnets)
+
+	// Applying the same config again is a no-op.
+	require.NoError(t, n.Update(newNetwork, "", request.ClientTypeNormal))
+}
+
+// Internal forwards are applied as OVN load balancers on an isolated network and removed along with the forward.
+func TestOVN_Forward(t *testing.T) {
+	n := newTestOVN(t)
+	ctx := context.Background()
+
+	require.NoError(t, n.Create(request.ClientTypeNormal))
+
+	forward := api.NetworkForwardsPost{
+		ListenAddress: "10.10.10.200",
+		NetworkForwardPut: api.NetworkForwardPut{
+			Config: map[string]string{"scope": "internal", "target_address": "10.10.10.10"},
+			Ports: []api.NetworkForwardPort{
+				{Protocol: "tcp", ListenPort: "80", TargetPort: "8080", TargetAddress: "10.10.10.11"},
+			},
+		},
+	}
+
+	listenAddress, err := n.ForwardCreate(ctx, forward, request.ClientTypeNormal)
+	require.NoError(t, err)
+	assert.Equal(t, "10.10.10.200", listenAddress.String())
+
+	lbTCP, err := n.ovnnb.GetLoadBalancer(ctx, networkOVN.OVNLoadBalancer(n.getLoadBalancerName("10.10.10.200")+"-tcp"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"10.10.10.200": "10.10.10.10", "10.10.10.200:80": "10.10.10.11:8080"}, lbTCP.Vips)
+
+	lbUDP, err := n.ovnnb.GetLoadBalancer(ctx, networkOVN.OVNLoadBalancer(n.getLoadBalancerName("10.10.10.200")+"-udp"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"10.10.10.200": "10.10.10.10"}, lbUDP.Vips)
+
+	// Creating the exact same forward again is a no-op while a different one conflicts.
+	_, err = n.ForwardCreate(ctx, forward, request.ClientTypeNormal)
+	require.NoError(t, err)
+
+	forward.Config["target_address"] = "10.10.10.12"
+	_, err = n.ForwardCreate(ctx, forward, request.ClientTypeNormal)
+	assert.Error(t, err)
+
+	// Forwards using the uplink can't be applied on an isolated network.
+	_, err = n.ForwardCreate(ctx, api.NetworkForwardsPost{ListenAddress: "192.0.2.1"}, request.ClientTypeNormal)
+	assert.Error(t, err)
+
+	require.NoError(t, n.ForwardDelete(ctx, "10.10.10.200", request.ClientTypeNormal))
+
+	exists, err := n.loadBalancerExists(ctx, "10.10.10.200")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	err = n.state.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := dbCluster.GetNetworkForward(ctx, tx.Tx(), n.ID(), "10.10.10.200")
+
+		return err
+	})
+	assert.True(t, api.StatusErrorCheck(err, http.StatusNotFound))
+}
+
+// Internal load balancers spread their ports over the backends and reject backends outside of the network.
+func TestOVN_LoadBalancer(t *testing.T) {
+	n := newTestOVN(t)
+	ctx := context.Background()
+
+	require.NoError(t, n.Create(request.ClientTypeNormal))
+
+	loadBalancer := api.NetworkLoadBalancersPost{
+		ListenAddress: "fd42:10::200",
+		NetworkLoadBalancerPut: api.NetworkLoadBalancerPut{
+			Config: map[string]string{"scope": "internal"},
+			Backends: []api.NetworkLoadBalancerBackend{
+				{Name: "b1", TargetAddress: "fd42:10::11", TargetPort: "8080"},
+				{Name: "b2", TargetAddress: "fd42:10::12", TargetPort: "8080"},
+			},
+			Ports: []api.NetworkLoadBalancerPort{
+				{Protocol: "tcp", ListenPort: "80", TargetBackend: []string{"b1", "b2"}},
+			},
+		},
+	}
+
+	listenAddress, err := n.LoadBalancerCreate(ctx, loadBalancer, request.ClientTypeNormal)
+	require.NoError(t, err)
+	assert.Equal(t, "fd42:10::200", listenAddress.String())
+
+	lbTCP, err := n.ovnnb.GetLoadBalancer(ctx, networkOVN.OVNLoadBalancer(n.getLoadBalancerName("fd42:10::200")+"-tcp"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"[fd42:10::200]:80": "[fd42:10::11]:8080,[fd42:10::12]:8080"}, lbTCP.Vips)
+
+	// Only the used protocols get a load balancer.
+	_, err = n.ovnnb.GetLoadBalancer(ctx, networkOVN.OVNLoadBalancer(n.getLoadBalancerName("fd42:10::200")+"-udp"))
+	assert.True(t, errors.Is(err, networkOVN.ErrNotFound))
+
+	// Backends must be within the network.
+	invalid := api.NetworkLoadBalancersPost{
+		ListenAddress: "fd42:10::201",
+		NetworkLoadBalancerPut: api.NetworkLoadBalancerPut{
+			Config:   map[string]string{"scope": "internal"},
+			Backends: []api.NetworkLoadBalancerBackend{{Name: "b1", TargetAddress: "fd42:20::11"}},
+			Ports:    []api.NetworkLoadBalancerPort{{Protocol: "tcp", ListenPort: "80", TargetBackend: []string{"b1"}}},
+		},
+	}
+
+	_, err = n.LoadBalancerCreate(ctx, invalid, request.ClientTypeNormal)
+	assert.Error(t, err)
+
+	exists, err := n.loadBalancerExists(ctx, "fd42:10::201")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, n.LoadBalancerDelete(ctx, "fd42:10::200", request.ClientTypeNormal))
+
+	exists, err = n.loadBalancerExists(ctx, "fd42:10::200")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"net/http"
 	"slices"
 	"strconv"
 
 	"github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/ip"
 	"github.com/lxc/incus/v6/internal/server/network/ovs"
 	"github.com/lxc/incus/v6/shared/api"
@@ -31,7 +33,7 @@ func (n *physical) DBType() db.NetworkType {
 }
 
 // Validate network config.
-func (n *physical) Validate(config map[string]string) error {
+func (n *physical) Validate(ctx context.Context, config map[string]string) error {
 	rules := map[string]func(value string) error{
 		// gendoc:generate(entity=network_physical, group=common, key=parent)
 		//
@@ -112,6 +114,33 @@ func (n *physical) Validate(config map[string]string) error {
 		// shortdesc: Comma-separated list of IPv6 ranges to use for child OVN network routers (FIRST-LAST format)
 		"ipv6.ovn.ranges": validate.Optional(validate.IsListOf(validate.IsNetworkRangeV6)),
 
+		// gendoc:generate(entity=network_physical, group=ipv6, key=ipv6.ovn.ranges.required)
+		// When disabled, child OVN network routers get an EUI64 address from the uplink subnet if `ipv6.ovn.ranges` isn't set.
+		// ---
+		// type: bool
+		// condition: -
+		// defaultdesc: `false`
+		// shortdesc: Whether to require `ipv6.ovn.ranges` rather than using EUI64 addresses for child OVN network routers
+		"ipv6.ovn.ranges.required": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_physical, group=ipv4, key=ipv4.ovn.unnumbered)
+		// When enabled, child OVN network routers get a link-local address (`169.254.0.0/16`) instead of one from `ipv4.ovn.ranges` and reach `ipv4.gateway` directly through the uplink.
+		// ---
+		// type: bool
+		// condition: `ovn.ingress_mode` set to `routed`
+		// defaultdesc: `false`
+		// shortdesc: Whether child OVN network routers attach to the uplink without an IPv4 address from the uplink subnet
+		"ipv4.ovn.unnumbered": validate.Optional(validate.IsBool),
+
+		// gendoc:generate(entity=network_physical, group=ipv6, key=ipv6.ovn.unnumbered)
+		// When enabled, child OVN network routers only get their IPv6 link-local address and reach `ipv6.gateway` directly through the uplink.
+		// ---
+		// type: bool
+		// condition: `ovn.ingress_mode` set to `routed`
+		// defaultdesc: `false`
+		// shortdesc: Whether child OVN network routers attach to the uplink without an IPv6 address from the uplink subnet
+		"ipv6.ovn.unnumbered": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=network_physical, group=ipv4, key=ipv4.routes)
 		//
 		// ---
@@ -154,6 +183,16 @@ func (n *physical) Validate(config map[string]string) error {
 		// shortdesc: List of DNS server IPs on `physical` network
 		"dns.nameservers": validate.Optional(validate.IsListOf(validate.IsNetworkAddress)),
 
+		// gendoc:generate(entity=network_physical, group=ovn, key=ovn.cluster_groups)
+		// Use this when the uplink only exists on some of the cluster members, for example a single rack.
+		// The routers of `ovn` downstream networks are then only scheduled on the members of those groups.
+		// ---
+		// type: string
+		// condition: standard mode
+		// defaultdesc: - (all members)
+		// shortdesc: Comma-separated list of cluster groups whose members have access to the uplink
+		"ovn.cluster_groups": validate.Optional(validate.IsListOf(validate.IsNotEmpty)),
+
 		// gendoc:generate(entity=network_physical, group=ovn, key=ovn.ingress_mode)
 		//
 		// ---
@@ -163,6 +202,15 @@ func (n *physical) Validate(config map[string]string) error {
 		// shortdesc: Sets the method how OVN NIC external IPs will be advertised on uplink network: `l2proxy` (proxy ARP/NDP) or `routed`
 		"ovn.ingress_mode": validate.Optional(validate.IsOneOf("l2proxy", "routed")),
 
+		// gendoc:generate(entity=network_physical, group=ovn, key=ovn.l2proxy.aggregate)
+		//
+		// ---
+		// type: bool
+		// condition: `ovn.ingress_mode` set to `l2proxy`
+		// defaultdesc: `false`
+		// shortdesc: Proxy ARP/NDP for whole OVN NIC external routes on the OVN router port rather than through per-address NAT rules (lifts the size limit of external routes, requires OVN support for prefixes in `arp_proxy`)
+		"ovn.l2proxy.aggregate": validate.Optional(validate.IsBool),
+
 		"volatile.last_state.created": validate.Optional(validate.IsBool),
 	}
 
@@ -212,6 +260,42 @@ func (n *physical) Validate(config map[string]string) error {
 		return err
 	}
 
+	// Check the cluster groups the uplink is restricted to exist.
+	if config["ovn.cluster_groups"] != "" {
+		err = n.state.DB.Cluster.Transaction(n.state.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+			for _, groupName := range util.SplitNTrimSpace(config["ovn.cluster_groups"], ",", -1, false) {
+				exists, err := dbCluster.ClusterGroupExists(ctx, tx.Tx(), groupName)
+				if err != nil {
+					return err
+				}
+
+				if !exists {
+					return api.StatusErrorf(http.StatusBadRequest, "Cluster group %q doesn't exist", groupName)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Unnumbered uplinks can only attract traffic for OVN networks through routing (BGP).
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		if util.IsFalseOrEmpty(config[fmt.Sprintf("%s.ovn.unnumbered", keyPrefix)]) {
+			continue
+		}
+
+		if config["ovn.ingress_mode"] != "routed" {
+			return fmt.Errorf(`%q requires "ovn.ingress_mode" to be "routed"`, fmt.Sprintf("%s.ovn.unnumbered", keyPrefix))
+		}
+
+		if config[fmt.Sprintf("%s.gateway", keyPrefix)] == "" {
+			return fmt.Errorf("%q requires %q to be set", fmt.Sprintf("%s.ovn.unnumbered", keyPrefix), fmt.Sprintf("%s.gateway", keyPrefix))
+		}
+	}
+
 	return nil
 }
 
@@ -521,6 +605,9 @@ func (n *physical) Update(newNetwork api.NetworkPut, targetNode string, clientTy
 	// doesn't prevent the network itself from being updated.
 	if clientType == request.ClientTypeNormal && len(changedKeys) > 0 {
 		n.common.notifyDependentNetworks(changedKeys)
+	} else if slices.Contains(changedKeys, "ovn.cluster_groups") {
+		// Each member applies its own access to the uplink to the dependent networks.
+		n.common.notifyDependentNetworks([]string{"ovn.cluster_groups"})
 	}
 
 	return nil
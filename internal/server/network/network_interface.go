@@ -1,19 +1,23 @@
 package network
 
 import (
+	"context"
 	"net"
 
 	"github.com/lxc/incus/v6/internal/iprange"
 	"github.com/lxc/incus/v6/internal/server/cluster"
 	"github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
+	deviceConfig "github.com/lxc/incus/v6/internal/server/device/config"
 	"github.com/lxc/incus/v6/internal/server/state"
 	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/revert"
 )
 
 // Type represents a network driver type.
 type Type interface {
-	FillConfig(config map[string]string) error
+	FillConfig(ctx context.Context, config map[string]string) error
+	RenderConfigTemplates(config map[string]string) error
 	Info() Info
 	ValidateName(name string) error
 	Type() string
@@ -28,7 +32,7 @@ type Network interface {
 	init(s *state.State, id int64, projectName string, netInfo *api.Network, netNodes map[int64]db.NetworkNode) error
 
 	// Config.
-	Validate(config map[string]string) error
+	Validate(ctx context.Context, config map[string]string) error
 	ID() int64
 	Name() string
 	Project() string
@@ -50,28 +54,59 @@ type Network interface {
 	Stop() error
 	Rename(name string) error
 	Update(newNetwork api.NetworkPut, targetNode string, clientType request.ClientType) error
+	DetachUplink(ctx context.Context, clientType request.ClientType) error
 	HandleHeartbeat(heartbeatData *cluster.APIHeartbeat) error
 	Delete(clientType request.ClientType) error
 	handleDependencyChange(netName string, netConfig map[string]string, changedKeys []string) error
+	ProjectConfigUpdated(oldProjectConfig map[string]string) error
+	RemapTargets(ctx context.Context, newConfig map[string]string) (revert.Hook, error)
 
 	// Status.
-	State() (*api.NetworkState, error)
-	Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error)
+	State(ctx context.Context) (*api.NetworkState, error)
+	Leases(ctx context.Context, projectName string, clientType request.ClientType) ([]api.NetworkLease, error)
+	InstanceAddresses(ctx context.Context) (map[string]string, error)
+	Health(ctx context.Context) (*api.NetworkHealth, error)
+	OVN() (*api.NetworkOVN, error)
+	Routes() (*api.NetworkRoutes, error)
+	PruneStaleRecords(dryRun bool) ([]string, error)
+	AddressConflicts() ([]AddressConflict, error)
+	InstanceDevicePortDiff(ctx context.Context, instanceUUID string, deviceName string, deviceConfig deviceConfig.Device, running bool) (*api.InstanceInterfaceOVN, error)
+
+	// Replication.
+	Replicate() error
+
+	// Traffic accounting.
+	LocalTraffic(ctx context.Context) ([]TrafficCounter, error)
 
 	// Address Forwards.
-	ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error
-	ForwardUpdate(listenAddress string, newForward api.NetworkForwardPut, clientType request.ClientType) error
-	ForwardDelete(listenAddress string, clientType request.ClientType) error
+	ForwardCreate(ctx context.Context, forward api.NetworkForwardsPost, clientType request.ClientType) (net.IP, error)
+	ForwardUpdate(ctx context.Context, listenAddress string, newForward api.NetworkForwardPut, clientType request.ClientType) error
+	ForwardRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) error
+	ForwardDelete(ctx context.Context, listenAddress string, clientType request.ClientType) error
+	ListenAddressStatus(config map[string]string) string
 
 	// Load Balancers.
-	LoadBalancerCreate(loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) error
-	LoadBalancerUpdate(listenAddress string, newLoadBalancer api.NetworkLoadBalancerPut, clientType request.ClientType) error
-	LoadBalancerState(loadbalancer api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error)
-	LoadBalancerDelete(listenAddress string, clientType request.ClientType) error
+	LoadBalancerCreate(ctx context.Context, loadBalancer api.NetworkLoadBalancersPost, clientType request.ClientType) (net.IP, error)
+	LoadBalancerUpdate(ctx context.Context, listenAddress string, newLoadBalancer api.NetworkLoadBalancerPut, clientType request.ClientType) error
+	LoadBalancerState(ctx context.Context, loadbalancer api.NetworkLoadBalancer) (*api.NetworkLoadBalancerState, error)
+	LoadBalancerRename(ctx context.Context, listenAddress string, newListenAddress string, clientType request.ClientType) error
+	LoadBalancerDelete(ctx context.Context, listenAddress string, clientType request.ClientType) error
+	LoadBalancerProbe() error
+
+	// Target groups.
+	TargetGroupsRefresh(ctx context.Context) error
+
+	// External ports.
+	ExternalPortCreate(port api.NetworkExternalPortsPost) error
+	ExternalPortUpdate(portName string, newPort api.NetworkExternalPortPut) error
+	ExternalPortDelete(portName string) error
 
 	// Peerings.
-	PeerCreate(forward api.NetworkPeersPost) error
-	PeerUpdate(peerName string, newPeer api.NetworkPeerPut) error
-	PeerDelete(peerName string) error
+	PeerCreate(ctx context.Context, forward api.NetworkPeersPost) error
+	PeerUpdate(ctx context.Context, peerName string, newPeer api.NetworkPeerPut) error
+	PeerDelete(ctx context.Context, peerName string) error
+	PeerApprove(ctx context.Context, peerName string, approve bool) error
+	PeerFailover(ctx context.Context) error
+	PeerRoutes(ctx context.Context, peerName string) (*api.NetworkPeerRoutes, error)
 	PeerUsedBy(peerName string) ([]string, error)
 }
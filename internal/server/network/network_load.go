@@ -29,15 +29,15 @@ var (
 	unavailableNetworksMu = sync.Mutex{}
 )
 
-// LoadByType loads a network by driver type.
-func LoadByType(driverType string) (Type, error) {
+// LoadByType loads a network by driver type, for the network with the given project and name that is about to be created.
+func LoadByType(driverType string, projectName string, name string) (Type, error) {
 	driverFunc, ok := drivers[driverType]
 	if !ok {
 		return nil, ErrUnknownDriver
 	}
 
 	n := driverFunc()
-	err := n.init(nil, -1, "", &api.Network{Type: driverType}, nil)
+	err := n.init(nil, -1, projectName, &api.Network{Name: name, Type: driverType}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9,9 +9,11 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"math"
 	"math/big"
 	"math/rand"
 	"net"
+	"net/http"
 	"net/netip"
 	"os"
 	"slices"
@@ -20,6 +22,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/mdlayher/arp"
+	"github.com/mdlayher/ndp"
+
 	"github.com/lxc/incus/v6/internal/iprange"
 	"github.com/lxc/incus/v6/internal/server/db"
 	"github.com/lxc/incus/v6/internal/server/db/cluster"
@@ -80,6 +85,16 @@ func MACDevName(mac net.HardwareAddr) string {
 	return fmt.Sprintf("inc%s", devName[2:])
 }
 
+// OVNIntegrationBridge returns the OVS integration bridge to use for OVN ports in a project.
+// The project's network.ovn.integration_bridge setting takes precedence over the server-wide one.
+func OVNIntegrationBridge(s *state.State, projectConfig map[string]string) string {
+	if projectConfig["network.ovn.integration_bridge"] != "" {
+		return projectConfig["network.ovn.integration_bridge"]
+	}
+
+	return s.GlobalConfig.NetworkOVNIntegrationBridge()
+}
+
 // UsedByInstanceDevices looks for instance NIC devices using the network and runs the supplied usageFunc for each.
 // Accepts optional filter arguments to specify a subset of instances.
 func UsedByInstanceDevices(s *state.State, networkProjectName string, networkName string, networkType string, usageFunc func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error, filters ...cluster.InstanceFilter) error {
@@ -108,7 +123,7 @@ func UsedByInstanceDevices(s *state.State, networkProjectName string, networkNam
 
 		// Skip instances who's effective network project doesn't match this Network's project.
 		if instNetworkProject != networkProjectName {
-			return nil
+			continue
 		}
 
 		// Look for NIC devices using this network.
@@ -279,6 +294,200 @@ func UsedBy(s *state.State, networkProjectName string, networkID int64, networkN
 	return usedBy, nil
 }
 
+// DNSDomainsAllowed checks that the DNS domains used by an OVN network, including the default one, are allowed by
+// the restricted.networks.domains setting of its project.
+func DNSDomainsAllowed(projectConfig map[string]string, netConfig map[string]string) error {
+	if util.IsFalseOrEmpty(projectConfig["restricted"]) || projectConfig["restricted.networks.domains"] == "" {
+		return nil
+	}
+
+	allowedDomains := util.SplitNTrimSpace(projectConfig["restricted.networks.domains"], ",", -1, true)
+
+	domainAllowed := func(domain string) bool {
+		for _, allowedDomain := range allowedDomains {
+			if domain == allowedDomain || strings.HasSuffix(domain, "."+allowedDomain) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	domainName := netConfig["dns.domain"]
+	if domainName == "" {
+		domainName = "incus"
+	}
+
+	if !domainAllowed(domainName) {
+		return api.StatusErrorf(http.StatusForbidden, "Project isn't allowed to use DNS domain %q", domainName)
+	}
+
+	for _, domain := range util.SplitNTrimSpace(netConfig["dns.search"], ",", -1, true) {
+		if !domainAllowed(domain) {
+			return api.StatusErrorf(http.StatusForbidden, "Project isn't allowed to use DNS search domain %q", domain)
+		}
+	}
+
+	return nil
+}
+
+// TargetAddressesUsedBy resolves target addresses to the instances owning them, using the supplied map of addresses
+// to instance URLs. Returns the sorted instance URLs along with the target addresses not matching any instance.
+func TargetAddressesUsedBy(instanceAddresses map[string]string, targetAddresses []string) ([]string, []string) {
+	usedBy := []string{}
+	unmatched := []string{}
+
+	for _, targetAddress := range targetAddresses {
+		ip := net.ParseIP(targetAddress)
+		if ip == nil {
+			continue
+		}
+
+		instanceURL, found := instanceAddresses[ip.String()]
+		if !found {
+			if !slices.Contains(unmatched, ip.String()) {
+				unmatched = append(unmatched, ip.String())
+			}
+
+			continue
+		}
+
+		if !slices.Contains(usedBy, instanceURL) {
+			usedBy = append(usedBy, instanceURL)
+		}
+	}
+
+	slices.Sort(usedBy)
+
+	return usedBy, unmatched
+}
+
+// TargetGroupLockName returns the name of the lock serializing the changes to the network target groups of a project
+// with the creation and update of the forwards and load balancers which may reference them.
+func TargetGroupLockName(projectName string) string {
+	return fmt.Sprintf("network.target_groups.%s", projectName)
+}
+
+// TargetGroupUsedBy returns the URLs of the network forwards and load balancers in the project which reference the
+// target group, along with the names of the networks they belong to.
+func TargetGroupUsedBy(ctx context.Context, s *state.State, projectName string, groupName string) ([]string, []string, error) {
+	usedBy := []string{}
+	networkNames := []string{}
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		networks, err := tx.GetCreatedNetworksByProject(ctx, projectName)
+		if err != nil {
+			return fmt.Errorf("Failed loading networks: %w", err)
+		}
+
+		for networkID, network := range networks {
+			forwards, err := cluster.GetNetworkForwards(ctx, tx.Tx(), cluster.NetworkForwardFilter{NetworkID: &networkID})
+			if err != nil {
+				return fmt.Errorf("Failed loading network forwards of %q: %w", network.Name, err)
+			}
+
+			loadBalancers, err := cluster.GetNetworkLoadBalancers(ctx, tx.Tx(), cluster.NetworkLoadBalancerFilter{NetworkID: &networkID})
+			if err != nil {
+				return fmt.Errorf("Failed loading network load balancers of %q: %w", network.Name, err)
+			}
+
+			networkUsedBy := targetGroupUsedByNetwork(projectName, network.Name, groupName, forwards, loadBalancers)
+			if len(networkUsedBy) > 0 {
+				usedBy = append(usedBy, networkUsedBy...)
+				networkNames = append(networkNames, network.Name)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usedBy, networkNames, nil
+}
+
+// targetGroupUsedByNetwork returns the URLs of the given forwards and load balancers of a network which reference
+// the target group.
+func targetGroupUsedByNetwork(projectName string, networkName string, groupName string, forwards []cluster.NetworkForward, loadBalancers []cluster.NetworkLoadBalancer) []string {
+	usedBy := []string{}
+
+	for _, forward := range forwards {
+		if slices.ContainsFunc(forward.Ports, func(port api.NetworkForwardPort) bool { return port.TargetGroup == groupName }) {
+			usedBy = append(usedBy, api.NewURL().Path(version.APIVersion, "networks", networkName, "forwards", forward.ListenAddress).Project(projectName).String())
+		}
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		if slices.ContainsFunc(loadBalancer.Backends, func(backend api.NetworkLoadBalancerBackend) bool { return backend.TargetGroup == groupName }) {
+			usedBy = append(usedBy, api.NewURL().Path(version.APIVersion, "networks", networkName, "load-balancers", loadBalancer.ListenAddress).Project(projectName).String())
+		}
+	}
+
+	return usedBy
+}
+
+// TargetGroupValidateName checks the target group name is valid.
+func TargetGroupValidateName(name string) error {
+	if name == "" {
+		return errors.New("Name is required")
+	}
+
+	return validate.IsHostname(name)
+}
+
+// TargetGroupValidate checks the target group addresses, instances and configuration are valid.
+func TargetGroupValidate(group *api.NetworkTargetGroupPut) error {
+	seenAddresses := make(map[string]struct{}, len(group.Addresses))
+	for i, address := range group.Addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return fmt.Errorf("Invalid target address %q at index %d", address, i)
+		}
+
+		if !ip.IsGlobalUnicast() {
+			return fmt.Errorf("Target address %q at index %d must be a unicast address", address, i)
+		}
+
+		_, exists := seenAddresses[address]
+		if exists {
+			return fmt.Errorf("Duplicate target address %q at index %d", address, i)
+		}
+
+		seenAddresses[address] = struct{}{}
+	}
+
+	seenInstances := make(map[string]struct{}, len(group.Instances))
+	for i, instanceName := range group.Instances {
+		err := validate.IsHostname(instanceName)
+		if err != nil {
+			return fmt.Errorf("Invalid instance name %q at index %d: %w", instanceName, i, err)
+		}
+
+		_, exists := seenInstances[instanceName]
+		if exists {
+			return fmt.Errorf("Duplicate instance %q at index %d", instanceName, i)
+		}
+
+		seenInstances[instanceName] = struct{}{}
+	}
+
+	for k := range group.Config {
+		// gendoc:generate(entity=network_target_group, group=common, key=user.*)
+		//
+		// ---
+		//  type: string
+		//  shortdesc: User defined key/value configuration
+		if strings.HasPrefix(k, "user.") {
+			continue
+		}
+
+		return fmt.Errorf("Invalid network target group configuration key %q", k)
+	}
+
+	return nil
+}
+
 // usedByProfileDevices indicates if network is referenced by a profile's NIC devices.
 // Checks if the device's parent or network properties match the network name.
 func usedByProfileDevices(s *state.State, profileDevices map[string]cluster.Device, profileProject *api.Project, networkProjectName string, networkName string, networkType string) (bool, error) {
@@ -962,19 +1171,17 @@ func usesIPv6Firewall(netConfig map[string]string) bool {
 	return false
 }
 
-// RandomHwaddr generates a random MAC address from the provided random source.
-func randomHwaddr(r *rand.Rand) string {
-	// Generate a new random MAC address using the usual prefix.
-	ret := bytes.Buffer{}
-	for _, c := range "10:66:6a:xx:xx:xx" {
-		if c == 'x' {
-			ret.WriteString(fmt.Sprintf("%x", r.Int31n(16)))
-		} else {
-			ret.WriteString(string(c))
-		}
+// randomHwaddr generates a random MAC address from the provided random source, using the configured MAC address prefix.
+func randomHwaddr(s *state.State, r *rand.Rand) string {
+	prefix := localUtil.DefaultHwaddrPrefix
+	if s.GlobalConfig != nil {
+		prefix = s.GlobalConfig.NetworkHwaddrPrefix()
 	}
 
-	return ret.String()
+	// The random source can't fail.
+	hwaddr, _ := localUtil.RandomHwaddr(prefix, func() (int64, error) { return int64(r.Int31n(16)), nil })
+
+	return hwaddr
 }
 
 // parseIPRange parses an IP range in the format "start-end" and converts it to a iprange.Range.
@@ -1157,6 +1364,23 @@ func IPInSlice(key net.IP, list []net.IP) bool {
 	return false
 }
 
+// externalRouteOriginValidate checks that an instance NIC external route can be originated by an OVN network
+// whose uplink uses routed ingress. The route must be within one of the uplink's routes, as the uplink's own
+// subnet isn't routed towards the network, and the network needs a next-hop address in the route's family.
+func externalRouteOriginValidate(uplinkRoutes []*net.IPNet, nexthop net.IP, route *net.IPNet) error {
+	if nexthop == nil || nexthop.IsUnspecified() || (nexthop.To4() == nil) != (route.IP.To4() == nil) {
+		return fmt.Errorf("External route %q has no next-hop address on the uplink network", route.String())
+	}
+
+	for _, uplinkRoute := range uplinkRoutes {
+		if SubnetContains(uplinkRoute, route) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("External route %q isn't within the uplink network's routes", route.String())
+}
+
 // SubnetContains returns true if outerSubnet contains innerSubnet.
 func SubnetContains(outerSubnet *net.IPNet, innerSubnet *net.IPNet) bool {
 	if outerSubnet == nil || innerSubnet == nil {
@@ -1235,6 +1459,61 @@ func SubnetIterate(subnet *net.IPNet, ipFunc func(ip net.IP) error) error {
 	return nil
 }
 
+// forwardDefaultTargetAddress returns the default target address of a forward for the family of its listen address.
+// The family specific target_address.ipv4 and target_address.ipv6 keys are used when target_address isn't set.
+func forwardDefaultTargetAddress(listenAddress net.IP, config map[string]string) net.IP {
+	if config["target_address"] != "" {
+		return net.ParseIP(config["target_address"])
+	}
+
+	if listenAddress.To4() != nil {
+		return net.ParseIP(config["target_address.ipv4"])
+	}
+
+	return net.ParseIP(config["target_address.ipv6"])
+}
+
+// forwardPortProtocols returns the protocols covered by a forward port protocol, expanding "any" into TCP and UDP.
+func forwardPortProtocols(protocol string) []string {
+	if protocol == "any" {
+		return []string{"tcp", "udp"}
+	}
+
+	return []string{protocol}
+}
+
+// subnetRemapIP returns the address found at the same host offset within newSubnet as ip is within oldSubnet.
+func subnetRemapIP(ip net.IP, oldSubnet *net.IPNet, newSubnet *net.IPNet) (net.IP, error) {
+	if !oldSubnet.Contains(ip) {
+		return nil, fmt.Errorf("Address %q isn't within subnet %q", ip.String(), oldSubnet.String())
+	}
+
+	// Use the native representation of each family so offsets can be computed.
+	ipBytes := ip.To4()
+	oldBytes := oldSubnet.IP.To4()
+	newBytes := newSubnet.IP.To4()
+	if ipBytes == nil {
+		ipBytes = ip.To16()
+		oldBytes = oldSubnet.IP.To16()
+		newBytes = newSubnet.IP.To16()
+	}
+
+	if newBytes == nil || len(oldBytes) != len(newBytes) {
+		return nil, fmt.Errorf("Subnet %q isn't of the same family as %q", newSubnet.String(), oldSubnet.String())
+	}
+
+	offset := big.NewInt(0).Sub(big.NewInt(0).SetBytes(ipBytes), big.NewInt(0).SetBytes(oldBytes))
+
+	ones, bits := newSubnet.Mask.Size()
+	if offset.BitLen() > bits-ones {
+		return nil, fmt.Errorf("Address %q doesn't fit within subnet %q", ip.String(), newSubnet.String())
+	}
+
+	newIP := big.NewInt(0).Add(big.NewInt(0).SetBytes(newBytes), offset)
+
+	return net.IP(newIP.FillBytes(make([]byte, len(newBytes)))), nil
+}
+
 // SubnetParseAppend parses one or more string CIDR subnets. Appends to the supplied slice. Returns subnets slice.
 func SubnetParseAppend(subnets []*net.IPNet, parseSubnet ...string) ([]*net.IPNet, error) {
 	for _, subnetStr := range parseSubnet {
@@ -1249,6 +1528,70 @@ func SubnetParseAppend(subnets []*net.IPNet, parseSubnet ...string) ([]*net.IPNe
 	return subnets, nil
 }
 
+// StaticNeighbor represents a static IP to MAC address mapping.
+type StaticNeighbor struct {
+	IP  net.IP
+	MAC net.HardwareAddr // Optional, nil if not specified.
+}
+
+// StaticNeighborsParse parses a comma-separated list of static neighbors in the form <address>[=<MAC>].
+func StaticNeighborsParse(value string) ([]StaticNeighbor, error) {
+	neighbors := []StaticNeighbor{}
+
+	for _, entry := range util.SplitNTrimSpace(value, ",", -1, true) {
+		address, macStr, hasMAC := strings.Cut(entry, "=")
+
+		ip := net.ParseIP(strings.TrimSpace(address))
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid static neighbor address %q", address)
+		}
+
+		neighbor := StaticNeighbor{IP: ip}
+
+		if hasMAC {
+			mac, err := net.ParseMAC(strings.TrimSpace(macStr))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid static neighbor MAC address %q: %w", macStr, err)
+			}
+
+			neighbor.MAC = mac
+		}
+
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors, nil
+}
+
+// natPublishedIPs returns the NIC addresses that need to be published on the uplink network with a stateless
+// dnat_and_snat rule. Families using NAT on the network or explicitly disabled on the network or the NIC (set to
+// "none") are never published, even if an address of that family was found for the NIC.
+func natPublishedIPs(netConfig map[string]string, deviceConfig map[string]string, ipv4 net.IP, ipv6 net.IP) []net.IP {
+	ips := []net.IP{}
+
+	for _, family := range []struct {
+		prefix string
+		ip     net.IP
+		isV4   bool
+	}{{"ipv4", ipv4, true}, {"ipv6", ipv6, false}} {
+		if family.ip == nil || (family.ip.To4() != nil) != family.isV4 {
+			continue // No qualifying address for the family.
+		}
+
+		if util.IsTrue(netConfig[family.prefix+".nat"]) {
+			continue // Family is reachable through the network's NAT address.
+		}
+
+		if netConfig[family.prefix+".address"] == "none" || deviceConfig[family.prefix+".address"] == "none" {
+			continue // Family is disabled on the network or on the NIC.
+		}
+
+		ips = append(ips, family.ip)
+	}
+
+	return ips
+}
+
 // IPRangesOverlap checks whether two ip ranges have ip addresses in common.
 func IPRangesOverlap(r1, r2 *iprange.Range) bool {
 	if r1.End == nil {
@@ -1462,6 +1805,73 @@ func ProxyParseAddr(data string) (*deviceConfig.ProxyAddress, error) {
 	return newProxyAddr, nil
 }
 
+// validateNameservers checks that the value is a list of unique unicast DNS server addresses.
+func validateNameservers(value string) error {
+	seen := make(map[string]struct{})
+
+	for _, entry := range util.SplitNTrimSpace(value, ",", -1, false) {
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return fmt.Errorf("Not an IP address %q", entry)
+		}
+
+		if ip.IsUnspecified() || ip.IsMulticast() {
+			return fmt.Errorf("Nameserver %q must be a unicast address", entry)
+		}
+
+		_, found := seen[ip.String()]
+		if found {
+			return fmt.Errorf("Duplicate nameserver %q", entry)
+		}
+
+		seen[ip.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateDNSSearchList checks that the value is a list of unique domain names which fits in the DHCPv4 domain
+// search option (option 119, limited to 255 bytes once encoded).
+func validateDNSSearchList(value string) error {
+	seen := make(map[string]struct{})
+	encodedLen := 0
+
+	for _, entry := range util.SplitNTrimSpace(value, ",", -1, false) {
+		domain := strings.ToLower(strings.TrimSuffix(entry, "."))
+		if domain == "" || len(domain) > 253 {
+			return fmt.Errorf("Invalid domain name %q", entry)
+		}
+
+		for _, label := range strings.Split(domain, ".") {
+			if len(label) < 1 || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+				return fmt.Errorf("Invalid domain name %q", entry)
+			}
+
+			for _, r := range label {
+				if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+					return fmt.Errorf("Invalid domain name %q", entry)
+				}
+			}
+		}
+
+		_, found := seen[domain]
+		if found {
+			return fmt.Errorf("Duplicate domain %q", entry)
+		}
+
+		seen[domain] = struct{}{}
+
+		// Each domain is encoded as length prefixed labels followed by the root label.
+		encodedLen += len(domain) + 2
+	}
+
+	if encodedLen > 255 {
+		return fmt.Errorf("Domain search list is too long to be advertised over DHCPv4 (%d bytes encoded, maximum 255)", encodedLen)
+	}
+
+	return nil
+}
+
 func validateExternalInterfaces(value string) error {
 	for _, entry := range strings.Split(value, ",") {
 		entry = strings.TrimSpace(entry)
@@ -1564,3 +1974,223 @@ func ipInRanges(ipAddr net.IP, ipRanges []iprange.Range) bool {
 
 	return false
 }
+
+// IsIPInUse checks if address responds to ARP/NDP neighbour probe on the parentInterface.
+// Returns true if IP is in use.
+func IsIPInUse(ctx context.Context, address net.IP, parentInterface string) (bool, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// Set default timeout of 500ms if no deadline context provided.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(500*time.Millisecond))
+		defer cancel()
+		deadline, _ = ctx.Deadline()
+	}
+
+	// Handle IPv4 address.
+	if address.To4() != nil {
+		err := pingOverIfaceByName(deadline, address, parentInterface)
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	// Handle IPv6 address.
+	networkInterface, err := net.InterfaceByName(parentInterface)
+	if err != nil {
+		return false, err
+	}
+
+	conn, _, err := ndp.Listen(networkInterface, ndp.LinkLocal)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	netipAddr, ok := netip.AddrFromSlice(address)
+	if !ok {
+		return false, errors.New("Couldn't convert address to netip")
+	}
+
+	solicitedNodeMulticast, err := ndp.SolicitedNodeMulticast(netipAddr)
+	if err != nil {
+		return false, err
+	}
+
+	neighbourSolicitationMessage := &ndp.NeighborSolicitation{
+		TargetAddress: netipAddr,
+	}
+
+	_ = conn.SetDeadline(deadline)
+	err = conn.WriteTo(neighbourSolicitationMessage, nil, solicitedNodeMulticast)
+	if err != nil {
+		return false, err
+	}
+
+	_ = conn.SetDeadline(deadline)
+	msg, _, _, err := conn.ReadFrom()
+	if err != nil {
+		var cause net.Error
+		if errors.As(err, &cause) && cause.Timeout() {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	neighbourAdvertisement, ok := msg.(*ndp.NeighborAdvertisement)
+	if ok && neighbourAdvertisement.TargetAddress == netipAddr {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// pingOverIfaceByName sends an ARP request to the given IPv4 address using the specified network interface.
+// It respects the provided deadline and returns an error if resolution fails (unless due to timeout).
+func pingOverIfaceByName(deadline time.Time, address net.IP, parentInterface string) error {
+	// Obtain the network interface.
+	ifi, err := net.InterfaceByName(parentInterface)
+	if err != nil {
+		return err
+	}
+
+	// Open an ARP client on that interface.
+	c, err := arp.Dial(ifi)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = c.Close() }()
+
+	// Honour the caller’s deadline.
+	_ = c.SetDeadline(deadline)
+
+	// Convert to netip.Addr which arp.Client expects.
+	netipAddr, ok := netip.AddrFromSlice(address.To4())
+	if !ok {
+		return fmt.Errorf("Invalid IPv4 address: %v", address)
+	}
+
+	// Try to resolve the IP → MAC. If it answers, the IP is in use.
+	_, err = c.Resolve(netipAddr)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// icGatewayPriorities returns the chassis group priorities to use for the interconnection gateways of a transit
+// switch, following the gateway selection settings of the network integration.
+// Excluded gateways are left out, the ones listed in preferred (when ovn.gateways.prefer_local is set) always
+// come first and the remaining order is drawn from r, with higher weighted gateways more likely to be ranked first.
+func icGatewayPriorities(gateways []string, preferred []string, config map[string]string, r *rand.Rand) map[string]int {
+	excluded := util.SplitNTrimSpace(config["ovn.gateways.exclude"], ",", -1, true)
+	preferLocal := util.IsTrue(config["ovn.gateways.prefer_local"])
+
+	weights := map[string]float64{}
+	for _, entry := range util.SplitNTrimSpace(config["ovn.gateways.weights"], ",", -1, true) {
+		name, value, _ := strings.Cut(entry, "=")
+
+		weight, err := strconv.Atoi(value)
+		if err == nil && weight > 0 {
+			weights[strings.TrimSpace(name)] = float64(weight)
+		}
+	}
+
+	// Sort the gateways first so the stable random generator always yields the same order.
+	candidates := make([]string, 0, len(gateways))
+	for _, gateway := range gateways {
+		if !slices.Contains(excluded, gateway) {
+			candidates = append(candidates, gateway)
+		}
+	}
+
+	slices.Sort(candidates)
+
+	// Draw a weighted random key for each gateway (u^(1/w)), higher keys are ranked first.
+	keys := make(map[string]float64, len(candidates))
+	for _, gateway := range candidates {
+		weight, ok := weights[gateway]
+		if !ok {
+			weight = 1
+		}
+
+		keys[gateway] = math.Pow(r.Float64(), 1/weight)
+	}
+
+	slices.SortStableFunc(candidates, func(a string, b string) int {
+		if preferLocal {
+			aLocal := slices.Contains(preferred, a)
+			bLocal := slices.Contains(preferred, b)
+			if aLocal != bLocal {
+				if aLocal {
+					return -1
+				}
+
+				return 1
+			}
+		}
+
+		if keys[a] > keys[b] {
+			return -1
+		} else if keys[a] < keys[b] {
+			return 1
+		}
+
+		return 0
+	})
+
+	// Spread the priorities evenly in ranking order.
+	priorities := make(map[string]int, len(candidates))
+	for i, gateway := range candidates {
+		priorities[gateway] = ovnChassisPriorityMax - i*(ovnChassisPriorityMax/len(candidates))
+	}
+
+	return priorities
+}
+
+// filterRoutesByPrefixes splits the routes between the ones contained in one of the comma-separated prefixes and
+// the others. An empty prefix list lets every route through.
+func filterRoutesByPrefixes(routes []net.IPNet, prefixes string) ([]net.IPNet, []net.IPNet) {
+	prefixList := util.SplitNTrimSpace(prefixes, ",", -1, true)
+	if len(prefixList) == 0 {
+		return routes, nil
+	}
+
+	var allowed, filtered []net.IPNet
+	for _, route := range routes {
+		routeOnes, routeBits := route.Mask.Size()
+
+		match := false
+		for _, prefix := range prefixList {
+			_, prefixNet, err := net.ParseCIDR(prefix)
+			if err != nil {
+				continue
+			}
+
+			prefixOnes, prefixBits := prefixNet.Mask.Size()
+			if prefixBits == routeBits && prefixOnes <= routeOnes && prefixNet.Contains(route.IP) {
+				match = true
+				break
+			}
+		}
+
+		if match {
+			allowed = append(allowed, route)
+		} else {
+			filtered = append(filtered, route)
+		}
+	}
+
+	return allowed, filtered
+}
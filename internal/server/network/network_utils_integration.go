@@ -0,0 +1,180 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/flosch/pongo2/v6"
+
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	networkOVN "github.com/lxc/incus/v6/internal/server/network/ovn"
+	"github.com/lxc/incus/v6/internal/server/state"
+	internalUtil "github.com/lxc/incus/v6/internal/util"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// integrationTransitSwitchName renders the name of the transit switch used by a network peer through the integration.
+func integrationTransitSwitchName(integration *api.NetworkIntegration, projectName string, networkName string, peerName string) (string, error) {
+	pattern := integration.Config["ovn.transit.pattern"]
+	if pattern == "" {
+		pattern = "ts-incus-{{ integrationName }}-{{ projectName }}-{{ networkName }}"
+	}
+
+	return internalUtil.RenderTemplate(pattern, pongo2.Context{
+		"projectName":     projectName,
+		"networkName":     networkName,
+		"integrationName": integration.Name,
+		"peerName":        peerName,
+	})
+}
+
+// IntegrationState returns the state of the interconnection layer behind a network integration.
+// Unreachable interconnection databases are reported in the state rather than as an error.
+func IntegrationState(ctx context.Context, s *state.State, integrationName string) (*api.NetworkIntegrationState, error) {
+	type integrationPeer struct {
+		projectName string
+		networkName string
+		peerName    string
+	}
+
+	// Load the integration and the peers using it.
+	var integration *api.NetworkIntegration
+	var peers []integrationPeer
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		entry, err := dbCluster.GetNetworkIntegration(ctx, tx.Tx(), integrationName)
+		if err != nil {
+			return err
+		}
+
+		integration, err = entry.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return err
+		}
+
+		dbPeers, err := dbCluster.GetNetworkPeers(ctx, tx.Tx())
+		if err != nil {
+			return fmt.Errorf("Failed loading network peers: %w", err)
+		}
+
+		for _, dbPeer := range dbPeers {
+			if !dbPeer.TargetNetworkIntegrationID.Valid || dbPeer.TargetNetworkIntegrationID.Int64 != int64(entry.ID) {
+				continue
+			}
+
+			networkName, projectName, err := tx.GetNetworkNameAndProjectWithID(ctx, int(dbPeer.NetworkID))
+			if err != nil {
+				return fmt.Errorf("Failed loading network of peer %q: %w", dbPeer.Name, err)
+			}
+
+			peers = append(peers, integrationPeer{projectName: projectName, networkName: networkName, peerName: dbPeer.Name})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if integration.Type != "ovn" {
+		return nil, fmt.Errorf("Network integration state isn't supported for %q integrations", integration.Type)
+	}
+
+	// Get the local availability zone.
+	ovnnb, _, err := s.OVN()
+	if err != nil {
+		return nil, err
+	}
+
+	azName, err := ovnnb.GetName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN availability zone name: %w", err)
+	}
+
+	integrationState := &api.NetworkIntegrationState{
+		AvailabilityZone: azName,
+		Gateways:         []string{},
+		TransitSwitches:  []api.NetworkIntegrationStateTransitSwitch{},
+		Peers:            []api.NetworkIntegrationStatePeer{},
+	}
+
+	// Get the transit switches.
+	icnb, err := networkOVN.NewICNB(integration.Config["ovn.northbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		integrationState.Northbound.Error = err.Error()
+	} else {
+		integrationState.Northbound.Reachable = true
+
+		transitSwitches, err := icnb.GetTransitSwitches(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Failed getting transit switches: %w", err)
+		}
+
+		for _, transitSwitch := range transitSwitches {
+			integrationState.TransitSwitches = append(integrationState.TransitSwitches, api.NetworkIntegrationStateTransitSwitch{
+				Name:              transitSwitch.Name,
+				AvailabilityZones: transitSwitch.AvailabilityZones,
+			})
+		}
+	}
+
+	// Get the gateways and port bindings.
+	var bindings []networkOVN.ICPortBinding
+	icsb, err := networkOVN.NewICSB(integration.Config["ovn.southbound_connection"], integration.Config["ovn.ca_cert"], integration.Config["ovn.client_cert"], integration.Config["ovn.client_key"])
+	if err != nil {
+		integrationState.Southbound.Error = err.Error()
+	} else {
+		integrationState.Southbound.Reachable = true
+
+		gateways, err := icsb.GetGateways(ctx, azName)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return nil, fmt.Errorf("Failed getting interconnection gateways: %w", err)
+		}
+
+		if gateways != nil {
+			slices.Sort(gateways)
+			integrationState.Gateways = gateways
+		}
+
+		bindings, err = icsb.GetPortBindings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Failed getting transit switch port bindings: %w", err)
+		}
+	}
+
+	// Report the binding state of each peer.
+	for _, peer := range peers {
+		tsName, err := integrationTransitSwitchName(integration, peer.projectName, peer.networkName, peer.peerName)
+		if err != nil {
+			return nil, err
+		}
+
+		peerState := api.NetworkIntegrationStatePeer{
+			Project:                 peer.projectName,
+			Network:                 peer.networkName,
+			Name:                    peer.peerName,
+			TransitSwitch:           tsName,
+			RemoteAvailabilityZones: []string{},
+		}
+
+		for _, binding := range bindings {
+			if binding.TransitSwitch != tsName {
+				continue
+			}
+
+			if binding.AvailabilityZone == azName {
+				peerState.Bound = binding.Gateway != ""
+				peerState.Gateway = binding.Gateway
+			} else if !slices.Contains(peerState.RemoteAvailabilityZones, binding.AvailabilityZone) {
+				peerState.RemoteAvailabilityZones = append(peerState.RemoteAvailabilityZones, binding.AvailabilityZone)
+			}
+		}
+
+		slices.Sort(peerState.RemoteAvailabilityZones)
+		integrationState.Peers = append(integrationState.Peers, peerState)
+	}
+
+	return integrationState, nil
+}
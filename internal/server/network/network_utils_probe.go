@@ -0,0 +1,328 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+// probeNetnsInterface is the name of the probe interface inside of the probe network namespace.
+const probeNetnsInterface = "eth0"
+
+// probeNetnsThread runs the function on a dedicated OS thread and switches that thread back to its original
+// network namespace afterwards. If the namespace can't be restored, the goroutine exits with the thread still
+// locked so that the runtime discards the thread rather than reusing it from the wrong namespace.
+func probeNetnsThread(f func() error) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		origin, err := netns.Get()
+		if err != nil {
+			runtime.UnlockOSThread()
+			errCh <- fmt.Errorf("Failed getting current network namespace: %w", err)
+			return
+		}
+
+		defer func() { _ = origin.Close() }()
+
+		runErr := f()
+
+		err = netns.Set(origin)
+		if err != nil {
+			errCh <- fmt.Errorf("Failed restoring network namespace: %w", err)
+			return
+		}
+
+		runtime.UnlockOSThread()
+		errCh <- runErr
+	}()
+
+	return <-errCh
+}
+
+// probeNetnsRun runs the function from within the named network namespace.
+// Sockets opened by the function remain in the namespace after it returns.
+func probeNetnsRun(nsName string, f func() error) error {
+	target, err := netns.GetFromName(nsName)
+	if err != nil {
+		return fmt.Errorf("Failed opening network namespace %q: %w", nsName, err)
+	}
+
+	defer func() { _ = target.Close() }()
+
+	return probeNetnsThread(func() error {
+		err := netns.Set(target)
+		if err != nil {
+			return fmt.Errorf("Failed entering network namespace %q: %w", nsName, err)
+		}
+
+		return f()
+	})
+}
+
+// probeNetnsSetup creates the named network namespace along with a veth pair whose host side is hostName and
+// configures the namespace side of it with the given MAC address and addresses.
+func probeNetnsSetup(nsName string, hostName string, mac net.HardwareAddr, mtu uint32, addresses []*net.IPNet) error {
+	// Create the namespace if missing. Creating a namespace also switches the current thread to it.
+	_, err := os.Stat(fmt.Sprintf("/run/netns/%s", nsName))
+	if err != nil {
+		err = probeNetnsThread(func() error {
+			created, err := netns.NewNamed(nsName)
+			if err != nil {
+				return fmt.Errorf("Failed creating network namespace %q: %w", nsName, err)
+			}
+
+			return created.Close()
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	nsHandle, err := netns.GetFromName(nsName)
+	if err != nil {
+		return fmt.Errorf("Failed opening network namespace %q: %w", nsName, err)
+	}
+
+	defer func() { _ = nsHandle.Close() }()
+
+	nl, err := netlink.NewHandleAt(nsHandle)
+	if err != nil {
+		return fmt.Errorf("Failed connecting to network namespace %q: %w", nsName, err)
+	}
+
+	defer nl.Close()
+
+	// Recreate the veth pair so the namespace side always gets the current configuration.
+	_ = nl.LinkDel(&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: probeNetnsInterface}})
+	_ = netlink.LinkDel(&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: hostName}})
+
+	veth := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: hostName,
+			MTU:  int(mtu),
+		},
+		PeerName:         probeNetnsInterface,
+		PeerHardwareAddr: mac,
+		PeerMTU:          mtu,
+		PeerNamespace:    netlink.NsFd(nsHandle),
+	}
+
+	err = netlink.LinkAdd(veth)
+	if err != nil {
+		return fmt.Errorf("Failed creating probe interface %q: %w", hostName, err)
+	}
+
+	err = netlink.LinkSetUp(veth)
+	if err != nil {
+		return fmt.Errorf("Failed bringing up probe interface %q: %w", hostName, err)
+	}
+
+	for _, name := range []string{"lo", probeNetnsInterface} {
+		link, err := nl.LinkByName(name)
+		if err != nil {
+			return fmt.Errorf("Failed getting interface %q in network namespace %q: %w", name, nsName, err)
+		}
+
+		err = nl.LinkSetUp(link)
+		if err != nil {
+			return fmt.Errorf("Failed bringing up interface %q in network namespace %q: %w", name, nsName, err)
+		}
+
+		if name != probeNetnsInterface {
+			continue
+		}
+
+		for _, address := range addresses {
+			// Skip duplicate address detection as OVN already guarantees the address is unique.
+			err = nl.AddrAdd(link, &netlink.Addr{IPNet: address, Flags: unix.IFA_F_NODAD})
+			if err != nil {
+				return fmt.Errorf("Failed adding address %q in network namespace %q: %w", address.String(), nsName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// probeNetnsTeardown removes the probe interface and the named network namespace.
+func probeNetnsTeardown(nsName string, hostName string) error {
+	if InterfaceExists(hostName) {
+		err := netlink.LinkDel(&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: hostName}})
+		if err != nil {
+			return fmt.Errorf("Failed deleting probe interface %q: %w", hostName, err)
+		}
+	}
+
+	err := netns.DeleteNamed(nsName)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Failed deleting network namespace %q: %w", nsName, err)
+	}
+
+	return nil
+}
+
+// probeUDP sends the payload to the UDP target from within the named network namespace and checks that a
+// reply comes back before the timeout.
+func probeUDP(nsName string, target string, payload []byte, timeout time.Duration) error {
+	var conn net.Conn
+
+	err := probeNetnsRun(nsName, func() error {
+		var err error
+
+		conn, err = net.Dial("udp", target)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Write(payload)
+	if err != nil {
+		return err
+	}
+
+	// Any reply counts. An ICMP port unreachable error surfaces as a read error.
+	buf := make([]byte, 1500)
+	_, err = conn.Read(buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// probeDNS queries the DNS server at the UDP target from within the named network namespace and checks that
+// it answers before the timeout. Any well formed answer counts, regardless of its response code.
+func probeDNS(nsName string, target string, name string, timeout time.Duration) error {
+	var conn net.Conn
+
+	err := probeNetnsRun(nsName, func() error {
+		var err error
+
+		conn, err = net.Dial("udp", target)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	if name == "" {
+		name = "."
+	}
+
+	msg := &dns.Msg{}
+	msg.SetQuestion(dns.Fqdn(name), dns.TypeSOA)
+
+	client := &dns.Client{Net: "udp", Timeout: timeout}
+	_, _, err = client.ExchangeWithConn(msg, &dns.Conn{Conn: conn})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// probeICMP sends an echo request to the address from within the named network namespace and checks that a
+// matching echo reply comes back before the timeout.
+func probeICMP(nsName string, address net.IP, timeout time.Duration) error {
+	network := "ip4:icmp"
+	listenAddress := "0.0.0.0"
+	protocol := 1 // ICMP.
+	var requestType icmp.Type = ipv4.ICMPTypeEcho
+	var replyType icmp.Type = ipv4.ICMPTypeEchoReply
+
+	if address.To4() == nil {
+		network = "ip6:ipv6-icmp"
+		listenAddress = "::"
+		protocol = 58 // ICMPv6.
+		requestType = ipv6.ICMPTypeEchoRequest
+		replyType = ipv6.ICMPTypeEchoReply
+	}
+
+	var conn *icmp.PacketConn
+
+	err := probeNetnsRun(nsName, func() error {
+		var err error
+
+		conn, err = icmp.ListenPacket(network, listenAddress)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	// The raw socket receives all ICMP traffic of the namespace, so use a random identifier to spot our reply.
+	id := rand.Intn(0xffff)
+
+	request := icmp.Message{
+		Type: requestType,
+		Body: &icmp.Echo{ID: id, Seq: 1, Data: []byte("incus")},
+	}
+
+	data, err := request.Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.WriteTo(data, &net.IPAddr{IP: address})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		peerAddr, ok := peer.(*net.IPAddr)
+		if !ok || !peerAddr.IP.Equal(address) {
+			continue
+		}
+
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil || reply.Type != replyType {
+			continue
+		}
+
+		echo, ok := reply.Body.(*icmp.Echo)
+		if ok && echo.ID == id {
+			return nil
+		}
+	}
+}
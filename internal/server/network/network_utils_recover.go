@@ -0,0 +1,378 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lxc/incus/v6/internal/server/network/acl"
+	networkOVN "github.com/lxc/incus/v6/internal/server/network/ovn"
+	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// OVNRecoverNetwork holds the records of an OVN network rebuilt from the OVN northbound database.
+type OVNRecoverNetwork struct {
+	ID            int64 // ID the network had when its northbound database records were created.
+	Network       api.NetworksPost
+	Forwards      []api.NetworkForwardsPost
+	LoadBalancers []api.NetworkLoadBalancersPost
+}
+
+// OVNRecoverNetworkScan looks up the OVN network an instance NIC was connected to using the NIC's logical switch port
+// in the OVN northbound database. The network, forward and load balancer records are then rebuilt from the logical
+// router ports, NAT rules, DHCP options and load balancers of that network.
+// Returns nil if no logical switch port exists for the NIC.
+func OVNRecoverNetworkScan(ctx context.Context, s *state.State, networkName string, instanceUUID string, deviceName string) (*OVNRecoverNetwork, error) {
+	if instanceUUID == "" {
+		return nil, nil
+	}
+
+	ovnnb, _, err := s.OVN()
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := ovnnb.GetLogicalSwitchPortsBySuffix(ctx, fmt.Sprintf("-instance-%s-%s", instanceUUID, deviceName))
+	if err != nil {
+		return nil, fmt.Errorf("Failed looking up logical switch port: %w", err)
+	}
+
+	if len(ports) == 0 {
+		return nil, nil
+	} else if len(ports) > 1 {
+		return nil, fmt.Errorf("Found %d logical switch ports for device %q of instance %q", len(ports), deviceName, instanceUUID)
+	}
+
+	var networkID int64
+	_, err = fmt.Sscanf(string(ports[0]), "incus-net%d-", &networkID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing network ID from logical switch port %q: %w", ports[0], err)
+	}
+
+	networkPrefix := acl.OVNNetworkPrefix(networkID)
+	routerName := networkOVN.OVNRouter(fmt.Sprintf("%s-lr", networkPrefix))
+	extSwitchName := networkOVN.OVNSwitch(fmt.Sprintf("%s-ls-ext", networkPrefix))
+	intSwitchName := acl.OVNIntSwitchName(networkID)
+	lbPrefix := fmt.Sprintf("%s-lb-", networkPrefix)
+
+	objects, err := ovnnb.GetNetworkObjects(ctx, routerName, []networkOVN.OVNSwitch{extSwitchName, intSwitchName}, intSwitchName, lbPrefix, fmt.Sprintf("%s_", acl.OVNIntSwitchPortGroupAddressSetPrefix(networkID)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN network objects: %w", err)
+	}
+
+	if objects.Router == nil {
+		return nil, fmt.Errorf("Logical router %q not found", routerName)
+	}
+
+	rec := &OVNRecoverNetwork{
+		ID: networkID,
+		Network: api.NetworksPost{
+			Name: networkName,
+			Type: "ovn",
+			NetworkPut: api.NetworkPut{
+				Config: map[string]string{
+					"ipv4.address": "none",
+					"ipv6.address": "none",
+				},
+			},
+		},
+	}
+
+	config := rec.Network.Config
+
+	// Get the uplink network from the provider port of the external switch.
+	for _, lsp := range objects.SwitchPorts[extSwitchName] {
+		if lsp.Name == fmt.Sprintf("%s-lsp-provider", extSwitchName) && lsp.Options["network_name"] != "" {
+			config["network"] = lsp.Options["network_name"]
+		}
+	}
+
+	if config["network"] == "" {
+		return nil, fmt.Errorf("Uplink network of logical router %q not found", routerName)
+	}
+
+	// Get the internal subnets and uplink addresses from the router ports.
+	var intSubnets []*net.IPNet
+	for _, lrp := range objects.RouterPorts {
+		for _, network := range lrp.Networks {
+			ip, subnet, err := net.ParseCIDR(network)
+			if err != nil || ip.IsLinkLocalUnicast() {
+				continue
+			}
+
+			family := "ipv4"
+			if ip.To4() == nil {
+				family = "ipv6"
+			}
+
+			switch lrp.Name {
+			case fmt.Sprintf("%s-lrp-int", routerName):
+				config[fmt.Sprintf("%s.address", family)] = network
+				config[fmt.Sprintf("%s.nat", family)] = "false"
+				intSubnets = append(intSubnets, subnet)
+			case fmt.Sprintf("%s-lrp-ext", routerName):
+				config[fmt.Sprintf("volatile.network.%s.address", family)] = ip.String()
+			}
+		}
+	}
+
+	// Get the NAT settings from the SNAT rules of the internal subnets.
+	for _, nat := range objects.RouterNAT {
+		if nat.Type != ovnNB.NATTypeSNAT {
+			continue
+		}
+
+		_, logicalNet, err := net.ParseCIDR(nat.LogicalIP)
+		if err != nil || !slices.ContainsFunc(intSubnets, func(subnet *net.IPNet) bool { return subnet.String() == logicalNet.String() }) {
+			continue
+		}
+
+		family := "ipv4"
+		if logicalNet.IP.To4() == nil {
+			family = "ipv6"
+		}
+
+		config[fmt.Sprintf("%s.nat", family)] = "true"
+
+		if nat.ExternalIP != config[fmt.Sprintf("volatile.network.%s.address", family)] {
+			config[fmt.Sprintf("%s.nat.address", family)] = nat.ExternalIP
+		}
+	}
+
+	// Get the DNS domain and MTU from the DHCPv4 options.
+	for _, dhcpOpts := range objects.DHCPOptions {
+		_, cidr, err := net.ParseCIDR(dhcpOpts.Cidr)
+		if err != nil || cidr.IP.To4() == nil {
+			continue
+		}
+
+		if dhcpOpts.Options["domain_name"] != "" {
+			config["dns.domain"] = strings.Trim(dhcpOpts.Options["domain_name"], `"`)
+		}
+
+		if dhcpOpts.Options["mtu"] != "" {
+			config["bridge.mtu"] = dhcpOpts.Options["mtu"]
+		}
+	}
+
+	// Get the forwards and load balancers from the OVN load balancers.
+	rec.Forwards, rec.LoadBalancers, err = ovnRecoverLoadBalancers(objects.LoadBalancers, lbPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// ovnRecoverLoadBalancers rebuilds the network forwards and load balancers from the OVN load balancers of a network.
+// OVN load balancers are recovered as network load balancers when they have health checks or any VIP with more than
+// one target, and as network forwards otherwise.
+func ovnRecoverLoadBalancers(lbs []ovnNB.LoadBalancer, lbPrefix string) ([]api.NetworkForwardsPost, []api.NetworkLoadBalancersPost, error) {
+	// parseAddress parses an OVN load balancer address, with an optional port.
+	parseAddress := func(address string) (net.IP, string, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			host = strings.Trim(address, "[]")
+			port = ""
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return nil, "", fmt.Errorf("Invalid load balancer address %q", address)
+		}
+
+		return ip, port, nil
+	}
+
+	// Group the TCP and UDP load balancers by listen address.
+	listenAddresses := []string{}
+	listenLBs := map[string][]ovnNB.LoadBalancer{}
+	for _, lb := range lbs {
+		listenAddress := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(lb.Name, lbPrefix), "-tcp"), "-udp")
+		if net.ParseIP(listenAddress) == nil {
+			continue
+		}
+
+		if listenLBs[listenAddress] == nil {
+			listenAddresses = append(listenAddresses, listenAddress)
+		}
+
+		listenLBs[listenAddress] = append(listenLBs[listenAddress], lb)
+	}
+
+	slices.Sort(listenAddresses)
+
+	forwards := []api.NetworkForwardsPost{}
+	loadBalancers := []api.NetworkLoadBalancersPost{}
+
+	for _, listenAddress := range listenAddresses {
+		isLoadBalancer := false
+		for _, lb := range listenLBs[listenAddress] {
+			if len(lb.HealthCheck) > 0 {
+				isLoadBalancer = true
+			}
+
+			for _, targets := range lb.Vips {
+				if strings.Contains(targets, ",") {
+					isLoadBalancer = true
+				}
+			}
+		}
+
+		forward := api.NetworkForwardsPost{
+			ListenAddress: listenAddress,
+			NetworkForwardPut: api.NetworkForwardPut{
+				Config: map[string]string{},
+				Ports:  []api.NetworkForwardPort{},
+			},
+		}
+
+		loadBalancer := api.NetworkLoadBalancersPost{
+			ListenAddress: listenAddress,
+			NetworkLoadBalancerPut: api.NetworkLoadBalancerPut{
+				Config:   map[string]string{},
+				Backends: []api.NetworkLoadBalancerBackend{},
+				Ports:    []api.NetworkLoadBalancerPort{},
+			},
+		}
+
+		backendNames := map[string]string{}
+
+		for _, lb := range listenLBs[listenAddress] {
+			if lb.Protocol == nil {
+				continue
+			}
+
+			if len(lb.HealthCheck) > 0 {
+				loadBalancer.Config["healthcheck"] = "true"
+			}
+
+			vips := make([]string, 0, len(lb.Vips))
+			for vip := range lb.Vips {
+				vips = append(vips, vip)
+			}
+
+			slices.Sort(vips)
+
+			for _, vip := range vips {
+				_, listenPort, err := parseAddress(vip)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				targetBackends := []string{}
+
+				for _, target := range strings.Split(lb.Vips[vip], ",") {
+					targetAddress, targetPort, err := parseAddress(target)
+					if err != nil {
+						return nil, nil, err
+					}
+
+					if !isLoadBalancer {
+						if listenPort == "" {
+							forward.Config["target_address"] = targetAddress.String()
+							continue
+						}
+
+						forward.Ports = append(forward.Ports, api.NetworkForwardPort{
+							Protocol:      *lb.Protocol,
+							ListenPort:    listenPort,
+							TargetAddress: targetAddress.String(),
+							TargetPort:    targetPort,
+						})
+
+						continue
+					}
+
+					backendKey := net.JoinHostPort(targetAddress.String(), targetPort)
+					if backendNames[backendKey] == "" {
+						backendNames[backendKey] = "backend" + strconv.Itoa(len(loadBalancer.Backends))
+						loadBalancer.Backends = append(loadBalancer.Backends, api.NetworkLoadBalancerBackend{
+							Name:          backendNames[backendKey],
+							TargetAddress: targetAddress.String(),
+							TargetPort:    targetPort,
+						})
+					}
+
+					targetBackends = append(targetBackends, backendNames[backendKey])
+				}
+
+				if isLoadBalancer && listenPort != "" {
+					loadBalancer.Ports = append(loadBalancer.Ports, api.NetworkLoadBalancerPort{
+						Protocol:      *lb.Protocol,
+						ListenPort:    listenPort,
+						TargetBackend: targetBackends,
+					})
+				}
+			}
+		}
+
+		if isLoadBalancer {
+			loadBalancers = append(loadBalancers, loadBalancer)
+		} else {
+			forwards = append(forwards, forward)
+		}
+	}
+
+	return forwards, loadBalancers, nil
+}
+
+// OVNRecoverNetworkCleanup deletes the OVN northbound database records left behind by a recovered OVN network, once
+// the network was created again from its recovered records.
+func OVNRecoverNetworkCleanup(ctx context.Context, s *state.State, rec *OVNRecoverNetwork) error {
+	ovnnb, _, err := s.OVN()
+	if err != nil {
+		return err
+	}
+
+	networkPrefix := acl.OVNNetworkPrefix(rec.ID)
+
+	loadBalancers := make([]networkOVN.OVNLoadBalancer, 0, len(rec.Forwards)+len(rec.LoadBalancers))
+	for _, forward := range rec.Forwards {
+		loadBalancers = append(loadBalancers, networkOVN.OVNLoadBalancer(fmt.Sprintf("%s-lb-%s", networkPrefix, forward.ListenAddress)))
+	}
+
+	for _, loadBalancer := range rec.LoadBalancers {
+		loadBalancers = append(loadBalancers, networkOVN.OVNLoadBalancer(fmt.Sprintf("%s-lb-%s", networkPrefix, loadBalancer.ListenAddress)))
+	}
+
+	err = ovnnb.DeleteLoadBalancer(ctx, loadBalancers...)
+	if err != nil {
+		return fmt.Errorf("Failed deleting OVN load balancers: %w", err)
+	}
+
+	err = ovnnb.DeleteLogicalRouter(ctx, networkOVN.OVNRouter(fmt.Sprintf("%s-lr", networkPrefix)))
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return fmt.Errorf("Failed deleting OVN logical router: %w", err)
+	}
+
+	for _, switchName := range []networkOVN.OVNSwitch{networkOVN.OVNSwitch(fmt.Sprintf("%s-ls-ext", networkPrefix)), acl.OVNIntSwitchName(rec.ID)} {
+		err = ovnnb.DeleteLogicalSwitch(ctx, switchName)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("Failed deleting OVN logical switch %q: %w", switchName, err)
+		}
+	}
+
+	err = ovnnb.DeletePortGroup(ctx, acl.OVNIntSwitchPortGroupName(rec.ID))
+	if err != nil {
+		return fmt.Errorf("Failed deleting OVN port group: %w", err)
+	}
+
+	err = ovnnb.DeleteAddressSet(ctx, acl.OVNIntSwitchPortGroupAddressSetPrefix(rec.ID))
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return fmt.Errorf("Failed deleting OVN address sets: %w", err)
+	}
+
+	err = ovnnb.DeleteChassisGroup(ctx, networkOVN.OVNChassisGroup(networkPrefix))
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return fmt.Errorf("Failed deleting OVN chassis group: %w", err)
+	}
+
+	return nil
+}
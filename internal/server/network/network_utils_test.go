@@ -1,11 +1,18 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"net"
+	"slices"
 	"strings"
 
 	"github.com/lxc/incus/v6/internal/iprange"
+	"github.com/lxc/incus/v6/internal/server/db/cluster"
+	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
+	ovnSB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-sb"
+	"github.com/lxc/incus/v6/shared/api"
 )
 
 func Example_parseIPRange() {
@@ -180,3 +187,505 @@ func Example_complementRanges() {
 	// Range2: 10.1.1.1-10.1.1.9, 10.1.1.101-10.1.1.199, 10.1.1.231-10.1.1.254
 	// Range3: 10.1.1.1-10.1.1.9, 10.1.1.26-10.1.1.254
 }
+
+func Example_staticNeighborsParse() {
+	values := []string{
+		"10.0.0.100",
+		"10.0.0.100=00:00:5e:00:01:01, fd42::100=00:00:5e:00:02:01",
+		"10.0.0.100=00:00:5e:00:01",
+		"10.0.0.256",
+	}
+
+	for _, value := range values {
+		neighbors, err := StaticNeighborsParse(value)
+		if err != nil {
+			fmt.Printf("Err: %v\n", err)
+			continue
+		}
+
+		for _, neighbor := range neighbors {
+			fmt.Printf("IP: %s, MAC: %q\n", neighbor.IP.String(), neighbor.MAC.String())
+		}
+	}
+
+	// Output:
+	// IP: 10.0.0.100, MAC: ""
+	// IP: 10.0.0.100, MAC: "00:00:5e:00:01:01"
+	// IP: fd42::100, MAC: "00:00:5e:00:02:01"
+	// Err: Invalid static neighbor MAC address "00:00:5e:00:01": address 00:00:5e:00:01: invalid MAC address
+	// Err: Invalid static neighbor address "10.0.0.256"
+}
+
+func Example_natPublishedIPs() {
+	ipv4 := net.ParseIP("10.0.0.10")
+	ipv6 := net.ParseIP("fd42::10")
+
+	tests := []struct {
+		name         string
+		netConfig    map[string]string
+		deviceConfig map[string]string
+		ipv4         net.IP
+		ipv6         net.IP
+	}{
+		{"dynamic/dynamic", map[string]string{}, map[string]string{}, ipv4, ipv6},
+		{"dynamic/dynamic with NAT", map[string]string{"ipv4.nat": "true", "ipv6.nat": "true"}, map[string]string{}, ipv4, ipv6},
+		{"dynamic/dynamic with IPv4 NAT", map[string]string{"ipv4.nat": "true"}, map[string]string{}, ipv4, ipv6},
+		{"static/static", map[string]string{}, map[string]string{"ipv4.address": "10.0.0.10", "ipv6.address": "fd42::10"}, ipv4, ipv6},
+		{"static/none", map[string]string{}, map[string]string{"ipv4.address": "10.0.0.10", "ipv6.address": "none"}, ipv4, ipv6},
+		{"none/static", map[string]string{}, map[string]string{"ipv4.address": "none", "ipv6.address": "fd42::10"}, ipv4, ipv6},
+		{"none/none", map[string]string{}, map[string]string{"ipv4.address": "none", "ipv6.address": "none"}, ipv4, ipv6},
+		{"static/none without IPv6", map[string]string{}, map[string]string{"ipv4.address": "10.0.0.10", "ipv6.address": "none"}, ipv4, nil},
+		{"dynamic/dynamic on IPv4 only network", map[string]string{"ipv6.address": "none"}, map[string]string{}, ipv4, ipv6},
+		{"dynamic/dynamic without addresses", map[string]string{}, map[string]string{}, nil, nil},
+		{"mismatched families", map[string]string{}, map[string]string{}, ipv6, ipv4},
+	}
+
+	for _, test := range tests {
+		ips := []string{}
+		for _, ip := range natPublishedIPs(test.netConfig, test.deviceConfig, test.ipv4, test.ipv6) {
+			ips = append(ips, ip.String())
+		}
+
+		fmt.Printf("%s: %v\n", test.name, ips)
+	}
+
+	// Output:
+	// dynamic/dynamic: [10.0.0.10 fd42::10]
+	// dynamic/dynamic with NAT: []
+	// dynamic/dynamic with IPv4 NAT: [fd42::10]
+	// static/static: [10.0.0.10 fd42::10]
+	// static/none: [10.0.0.10]
+	// none/static: [fd42::10]
+	// none/none: []
+	// static/none without IPv6: [10.0.0.10]
+	// dynamic/dynamic on IPv4 only network: [10.0.0.10]
+	// dynamic/dynamic without addresses: []
+	// mismatched families: []
+}
+
+func Example_subnetRemapIP() {
+	tests := []struct {
+		ip        string
+		oldSubnet string
+		newSubnet string
+	}{
+		{"10.0.0.10", "10.0.0.0/24", "10.1.2.0/24"},
+		{"10.0.0.10", "10.0.0.0/24", "192.168.0.0/16"},
+		{"10.0.1.10", "10.0.0.0/23", "10.1.2.0/24"},
+		{"10.0.0.10", "10.1.0.0/24", "10.1.2.0/24"},
+		{"fd42::10", "fd42::/64", "fd43:1:2:3::/64"},
+		{"10.0.0.10", "10.0.0.0/24", "fd43::/64"},
+	}
+
+	for _, test := range tests {
+		_, oldSubnet, _ := net.ParseCIDR(test.oldSubnet)
+		_, newSubnet, _ := net.ParseCIDR(test.newSubnet)
+
+		ip, err := subnetRemapIP(net.ParseIP(test.ip), oldSubnet, newSubnet)
+		if err != nil {
+			fmt.Printf("Err: %v\n", err)
+			continue
+		}
+
+		fmt.Println(ip.String())
+	}
+
+	// Output:
+	// 10.1.2.10
+	// 192.168.0.10
+	// Err: Address "10.0.1.10" doesn't fit within subnet "10.1.2.0/24"
+	// Err: Address "10.0.0.10" isn't within subnet "10.1.0.0/24"
+	// fd43:1:2:3::10
+	// Err: Subnet "fd43::/64" isn't of the same family as "10.0.0.0/24"
+}
+
+func Example_validateNameservers() {
+	tests := []string{
+		"192.0.2.53",
+		"192.0.2.53, 2001:db8::53",
+		"192.0.2.53,dns.example.net",
+		"0.0.0.0",
+		"ff02::1",
+		"192.0.2.53,2001:db8::53,192.0.2.53",
+	}
+
+	for _, test := range tests {
+		fmt.Println(validateNameservers(test))
+	}
+
+	// Output:
+	// <nil>
+	// <nil>
+	// Not an IP address "dns.example.net"
+	// Nameserver "0.0.0.0" must be a unicast address
+	// Nameserver "ff02::1" must be a unicast address
+	// Duplicate nameserver "192.0.2.53"
+}
+
+func Example_forwardDefaultTargetAddress() {
+	tests := []struct {
+		listenAddress string
+		config        map[string]string
+	}{
+		{"192.0.2.1", map[string]string{"target_address": "10.0.0.2"}},
+		{"192.0.2.1", map[string]string{"target_address.ipv4": "10.0.0.3", "target_address.ipv6": "fd42::3"}},
+		{"2001:db8::1", map[string]string{"target_address.ipv4": "10.0.0.3", "target_address.ipv6": "fd42::3"}},
+		{"2001:db8::1", map[string]string{"target_address.ipv4": "10.0.0.3"}},
+	}
+
+	for _, test := range tests {
+		fmt.Println(forwardDefaultTargetAddress(net.ParseIP(test.listenAddress), test.config))
+	}
+
+	// Output:
+	// 10.0.0.2
+	// 10.0.0.3
+	// fd42::3
+	// <nil>
+}
+
+func Example_icGatewayPriorities() {
+	gateways := []string{"gw3", "gw1", "gw4", "gw2"}
+	configs := []map[string]string{
+		{},
+		{"ovn.gateways.exclude": "gw2,gw4"},
+		{"ovn.gateways.weights": "gw4=100"},
+		{"ovn.gateways.prefer_local": "true", "ovn.gateways.weights": "gw4=100"},
+	}
+
+	for _, config := range configs {
+		priorities := icGatewayPriorities(gateways, []string{"gw2"}, config, rand.New(rand.NewSource(1)))
+
+		names := make([]string, 0, len(priorities))
+		for name := range priorities {
+			names = append(names, name)
+		}
+
+		slices.SortFunc(names, func(a string, b string) int { return priorities[b] - priorities[a] })
+		fmt.Println(names)
+	}
+
+	// Output:
+	// [gw2 gw3 gw1 gw4]
+	// [gw3 gw1]
+	// [gw4 gw2 gw3 gw1]
+	// [gw2 gw4 gw3 gw1]
+}
+
+func Example_filterRoutesByPrefixes() {
+	var routes []net.IPNet
+	for _, route := range []string{"10.0.0.0/24", "10.1.0.0/16", "192.0.2.0/24", "fd42::/64"} {
+		_, routeNet, _ := net.ParseCIDR(route)
+		routes = append(routes, *routeNet)
+	}
+
+	toStrings := func(routes []net.IPNet) []string {
+		result := []string{}
+		for _, route := range routes {
+			result = append(result, route.String())
+		}
+
+		return result
+	}
+
+	for _, prefixes := range []string{"", "10.0.0.0/16", "10.0.0.0/8, fd42::/48"} {
+		allowed, filtered := filterRoutesByPrefixes(routes, prefixes)
+		fmt.Printf("allowed=%v filtered=%v\n", toStrings(allowed), toStrings(filtered))
+	}
+
+	// Output:
+	// allowed=[10.0.0.0/24 10.1.0.0/16 192.0.2.0/24 fd42::/64] filtered=[]
+	// allowed=[10.0.0.0/24] filtered=[10.1.0.0/16 192.0.2.0/24 fd42::/64]
+	// allowed=[10.0.0.0/24 10.1.0.0/16 fd42::/64] filtered=[192.0.2.0/24]
+}
+
+func Example_externalRouteOriginValidate() {
+	var uplinkRoutes []*net.IPNet
+	for _, route := range []string{"198.51.100.0/24", "2001:db8:1::/48"} {
+		_, routeNet, _ := net.ParseCIDR(route)
+		uplinkRoutes = append(uplinkRoutes, routeNet)
+	}
+
+	tests := []struct {
+		route   string
+		nexthop string
+	}{
+		{"198.51.100.0/25", "192.0.2.10"},
+		{"2001:db8:1:1::/64", "2001:db8::10"},
+		{"203.0.113.0/24", "192.0.2.10"},
+		{"198.51.100.0/25", ""},
+		{"198.51.100.0/25", "0.0.0.0"},
+		{"2001:db8:1:1::/64", "192.0.2.10"},
+	}
+
+	for _, tt := range tests {
+		_, route, _ := net.ParseCIDR(tt.route)
+
+		err := externalRouteOriginValidate(uplinkRoutes, net.ParseIP(tt.nexthop), route)
+		if err != nil {
+			fmt.Printf("Err: %v\n", err)
+			continue
+		}
+
+		fmt.Printf("Route: %s, Next-hop: %s\n", tt.route, tt.nexthop)
+	}
+
+	// Output:
+	// Route: 198.51.100.0/25, Next-hop: 192.0.2.10
+	// Route: 2001:db8:1:1::/64, Next-hop: 2001:db8::10
+	// Err: External route "203.0.113.0/24" isn't within the uplink network's routes
+	// Err: External route "198.51.100.0/25" has no next-hop address on the uplink network
+	// Err: External route "198.51.100.0/25" has no next-hop address on the uplink network
+	// Err: External route "2001:db8:1:1::/64" has no next-hop address on the uplink network
+}
+
+func ExampleTargetAddressesUsedBy() {
+	instanceAddresses := map[string]string{
+		"10.0.0.2": "/1.0/instances/web01",
+		"fd42::2":  "/1.0/instances/web01",
+		"10.0.0.3": "/1.0/instances/web02?project=foo",
+	}
+
+	fmt.Println(TargetAddressesUsedBy(instanceAddresses, []string{"10.0.0.3", "fd42:0::2", "10.0.0.2"}))
+	fmt.Println(TargetAddressesUsedBy(instanceAddresses, []string{"10.0.0.2", "10.0.0.4", "10.0.0.4", ""}))
+	fmt.Println(TargetAddressesUsedBy(instanceAddresses, nil))
+
+	// Output:
+	// [/1.0/instances/web01 /1.0/instances/web02?project=foo] []
+	// [/1.0/instances/web01] [10.0.0.4]
+	// [] []
+}
+
+func ExampleTargetGroupValidate() {
+	groups := []api.NetworkTargetGroupPut{
+		{Addresses: []string{"10.0.0.2", "fd42::2"}, Instances: []string{"web01"}, Config: map[string]string{"user.foo": "bar"}},
+		{},
+		{Addresses: []string{"10.0.0.2", "foo"}},
+		{Addresses: []string{"224.0.0.1"}},
+		{Addresses: []string{"10.0.0.2", "10.0.0.2"}},
+		{Instances: []string{"web-"}},
+		{Instances: []string{"web01", "web01"}},
+		{Config: map[string]string{"healthcheck": "true"}},
+	}
+
+	for _, group := range groups {
+		fmt.Println(TargetGroupValidate(&group))
+	}
+
+	// Output: <nil>
+	// <nil>
+	// Invalid target address "foo" at index 1
+	// Target address "224.0.0.1" at index 0 must be a unicast address
+	// Duplicate target address "10.0.0.2" at index 1
+	// Invalid instance name "web-" at index 0: Name must not end with "-" character
+	// Duplicate instance "web01" at index 1
+	// Invalid network target group configuration key "healthcheck"
+}
+
+func Example_targetGroupUsedByNetwork() {
+	forwards := []cluster.NetworkForward{
+		{ListenAddress: "192.0.2.1", Ports: []api.NetworkForwardPort{{TargetAddress: "10.0.0.2"}, {TargetGroup: "web"}, {TargetGroup: "web"}}},
+		{ListenAddress: "192.0.2.2", Ports: []api.NetworkForwardPort{{TargetGroup: "db"}}},
+	}
+
+	loadBalancers := []cluster.NetworkLoadBalancer{
+		{ListenAddress: "192.0.2.3", Backends: []api.NetworkLoadBalancerBackend{{Name: "b0", TargetGroup: "web"}}},
+		{ListenAddress: "192.0.2.4", Backends: []api.NetworkLoadBalancerBackend{{Name: "b0", TargetAddress: "10.0.0.3"}}},
+	}
+
+	fmt.Println(targetGroupUsedByNetwork("default", "ovn0", "web", forwards, loadBalancers))
+	fmt.Println(targetGroupUsedByNetwork("foo", "ovn0", "db", forwards, loadBalancers))
+	fmt.Println(targetGroupUsedByNetwork("default", "ovn0", "unused", forwards, loadBalancers))
+
+	// Output: [/1.0/networks/ovn0/forwards/192.0.2.1 /1.0/networks/ovn0/load-balancers/192.0.2.3]
+	// [/1.0/networks/ovn0/forwards/192.0.2.2?project=foo]
+	// []
+}
+
+func Example_ovnUplinkAllocateIP() {
+	n := &ovn{}
+	ipRanges, _ := parseIPRanges("192.0.2.10-192.0.2.12,192.0.2.20-192.0.2.20,2001:db8::1-2001:db8::2")
+
+	allocate := func(allocated ...string) {
+		allocatedIPs := []net.IP{}
+		for _, ip := range allocated {
+			allocatedIPs = append(allocatedIPs, net.ParseIP(ip))
+		}
+
+		ip, err := n.uplinkAllocateIP(ipRanges, allocatedIPs)
+		if err != nil {
+			fmt.Println("Err:", err)
+			return
+		}
+
+		fmt.Println(ip)
+	}
+
+	allocate()
+	allocate("192.0.2.10", "192.0.2.11", "192.0.2.11", "198.51.100.1")
+	allocate("192.0.2.12", "192.0.2.10")
+	allocate("192.0.2.10", "192.0.2.11", "192.0.2.12")
+	allocate("192.0.2.10", "192.0.2.11", "192.0.2.12", "192.0.2.20", "2001:db8::1")
+	allocate("192.0.2.10", "192.0.2.11", "192.0.2.12", "192.0.2.20", "2001:db8::1", "2001:db8::2")
+
+	// Output: 192.0.2.10
+	// 192.0.2.12
+	// 192.0.2.11
+	// 192.0.2.20
+	// 2001:db8::2
+	// Err: No free IPs available
+}
+
+func Example_ovnExternalPortReservedInterfaces() {
+	netConfig := map[string]string{"bridge.external_interfaces": "eth1, eth2.100/eth2/100"}
+
+	fmt.Println(ovnExternalPortReservedInterfaces(netConfig, "incusbr0", 1, "bridge", map[string]string{"bridge.external_interfaces": "eth3"}))
+	fmt.Println(ovnExternalPortReservedInterfaces(nil, "uplink", 2, "physical", map[string]string{"parent": "eth0"}))
+	fmt.Println(ovnExternalPortReservedInterfaces(netConfig, "none", 0, "", nil))
+
+	// Output:
+	// [eth1 eth2.100 incusbr0 eth3 incusovn1 incusovn1a incusovn1b]
+	// [eth0 incusovn2 incusovn2a incusovn2b]
+	// [eth1 eth2.100]
+}
+
+func Example_ovnFallbackBridgeMTU() {
+	fmt.Println(ovnFallbackBridgeMTU(nil))
+	fmt.Println(ovnFallbackBridgeMTU([]ovnSB.Encap{{Type: ovnSB.EncapTypeGeneve, IP: "192.0.2.1"}}))
+	fmt.Println(ovnFallbackBridgeMTU([]ovnSB.Encap{{Type: ovnSB.EncapTypeVxlan, IP: "192.0.2.1"}}))
+	fmt.Println(ovnFallbackBridgeMTU([]ovnSB.Encap{{Type: ovnSB.EncapTypeVxlan, IP: "192.0.2.1"}, {Type: ovnSB.EncapTypeGeneve, IP: "2001:db8::1"}}))
+
+	// Output:
+	// 1422
+	// 1442
+	// 1450
+	// 1422
+}
+
+func Example_ovnRenderConfigTemplates() {
+	n := &ovn{common: common{project: "foo", name: "ovn0"}}
+
+	config := map[string]string{
+		"ipv4.address": "none",
+		"ipv6.address": "none",
+		"dns.domain":   "{{ projectName }}-{{ networkName }}.internal",
+		"user.domain":  "{{ networkName }}",
+	}
+
+	// Filling the defaults, as done when recovering a network, leaves the stored values untouched.
+	_ = n.FillConfig(context.Background(), config)
+	fmt.Println(config["dns.domain"], config["user.domain"])
+
+	_ = n.RenderConfigTemplates(config)
+	fmt.Println(config["dns.domain"], config["user.domain"])
+
+	// Output: {{ projectName }}-{{ networkName }}.internal {{ networkName }}
+	// foo-ovn0.internal {{ networkName }}
+}
+
+func Example_peerApprovalTransition() {
+	transition := func(action string, approval string, mutualApproval string) {
+		approval, mutualApproval, err := peerApprovalTransition(action, approval, mutualApproval)
+		if err != nil {
+			fmt.Printf("%s: %v\n", action, err)
+			return
+		}
+
+		fmt.Printf("%s: %q %q\n", action, approval, mutualApproval)
+	}
+
+	// The first side requests the peering from the target network, which approves it.
+	transition("create", peerApprovalAbsent, peerApprovalAbsent)
+	transition("approve", cluster.NetworkPeerApprovalPending, cluster.NetworkPeerApprovalRequested)
+	transition("approve", "", "")
+
+	// The target network rejects the request, which is then deleted and created again.
+	transition("reject", cluster.NetworkPeerApprovalPending, cluster.NetworkPeerApprovalRequested)
+	transition("delete", cluster.NetworkPeerApprovalRejected, peerApprovalAbsent)
+	transition("create", peerApprovalAbsent, peerApprovalAbsent)
+
+	// Creating the second side consents, even to a request which was rejected before.
+	transition("create", peerApprovalAbsent, cluster.NetworkPeerApprovalRequested)
+	transition("create", peerApprovalAbsent, cluster.NetworkPeerApprovalRejected)
+	transition("create", peerApprovalAbsent, "")
+
+	// Deleting either side of a request, or an active peering.
+	transition("delete", cluster.NetworkPeerApprovalRequested, cluster.NetworkPeerApprovalPending)
+	transition("delete", cluster.NetworkPeerApprovalPending, cluster.NetworkPeerApprovalRequested)
+	transition("delete", "", "")
+	transition("approve", cluster.NetworkPeerApprovalPending, peerApprovalAbsent)
+
+	// Output: create: "requested" "pending"
+	// approve: "" ""
+	// approve: Peering isn't awaiting approval from this network
+	// reject: "absent" "rejected"
+	// delete: "absent" "absent"
+	// create: "requested" "pending"
+	// create: "" ""
+	// create: "" ""
+	// create: "" ""
+	// delete: "absent" "absent"
+	// delete: "absent" "rejected"
+	// delete: "absent" ""
+	// approve: The peering request was withdrawn
+}
+
+func Example_ovnRecoverLoadBalancers() {
+	lbs := []ovnNB.LoadBalancer{
+		{
+			Name:     "incus-net1-lb-192.0.2.20-tcp",
+			Protocol: &ovnNB.LoadBalancerProtocolTCP,
+			Vips:     map[string]string{"192.0.2.20:443": "10.0.0.4:443,10.0.0.5:443"},
+		},
+		{
+			Name:     "incus-net1-lb-192.0.2.10-tcp",
+			Protocol: &ovnNB.LoadBalancerProtocolTCP,
+			Vips:     map[string]string{"192.0.2.10": "10.0.0.2", "192.0.2.10:80": "10.0.0.3:8080"},
+		},
+		{
+			Name:     "incus-net1-lb-192.0.2.10-udp",
+			Protocol: &ovnNB.LoadBalancerProtocolUDP,
+			Vips:     map[string]string{"192.0.2.10": "10.0.0.2"},
+		},
+		{
+			Name:     "incus-net1-lb-2001:db8::10-udp",
+			Protocol: &ovnNB.LoadBalancerProtocolUDP,
+			Vips:     map[string]string{"[2001:db8::10]:53": "[fd42::2]:53"},
+		},
+	}
+
+	forwards, loadBalancers, err := ovnRecoverLoadBalancers(lbs, "incus-net1-lb-")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, forward := range forwards {
+		fmt.Printf("Forward %s (default target %q)\n", forward.ListenAddress, forward.Config["target_address"])
+
+		for _, port := range forward.Ports {
+			fmt.Printf("  %s/%s to %s port %s\n", port.Protocol, port.ListenPort, port.TargetAddress, port.TargetPort)
+		}
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		fmt.Printf("Load balancer %s\n", loadBalancer.ListenAddress)
+
+		for _, backend := range loadBalancer.Backends {
+			fmt.Printf("  %s: %s port %s\n", backend.Name, backend.TargetAddress, backend.TargetPort)
+		}
+
+		for _, port := range loadBalancer.Ports {
+			fmt.Printf("  %s/%s to %s\n", port.Protocol, port.ListenPort, strings.Join(port.TargetBackend, ","))
+		}
+	}
+
+	// Output: Forward 192.0.2.10 (default target "10.0.0.2")
+	//   tcp/80 to 10.0.0.3 port 8080
+	// Forward 2001:db8::10 (default target "")
+	//   udp/53 to fd42::2 port 53
+	// Load balancer 192.0.2.20
+	//   backend0: 10.0.0.4 port 443
+	//   backend1: 10.0.0.5 port 443
+	//   tcp/443 to backend0,backend1
+}
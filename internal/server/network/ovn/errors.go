@@ -1,9 +1,17 @@
 package ovn
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sync"
+	"syscall"
+	"time"
 
 	ovsdbClient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/ovsdb"
 )
 
 // ErrExists indicates that a DB record already exists.
@@ -12,8 +20,210 @@ var ErrExists = errors.New("object already exists")
 // ErrNotFound indicates that a DB record doesn't exist.
 var ErrNotFound = ovsdbClient.ErrNotFound
 
+// ErrNotBound indicates that a port isn't bound to any chassis.
+var ErrNotBound = errors.New("No chassis found")
+
 // ErrTooMany is returned when one match is expected but multiple are found.
 var ErrTooMany = errors.New("too many objects found")
 
 // ErrNotManaged indicates that a DB record wasn't created by Incus.
 var ErrNotManaged = errors.New("object not incus-managed")
+
+// ErrQuotaExceeded indicates that the database refused a transaction because it ran out of resources.
+var ErrQuotaExceeded = errors.New("database resources exhausted")
+
+// ErrDuplicate indicates that a transaction conflicted with an existing record.
+var ErrDuplicate = errors.New("duplicate record")
+
+// ErrConstraintViolation indicates that a transaction would have left the database in an inconsistent state.
+var ErrConstraintViolation = errors.New("database constraint violation")
+
+// ErrSchemaUnsupported indicates that a transaction requires a feature missing from the database schema.
+var ErrSchemaUnsupported = errors.New("operation not supported by database schema")
+
+// ErrConnectionDown indicates that the database connection is currently unavailable.
+var ErrConnectionDown = errors.New("database connection unavailable")
+
+// ErrConcurrentChange indicates that a record was modified by someone else while the transaction was prepared.
+var ErrConcurrentChange = errors.New("object modified concurrently")
+
+// transactWaitFailed returns whether an operation error comes from a wait operation whose condition didn't hold.
+func transactWaitFailed(opErr ovsdb.OperationError) bool {
+	_, timedOut := opErr.(*ovsdb.TimedOut)
+
+	return timedOut && opErr.Operation() != nil && opErr.Operation().Op == ovsdb.OperationWait
+}
+
+// transactError classifies the errors of a transaction, wrapping the matching typed error if any.
+// The original error is always kept in the chain.
+func transactError(err error, opErrs []ovsdb.OperationError) error {
+	if err == nil {
+		return nil
+	}
+
+	// Look at the individual operation errors first as they carry the details.
+	for _, opErr := range opErrs {
+		var typedErr error
+
+		switch opErr.(type) {
+		case *ovsdb.TimedOut:
+			if transactWaitFailed(opErr) {
+				typedErr = ErrConcurrentChange
+			} else {
+				typedErr = ErrConnectionDown
+			}
+
+		case *ovsdb.ResourcesExhausted:
+			typedErr = ErrQuotaExceeded
+		case *ovsdb.DuplicateUUIDName:
+			typedErr = ErrDuplicate
+		case *ovsdb.ConstraintViolation:
+			typedErr = ErrConstraintViolation
+		case *ovsdb.NotSupported:
+			typedErr = ErrSchemaUnsupported
+		case *ovsdb.IOError:
+			typedErr = ErrConnectionDown
+		}
+
+		if typedErr != nil {
+			return fmt.Errorf("%w: %w", typedErr, opErr)
+		}
+	}
+
+	if len(opErrs) > 0 {
+		return fmt.Errorf("%w: %w", err, opErrs[0])
+	}
+
+	// Then the transport errors.
+	switch {
+	case errors.Is(err, ovsdbClient.ErrNotConnected):
+		return fmt.Errorf("%w: %w", ErrConnectionDown, err)
+	case errors.Is(err, ovsdbClient.ErrUnsupportedRPC), err.Error() == "validation failed for the operation":
+		return fmt.Errorf("%w: %w", ErrSchemaUnsupported, err)
+	}
+
+	return err
+}
+
+// transactRetryMaxDelay caps the delay between two attempts of a transaction.
+const transactRetryMaxDelay = 5 * time.Second
+
+// transactRetries and transactRetryDelay hold the retry policy used for transactions failing with transient errors.
+var (
+	transactRetries    = 3
+	transactRetryDelay = 250 * time.Millisecond
+	transactRetryMu    sync.Mutex
+)
+
+// SetTransactRetryPolicy configures how many times transactions failing with transient errors (such as the
+// database connection being reset during a leader election) are retried and the initial delay between attempts.
+// The delay doubles on each attempt and gets randomized so members don't retry in lockstep.
+func SetTransactRetryPolicy(retries int, delay time.Duration) {
+	transactRetryMu.Lock()
+	defer transactRetryMu.Unlock()
+
+	transactRetries = retries
+	transactRetryDelay = delay
+}
+
+// transactRetryPolicy returns the current retry count and initial delay.
+func transactRetryPolicy() (int, time.Duration) {
+	transactRetryMu.Lock()
+	defer transactRetryMu.Unlock()
+
+	return transactRetries, transactRetryDelay
+}
+
+// transactIdempotent returns whether the operations can safely be applied twice.
+// Inserts create a new row each time and arithmetic mutations accumulate, everything else converges.
+func transactIdempotent(operations []ovsdb.Operation) bool {
+	for _, op := range operations {
+		switch op.Op {
+		case ovsdb.OperationInsert:
+			return false
+		case ovsdb.OperationMutate:
+			for _, mutation := range op.Mutations {
+				if mutation.Mutator != ovsdb.MutateOperationInsert && mutation.Mutator != ovsdb.MutateOperationDelete {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// transactRetryable returns whether a failed transaction can be retried.
+// Transactions the server reported as failed were aborted as a whole and can always be retried. When the
+// connection dropped instead, the transaction may or may not have been committed, so it's only retried if
+// applying it again is harmless. Failed wait operations aren't retried as the same condition would fail again.
+func transactRetryable(err error, opErrs []ovsdb.OperationError, operations []ovsdb.Operation) bool {
+	for _, opErr := range opErrs {
+		if transactWaitFailed(opErr) {
+			return false
+		}
+
+		switch opErr.(type) {
+		case *ovsdb.TimedOut, *ovsdb.IOError:
+			return true
+		}
+	}
+
+	if len(opErrs) > 0 {
+		return false
+	}
+
+	if errors.Is(err, ovsdbClient.ErrNotConnected) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return transactIdempotent(operations)
+	}
+
+	return false
+}
+
+// transactClient wraps an OVSDB client so transaction failures are returned as typed errors.
+type transactClient struct {
+	ovsdbClient.Client
+}
+
+// Transact runs the operations and checks their results, returning typed errors on failure.
+// Transactions failing with transient errors are retried according to the configured retry policy.
+func (c *transactClient) Transact(ctx context.Context, operations ...ovsdb.Operation) ([]ovsdb.OperationResult, error) {
+	retries, delay := transactRetryPolicy()
+
+	for attempt := 0; ; attempt++ {
+		resp, opErrs, err := c.transact(ctx, operations...)
+		if err == nil {
+			return resp, nil
+		}
+
+		if attempt >= retries || ctx.Err() != nil || !transactRetryable(err, opErrs, operations) {
+			return nil, transactError(err, opErrs)
+		}
+
+		// Wait between half and all of the current delay.
+		wait := delay/2 + rand.N(delay/2+1)
+
+		select {
+		case <-ctx.Done():
+			return nil, transactError(err, opErrs)
+		case <-time.After(wait):
+		}
+
+		delay = min(delay*2, transactRetryMaxDelay)
+	}
+}
+
+// transact runs a single attempt of the transaction.
+func (c *transactClient) transact(ctx context.Context, operations ...ovsdb.Operation) ([]ovsdb.OperationResult, []ovsdb.OperationError, error) {
+	resp, err := c.Client.Transact(ctx, operations...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opErrs, err := ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return nil, opErrs, err
+	}
+
+	return resp, nil, nil
+}
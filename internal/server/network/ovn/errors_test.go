@@ -0,0 +1,241 @@
+package ovn
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+
+	ovsdbClient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/ovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitFailed returns the error reported when the condition of a wait operation doesn't hold.
+func waitFailed() ovsdb.OperationError {
+	opErrs, _ := ovsdb.CheckOperationResults([]ovsdb.OperationResult{{Error: "timed out"}}, []ovsdb.Operation{{Op: ovsdb.OperationWait, Table: "Logical_Switch_Port"}})
+
+	return opErrs[0]
+}
+
+// Transaction errors are mapped to the matching typed error while keeping the original errors in the chain.
+func TestTransactError(t *testing.T) {
+	opErr := errors.New("operation failed")
+
+	tests := []struct {
+		name     string
+		err      error
+		opErrs   []ovsdb.OperationError
+		wantIs   []error
+		wantNone bool
+	}{
+		{
+			name:     "No error",
+			err:      nil,
+			wantNone: true,
+		},
+		{
+			name:   "Resources exhausted",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.ResourcesExhausted{}},
+			wantIs: []error{ErrQuotaExceeded},
+		},
+		{
+			name:   "Duplicate UUID name",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.DuplicateUUIDName{}},
+			wantIs: []error{ErrDuplicate},
+		},
+		{
+			name:   "Constraint violation",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.ConstraintViolation{}},
+			wantIs: []error{ErrConstraintViolation},
+		},
+		{
+			name:   "Not supported",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.NotSupported{}},
+			wantIs: []error{ErrSchemaUnsupported},
+		},
+		{
+			name:   "Timed out",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.TimedOut{}},
+			wantIs: []error{ErrConnectionDown},
+		},
+		{
+			name:   "Wait condition failed",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{waitFailed()},
+			wantIs: []error{ErrConcurrentChange},
+		},
+		{
+			name:   "Untyped operation error",
+			err:    opErr,
+			opErrs: []ovsdb.OperationError{&ovsdb.Aborted{}},
+			wantIs: []error{opErr},
+		},
+		{
+			name:   "Not connected",
+			err:    ovsdbClient.ErrNotConnected,
+			wantIs: []error{ErrConnectionDown, ovsdbClient.ErrNotConnected},
+		},
+		{
+			name:   "Unsupported RPC",
+			err:    ovsdbClient.ErrUnsupportedRPC,
+			wantIs: []error{ErrSchemaUnsupported, ovsdbClient.ErrUnsupportedRPC},
+		},
+		{
+			name:   "Other transport error",
+			err:    io.EOF,
+			wantIs: []error{io.EOF},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := transactError(tt.err, tt.opErrs)
+			if tt.wantNone {
+				assert.NoError(t, err)
+				return
+			}
+
+			for _, want := range tt.wantIs {
+				assert.ErrorIs(t, err, want)
+			}
+
+			for _, opErr := range tt.opErrs {
+				assert.ErrorIs(t, err, opErr)
+			}
+		})
+	}
+}
+
+// Only operations which converge when applied twice are considered idempotent.
+func TestTransactIdempotent(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []ovsdb.Operation
+		want       bool
+	}{
+		{
+			name: "No operations",
+			want: true,
+		},
+		{
+			name: "Update and delete",
+			operations: []ovsdb.Operation{
+				{Op: ovsdb.OperationUpdate, Table: "Logical_Switch"},
+				{Op: ovsdb.OperationDelete, Table: "Logical_Switch_Port"},
+			},
+			want: true,
+		},
+		{
+			name: "Set mutations",
+			operations: []ovsdb.Operation{{Op: ovsdb.OperationMutate, Table: "Logical_Switch", Mutations: []ovsdb.Mutation{
+				{Column: "ports", Mutator: ovsdb.MutateOperationInsert},
+				{Column: "acls", Mutator: ovsdb.MutateOperationDelete},
+			}}},
+			want: true,
+		},
+		{
+			name: "Insert",
+			operations: []ovsdb.Operation{
+				{Op: ovsdb.OperationUpdate, Table: "Logical_Switch"},
+				{Op: ovsdb.OperationInsert, Table: "Logical_Switch_Port"},
+			},
+			want: false,
+		},
+		{
+			name: "Arithmetic mutation",
+			operations: []ovsdb.Operation{{Op: ovsdb.OperationMutate, Table: "NB_Global", Mutations: []ovsdb.Mutation{
+				{Column: "ports", Mutator: ovsdb.MutateOperationInsert},
+				{Column: "nb_cfg", Mutator: ovsdb.MutateOperationAdd},
+			}}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, transactIdempotent(tt.operations))
+		})
+	}
+}
+
+// Server side transient failures are always retried while dropped connections are only retried for idempotent
+// transactions.
+func TestTransactRetryable(t *testing.T) {
+	idempotent := []ovsdb.Operation{{Op: ovsdb.OperationUpdate, Table: "Logical_Switch"}}
+	insert := []ovsdb.Operation{{Op: ovsdb.OperationInsert, Table: "Logical_Switch"}}
+	opErr := errors.New("operation failed")
+
+	tests := []struct {
+		name       string
+		err        error
+		opErrs     []ovsdb.OperationError
+		operations []ovsdb.Operation
+		want       bool
+	}{
+		{
+			name:       "Timed out",
+			err:        opErr,
+			opErrs:     []ovsdb.OperationError{&ovsdb.TimedOut{}},
+			operations: insert,
+			want:       true,
+		},
+		{
+			name:       "Wait condition failed",
+			err:        opErr,
+			opErrs:     []ovsdb.OperationError{waitFailed()},
+			operations: idempotent,
+			want:       false,
+		},
+		{
+			name:       "I/O error",
+			err:        opErr,
+			opErrs:     []ovsdb.OperationError{&ovsdb.ConstraintViolation{}, &ovsdb.IOError{}},
+			operations: insert,
+			want:       true,
+		},
+		{
+			name:       "Constraint violation",
+			err:        opErr,
+			opErrs:     []ovsdb.OperationError{&ovsdb.ConstraintViolation{}},
+			operations: idempotent,
+			want:       false,
+		},
+		{
+			name:       "Not connected with idempotent operations",
+			err:        ovsdbClient.ErrNotConnected,
+			operations: idempotent,
+			want:       true,
+		},
+		{
+			name:       "Connection reset with idempotent operations",
+			err:        fmt.Errorf("read: %w", syscall.ECONNRESET),
+			operations: idempotent,
+			want:       true,
+		},
+		{
+			name:       "Unexpected EOF with insert",
+			err:        io.ErrUnexpectedEOF,
+			operations: insert,
+			want:       false,
+		},
+		{
+			name:       "Other error",
+			err:        errors.New("permission denied"),
+			operations: idempotent,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, transactRetryable(tt.err, tt.opErrs, tt.operations))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package ovn
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	ovsdbClient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/database/inmemory"
+	ovsdbModel "github.com/ovn-org/libovsdb/model"
+	ovsdbServer "github.com/ovn-org/libovsdb/server"
+
+	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
+	ovnSB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-sb"
+)
+
+// Fake is an in-memory OVN database server holding both the northbound and southbound databases, along with
+// clients connected to it. It stands in for a real OVN deployment in mock mode and in tests.
+//
+// Nothing plays the part of ovn-northd or ovn-controller, so the southbound database only contains what's
+// written to it directly.
+type Fake struct {
+	NB *NB
+	SB *SB
+
+	server *ovsdbServer.OvsdbServer
+	tmpDir string
+}
+
+// NewFake starts an in-memory OVN database server and connects new NB and SB clients to it.
+func NewFake() (*Fake, error) {
+	nbModel, err := ovnNB.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+
+	sbModel, err := ovnSB.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+
+	nbDatabaseModel, errs := ovsdbModel.NewDatabaseModel(ovnNB.Schema(), nbModel)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("Failed loading northbound database model: %w", errors.Join(errs...))
+	}
+
+	sbDatabaseModel, errs := ovsdbModel.NewDatabaseModel(ovnSB.Schema(), sbModel)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("Failed loading southbound database model: %w", errors.Join(errs...))
+	}
+
+	db := inmemory.NewDatabase(map[string]ovsdbModel.ClientDBModel{
+		nbDatabaseModel.Schema.Name: nbModel,
+		sbDatabaseModel.Schema.Name: sbModel,
+	})
+
+	server, err := ovsdbServer.NewOvsdbServer(db, nbDatabaseModel, sbDatabaseModel)
+	if err != nil {
+		return nil, fmt.Errorf("Failed creating in-memory OVN database server: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "incus_ovn_")
+	if err != nil {
+		return nil, err
+	}
+
+	fake := &Fake{server: server, tmpDir: tmpDir}
+
+	socketPath := filepath.Join(tmpDir, "ovsdb.sock")
+	go func() { _ = server.Serve("unix", socketPath) }()
+
+	// Wait for the server to listen.
+	for i := 0; !server.Ready(); i++ {
+		if i >= 100 {
+			fake.Close()
+			return nil, errors.New("Timed out waiting for the in-memory OVN database server")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	discard := logr.Discard()
+	endpoint := fmt.Sprintf("unix:%s", socketPath)
+
+	fake.NB, err = connectNB(ovsdbClient.WithLogger(&discard), ovsdbClient.WithEndpoint(endpoint))
+	if err != nil {
+		fake.Close()
+		return nil, fmt.Errorf("Failed connecting to the in-memory northbound database: %w", err)
+	}
+
+	fake.SB, err = connectSB(ovsdbClient.WithLogger(&discard), ovsdbClient.WithEndpoint(endpoint))
+	if err != nil {
+		fake.Close()
+		return nil, fmt.Errorf("Failed connecting to the in-memory southbound database: %w", err)
+	}
+
+	return fake, nil
+}
+
+// Close disconnects the clients and stops the in-memory server.
+func (f *Fake) Close() {
+	if f.NB != nil {
+		f.NB.client.Close()
+	}
+
+	if f.SB != nil {
+		f.SB.client.Close()
+	}
+
+	f.server.Close()
+	_ = os.RemoveAll(f.tmpDir)
+}
@@ -0,0 +1,607 @@
+package ovn_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lxc/incus/v6/internal/server/network/ovn"
+)
+
+// newTestNB returns the northbound database of an in-memory OVN setup holding a logical router, three logical
+// switches and the instance ports the tests rely on:
+//   - ls-a-instance-1234-eth0 on ls-a with 10.0.0.2 and fd42::2, member of the pg-net port group.
+//   - ls-a-instance-1234-eth1 on ls-a with 10.0.0.3.
+//   - ls-b-instance-5678-eth0 on ls-b with 10.1.0.2.
+func newTestNB(t *testing.T) (*ovn.NB, context.Context) {
+	t.Helper()
+
+	fake, err := ovn.NewFake()
+	require.NoError(t, err)
+	t.Cleanup(fake.Close)
+
+	ctx := context.Background()
+
+	err = fake.NB.CreateLogicalRouter(ctx, "lr", false)
+	require.NoError(t, err)
+
+	for _, switchName := range []ovn.OVNSwitch{"ls-a", "ls-b", "ls-c"} {
+		err = fake.NB.CreateLogicalSwitch(ctx, switchName, false)
+		require.NoError(t, err)
+	}
+
+	ports := []struct {
+		switchName ovn.OVNSwitch
+		portName   ovn.OVNSwitchPort
+		opts       ovn.OVNSwitchPortOpts
+	}{
+		{"ls-a", "ls-a-instance-1234-eth0", ovn.OVNSwitchPortOpts{MAC: net.HardwareAddr{0x00, 0x16, 0x3e, 0x00, 0x00, 0x01}, IPV4: "10.0.0.2", IPV6: "fd42::2"}},
+		{"ls-a", "ls-a-instance-1234-eth1", ovn.OVNSwitchPortOpts{MAC: net.HardwareAddr{0x00, 0x16, 0x3e, 0x00, 0x00, 0x02}, IPV4: "10.0.0.3", IPV6: "none"}},
+		{"ls-b", "ls-b-instance-5678-eth0", ovn.OVNSwitchPortOpts{MAC: net.HardwareAddr{0x00, 0x16, 0x3e, 0x00, 0x00, 0x03}, IPV4: "10.1.0.2", IPV6: "none"}},
+	}
+
+	for _, port := range ports {
+		err = fake.NB.CreateLogicalSwitchPort(ctx, port.switchName, port.portName, &port.opts, false)
+		require.NoError(t, err)
+	}
+
+	err = fake.NB.CreatePortGroup(ctx, 1, "pg-net", "", "ls-a", "ls-a-instance-1234-eth0")
+	require.NoError(t, err)
+
+	err = fake.NB.CreatePortGroup(ctx, 1, "pg-other", "", "ls-a")
+	require.NoError(t, err)
+
+	return fake.NB, ctx
+}
+
+// The lookups used by the driver to find ports and their addresses.
+func TestFake_LogicalSwitchPortLookups(t *testing.T) {
+	tests := []struct {
+		name    string
+		lookup  func(ctx context.Context, nb *ovn.NB) (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name: "Port addresses",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchPortIPs(ctx, "ls-a-instance-1234-eth0")
+			},
+			want: []net.IP{net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2")},
+		},
+		{
+			name: "Switch addresses",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchIPs(ctx, "ls-a")
+			},
+			want: map[ovn.OVNSwitchPort][]net.IP{
+				"ls-a-instance-1234-eth0": {net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2")},
+				"ls-a-instance-1234-eth1": {net.ParseIP("10.0.0.3")},
+			},
+		},
+		{
+			name: "Instance NIC ports across switches",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchPortsBySuffix(ctx, "-instance-1234-eth0")
+			},
+			want: []ovn.OVNSwitchPort{"ls-a-instance-1234-eth0"},
+		},
+		{
+			name: "Instance NIC ports of an unknown instance",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchPortsBySuffix(ctx, "-instance-9999-eth0")
+			},
+			want: []ovn.OVNSwitchPort{},
+		},
+		{
+			name: "Port groups",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchPortGroups(ctx, "ls-a-instance-1234-eth0")
+			},
+			want: []ovn.OVNPortGroup{"pg-net"},
+		},
+		{
+			name: "Port groups of a missing port",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nb.GetLogicalSwitchPortGroups(ctx, "ls-a-instance-9999-eth0")
+			},
+			wantErr: ovn.ErrNotFound,
+		},
+		{
+			name: "Existing port",
+			lookup: func(ctx context.Context, nb *ovn.NB) (any, error) {
+				return nil, nb.CreateLogicalSwitchPort(ctx, "ls-a", "ls-a-instance-1234-eth0", &ovn.OVNSwitchPortOpts{}, false)
+			},
+			wantErr: ovn.ErrExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb, ctx := newTestNB(t)
+
+			got, err := tt.lookup(ctx, nb)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Stopped instance NICs have their ports disabled, keeping their addresses, and enabled again once re-created.
+func TestFake_LogicalSwitchPortDisabled(t *testing.T) {
+	nb, ctx := newTestNB(t)
+
+	opts := &ovn.OVNSwitchPortOpts{MAC: net.HardwareAddr{0x00, 0x16, 0x3e, 0x00, 0x00, 0x01}, IPV4: "10.0.0.2", IPV6: "fd42::2"}
+
+	for _, disabled := range []bool{true, false, true} {
+		opts.Disabled = disabled
+		err := nb.CreateLogicalSwitchPort(ctx, "ls-a", "ls-a-instance-1234-eth0", opts, true)
+		require.NoError(t, err)
+
+		enabled, err := nb.GetLogicalSwitchPortEnabled(ctx, "ls-a-instance-1234-eth0")
+		require.NoError(t, err)
+		assert.Equal(t, !disabled, enabled)
+
+		ips, err := nb.GetLogicalSwitchPortIPs(ctx, "ls-a-instance-1234-eth0")
+		require.NoError(t, err)
+		assert.Equal(t, []net.IP{net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2")}, ips)
+	}
+}
+
+// Failover addresses follow the port they're assigned to and are reported among the addresses of that port only.
+func TestFake_FailoverAddresses(t *testing.T) {
+	vip := net.ParseIP("10.0.0.100")
+
+	steps := []struct {
+		name          string
+		portAddresses map[ovn.OVNSwitchPort][]net.IP
+		wantSwitchIPs map[ovn.OVNSwitchPort][]net.IP
+	}{
+		{
+			name:          "Assigned to the first port",
+			portAddresses: map[ovn.OVNSwitchPort][]net.IP{"ls-a-instance-1234-eth0": {vip}},
+			wantSwitchIPs: map[ovn.OVNSwitchPort][]net.IP{
+				"ls-a-instance-1234-eth0": {net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2"), vip},
+				"ls-a-instance-1234-eth1": {net.ParseIP("10.0.0.3")},
+			},
+		},
+		{
+			name:          "Moved to the second port",
+			portAddresses: map[ovn.OVNSwitchPort][]net.IP{"ls-a-instance-1234-eth1": {vip}},
+			wantSwitchIPs: map[ovn.OVNSwitchPort][]net.IP{
+				"ls-a-instance-1234-eth0": {net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2")},
+				"ls-a-instance-1234-eth1": {net.ParseIP("10.0.0.3"), vip},
+			},
+		},
+		{
+			name: "Released",
+			wantSwitchIPs: map[ovn.OVNSwitchPort][]net.IP{
+				"ls-a-instance-1234-eth0": {net.ParseIP("10.0.0.2"), net.ParseIP("fd42::2")},
+				"ls-a-instance-1234-eth1": {net.ParseIP("10.0.0.3")},
+			},
+		},
+	}
+
+	nb, ctx := newTestNB(t)
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			err := nb.UpdateLogicalSwitchFailoverAddresses(ctx, "ls-a", step.portAddresses)
+			require.NoError(t, err)
+
+			portAddresses, err := nb.GetLogicalSwitchFailoverAddresses(ctx, "ls-a")
+			require.NoError(t, err)
+			assert.Equal(t, len(step.portAddresses), len(portAddresses))
+			for portName, ips := range step.portAddresses {
+				assert.Equal(t, ips, portAddresses[portName])
+			}
+
+			switchIPs, err := nb.GetLogicalSwitchIPs(ctx, "ls-a")
+			require.NoError(t, err)
+			assert.Equal(t, step.wantSwitchIPs, switchIPs)
+
+			// The port's own addresses don't include the failover ones.
+			ips, err := nb.GetLogicalSwitchPortIPs(ctx, "ls-a-instance-1234-eth1")
+			require.NoError(t, err)
+			assert.Equal(t, []net.IP{net.ParseIP("10.0.0.3")}, ips)
+		})
+	}
+}
+
+// DHCPv6 reservations only accept IPv6 addresses and can be cleared again.
+func TestFake_LogicalSwitchDHCPv6Reservations(t *testing.T) {
+	steps := []struct {
+		name         string
+		reservations []net.IP
+		wantErr      bool
+		want         []net.IP
+	}{
+		{
+			name:         "IPv4 address",
+			reservations: []net.IP{net.ParseIP("10.0.0.2")},
+			wantErr:      true,
+		},
+		{
+			name:         "IPv6 addresses",
+			reservations: []net.IP{net.ParseIP("fd42::2"), net.ParseIP("fd42::3")},
+			want:         []net.IP{net.ParseIP("fd42::2"), net.ParseIP("fd42::3")},
+		},
+		{
+			name: "Cleared",
+		},
+	}
+
+	nb, ctx := newTestNB(t)
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			err := nb.UpdateLogicalSwitchDHCPv6Reservations(ctx, "ls-a", step.reservations)
+			if step.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			reservations, err := nb.GetLogicalSwitchDHCPv6Reservations(ctx, "ls-a")
+			require.NoError(t, err)
+			assert.Equal(t, len(step.want), len(reservations))
+			for i, ip := range step.want {
+				assert.Equal(t, ip.String(), reservations[i].String())
+			}
+		})
+	}
+}
+
+// The NAT rules of the router, plain or restricted to some destinations, as listed by the driver.
+func TestFake_LogicalRouterNATs(t *testing.T) {
+	_, intNetV4, _ := net.ParseCIDR("10.0.0.0/24")
+	_, intNetV6, _ := net.ParseCIDR("fd42::/64")
+	_, destinationV4, _ := net.ParseCIDR("10.0.0.0/8")
+	_, destinationV6, _ := net.ParseCIDR("fd00::/8")
+
+	tests := []struct {
+		name            string
+		create          func(ctx context.Context, nb *ovn.NB) error
+		wantExternalIPs []string
+		wantAddressSets [2][]string
+	}{
+		{
+			name: "SNAT and DNAT",
+			create: func(ctx context.Context, nb *ovn.NB) error {
+				err := nb.CreateLogicalRouterNAT(ctx, "lr", "snat", intNetV4, net.ParseIP("192.0.2.1"), nil, false, false)
+				if err != nil {
+					return err
+				}
+
+				return nb.CreateLogicalRouterNAT(ctx, "lr", "dnat_and_snat", nil, net.ParseIP("192.0.2.2"), net.ParseIP("192.0.2.2"), true, false)
+			},
+			wantExternalIPs: []string{"192.0.2.1", "192.0.2.2"},
+		},
+		{
+			name: "Selective SNAT",
+			create: func(ctx context.Context, nb *ovn.NB) error {
+				err := nb.CreateLogicalRouterSelectiveSNAT(ctx, "lr", intNetV4, net.ParseIP("192.0.2.1"), "snat0", []net.IPNet{*destinationV4}, false)
+				if err != nil {
+					return err
+				}
+
+				return nb.CreateLogicalRouterSelectiveSNAT(ctx, "lr", intNetV6, net.ParseIP("2001:db8::1"), "snat0", []net.IPNet{*destinationV6}, true)
+			},
+			wantExternalIPs: []string{"192.0.2.1", "2001:db8::1"},
+			wantAddressSets: [2][]string{{"10.0.0.0/8"}, {"fd00::/8"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb, ctx := newTestNB(t)
+
+			err := tt.create(ctx, nb)
+			require.NoError(t, err)
+
+			natRules, err := nb.GetLogicalRouterNATs(ctx, "lr")
+			require.NoError(t, err)
+
+			externalIPs := []string{}
+			for _, natRule := range natRules {
+				externalIPs = append(externalIPs, natRule.ExternalIP)
+			}
+
+			assert.ElementsMatch(t, tt.wantExternalIPs, externalIPs)
+
+			setV4, setV6, err := nb.GetAddressSet(ctx, "snat0")
+			if tt.wantAddressSets[0] == nil {
+				assert.ErrorIs(t, err, ovn.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAddressSets[0], setV4.Addresses)
+				assert.Equal(t, tt.wantAddressSets[1], setV6.Addresses)
+			}
+
+			// Removing the SNAT rules also removes the address sets of their destinations.
+			err = nb.DeleteLogicalRouterNAT(ctx, "lr", "snat", true)
+			require.NoError(t, err)
+
+			_, _, err = nb.GetAddressSet(ctx, "snat0")
+			assert.ErrorIs(t, err, ovn.ErrNotFound)
+		})
+	}
+
+	nb, ctx := newTestNB(t)
+
+	_, err := nb.GetLogicalRouterNATs(ctx, "missing")
+	assert.ErrorIs(t, err, ovn.ErrNotFound)
+}
+
+// Concurrent changes to the ARP/NDP proxy of a port don't overwrite each other.
+func TestFake_LogicalSwitchPortARPProxy(t *testing.T) {
+	nb, ctx := newTestNB(t)
+
+	prefixes := []string{}
+	for i := range 10 {
+		prefixes = append(prefixes, fmt.Sprintf("198.51.100.%d/32", i))
+	}
+
+	var wg sync.WaitGroup
+	for _, prefix := range prefixes {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, ipNet, _ := net.ParseCIDR(prefix)
+			assert.NoError(t, nb.UpdateLogicalSwitchPortARPProxyAdd(ctx, "ls-a-instance-1234-eth1", *ipNet))
+		}()
+	}
+
+	wg.Wait()
+
+	lsp, err := nb.GetLogicalSwitchPort(ctx, "ls-a-instance-1234-eth1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, prefixes, strings.Fields(lsp.Options["arp_proxy"]))
+
+	_, ipNet, _ := net.ParseCIDR(prefixes[0])
+	err = nb.UpdateLogicalSwitchPortARPProxyRemove(ctx, "ls-a-instance-1234-eth1", *ipNet)
+	require.NoError(t, err)
+
+	lsp, err = nb.GetLogicalSwitchPort(ctx, "ls-a-instance-1234-eth1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, prefixes[1:], strings.Fields(lsp.Options["arp_proxy"]))
+}
+
+// Replacing the published addresses of a router swaps its per-address rules and ARP/NDP proxy at once.
+func TestFake_LogicalRouterL2Proxy(t *testing.T) {
+	nb, ctx := newTestNB(t)
+
+	_, intNet, _ := net.ParseCIDR("10.0.0.0/24")
+	_, route, _ := net.ParseCIDR("198.51.100.0/28")
+
+	err := nb.CreateLogicalRouterNAT(ctx, "lr", "snat", intNet, net.ParseIP("192.0.2.1"), nil, false, false)
+	require.NoError(t, err)
+
+	for _, ip := range []string{"192.0.2.2", "192.0.2.3"} {
+		err = nb.CreateLogicalRouterNAT(ctx, "lr", "dnat_and_snat", nil, net.ParseIP(ip), net.ParseIP(ip), true, false)
+		require.NoError(t, err)
+	}
+
+	err = nb.UpdateLogicalSwitchPortARPProxyAdd(ctx, "ls-a-instance-1234-eth1", *route)
+	require.NoError(t, err)
+
+	steps := []struct {
+		name            string
+		natIPs          []net.IP
+		prefixes        []net.IPNet
+		wantExternalIPs []string
+		wantARPProxy    []string
+	}{
+		{
+			name:            "Per-address rules",
+			natIPs:          []net.IP{net.ParseIP("192.0.2.3"), net.ParseIP("192.0.2.4")},
+			wantExternalIPs: []string{"192.0.2.1", "192.0.2.3", "192.0.2.4"},
+		},
+		{
+			name:            "Aggregated",
+			natIPs:          []net.IP{net.ParseIP("192.0.2.4")},
+			prefixes:        []net.IPNet{*route, *route},
+			wantExternalIPs: []string{"192.0.2.1", "192.0.2.4"},
+			wantARPProxy:    []string{"198.51.100.0/28"},
+		},
+		{
+			name:            "Cleared",
+			wantExternalIPs: []string{"192.0.2.1"},
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			err := nb.UpdateLogicalRouterL2Proxy(ctx, "lr", "ls-a-instance-1234-eth1", step.natIPs, step.prefixes)
+			require.NoError(t, err)
+
+			natRules, err := nb.GetLogicalRouterNATs(ctx, "lr")
+			require.NoError(t, err)
+
+			externalIPs := []string{}
+			for _, natRule := range natRules {
+				externalIPs = append(externalIPs, natRule.ExternalIP)
+			}
+
+			assert.ElementsMatch(t, step.wantExternalIPs, externalIPs)
+
+			lsp, err := nb.GetLogicalSwitchPort(ctx, "ls-a-instance-1234-eth1")
+			require.NoError(t, err)
+			assert.ElementsMatch(t, step.wantARPProxy, strings.Fields(lsp.Options["arp_proxy"]))
+		})
+	}
+}
+
+// Load balancers are shared with the listed switches only, the owning switch always keeps it.
+func TestFake_LoadBalancerSharedSwitches(t *testing.T) {
+	steps := []struct {
+		name       string
+		shared     []ovn.OVNSwitch
+		wantShared map[ovn.OVNSwitch]bool
+	}{
+		{
+			name:       "Shared with two switches",
+			shared:     []ovn.OVNSwitch{"ls-b", "ls-c"},
+			wantShared: map[ovn.OVNSwitch]bool{"ls-a": true, "ls-b": true, "ls-c": true},
+		},
+		{
+			name:       "No longer shared with one of them",
+			shared:     []ovn.OVNSwitch{"ls-c"},
+			wantShared: map[ovn.OVNSwitch]bool{"ls-a": true, "ls-b": false, "ls-c": true},
+		},
+		{
+			name:       "Not shared",
+			wantShared: map[ovn.OVNSwitch]bool{"ls-a": true, "ls-b": false, "ls-c": false},
+		},
+	}
+
+	nb, ctx := newTestNB(t)
+
+	err := nb.CreateLoadBalancer(ctx, "lb", "lr", "ls-a", true, ovn.OVNLoadBalancerVIP{
+		ListenAddress: net.ParseIP("192.0.2.10"),
+		Protocol:      "tcp",
+		ListenPort:    80,
+		Targets:       []ovn.OVNLoadBalancerTarget{{Address: net.ParseIP("10.0.0.2"), Port: 80}},
+	})
+	require.NoError(t, err)
+
+	lb, err := nb.GetLoadBalancer(ctx, "lb-tcp")
+	require.NoError(t, err)
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			err := nb.UpdateLoadBalancerSharedSwitches(ctx, "lb", "ls-a", step.shared...)
+			require.NoError(t, err)
+
+			for switchName, wantShared := range step.wantShared {
+				ls, err := nb.GetLogicalSwitch(ctx, switchName)
+				require.NoError(t, err)
+				assert.Equal(t, wantShared, len(ls.LoadBalancer) == 1 && ls.LoadBalancer[0] == lb.UUID, switchName)
+			}
+		})
+	}
+}
+
+// DNS records of forwarded switches, existing or added later on, are attached to the forwarding switch.
+func TestFake_LogicalSwitchDNSForwarding(t *testing.T) {
+	nb, ctx := newTestNB(t)
+
+	records := map[ovn.OVNSwitchPort]ovn.OVNDNSUUID{}
+	updateDNS := func(switchName ovn.OVNSwitch, portName ovn.OVNSwitchPort, dnsName string, ip string) {
+		var err error
+
+		records[portName], err = nb.UpdateLogicalSwitchPortDNS(ctx, switchName, portName, dnsName, []net.IP{net.ParseIP(ip)})
+		require.NoError(t, err)
+	}
+
+	updateDNS("ls-a", "ls-a-instance-1234-eth0", "c1.a.example", "10.0.0.2")
+	updateDNS("ls-b", "ls-b-instance-5678-eth0", "c1.b.example", "10.1.0.2")
+
+	steps := []struct {
+		name        string
+		forwarded   []ovn.OVNSwitch
+		addPort     ovn.OVNSwitchPort
+		wantRecords []ovn.OVNSwitchPort
+	}{
+		{
+			name:        "Forwarding",
+			forwarded:   []ovn.OVNSwitch{"ls-b"},
+			wantRecords: []ovn.OVNSwitchPort{"ls-a-instance-1234-eth0", "ls-b-instance-5678-eth0"},
+		},
+		{
+			name:        "Record added to the forwarded switch",
+			forwarded:   []ovn.OVNSwitch{"ls-b"},
+			addPort:     "ls-b-instance-5678-eth1",
+			wantRecords: []ovn.OVNSwitchPort{"ls-a-instance-1234-eth0", "ls-b-instance-5678-eth0", "ls-b-instance-5678-eth1"},
+		},
+		{
+			name:        "Not forwarding",
+			wantRecords: []ovn.OVNSwitchPort{"ls-a-instance-1234-eth0"},
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			err := nb.UpdateLogicalSwitchDNSForwarding(ctx, "ls-a", step.forwarded...)
+			require.NoError(t, err)
+
+			if step.addPort != "" {
+				updateDNS("ls-b", step.addPort, "c2.b.example", "10.1.0.3")
+			}
+
+			wantRecords := []string{}
+			for _, portName := range step.wantRecords {
+				wantRecords = append(wantRecords, string(records[portName]))
+			}
+
+			ls, err := nb.GetLogicalSwitch(ctx, "ls-a")
+			require.NoError(t, err)
+			assert.ElementsMatch(t, wantRecords, ls.DNSRecords)
+		})
+	}
+
+	// The forwarded switch keeps its own records.
+	ls, err := nb.GetLogicalSwitch(ctx, "ls-b")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{string(records["ls-b-instance-5678-eth0"]), string(records["ls-b-instance-5678-eth1"])}, ls.DNSRecords)
+}
+
+// A DNS name shared by several ports can be combined while each port keeps reporting its own addresses.
+func TestFake_LogicalSwitchDNSNameShared(t *testing.T) {
+	nb, ctx := newTestNB(t)
+
+	ports := []struct {
+		portName ovn.OVNSwitchPort
+		dnsName  string
+		ip       string
+	}{
+		{"ls-a-port0", "c1.example", "10.0.0.2"},
+		{"ls-a-port1", "C1.example", "10.0.0.3"},
+		{"ls-a-port2", "c2.example", "10.0.0.4"},
+	}
+
+	for _, port := range ports {
+		_, err := nb.UpdateLogicalSwitchPortDNS(ctx, "ls-a", port.portName, port.dnsName, []net.IP{net.ParseIP(port.ip)})
+		require.NoError(t, err)
+	}
+
+	names, err := nb.GetLogicalSwitchDNSNames(ctx, "ls-a")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]ovn.OVNSwitchPort{
+		"c1.example": {"ls-a-port0", "ls-a-port1"},
+		"c2.example": {"ls-a-port2"},
+	}, names)
+
+	err = nb.UpdateLogicalSwitchDNSNameShared(ctx, "ls-a", "c1.example")
+	require.NoError(t, err)
+
+	for _, port := range ports[:2] {
+		_, dnsName, dnsIPs, err := nb.GetLogicalSwitchPortDNS(ctx, port.portName)
+		require.NoError(t, err)
+		assert.Equal(t, "c1.example", dnsName)
+		assert.Equal(t, []string{port.ip}, []string{dnsIPs[0].String()})
+	}
+
+	// Renaming a port removes it from the shared name.
+	_, err = nb.UpdateLogicalSwitchPortDNS(ctx, "ls-a", "ls-a-port1", "c1-eth1.example", []net.IP{net.ParseIP("10.0.0.3")})
+	require.NoError(t, err)
+
+	names, err = nb.GetLogicalSwitchDNSNames(ctx, "ls-a")
+	require.NoError(t, err)
+	assert.Equal(t, []ovn.OVNSwitchPort{"ls-a-port0"}, names["c1.example"])
+	assert.Equal(t, []ovn.OVNSwitchPort{"ls-a-port1"}, names["c1-eth1.example"])
+}
@@ -128,11 +128,13 @@ func NewICNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey
 
 	err = ovn.Echo(context.TODO())
 	if err != nil {
+		ovn.Close()
 		return nil, err
 	}
 
 	monitorCookie, err := ovn.MonitorAll(context.TODO())
 	if err != nil {
+		ovn.Close()
 		return nil, err
 	}
 
@@ -148,3 +150,11 @@ func NewICNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey
 
 	return client, nil
 }
+
+// Close stops the monitor and disconnects from the database.
+func (o *ICNB) Close() {
+	runtime.SetFinalizer(o, nil)
+
+	_ = o.client.MonitorCancel(context.Background(), o.cookie)
+	o.client.Close()
+}
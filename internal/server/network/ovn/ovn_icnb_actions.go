@@ -9,7 +9,9 @@ import (
 	"net"
 	"net/netip"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	ovsdbClient "github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/ovsdb"
@@ -17,6 +19,51 @@ import (
 	ovnICNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-ic-nb"
 )
 
+// transitSwitchGCGracePeriod is how long a new transit switch is kept without any allocation before being
+// considered unused, so that a switch being set up by another availability zone isn't collected.
+const transitSwitchGCGracePeriod = 10 * time.Minute
+
+// transitSwitchAllocations returns the names of the availability zones holding an allocation on the switch.
+func transitSwitchAllocations(transitSwitch *ovnICNB.TransitSwitch) []string {
+	azNames := []string{}
+	for k := range transitSwitch.ExternalIDs {
+		azName, found := strings.CutPrefix(k, "incus-allocation-")
+		if found {
+			azNames = append(azNames, azName)
+		}
+	}
+
+	return azNames
+}
+
+// ICTransitSwitch represents an Incus managed transit switch.
+type ICTransitSwitch struct {
+	Name              string
+	AvailabilityZones []string
+}
+
+// GetTransitSwitches returns the managed transit switches along with the availability zones holding an
+// allocation on them.
+func (o *ICNB) GetTransitSwitches(ctx context.Context) ([]ICTransitSwitch, error) {
+	transitSwitches := []ovnICNB.TransitSwitch{}
+	err := o.client.WhereCache(func(ts *ovnICNB.TransitSwitch) bool {
+		return ts.ExternalIDs["incus-managed"] == "true"
+	}).List(ctx, &transitSwitches)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ICTransitSwitch, 0, len(transitSwitches))
+	for _, transitSwitch := range transitSwitches {
+		azNames := transitSwitchAllocations(&transitSwitch)
+		slices.Sort(azNames)
+
+		result = append(result, ICTransitSwitch{Name: transitSwitch.Name, AvailabilityZones: azNames})
+	}
+
+	return result, nil
+}
+
 // CreateTransitSwitch creates a new managed transit switch.
 func (o *ICNB) CreateTransitSwitch(ctx context.Context, name string, mayExist bool) error {
 	// Look for an existing transit switch.
@@ -51,6 +98,7 @@ func (o *ICNB) CreateTransitSwitch(ctx context.Context, name string, mayExist bo
 		"incus-managed":     "true",
 		"incus-subnet-ipv4": ipv4Net.String(),
 		"incus-subnet-ipv6": fmt.Sprintf("fd42:%x:%x:%x::/64", rand.Intn(65535), rand.Intn(65535), rand.Intn(65535)),
+		"incus-created":     strconv.FormatInt(time.Now().Unix(), 10),
 	}
 
 	// Create the switch.
@@ -206,6 +254,127 @@ func (o *ICNB) DeleteTransitSwitchAllocation(ctx context.Context, switchName str
 	return nil
 }
 
+// ReleaseTransitSwitch removes the availability zone's allocation from the switch and deletes the switch once no
+// availability zone holds an allocation on it anymore. Returns whether the switch was deleted.
+func (o *ICNB) ReleaseTransitSwitch(ctx context.Context, switchName string, azName string) (bool, error) {
+	// Get the switch.
+	transitSwitch := ovnICNB.TransitSwitch{
+		Name: switchName,
+	}
+
+	err := o.client.Get(ctx, &transitSwitch)
+	if err != nil {
+		// Already deleted.
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	// Check that it's managed by us.
+	if transitSwitch.ExternalIDs["incus-managed"] != "true" {
+		return false, ErrNotManaged
+	}
+
+	// Release the allocation, deleting the switch if it was the last one.
+	delete(transitSwitch.ExternalIDs, fmt.Sprintf("incus-allocation-%s", azName))
+
+	deleted := len(transitSwitchAllocations(&transitSwitch)) == 0
+
+	var ops []ovsdb.Operation
+	if deleted {
+		ops, err = o.client.Where(&transitSwitch).Delete()
+	} else {
+		ops, err = o.client.Where(&transitSwitch).Update(&transitSwitch)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := o.client.Transact(ctx, ops...)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, ops)
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// DeleteUnusedTransitSwitches garbage collects the managed transit switches.
+// Allocations held by availability zones not in the active list are released and switches left without any
+// allocation are deleted. Returns the names of the deleted switches.
+func (o *ICNB) DeleteUnusedTransitSwitches(ctx context.Context, activeAZs []string) ([]string, error) {
+	transitSwitches := []ovnICNB.TransitSwitch{}
+	err := o.client.WhereCache(func(ts *ovnICNB.TransitSwitch) bool {
+		return ts.ExternalIDs["incus-managed"] == "true"
+	}).List(ctx, &transitSwitches)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []ovsdb.Operation{}
+	deleted := []string{}
+	for _, transitSwitch := range transitSwitches {
+		// Release the allocations of availability zones which are gone.
+		changed := false
+		for _, azName := range transitSwitchAllocations(&transitSwitch) {
+			if !slices.Contains(activeAZs, azName) {
+				delete(transitSwitch.ExternalIDs, fmt.Sprintf("incus-allocation-%s", azName))
+				changed = true
+			}
+		}
+
+		if len(transitSwitchAllocations(&transitSwitch)) > 0 {
+			if changed {
+				updateOps, err := o.client.Where(&transitSwitch).Update(&transitSwitch)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, updateOps...)
+			}
+
+			continue
+		}
+
+		// Leave recently created switches alone, their first allocation may still be in progress.
+		created, err := strconv.ParseInt(transitSwitch.ExternalIDs["incus-created"], 10, 64)
+		if err == nil && time.Since(time.Unix(created, 0)) < transitSwitchGCGracePeriod {
+			continue
+		}
+
+		deleteOps, err := o.client.Where(&transitSwitch).Delete()
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, deleteOps...)
+		deleted = append(deleted, transitSwitch.Name)
+	}
+
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	resp, err := o.client.Transact(ctx, ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
 // DeleteTransitSwitch deletes an existing transit switch.
 // The force parameter is required to delete a transit switch which wasn't created by Incus.
 func (o *ICNB) DeleteTransitSwitch(ctx context.Context, name string, force bool) error {
@@ -134,11 +134,13 @@ func NewICSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey
 
 	err = ovn.Echo(context.TODO())
 	if err != nil {
+		ovn.Close()
 		return nil, err
 	}
 
 	monitorCookie, err := ovn.MonitorAll(context.TODO())
 	if err != nil {
+		ovn.Close()
 		return nil, err
 	}
 
@@ -154,3 +156,11 @@ func NewICSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey
 
 	return client, nil
 }
+
+// Close stops the monitor and disconnects from the database.
+func (o *ICSB) Close() {
+	runtime.SetFinalizer(o, nil)
+
+	_ = o.client.MonitorCancel(context.Background(), o.cookie)
+	o.client.Close()
+}
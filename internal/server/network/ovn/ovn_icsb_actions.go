@@ -37,3 +37,62 @@ func (o *ICSB) GetGateways(ctx context.Context, name string) ([]string, error) {
 
 	return names, nil
 }
+
+// GetAvailabilityZones returns the names of all the availability zones connected to the interconnect.
+func (o *ICSB) GetAvailabilityZones(ctx context.Context) ([]string, error) {
+	availabilityZones := []ovnICSB.AvailabilityZone{}
+	err := o.client.List(ctx, &availabilityZones)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the names.
+	names := make([]string, 0, len(availabilityZones))
+	for _, entry := range availabilityZones {
+		names = append(names, entry.Name)
+	}
+
+	return names, nil
+}
+
+// ICPortBinding represents the binding of a transit switch port in an availability zone.
+type ICPortBinding struct {
+	TransitSwitch    string
+	LogicalPort      string
+	AvailabilityZone string
+	Gateway          string
+}
+
+// GetPortBindings returns the transit switch port bindings of all the availability zones.
+func (o *ICSB) GetPortBindings(ctx context.Context) ([]ICPortBinding, error) {
+	// Map the availability zones to their names.
+	availabilityZones := []ovnICSB.AvailabilityZone{}
+	err := o.client.List(ctx, &availabilityZones)
+	if err != nil {
+		return nil, err
+	}
+
+	azNames := make(map[string]string, len(availabilityZones))
+	for _, entry := range availabilityZones {
+		azNames[entry.UUID] = entry.Name
+	}
+
+	// Get the port bindings.
+	portBindings := []ovnICSB.PortBinding{}
+	err = o.client.List(ctx, &portBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]ICPortBinding, 0, len(portBindings))
+	for _, entry := range portBindings {
+		bindings = append(bindings, ICPortBinding{
+			TransitSwitch:    entry.TransitSwitch,
+			LogicalPort:      entry.LogicalPort,
+			AvailabilityZone: azNames[entry.AvailabilityZone],
+			Gateway:          entry.Gateway,
+		})
+	}
+
+	return bindings, nil
+}
@@ -8,11 +8,10 @@ import (
 	"errors"
 	"reflect"
 	"runtime"
+	"slices"
 	"strings"
-	"time"
 
-	"github.com/cenkalti/backoff/v4"
-	"github.com/go-logr/logr"
+	ovsdbCache "github.com/ovn-org/libovsdb/cache"
 	ovsdbClient "github.com/ovn-org/libovsdb/client"
 	ovsdbModel "github.com/ovn-org/libovsdb/model"
 
@@ -33,29 +32,7 @@ func NewNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 		return nb, nil
 	}
 
-	// Create the NB struct.
-	client := &NB{}
-
-	// Prepare the OVSDB client.
-	dbSchema, err := ovnNB.FullDatabaseModel()
-	if err != nil {
-		return nil, err
-	}
-
-	// Add some missing indexes.
-	dbSchema.SetIndexes(map[string][]ovsdbModel.ClientIndex{
-		"Load_Balancer":       {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
-		"Logical_Router":      {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
-		"Logical_Switch":      {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
-		"Logical_Switch_Port": {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
-	})
-
-	discard := logr.Discard()
-
-	options := []ovsdbClient.Option{ovsdbClient.WithLogger(&discard), ovsdbClient.WithReconnect(5*time.Second, &backoff.ZeroBackOff{})}
-	for _, entry := range strings.Split(dbAddr, ",") {
-		options = append(options, ovsdbClient.WithEndpoint(entry))
-	}
+	options := ovsdbConnectionOptions(dbAddr)
 
 	// Handle SSL.
 	if strings.Contains(dbAddr, "ssl:") {
@@ -131,6 +108,34 @@ func NewNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 		options = append(options, ovsdbClient.WithTLSConfig(tlsConfig))
 	}
 
+	client, err := connectNB(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	nb = client
+	return client, nil
+}
+
+// connectNB connects a new NB client to the database using the supplied client options.
+func connectNB(options ...ovsdbClient.Option) (*NB, error) {
+	// Create the NB struct.
+	client := &NB{}
+
+	// Prepare the OVSDB client.
+	dbSchema, err := ovnNB.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+
+	// Add some missing indexes.
+	dbSchema.SetIndexes(map[string][]ovsdbModel.ClientIndex{
+		"Load_Balancer":       {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
+		"Logical_Router":      {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
+		"Logical_Switch":      {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
+		"Logical_Switch_Port": {{Columns: []ovsdbModel.ColumnKey{{Column: "name"}}}},
+	})
+
 	// Connect to OVSDB.
 	ovn, err := ovsdbClient.NewOVSDBClient(dbSchema, options...)
 	if err != nil {
@@ -147,13 +152,50 @@ func NewNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 		return nil, err
 	}
 
+	// Set up event handlers.
+	eventHandler := &ovsdbCache.EventHandlerFuncs{}
+	eventHandler.AddFunc = func(table string, newModel ovsdbModel.Model) {
+		nbEventHandlersMu.Lock()
+		defer nbEventHandlersMu.Unlock()
+
+		for _, handler := range nbEventHandlers {
+			if handler.Hook != nil && slices.Contains(handler.Tables, table) {
+				go handler.Hook("add", table, nil, newModel)
+			}
+		}
+	}
+
+	eventHandler.UpdateFunc = func(table string, oldModel ovsdbModel.Model, newModel ovsdbModel.Model) {
+		nbEventHandlersMu.Lock()
+		defer nbEventHandlersMu.Unlock()
+
+		for _, handler := range nbEventHandlers {
+			if handler.Hook != nil && slices.Contains(handler.Tables, table) {
+				go handler.Hook("update", table, oldModel, newModel)
+			}
+		}
+	}
+
+	eventHandler.DeleteFunc = func(table string, oldModel ovsdbModel.Model) {
+		nbEventHandlersMu.Lock()
+		defer nbEventHandlersMu.Unlock()
+
+		for _, handler := range nbEventHandlers {
+			if handler.Hook != nil && slices.Contains(handler.Tables, table) {
+				go handler.Hook("remove", table, oldModel, nil)
+			}
+		}
+	}
+
+	ovn.Cache().AddEventHandler(eventHandler)
+
 	monitorCookie, err := ovn.MonitorAll(context.TODO())
 	if err != nil {
 		return nil, err
 	}
 
 	// Add the client to the struct.
-	client.client = ovn
+	client.client = &transactClient{Client: ovn}
 	client.cookie = monitorCookie
 
 	// Set finalizer to stop the monitor.
@@ -162,10 +204,14 @@ func NewNB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 		ovn.Close()
 	})
 
-	nb = client
 	return client, nil
 }
 
+// Endpoint returns the northbound database endpoint the client is currently connected to (empty if disconnected).
+func (o *NB) Endpoint() string {
+	return o.client.CurrentEndpoint()
+}
+
 // get is used to perform a libovsdb Get call while also makes use of the custom defined index.
 // For some reason the main Get() function only uses the built-in indices rather than considering the user provided ones.
 // This is apparently by design but makes it much more annoying to fetch records from some tables.
@@ -15,6 +15,7 @@ import (
 	"github.com/ovn-org/libovsdb/ovsdb"
 
 	"github.com/lxc/incus/v6/internal/iprange"
+	"github.com/lxc/incus/v6/internal/server/locking"
 	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
 	ovnSB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-sb"
 	"github.com/lxc/incus/v6/shared/util"
@@ -82,6 +83,12 @@ const (
 	ovnExtIDIncusProjectID  = "incus_project_id"
 	ovnExtIDIncusPortGroup  = "incus_port_group"
 	ovnExtIDIncusLocation   = "incus_location"
+	ovnExtIDIncusNeighbors  = "incus_neighbors"
+	ovnExtIDIncusQoSOwner   = "incus_qos_owner"
+	ovnExtIDIncusFailover   = "incus_failover"
+	ovnExtIDIncusDNSForward = "incus_dns_forward"
+	ovnExtIDIncusDNSAddrs   = "incus_dns_addresses"
+	ovnExtIDIncusDHCPv6Res  = "incus_dhcpv6_reservations"
 )
 
 // OVNIPv6RAOpts IPv6 router advertisements options that can be applied to a router.
@@ -113,6 +120,7 @@ type OVNDHCPv4Opts struct {
 	Netmask            string
 	DNSSearchList      []string
 	StaticRoutes       string
+	NTPServers         []net.IP
 }
 
 // OVNDHCPv6Opts IPv6 DHCP option set that can be created (and then applied to a switch port by resulting ID).
@@ -135,6 +143,8 @@ type OVNSwitchPortOpts struct {
 	Location     string             // Optional, use to indicate the name of the server this port is bound to.
 	RouterPort   OVNRouterPort      // Optional, the name of the associated logical router port.
 	Promiscuous  bool               // Optional, controls whether to allow unknown traffic on the port.
+	Neighbors    []net.IP           // Optional, static neighbor addresses owned by the port.
+	Disabled     bool               // Optional, create the port administratively down.
 }
 
 // OVNACLRule represents an ACL rule that can be added to a logical switch or port group.
@@ -147,6 +157,15 @@ type OVNACLRule struct {
 	LogName   string // Log label name (requires Log be true).
 }
 
+// OVNQoSRule represents a QoS rule limiting the bandwidth of the traffic it matches on a logical switch.
+type OVNQoSRule struct {
+	Direction string // Either "from-lport" or "to-lport".
+	Match     string // Match criteria. See OVN Southbound database's Logical_Flow table match column usage.
+	Priority  int    // Priority (between 0 and 32767, inclusive). Higher values take precedence.
+	Rate      int    // Rate limit in kbps.
+	Burst     int    // Burst size in kbits (0 to use the OVN default).
+}
+
 // OVNLoadBalancerTarget represents an OVN load balancer Virtual IP target.
 type OVNLoadBalancerTarget struct {
 	Address net.IP
@@ -204,6 +223,32 @@ type OVNRouterPeering struct {
 	TargetRouterRoutes  []net.IPNet
 }
 
+// OVNSequenceNumbers represents the configuration sequence numbers tracked in NB_Global.
+// NbCfg is bumped by the client, SbCfg once ovn-northd has processed it and HvCfg once all chassis have.
+type OVNSequenceNumbers struct {
+	NbCfg          int
+	NbCfgTimestamp time.Time
+	SbCfg          int
+	SbCfgTimestamp time.Time
+	HvCfg          int
+	HvCfgTimestamp time.Time
+}
+
+// OVNNetworkObjects represents the northbound database records backing a network.
+type OVNNetworkObjects struct {
+	Router         *ovnNB.LogicalRouter
+	RouterPorts    []ovnNB.LogicalRouterPort
+	RouterNAT      []ovnNB.NAT
+	RouterRoutes   []ovnNB.LogicalRouterStaticRoute
+	RouterPolicies []ovnNB.LogicalRouterPolicy
+	Switches       []ovnNB.LogicalSwitch
+	SwitchPorts    map[OVNSwitch][]ovnNB.LogicalSwitchPort
+	DHCPOptions    []ovnNB.DHCPOptions
+	LoadBalancers  []ovnNB.LoadBalancer
+	PortGroups     []ovnNB.PortGroup
+	AddressSets    []ovnNB.AddressSet
+}
+
 // CreateLogicalRouter adds a named logical router.
 // If mayExist is true, then an existing resource of the same name is not treated as an error.
 func (o *NB) CreateLogicalRouter(ctx context.Context, routerName OVNRouter, mayExist bool) error {
@@ -282,6 +327,25 @@ func (o *NB) DeleteLogicalRouter(ctx context.Context, routerName OVNRouter) erro
 		return err
 	}
 
+	// The NAT rules go away with the router but the address sets they use don't.
+	for _, natUUID := range logicalRouter.Nat {
+		natRule := ovnNB.NAT{
+			UUID: natUUID,
+		}
+
+		err = o.get(ctx, &natRule)
+		if err != nil {
+			return err
+		}
+
+		deleteOps, err := o.deleteLogicalRouterNATAddressSets(&natRule)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
 	// Apply the changes.
 	resp, err := o.client.Transact(ctx, operations...)
 	if err != nil {
@@ -310,6 +374,30 @@ func (o *NB) GetLogicalRouter(ctx context.Context, routerName OVNRouter) (*ovnNB
 	return logicalRouter, nil
 }
 
+// GetLogicalRouterNATs returns the NAT rules of a logical router.
+func (o *NB) GetLogicalRouterNATs(ctx context.Context, routerName OVNRouter) ([]ovnNB.NAT, error) {
+	logicalRouter, err := o.GetLogicalRouter(ctx, routerName)
+	if err != nil {
+		return nil, err
+	}
+
+	natRules := make([]ovnNB.NAT, 0, len(logicalRouter.Nat))
+	for _, natUUID := range logicalRouter.Nat {
+		natRule := ovnNB.NAT{
+			UUID: natUUID,
+		}
+
+		err = o.get(ctx, &natRule)
+		if err != nil {
+			return nil, err
+		}
+
+		natRules = append(natRules, natRule)
+	}
+
+	return natRules, nil
+}
+
 // CreateLogicalRouterNAT adds an SNAT or DNAT rule to a logical router to translate packets from intNet to extIP.
 func (o *NB) CreateLogicalRouterNAT(ctx context.Context, routerName OVNRouter, natType string, intNet *net.IPNet, extIP net.IP, intIP net.IP, stateless bool, mayExist bool) error {
 	// Prepare the addresses.
@@ -401,6 +489,125 @@ func (o *NB) CreateLogicalRouterNAT(ctx context.Context, routerName OVNRouter, n
 	return nil
 }
 
+// CreateLogicalRouterSelectiveSNAT adds an SNAT rule to a logical router translating packets from intNet to extIP
+// only when they're headed to one of the destinations (or, when exempt is set, to anything but the destinations).
+// The destinations are kept in an address set named "<addressSetPrefix>_ip<IP version>" which belongs to the rule
+// and is removed along with it.
+func (o *NB) CreateLogicalRouterSelectiveSNAT(ctx context.Context, routerName OVNRouter, intNet *net.IPNet, extIP net.IP, addressSetPrefix OVNAddressSet, destinations []net.IPNet, exempt bool) error {
+	// Get the logical router.
+	logicalRouter, err := o.GetLogicalRouter(ctx, routerName)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the address set, re-using any left behind by a previous rule.
+	ipVersion := 6
+	if extIP.To4() != nil {
+		ipVersion = 4
+	}
+
+	addressSet := ovnNB.AddressSet{
+		Name: fmt.Sprintf("%s_ip%d", addressSetPrefix, ipVersion),
+	}
+
+	err = o.get(ctx, &addressSet)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	addressSet.Addresses = make([]string, 0, len(destinations))
+	for _, destination := range destinations {
+		addressSet.Addresses = append(addressSet.Addresses, destination.String())
+	}
+
+	operations := []ovsdb.Operation{}
+
+	if addressSet.UUID == "" {
+		addressSet.UUID = "address_set"
+
+		createOps, err := o.client.Create(&addressSet)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, createOps...)
+	} else {
+		updateOps, err := o.client.Where(&addressSet).Update(&addressSet)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	// Create the rule.
+	natRule := ovnNB.NAT{
+		UUID:       "nat",
+		Options:    map[string]string{"stateless": "false"},
+		Type:       ovnNB.NATTypeSNAT,
+		LogicalIP:  intNet.String(),
+		ExternalIP: extIP.String(),
+	}
+
+	if exempt {
+		natRule.ExemptedExtIPs = &addressSet.UUID
+	} else {
+		natRule.AllowedExtIPs = &addressSet.UUID
+	}
+
+	createOps, err := o.client.Create(&natRule)
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, createOps...)
+
+	// Add it to the router.
+	updateOps, err := o.client.Where(logicalRouter).Mutate(logicalRouter, ovsModel.Mutation{
+		Field:   &logicalRouter.Nat,
+		Mutator: ovsdb.MutateOperationInsert,
+		Value:   []string{natRule.UUID},
+	})
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, updateOps...)
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteLogicalRouterNATAddressSets returns the operations deleting the address sets belonging to a NAT rule.
+func (o *NB) deleteLogicalRouterNATAddressSets(natRule *ovnNB.NAT) ([]ovsdb.Operation, error) {
+	operations := []ovsdb.Operation{}
+
+	for _, addressSetUUID := range []*string{natRule.AllowedExtIPs, natRule.ExemptedExtIPs} {
+		if addressSetUUID == nil {
+			continue
+		}
+
+		deleteOps, err := o.client.Where(&ovnNB.AddressSet{UUID: *addressSetUUID}).Delete()
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
+	return operations, nil
+}
+
 // DeleteLogicalRouterNAT deletes all NAT rules of a particular type from a logical router.
 func (o *NB) DeleteLogicalRouterNAT(ctx context.Context, routerName OVNRouter, natType string, all bool, extIPs ...net.IP) error {
 	// Quick checks.
@@ -455,6 +662,13 @@ func (o *NB) DeleteLogicalRouterNAT(ctx context.Context, routerName OVNRouter, n
 
 		operations = append(operations, deleteOps...)
 
+		deleteOps, err = o.deleteLogicalRouterNATAddressSets(&natRule)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, deleteOps...)
+
 		// Delete the entry from the logical router.
 		deleteOps, err = o.client.Where(logicalRouter).Mutate(logicalRouter, ovsModel.Mutation{
 			Field:   &logicalRouter.Nat,
@@ -540,6 +754,83 @@ func (o *NB) CreateStaticMACBinding(ctx context.Context, portName OVNRouterPort,
 	return nil
 }
 
+// GetStaticMACBindings returns the static MAC bindings of the specified router port, keyed by IP address.
+func (o *NB) GetStaticMACBindings(ctx context.Context, portName OVNRouterPort) (map[string]net.HardwareAddr, error) {
+	staticMACBindings := []ovnNB.StaticMACBinding{}
+	err := o.client.WhereCache(func(smb *ovnNB.StaticMACBinding) bool {
+		return smb.LogicalPort == string(portName)
+	}).List(ctx, &staticMACBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make(map[string]net.HardwareAddr, len(staticMACBindings))
+	for _, binding := range staticMACBindings {
+		ip := net.ParseIP(binding.IP)
+		if ip == nil {
+			continue
+		}
+
+		mac, err := net.ParseMAC(binding.MAC)
+		if err != nil {
+			continue
+		}
+
+		bindings[ip.String()] = mac
+	}
+
+	return bindings, nil
+}
+
+// DeleteStaticMACBinding deletes the static MAC bindings for the specified IPs from the router port.
+func (o *NB) DeleteStaticMACBinding(ctx context.Context, portName OVNRouterPort, ips ...net.IP) error {
+	if len(ips) == 0 {
+		return nil
+	}
+
+	// Get all the entries for this router port.
+	staticMACBindings := []ovnNB.StaticMACBinding{}
+	err := o.client.WhereCache(func(smb *ovnNB.StaticMACBinding) bool {
+		return smb.LogicalPort == string(portName)
+	}).List(ctx, &staticMACBindings)
+	if err != nil {
+		return err
+	}
+
+	// Check what needs to be deleted.
+	var operations []ovsdb.Operation
+	for _, binding := range staticMACBindings {
+		ip := net.ParseIP(binding.IP)
+		if ip == nil || !slices.ContainsFunc(ips, ip.Equal) {
+			continue
+		}
+
+		op, err := o.client.Where(&ovnNB.StaticMACBinding{UUID: binding.UUID}).Delete()
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, op...)
+	}
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// Apply the database changes.
+	reply, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(reply, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteStaticMACBindings deletes all static MAC bindings from the specified router port.
 // It allows filtering what family to flush.
 func (o *NB) DeleteStaticMACBindings(ctx context.Context, portName OVNRouterPort, ipv4 bool, ipv6 bool) error {
@@ -1020,6 +1311,46 @@ func (o *NB) UpdateLogicalRouterPort(ctx context.Context, portName OVNRouterPort
 	return nil
 }
 
+// SetLogicalRouterPortOptions sets options on a logical router port, leaving its other options untouched.
+// Options with an empty value are removed.
+func (o *NB) SetLogicalRouterPortOptions(ctx context.Context, portName OVNRouterPort, options map[string]string) error {
+	lrp, err := o.GetLogicalRouterPort(ctx, portName)
+	if err != nil {
+		return err
+	}
+
+	if lrp.Options == nil {
+		lrp.Options = map[string]string{}
+	}
+
+	for k, v := range options {
+		if v == "" {
+			delete(lrp.Options, k)
+		} else {
+			lrp.Options[k] = v
+		}
+	}
+
+	// Update the record.
+	operations, err := o.client.Where(lrp).Update(lrp)
+	if err != nil {
+		return err
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetLogicalSwitch gets the OVN database record for the switch.
 func (o *NB) GetLogicalSwitch(ctx context.Context, switchName OVNSwitch) (*ovnNB.LogicalSwitch, error) {
 	logicalSwitch := &ovnNB.LogicalSwitch{
@@ -1337,27 +1668,100 @@ func (o *NB) GetLogicalSwitchDHCPv4Revervations(ctx context.Context, switchName
 	return excludeIPs, nil
 }
 
-// UpdateLogicalSwitchDHCPv4Options creates or updates a DHCPv4 option set associated with the specified switchName
-// and subnet. If uuid is non-empty then the record that exists with that ID is updated, otherwise a new record
-// is created.
-func (o *NB) UpdateLogicalSwitchDHCPv4Options(ctx context.Context, switchName OVNSwitch, uuid OVNDHCPOptionsUUID, subnet *net.IPNet, opts *OVNDHCPv4Opts) error {
-	dhcpOption := ovnNB.DHCPOptions{}
-	if uuid != "" {
-		// Load the existing record.
-		dhcpOption.UUID = string(uuid)
-		err := o.get(ctx, &dhcpOption)
-		if err != nil {
-			return err
-		}
+// UpdateLogicalSwitchDHCPv6Reservations sets the DHCPv6 IP reservations.
+// OVN has no equivalent of exclude_ips for IPv6, so they are only recorded on the switch for Incus to skip them when
+// picking addresses itself.
+func (o *NB) UpdateLogicalSwitchDHCPv6Reservations(ctx context.Context, switchName OVNSwitch, reservedIPs []net.IP) error {
+	// Get the logical switch.
+	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
+		return err
 	}
 
-	if dhcpOption.ExternalIDs == nil {
-		dhcpOption.ExternalIDs = map[string]string{}
+	// Update the configuration.
+	if logicalSwitch.ExternalIDs == nil {
+		logicalSwitch.ExternalIDs = map[string]string{}
 	}
 
-	if dhcpOption.Options == nil {
-		dhcpOption.Options = map[string]string{}
-	}
+	if len(reservedIPs) > 0 {
+		reservations := make([]string, 0, len(reservedIPs))
+		for _, ip := range reservedIPs {
+			if ip == nil || ip.To4() != nil {
+				return errors.New("Invalid reserved IPv6 address")
+			}
+
+			reservations = append(reservations, ip.String())
+		}
+
+		logicalSwitch.ExternalIDs[ovnExtIDIncusDHCPv6Res] = strings.Join(reservations, " ")
+	} else {
+		delete(logicalSwitch.ExternalIDs, ovnExtIDIncusDHCPv6Res)
+	}
+
+	// Name the column so that it gets cleared when the last reservation is removed.
+	operations, err := o.client.Where(logicalSwitch).Update(logicalSwitch, &logicalSwitch.ExternalIDs)
+	if err != nil {
+		return err
+	}
+
+	// Apply the database changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLogicalSwitchDHCPv6Reservations gets the DHCPv6 IP reservations.
+func (o *NB) GetLogicalSwitchDHCPv6Reservations(ctx context.Context, switchName OVNSwitch) ([]net.IP, error) {
+	// Get the logical switch.
+	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
+		return nil, err
+	}
+
+	reservationsParts := util.SplitNTrimSpace(logicalSwitch.ExternalIDs[ovnExtIDIncusDHCPv6Res], " ", -1, true)
+	reservations := make([]net.IP, 0, len(reservationsParts))
+
+	for _, reservationsPart := range reservationsParts {
+		ip := net.ParseIP(reservationsPart)
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid DHCPv6 reservation: %q", reservationsPart)
+		}
+
+		reservations = append(reservations, ip)
+	}
+
+	return reservations, nil
+}
+
+// UpdateLogicalSwitchDHCPv4Options creates or updates a DHCPv4 option set associated with the specified switchName
+// and subnet. If uuid is non-empty then the record that exists with that ID is updated, otherwise a new record
+// is created.
+func (o *NB) UpdateLogicalSwitchDHCPv4Options(ctx context.Context, switchName OVNSwitch, uuid OVNDHCPOptionsUUID, subnet *net.IPNet, opts *OVNDHCPv4Opts) error {
+	dhcpOption := ovnNB.DHCPOptions{}
+	if uuid != "" {
+		// Load the existing record.
+		dhcpOption.UUID = string(uuid)
+		err := o.get(ctx, &dhcpOption)
+		if err != nil {
+			return err
+		}
+	}
+
+	if dhcpOption.ExternalIDs == nil {
+		dhcpOption.ExternalIDs = map[string]string{}
+	}
+
+	if dhcpOption.Options == nil {
+		dhcpOption.Options = map[string]string{}
+	}
 
 	dhcpOption.ExternalIDs[ovnExtIDIncusSwitch] = string(switchName)
 	dhcpOption.Cidr = subnet.String()
@@ -1419,6 +1823,17 @@ func (o *NB) UpdateLogicalSwitchDHCPv4Options(ctx context.Context, switchName OV
 		delete(dhcpOption.Options, "classless_static_route")
 	}
 
+	ntpIPs := make([]string, 0, len(opts.NTPServers))
+	for _, ntpIP := range opts.NTPServers {
+		ntpIPs = append(ntpIPs, ntpIP.String())
+	}
+
+	if len(ntpIPs) > 0 {
+		dhcpOption.Options["ntp_server"] = fmt.Sprintf("{%s}", strings.Join(ntpIPs, ","))
+	} else {
+		delete(dhcpOption.Options, "ntp_server")
+	}
+
 	// Prepare the changes.
 	operations := []ovsdb.Operation{}
 	if dhcpOption.UUID == "" {
@@ -1542,11 +1957,12 @@ func (o *NB) UpdateLogicalSwitchDHCPv6Options(ctx context.Context, switchName OV
 }
 
 // GetLogicalSwitchDHCPOptions retrieves the existing DHCP options defined for a logical switch.
+// Option sets dedicated to a single port aren't included.
 func (o *NB) GetLogicalSwitchDHCPOptions(ctx context.Context, switchName OVNSwitch) ([]OVNDHCPOptsSet, error) {
 	// Get the matching DHCP options.
 	dhcpOptions := []ovnNB.DHCPOptions{}
 	err := o.client.WhereCache(func(do *ovnNB.DHCPOptions) bool {
-		return do.ExternalIDs != nil && do.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName)
+		return do.ExternalIDs != nil && do.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && do.ExternalIDs[ovnExtIDIncusSwitchPort] == ""
 	}).List(ctx, &dhcpOptions)
 	if err != nil {
 		return nil, err
@@ -1568,6 +1984,228 @@ func (o *NB) GetLogicalSwitchDHCPOptions(ctx context.Context, switchName OVNSwit
 	return dhcpOpts, nil
 }
 
+// GetLogicalSwitchPortDHCPOptions retrieves the DHCPv4 and DHCPv6 option sets applied to a logical switch port.
+// A nil record is returned for each family that doesn't have DHCP enabled on the port.
+func (o *NB) GetLogicalSwitchPortDHCPOptions(ctx context.Context, portName OVNSwitchPort) (*ovnNB.DHCPOptions, *ovnNB.DHCPOptions, error) {
+	lsp := &ovnNB.LogicalSwitchPort{
+		Name: string(portName),
+	}
+
+	err := o.get(ctx, lsp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dhcpv4Option *ovnNB.DHCPOptions
+	if lsp.Dhcpv4Options != nil {
+		dhcpv4Option = &ovnNB.DHCPOptions{
+			UUID: *lsp.Dhcpv4Options,
+		}
+
+		err = o.get(ctx, dhcpv4Option)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var dhcpv6Option *ovnNB.DHCPOptions
+	if lsp.Dhcpv6Options != nil {
+		dhcpv6Option = &ovnNB.DHCPOptions{
+			UUID: *lsp.Dhcpv6Options,
+		}
+
+		err = o.get(ctx, dhcpv6Option)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return dhcpv4Option, dhcpv6Option, nil
+}
+
+// logicalSwitchPortDHCPv4Options returns the DHCPv4 option sets dedicated to the specified port.
+func (o *NB) logicalSwitchPortDHCPv4Options(ctx context.Context, portName OVNSwitchPort) ([]ovnNB.DHCPOptions, error) {
+	dhcpOptions := []ovnNB.DHCPOptions{}
+	err := o.client.WhereCache(func(do *ovnNB.DHCPOptions) bool {
+		return do.ExternalIDs != nil && do.ExternalIDs[ovnExtIDIncusSwitchPort] == string(portName)
+	}).List(ctx, &dhcpOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return dhcpOptions, nil
+}
+
+// GetLogicalSwitchPortsDHCPv4Options returns the ports of the switch using a dedicated DHCPv4 option set.
+func (o *NB) GetLogicalSwitchPortsDHCPv4Options(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort]OVNDHCPOptionsUUID, error) {
+	dhcpOptions := []ovnNB.DHCPOptions{}
+	err := o.client.WhereCache(func(do *ovnNB.DHCPOptions) bool {
+		return do.ExternalIDs != nil && do.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && do.ExternalIDs[ovnExtIDIncusSwitchPort] != ""
+	}).List(ctx, &dhcpOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := make(map[OVNSwitchPort]OVNDHCPOptionsUUID, len(dhcpOptions))
+	for _, dhcpOption := range dhcpOptions {
+		ports[OVNSwitchPort(dhcpOption.ExternalIDs[ovnExtIDIncusSwitchPort])] = OVNDHCPOptionsUUID(dhcpOption.UUID)
+	}
+
+	return ports, nil
+}
+
+// SetLogicalSwitchPortDHCPv4Options applies a DHCPv4 option set dedicated to the specified port. The option set is
+// a copy of the switch wide option set identified by baseUUID with the supplied options overridden, and is created
+// or updated as needed.
+func (o *NB) SetLogicalSwitchPortDHCPv4Options(ctx context.Context, switchName OVNSwitch, portName OVNSwitchPort, baseUUID OVNDHCPOptionsUUID, overrides map[string]string) error {
+	// Get the switch wide option set.
+	baseOption := ovnNB.DHCPOptions{
+		UUID: string(baseUUID),
+	}
+
+	err := o.get(ctx, &baseOption)
+	if err != nil {
+		return err
+	}
+
+	// Get the logical switch port.
+	lsp := ovnNB.LogicalSwitchPort{
+		Name: string(portName),
+	}
+
+	err = o.get(ctx, &lsp)
+	if err != nil {
+		return err
+	}
+
+	// Look for an existing dedicated option set.
+	existingOptions, err := o.logicalSwitchPortDHCPv4Options(ctx, portName)
+	if err != nil {
+		return err
+	}
+
+	dhcpOption := ovnNB.DHCPOptions{}
+	if len(existingOptions) > 0 {
+		dhcpOption = existingOptions[0]
+	}
+
+	dhcpOption.Cidr = baseOption.Cidr
+	dhcpOption.ExternalIDs = map[string]string{
+		ovnExtIDIncusSwitch:     string(switchName),
+		ovnExtIDIncusSwitchPort: string(portName),
+	}
+
+	dhcpOption.Options = maps.Clone(baseOption.Options)
+	maps.Copy(dhcpOption.Options, overrides)
+
+	// Prepare the changes.
+	operations := []ovsdb.Operation{}
+	if dhcpOption.UUID == "" {
+		// Create a new record.
+		dhcpOption.UUID = "dhcpv4"
+
+		createOps, err := o.client.Create(&dhcpOption)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, createOps...)
+	} else {
+		// Update the record.
+		updateOps, err := o.client.Where(&dhcpOption).Update(&dhcpOption)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	// Bind the option set to the port.
+	lsp.Dhcpv4Options = &dhcpOption.UUID
+
+	updateOps, err := o.client.Where(&lsp).Update(&lsp)
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, updateOps...)
+
+	// Apply the database changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLogicalSwitchPortDHCPv4Options deletes the DHCPv4 option sets dedicated to the specified port, binding the
+// port to the switch wide option set identified by baseUUID instead (or leaving DHCPv4 disabled if empty).
+func (o *NB) DeleteLogicalSwitchPortDHCPv4Options(ctx context.Context, portName OVNSwitchPort, baseUUID OVNDHCPOptionsUUID) error {
+	// Get the logical switch port.
+	lsp := ovnNB.LogicalSwitchPort{
+		Name: string(portName),
+	}
+
+	err := o.get(ctx, &lsp)
+	if err != nil {
+		return err
+	}
+
+	existingOptions, err := o.logicalSwitchPortDHCPv4Options(ctx, portName)
+	if err != nil {
+		return err
+	}
+
+	if len(existingOptions) == 0 {
+		return nil
+	}
+
+	// Rebind the port.
+	operations := []ovsdb.Operation{}
+	if baseUUID != "" {
+		dhcpv4Options := string(baseUUID)
+		lsp.Dhcpv4Options = &dhcpv4Options
+	} else {
+		lsp.Dhcpv4Options = nil
+	}
+
+	updateOps, err := o.client.Where(&lsp).Update(&lsp, &lsp.Dhcpv4Options)
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, updateOps...)
+
+	// Delete the dedicated option sets.
+	for _, dhcpOption := range existingOptions {
+		deleteOps, err := o.client.Where(&dhcpOption).Delete()
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
+	// Apply the database changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteLogicalSwitchDHCPOption deletes the specified DHCP options defined for a switch.
 func (o *NB) DeleteLogicalSwitchDHCPOption(ctx context.Context, switchName OVNSwitch, uuids ...OVNDHCPOptionsUUID) error {
 	operations := []ovsdb.Operation{}
@@ -1655,31 +2293,125 @@ func (o *NB) UpdateLogicalSwitchACLRules(ctx context.Context, switchName OVNSwit
 	return nil
 }
 
-// logicalSwitchPortACLRules returns the ACL rule UUIDs belonging to a logical switch port.
-func (o *NB) logicalSwitchPortACLRules(ctx context.Context, portName OVNSwitchPort) ([]string, error) {
-	acls := []ovnNB.ACL{}
+// UpdateLogicalSwitchQoSRules replaces the QoS rules of a logical switch which belong to the given owner with the
+// ones provided. Rules of other owners are left untouched.
+func (o *NB) UpdateLogicalSwitchQoSRules(ctx context.Context, switchName OVNSwitch, owner string, rules ...OVNQoSRule) error {
+	operations := []ovsdb.Operation{}
 
-	err := o.client.WhereCache(func(acl *ovnNB.ACL) bool {
-		return acl.ExternalIDs != nil && acl.ExternalIDs[ovnExtIDIncusSwitchPort] == string(portName)
-	}).List(ctx, &acls)
+	// Get the logical switch.
+	ls, err := o.GetLogicalSwitch(ctx, switchName)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ruleUUIDs := []string{}
-	for _, acl := range acls {
-		ruleUUIDs = append(ruleUUIDs, acl.UUID)
-	}
+	// Remove any existing rules of the owner.
+	for _, qosUUID := range ls.QOSRules {
+		qos := ovnNB.QoS{
+			UUID: qosUUID,
+		}
 
-	return ruleUUIDs, nil
-}
+		err = o.get(ctx, &qos)
+		if err != nil {
+			return err
+		}
 
-// GetLogicalSwitchPorts returns a map of logical switch ports (name and UUID) for a switch.
-// Includes non-instance ports, such as the router port.
-func (o *NB) GetLogicalSwitchPorts(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort]OVNSwitchPortUUID, error) {
-	// Get the logical switch.
-	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
-	if err != nil {
+		if qos.ExternalIDs[ovnExtIDIncusQoSOwner] != owner {
+			continue
+		}
+
+		updateOps, err := o.client.Where(ls).Mutate(ls, ovsModel.Mutation{
+			Field:   &ls.QOSRules,
+			Mutator: ovsdb.MutateOperationDelete,
+			Value:   []string{qosUUID},
+		})
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	// Add new rules.
+	for i, rule := range rules {
+		qos := ovnNB.QoS{
+			UUID:      fmt.Sprintf("qos_%d", i),
+			Direction: rule.Direction,
+			Match:     rule.Match,
+			Priority:  rule.Priority,
+			Bandwidth: map[string]int{ovnNB.QoSBandwidthRate: rule.Rate},
+			ExternalIDs: map[string]string{
+				ovnExtIDIncusSwitch:   string(switchName),
+				ovnExtIDIncusQoSOwner: owner,
+			},
+		}
+
+		if rule.Burst > 0 {
+			qos.Bandwidth[ovnNB.QoSBandwidthBurst] = rule.Burst
+		}
+
+		createOps, err := o.client.Create(&qos)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, createOps...)
+
+		updateOps, err := o.client.Where(ls).Mutate(ls, ovsModel.Mutation{
+			Field:   &ls.QOSRules,
+			Mutator: ovsdb.MutateOperationInsert,
+			Value:   []string{qos.UUID},
+		})
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// Apply the database changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// logicalSwitchPortACLRules returns the ACL rule UUIDs belonging to a logical switch port.
+func (o *NB) logicalSwitchPortACLRules(ctx context.Context, portName OVNSwitchPort) ([]string, error) {
+	acls := []ovnNB.ACL{}
+
+	err := o.client.WhereCache(func(acl *ovnNB.ACL) bool {
+		return acl.ExternalIDs != nil && acl.ExternalIDs[ovnExtIDIncusSwitchPort] == string(portName)
+	}).List(ctx, &acls)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleUUIDs := []string{}
+	for _, acl := range acls {
+		ruleUUIDs = append(ruleUUIDs, acl.UUID)
+	}
+
+	return ruleUUIDs, nil
+}
+
+// GetLogicalSwitchPorts returns a map of logical switch ports (name and UUID) for a switch.
+// Includes non-instance ports, such as the router port.
+// The lookups are served from the monitored client cache, so this doesn't query the database.
+func (o *NB) GetLogicalSwitchPorts(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort]OVNSwitchPortUUID, error) {
+	// Get the logical switch.
+	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -1701,6 +2433,26 @@ func (o *NB) GetLogicalSwitchPorts(ctx context.Context, switchName OVNSwitch) (m
 	return ports, nil
 }
 
+// GetLogicalSwitchPortsBySuffix returns the names of the logical switch ports, across all switches, whose name ends
+// with the provided suffix.
+func (o *NB) GetLogicalSwitchPortsBySuffix(ctx context.Context, suffix string) ([]OVNSwitchPort, error) {
+	lsps := []ovnNB.LogicalSwitchPort{}
+
+	err := o.client.WhereCache(func(lsp *ovnNB.LogicalSwitchPort) bool {
+		return strings.HasSuffix(lsp.Name, suffix)
+	}).List(ctx, &lsps)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := make([]OVNSwitchPort, 0, len(lsps))
+	for _, lsp := range lsps {
+		ports = append(ports, OVNSwitchPort(lsp.Name))
+	}
+
+	return ports, nil
+}
+
 // GetLogicalSwitchIPs returns a list of IPs associated to each port connected to switch.
 func (o *NB) GetLogicalSwitchIPs(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort][]net.IP, error) {
 	lsps := []ovnNB.LogicalSwitchPort{}
@@ -1742,6 +2494,200 @@ func (o *NB) GetLogicalSwitchIPs(ctx context.Context, switchName OVNSwitch) (map
 	return portIPs, nil
 }
 
+// GetLogicalSwitchNeighbors returns the static neighbor addresses owned by each port connected to switch.
+func (o *NB) GetLogicalSwitchNeighbors(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort][]net.IP, error) {
+	lsps := []ovnNB.LogicalSwitchPort{}
+
+	err := o.client.WhereCache(func(lsp *ovnNB.LogicalSwitchPort) bool {
+		return lsp.ExternalIDs != nil && lsp.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && lsp.ExternalIDs[ovnExtIDIncusNeighbors] != ""
+	}).List(ctx, &lsps)
+	if err != nil {
+		return nil, err
+	}
+
+	portNeighbors := make(map[OVNSwitchPort][]net.IP, len(lsps))
+	for _, lsp := range lsps {
+		var ips []net.IP
+
+		for _, entry := range util.SplitNTrimSpace(lsp.ExternalIDs[ovnExtIDIncusNeighbors], ",", -1, true) {
+			ip := net.ParseIP(entry)
+			if ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+
+		portNeighbors[OVNSwitchPort(lsp.Name)] = ips
+	}
+
+	return portNeighbors, nil
+}
+
+// logicalSwitchPortFailoverIPs returns the failover addresses currently assigned to the logical switch port.
+func logicalSwitchPortFailoverIPs(lsp *ovnNB.LogicalSwitchPort) []net.IP {
+	var ips []net.IP
+	for _, entry := range util.SplitNTrimSpace(lsp.ExternalIDs[ovnExtIDIncusFailover], ",", -1, true) {
+		ip := net.ParseIP(entry)
+		if ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+// GetLogicalSwitchFailoverAddresses returns the failover addresses assigned to each port connected to switch.
+func (o *NB) GetLogicalSwitchFailoverAddresses(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort][]net.IP, error) {
+	lsps := []ovnNB.LogicalSwitchPort{}
+
+	err := o.client.WhereCache(func(lsp *ovnNB.LogicalSwitchPort) bool {
+		return lsp.ExternalIDs != nil && lsp.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && lsp.ExternalIDs[ovnExtIDIncusFailover] != ""
+	}).List(ctx, &lsps)
+	if err != nil {
+		return nil, err
+	}
+
+	portAddresses := make(map[OVNSwitchPort][]net.IP, len(lsps))
+	for _, lsp := range lsps {
+		portAddresses[OVNSwitchPort(lsp.Name)] = logicalSwitchPortFailoverIPs(&lsp)
+	}
+
+	return portAddresses, nil
+}
+
+// UpdateLogicalSwitchFailoverAddresses assigns the failover addresses to the ports connected to switch.
+// Each port is given an additional address entry using its MAC address, so that OVN answers ARP and NDP requests
+// for the failover addresses and delivers their traffic to the port. Ports missing from portAddresses lose any
+// failover address they had.
+func (o *NB) UpdateLogicalSwitchFailoverAddresses(ctx context.Context, switchName OVNSwitch, portAddresses map[OVNSwitchPort][]net.IP) error {
+	lsps := []ovnNB.LogicalSwitchPort{}
+
+	err := o.client.WhereCache(func(lsp *ovnNB.LogicalSwitchPort) bool {
+		return lsp.ExternalIDs != nil && lsp.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && lsp.Type == ""
+	}).List(ctx, &lsps)
+	if err != nil {
+		return err
+	}
+
+	operations := []ovsdb.Operation{}
+	for _, lsp := range lsps {
+		oldIPs := logicalSwitchPortFailoverIPs(&lsp)
+		newIPs := portAddresses[OVNSwitchPort(lsp.Name)]
+		if len(oldIPs) == 0 && len(newIPs) == 0 {
+			continue
+		}
+
+		// Remove the previous failover entry, keeping the port's own addresses.
+		addresses := make([]string, 0, len(lsp.Addresses)+1)
+		var mac net.HardwareAddr
+		for i, address := range lsp.Addresses {
+			fields := util.SplitNTrimSpace(address, " ", -1, true)
+			if i == 0 && len(fields) > 0 {
+				mac, _ = net.ParseMAC(fields[0])
+			}
+
+			if i > 0 && len(oldIPs) > 0 && len(fields) > 1 && !slices.ContainsFunc(fields[1:], func(field string) bool {
+				return !slices.ContainsFunc(oldIPs, net.ParseIP(field).Equal)
+			}) {
+				continue
+			}
+
+			addresses = append(addresses, address)
+		}
+
+		if len(newIPs) > 0 {
+			if mac == nil {
+				return fmt.Errorf("Logical switch port %q has no MAC address", lsp.Name)
+			}
+
+			entry := []string{mac.String()}
+			for _, ip := range newIPs {
+				entry = append(entry, ip.String())
+			}
+
+			// Keep the "unknown" entry of promiscuous ports last.
+			unknown := slices.Contains(addresses, "unknown")
+			addresses = slices.DeleteFunc(addresses, func(address string) bool { return address == "unknown" })
+			addresses = append(addresses, strings.Join(entry, " "))
+			if unknown {
+				addresses = append(addresses, "unknown")
+			}
+
+			ips := make([]string, 0, len(newIPs))
+			for _, ip := range newIPs {
+				ips = append(ips, ip.String())
+			}
+
+			lsp.ExternalIDs[ovnExtIDIncusFailover] = strings.Join(ips, ",")
+		} else {
+			delete(lsp.ExternalIDs, ovnExtIDIncusFailover)
+		}
+
+		lsp.Addresses = addresses
+
+		updateOps, err := o.client.Where(&lsp).Update(&lsp)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLogicalSwitchPort gets the OVN database record for the logical switch port.
+func (o *NB) GetLogicalSwitchPort(ctx context.Context, portName OVNSwitchPort) (*ovnNB.LogicalSwitchPort, error) {
+	logicalSwitchPort := &ovnNB.LogicalSwitchPort{
+		Name: string(portName),
+	}
+
+	err := o.get(ctx, logicalSwitchPort)
+	if err != nil {
+		return nil, err
+	}
+
+	return logicalSwitchPort, nil
+}
+
+// GetLogicalSwitchPortGroups returns the names of the port groups the logical switch port is a member of.
+func (o *NB) GetLogicalSwitchPortGroups(ctx context.Context, portName OVNSwitchPort) ([]OVNPortGroup, error) {
+	portUUID, err := o.GetLogicalSwitchPortUUID(ctx, portName)
+	if err != nil {
+		return nil, err
+	}
+
+	pgs := []ovnNB.PortGroup{}
+
+	err = o.client.WhereCache(func(pg *ovnNB.PortGroup) bool {
+		return slices.Contains(pg.Ports, string(portUUID))
+	}).List(ctx, &pgs)
+	if err != nil {
+		return nil, err
+	}
+
+	portGroups := make([]OVNPortGroup, 0, len(pgs))
+	for _, pg := range pgs {
+		portGroups = append(portGroups, OVNPortGroup(pg.Name))
+	}
+
+	return portGroups, nil
+}
+
 // GetLogicalSwitchPortUUID returns the logical switch port UUID.
 func (o *NB) GetLogicalSwitchPortUUID(ctx context.Context, portName OVNSwitchPort) (OVNSwitchPortUUID, error) {
 	// Get the logical switch port.
@@ -1796,12 +2742,14 @@ func (o *NB) CreateLogicalSwitchPort(ctx context.Context, switchName OVNSwitch,
 			logicalSwitchPort.Addresses = []string{"router"}
 			logicalSwitchPort.Options = map[string]string{"router-port": string(opts.RouterPort)}
 		} else {
-			if (opts.IPV4 == "none" && opts.IPV6 != "none") || (opts.IPV6 == "none" && opts.IPV4 != "none") {
+			// Disabling one protocol is only possible when the other one uses a static address, as OVN's
+			// dynamic allocation always covers both protocols.
+			if (opts.IPV4 == "none" && opts.IPV6 == "") || (opts.IPV6 == "none" && opts.IPV4 == "") {
 				return errors.New("OVN doesn't support disabling IP allocation on only one protocol")
 			}
 
 			addresses := []string{}
-			if opts.IPV4 != "none" && opts.IPV6 != "none" {
+			if opts.IPV4 != "none" || opts.IPV6 != "none" {
 				address := []string{}
 				if opts.MAC != nil {
 					address = append(address, opts.MAC.String())
@@ -1810,11 +2758,11 @@ func (o *NB) CreateLogicalSwitchPort(ctx context.Context, switchName OVNSwitch,
 				if opts.IPV4 == "" && opts.IPV6 == "" {
 					address = append(address, "dynamic")
 				} else {
-					if opts.IPV4 != "" {
+					if opts.IPV4 != "" && opts.IPV4 != "none" {
 						address = append(address, opts.IPV4)
 					}
 
-					if opts.IPV6 != "" {
+					if opts.IPV6 != "" && opts.IPV6 != "none" {
 						address = append(address, opts.IPV6)
 					}
 				}
@@ -1837,11 +2785,31 @@ func (o *NB) CreateLogicalSwitchPort(ctx context.Context, switchName OVNSwitch,
 			}
 
 			logicalSwitchPort.Addresses = addresses
+
+			// The failover addresses entry is gone, it gets re-assigned by the network.
+			delete(logicalSwitchPort.ExternalIDs, ovnExtIDIncusFailover)
+		}
+
+		// Ports are enabled unless requested otherwise, which also re-enables previously disabled ports.
+		if opts.Disabled || logicalSwitchPort.Enabled != nil {
+			enabled := !opts.Disabled
+			logicalSwitchPort.Enabled = &enabled
 		}
 
 		if opts.Location != "" {
 			logicalSwitchPort.ExternalIDs[ovnExtIDIncusLocation] = opts.Location
 		}
+
+		if len(opts.Neighbors) > 0 {
+			neighbors := make([]string, 0, len(opts.Neighbors))
+			for _, ip := range opts.Neighbors {
+				neighbors = append(neighbors, ip.String())
+			}
+
+			logicalSwitchPort.ExternalIDs[ovnExtIDIncusNeighbors] = strings.Join(neighbors, ",")
+		} else {
+			delete(logicalSwitchPort.ExternalIDs, ovnExtIDIncusNeighbors)
+		}
 	}
 
 	logicalSwitchPort.ExternalIDs[ovnExtIDIncusSwitch] = string(switchName)
@@ -1907,11 +2875,14 @@ func (o *NB) GetLogicalSwitchPortIPs(ctx context.Context, portName OVNSwitchPort
 		return nil, err
 	}
 
+	// Failover addresses only follow the port while it's active, they aren't its own.
+	failoverIPs := logicalSwitchPortFailoverIPs(&lsp)
+
 	addresses := []net.IP{}
 	for _, address := range lsp.Addresses {
 		for _, entry := range strings.Split(address, " ") {
 			ip := net.ParseIP(entry)
-			if ip != nil {
+			if ip != nil && !slices.ContainsFunc(failoverIPs, ip.Equal) {
 				addresses = append(addresses, ip)
 			}
 		}
@@ -1958,6 +2929,67 @@ func (o *NB) GetLogicalSwitchPortDynamicIPs(ctx context.Context, portName OVNSwi
 	return dynamicIPs, nil
 }
 
+// WaitLogicalSwitchPortDynamicIPs waits for OVN to allocate dynamic IPs to the logical switch port and returns them.
+// Rather than polling, it gets woken up by the monitor notifications of the port so it returns as soon as the
+// addresses are assigned. The port not existing yet isn't an error as it may be about to be created.
+func (o *NB) WaitLogicalSwitchPortDynamicIPs(ctx context.Context, portName OVNSwitchPort) ([]net.IP, error) {
+	// Start watching before the first lookup so no change can be missed.
+	// Changes happening while the previous one wasn't consumed yet are coalesced.
+	changed := make(chan struct{}, 1)
+	handlerName := fmt.Sprintf("wait-dynamic-ips-%s-%p", portName, changed)
+
+	err := AddOVNNBHandler(handlerName, EventHandler{
+		Tables: []string{"Logical_Switch_Port"},
+		Hook: func(action string, table string, oldObject ovsModel.Model, newObject ovsModel.Model) {
+			lsp, ok := newObject.(*ovnNB.LogicalSwitchPort)
+			if !ok || lsp.Name != string(portName) {
+				return
+			}
+
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = RemoveOVNNBHandler(handlerName) }()
+
+	for {
+		dynamicIPs, err := o.GetLogicalSwitchPortDynamicIPs(ctx, portName)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+
+		if len(dynamicIPs) > 0 {
+			return dynamicIPs, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// GetLogicalSwitchPortEnabled returns whether a logical switch port is administratively up.
+func (o *NB) GetLogicalSwitchPortEnabled(ctx context.Context, portName OVNSwitchPort) (bool, error) {
+	lsp := ovnNB.LogicalSwitchPort{
+		Name: string(portName),
+	}
+
+	err := o.get(ctx, &lsp)
+	if err != nil {
+		return false, err
+	}
+
+	return lsp.Enabled == nil || *lsp.Enabled, nil
+}
+
 // GetLogicalSwitchPortLocation returns the last set location of a logical switch port.
 func (o *NB) GetLogicalSwitchPortLocation(ctx context.Context, portName OVNSwitchPort) (string, error) {
 	lsp := ovnNB.LogicalSwitchPort{
@@ -2098,12 +3130,10 @@ func (o *NB) UpdateLogicalSwitchPortDNS(ctx context.Context, switchName OVNSwitc
 	dnsRecord.ExternalIDs[ovnExtIDIncusSwitch] = string(switchName)
 	dnsRecord.ExternalIDs[ovnExtIDIncusSwitchPort] = string(portName)
 
-	// Add the records.
-	if dnsRecord.Records == nil {
-		dnsRecord.Records = map[string]string{}
-	}
+	// Replace the records, only including the DNS name record if IPs supplied.
+	dnsRecord.Records = map[string]string{}
+	delete(dnsRecord.ExternalIDs, ovnExtIDIncusDNSAddrs)
 
-	// Only include DNS name record if IPs supplied.
 	if len(dnsIPs) > 0 {
 		var dnsIPsStr strings.Builder
 		for i, dnsIP := range dnsIPs {
@@ -2115,9 +3145,9 @@ func (o *NB) UpdateLogicalSwitchPortDNS(ctx context.Context, switchName OVNSwitc
 		}
 
 		dnsRecord.Records[strings.ToLower(dnsName)] = dnsIPsStr.String()
-	} else {
-		// Clear any existing DNS name if no IPs supplied.
-		dnsRecord.Records = map[string]string{}
+
+		// Keep track of the port's own addresses in case the record gets shared with other ports.
+		dnsRecord.ExternalIDs[ovnExtIDIncusDNSAddrs] = dnsIPsStr.String()
 	}
 
 	operations := []ovsdb.Operation{}
@@ -2143,7 +3173,29 @@ func (o *NB) UpdateLogicalSwitchPortDNS(ctx context.Context, switchName OVNSwitc
 		}
 
 		operations = append(operations, updateOps...)
-	} else {
+
+		// Add it to the logical switches the records of this one are forwarded to.
+		forwardSwitches := []ovnNB.LogicalSwitch{}
+		err = o.client.WhereCache(func(forwardSwitch *ovnNB.LogicalSwitch) bool {
+			return slices.Contains(util.SplitNTrimSpace(forwardSwitch.ExternalIDs[ovnExtIDIncusDNSForward], ",", -1, true), string(switchName))
+		}).List(ctx, &forwardSwitches)
+		if err != nil {
+			return "", err
+		}
+
+		for _, forwardSwitch := range forwardSwitches {
+			updateOps, err := o.client.Where(&forwardSwitch).Mutate(&forwardSwitch, ovsModel.Mutation{
+				Field:   &forwardSwitch.DNSRecords,
+				Mutator: ovsdb.MutateOperationInsert,
+				Value:   []string{dnsRecord.UUID},
+			})
+			if err != nil {
+				return "", err
+			}
+
+			operations = append(operations, updateOps...)
+		}
+	} else {
 		// Update the record.
 		updateOps, err := o.client.Where(&dnsRecord).Update(&dnsRecord)
 		if err != nil {
@@ -2171,6 +3223,81 @@ func (o *NB) UpdateLogicalSwitchPortDNS(ctx context.Context, switchName OVNSwitc
 	return OVNDNSUUID(dnsRecord.UUID), nil
 }
 
+// UpdateLogicalSwitchDNSForwarding makes the DNS records of the remote logical switches resolvable from the logical
+// switch, including the records added to them later on. Any records of other switches are removed.
+func (o *NB) UpdateLogicalSwitchDNSForwarding(ctx context.Context, switchName OVNSwitch, remoteSwitchNames ...OVNSwitch) error {
+	// Get the logical switch.
+	ls, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
+		return err
+	}
+
+	remoteNames := make([]string, 0, len(remoteSwitchNames))
+	for _, remoteSwitchName := range remoteSwitchNames {
+		remoteNames = append(remoteNames, string(remoteSwitchName))
+	}
+
+	if ls.ExternalIDs == nil {
+		ls.ExternalIDs = map[string]string{}
+	}
+
+	if len(remoteNames) > 0 {
+		ls.ExternalIDs[ovnExtIDIncusDNSForward] = strings.Join(remoteNames, ",")
+	} else {
+		delete(ls.ExternalIDs, ovnExtIDIncusDNSForward)
+	}
+
+	// Get the switch each record belongs to.
+	dnsRecords := []ovnNB.DNS{}
+	err = o.client.WhereCache(func(dnsRecord *ovnNB.DNS) bool {
+		return dnsRecord.ExternalIDs != nil && dnsRecord.ExternalIDs[ovnExtIDIncusSwitch] != ""
+	}).List(ctx, &dnsRecords)
+	if err != nil {
+		return err
+	}
+
+	recordSwitches := make(map[string]string, len(dnsRecords))
+	for _, dnsRecord := range dnsRecords {
+		recordSwitches[dnsRecord.UUID] = dnsRecord.ExternalIDs[ovnExtIDIncusSwitch]
+	}
+
+	// Keep the records of the switch itself and those not managed by us.
+	records := []string{}
+	for _, recordUUID := range ls.DNSRecords {
+		recordSwitch, found := recordSwitches[recordUUID]
+		if !found || recordSwitch == string(switchName) {
+			records = append(records, recordUUID)
+		}
+	}
+
+	// Add the records of the remote switches.
+	for _, dnsRecord := range dnsRecords {
+		if slices.Contains(remoteNames, recordSwitches[dnsRecord.UUID]) {
+			records = append(records, dnsRecord.UUID)
+		}
+	}
+
+	ls.DNSRecords = records
+
+	operations, err := o.client.Where(ls).Update(ls, &ls.ExternalIDs, &ls.DNSRecords)
+	if err != nil {
+		return err
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetLogicalSwitchPortDNS returns the logical switch port DNS info (UUID, name and IPs).
 func (o *NB) GetLogicalSwitchPortDNS(ctx context.Context, portName OVNSwitchPort) (OVNDNSUUID, string, []net.IP, error) {
 	dnsRecords := []ovnNB.DNS{}
@@ -2196,6 +3323,12 @@ func (o *NB) GetLogicalSwitchPortDNS(ctx context.Context, portName OVNSwitchPort
 	for key, value := range dnsRecords[0].Records {
 		dnsName = key
 
+		// Only return the port's own addresses when the record is shared with other ports.
+		ownAddresses, found := dnsRecords[0].ExternalIDs[ovnExtIDIncusDNSAddrs]
+		if found {
+			value = ownAddresses
+		}
+
 		for _, ipPart := range strings.Split(value, " ") {
 			ip := net.ParseIP(strings.TrimSpace(ipPart))
 			if ip != nil {
@@ -2207,6 +3340,124 @@ func (o *NB) GetLogicalSwitchPortDNS(ctx context.Context, portName OVNSwitchPort
 	return OVNDNSUUID(dnsRecords[0].UUID), dnsName, ips, nil
 }
 
+// GetLogicalSwitchDNSRecords returns the DNS records of the logical switch, keyed by the switch port they belong to.
+func (o *NB) GetLogicalSwitchDNSRecords(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort]OVNDNSUUID, error) {
+	dnsRecords := []ovnNB.DNS{}
+
+	err := o.client.WhereCache(func(dnsRecord *ovnNB.DNS) bool {
+		return dnsRecord.ExternalIDs != nil && dnsRecord.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && dnsRecord.ExternalIDs[ovnExtIDIncusSwitchPort] != ""
+	}).List(ctx, &dnsRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[OVNSwitchPort]OVNDNSUUID, len(dnsRecords))
+	for _, dnsRecord := range dnsRecords {
+		records[OVNSwitchPort(dnsRecord.ExternalIDs[ovnExtIDIncusSwitchPort])] = OVNDNSUUID(dnsRecord.UUID)
+	}
+
+	return records, nil
+}
+
+// GetLogicalSwitchDNSNames returns the logical switch ports with a DNS record on the logical switch, keyed by DNS name.
+func (o *NB) GetLogicalSwitchDNSNames(ctx context.Context, switchName OVNSwitch) (map[string][]OVNSwitchPort, error) {
+	dnsRecords := []ovnNB.DNS{}
+
+	err := o.client.WhereCache(func(dnsRecord *ovnNB.DNS) bool {
+		return dnsRecord.ExternalIDs != nil && dnsRecord.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && dnsRecord.ExternalIDs[ovnExtIDIncusSwitchPort] != ""
+	}).List(ctx, &dnsRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string][]OVNSwitchPort{}
+	for _, dnsRecord := range dnsRecords {
+		for dnsName := range dnsRecord.Records {
+			names[dnsName] = append(names[dnsName], OVNSwitchPort(dnsRecord.ExternalIDs[ovnExtIDIncusSwitchPort]))
+		}
+	}
+
+	for _, ports := range names {
+		slices.Sort(ports)
+	}
+
+	return names, nil
+}
+
+// UpdateLogicalSwitchDNSNameShared makes the DNS name resolve to the addresses of all the ports of the logical
+// switch registering it, by setting the record of each of them to the combined addresses.
+func (o *NB) UpdateLogicalSwitchDNSNameShared(ctx context.Context, switchName OVNSwitch, dnsName string) error {
+	dnsName = strings.ToLower(dnsName)
+	dnsRecords := []ovnNB.DNS{}
+
+	err := o.client.WhereCache(func(dnsRecord *ovnNB.DNS) bool {
+		if dnsRecord.ExternalIDs == nil || dnsRecord.ExternalIDs[ovnExtIDIncusSwitch] != string(switchName) {
+			return false
+		}
+
+		_, found := dnsRecord.Records[dnsName]
+		return found
+	}).List(ctx, &dnsRecords)
+	if err != nil {
+		return err
+	}
+
+	// Sort the records so that the addresses are listed in a stable order.
+	slices.SortFunc(dnsRecords, func(a ovnNB.DNS, b ovnNB.DNS) int {
+		return strings.Compare(a.ExternalIDs[ovnExtIDIncusSwitchPort], b.ExternalIDs[ovnExtIDIncusSwitchPort])
+	})
+
+	// Combine the addresses of the ports.
+	addresses := []string{}
+	for _, dnsRecord := range dnsRecords {
+		ownAddresses, found := dnsRecord.ExternalIDs[ovnExtIDIncusDNSAddrs]
+		if !found {
+			ownAddresses = dnsRecord.Records[dnsName]
+		}
+
+		for _, address := range strings.Fields(ownAddresses) {
+			if !slices.Contains(addresses, address) {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+
+	value := strings.Join(addresses, " ")
+
+	operations := []ovsdb.Operation{}
+	for _, dnsRecord := range dnsRecords {
+		if dnsRecord.Records[dnsName] == value {
+			continue
+		}
+
+		dnsRecord.Records[dnsName] = value
+
+		updateOps, err := o.client.Where(&dnsRecord).Update(&dnsRecord, &dnsRecord.Records)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, updateOps...)
+	}
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // logicalSwitchPortDeleteDNSOperations returns a list of ovsdb operations to remove DNS records from a switch port.
 // If destroyEntry the DNS entry record itself is also removed, otherwise it is just cleared but left in place.
 func (o *NB) logicalSwitchPortDeleteDNSOperations(ctx context.Context, switchName OVNSwitch, dnsUUID OVNDNSUUID, destroyEntry bool) ([]ovsdb.Operation, error) {
@@ -2327,6 +3578,21 @@ func (o *NB) logicalSwitchPortDeleteOperations(ctx context.Context, switchName O
 
 	operations = append(operations, deleteOps...)
 
+	// Delete the DHCP option sets dedicated to the port.
+	dhcpOptions, err := o.logicalSwitchPortDHCPv4Options(ctx, portName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dhcpOption := range dhcpOptions {
+		deleteOps, err := o.client.Where(&dhcpOption).Delete()
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
 	return operations, nil
 }
 
@@ -2367,45 +3633,276 @@ func (o *NB) CleanupLogicalSwitchPort(ctx context.Context, portName OVNSwitchPor
 		return err
 	}
 
-	operations = append(operations, deleteOps...)
+	operations = append(operations, deleteOps...)
+
+	// Remove logical switch port.
+	deleteOps, err = o.logicalSwitchPortDeleteOperations(ctx, switchName, portName)
+	if err != nil {
+		return err
+	}
+
+	operations = append(operations, deleteOps...)
+
+	// Remove DNS records.
+	if dnsUUID != "" {
+		deleteOps, err := o.logicalSwitchPortDeleteDNSOperations(ctx, switchName, dnsUUID, false)
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateLogicalSwitchPortLinkRouter links a logical switch port to a logical router port.
+func (o *NB) UpdateLogicalSwitchPortLinkRouter(ctx context.Context, switchPortName OVNSwitchPort, routerPortName OVNRouterPort) error {
+	// Get the logical switch port.
+	lsp := ovnNB.LogicalSwitchPort{
+		Name: string(switchPortName),
+	}
+
+	err := o.get(ctx, &lsp)
+	if err != nil {
+		return err
+	}
+
+	// Update the fields.
+	lsp.Type = "router"
+	lsp.Addresses = []string{"router"}
+	if lsp.Options == nil {
+		lsp.Options = map[string]string{}
+	}
+
+	lsp.Options["nat-addresses"] = "router"
+	lsp.Options["router-port"] = string(routerPortName)
+
+	// Update the record.
+	operations, err := o.client.Where(&lsp).Update(&lsp)
+	if err != nil {
+		return err
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// arpProxyUpdateAttempts is how many times an update of the ARP/NDP proxy of a switch port is attempted while
+// other servers keep modifying the port.
+const arpProxyUpdateAttempts = 5
+
+// UpdateLogicalSwitchPortARPProxyAdd adds the supplied prefixes to the ARP/NDP proxy of a router type switch port.
+// The port will then answer ARP and NDP requests for any address within those prefixes.
+func (o *NB) UpdateLogicalSwitchPortARPProxyAdd(ctx context.Context, portName OVNSwitchPort, prefixes ...net.IPNet) error {
+	return o.updateLogicalSwitchPortARPProxy(ctx, portName, func(entries []string) []string {
+		for _, prefix := range prefixes {
+			if !slices.Contains(entries, prefix.String()) {
+				entries = append(entries, prefix.String())
+			}
+		}
+
+		return entries
+	}, nil)
+}
+
+// UpdateLogicalSwitchPortARPProxyRemove removes the supplied prefixes from the ARP/NDP proxy of a router type switch port.
+// If no prefixes are supplied, the ARP/NDP proxy is cleared entirely.
+func (o *NB) UpdateLogicalSwitchPortARPProxyRemove(ctx context.Context, portName OVNSwitchPort, prefixes ...net.IPNet) error {
+	return o.updateLogicalSwitchPortARPProxy(ctx, portName, func(entries []string) []string {
+		if len(prefixes) == 0 {
+			return nil
+		}
+
+		return slices.DeleteFunc(entries, func(entry string) bool {
+			return slices.ContainsFunc(prefixes, func(prefix net.IPNet) bool {
+				return prefix.String() == entry
+			})
+		})
+	}, nil)
+}
+
+// UpdateLogicalRouterL2Proxy replaces the addresses a logical router publishes on its uplink in l2proxy ingress mode.
+// The router's dnat_and_snat rules are set to stateless rules for natIPs and the ARP/NDP proxy of its router type
+// switch port to prefixes, all in a single transaction.
+func (o *NB) UpdateLogicalRouterL2Proxy(ctx context.Context, routerName OVNRouter, portName OVNSwitchPort, natIPs []net.IP, prefixes []net.IPNet) error {
+	entries := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if !slices.Contains(entries, prefix.String()) {
+			entries = append(entries, prefix.String())
+		}
+	}
+
+	return o.updateLogicalSwitchPortARPProxy(ctx, portName, func(_ []string) []string {
+		return entries
+	}, func() ([]ovsdb.Operation, error) {
+		return o.replaceLogicalRouterL2ProxyNAT(ctx, routerName, natIPs)
+	})
+}
+
+// replaceLogicalRouterL2ProxyNAT returns the operations setting the dnat_and_snat rules of a logical router to
+// stateless rules for the supplied addresses, keeping the rules which already match.
+func (o *NB) replaceLogicalRouterL2ProxyNAT(ctx context.Context, routerName OVNRouter, natIPs []net.IP) ([]ovsdb.Operation, error) {
+	// Get the logical router.
+	logicalRouter, err := o.GetLogicalRouter(ctx, routerName)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := []ovsdb.Operation{}
+	existing := map[string]bool{}
+
+	// Remove the rules that are no longer wanted.
+	for _, natUUID := range logicalRouter.Nat {
+		natRule := ovnNB.NAT{
+			UUID: natUUID,
+		}
+
+		err = o.get(ctx, &natRule)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if rule is of the requested type.
+		if natRule.Type != ovnNB.NATTypeDNATAndSNAT {
+			continue
+		}
+
+		// Keep the first rule already publishing a wanted address.
+		wanted := slices.ContainsFunc(natIPs, func(ip net.IP) bool {
+			return natRule.ExternalIP == ip.String() && natRule.LogicalIP == ip.String()
+		})
+
+		if wanted && !existing[natRule.ExternalIP] {
+			existing[natRule.ExternalIP] = true
+			continue
+		}
+
+		// Delete the rule.
+		deleteOps, err := o.client.Where(&natRule).Delete()
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, deleteOps...)
+
+		deleteOps, err = o.deleteLogicalRouterNATAddressSets(&natRule)
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, deleteOps...)
+
+		// Delete the entry from the logical router.
+		deleteOps, err = o.client.Where(logicalRouter).Mutate(logicalRouter, ovsModel.Mutation{
+			Field:   &logicalRouter.Nat,
+			Mutator: ovsdb.MutateOperationDelete,
+			Value:   []string{natRule.UUID},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, deleteOps...)
+	}
+
+	// Add the missing rules.
+	natUUIDs := []string{}
 
-	// Remove logical switch port.
-	deleteOps, err = o.logicalSwitchPortDeleteOperations(ctx, switchName, portName)
-	if err != nil {
-		return err
-	}
+	for i, ip := range natIPs {
+		if existing[ip.String()] {
+			continue
+		}
 
-	operations = append(operations, deleteOps...)
+		existing[ip.String()] = true
 
-	// Remove DNS records.
-	if dnsUUID != "" {
-		deleteOps, err := o.logicalSwitchPortDeleteDNSOperations(ctx, switchName, dnsUUID, false)
+		natRule := ovnNB.NAT{
+			UUID:       fmt.Sprintf("nat%d", i),
+			Options:    map[string]string{"stateless": "true"},
+			Type:       ovnNB.NATTypeDNATAndSNAT,
+			LogicalIP:  ip.String(),
+			ExternalIP: ip.String(),
+		}
+
+		createOps, err := o.client.Create(&natRule)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		operations = append(operations, deleteOps...)
+		operations = append(operations, createOps...)
+		natUUIDs = append(natUUIDs, natRule.UUID)
 	}
 
-	// Apply the changes.
-	resp, err := o.client.Transact(ctx, operations...)
-	if err != nil {
-		return err
+	if len(natUUIDs) > 0 {
+		// Add them to the router.
+		updateOps, err := o.client.Where(logicalRouter).Mutate(logicalRouter, ovsModel.Mutation{
+			Field:   &logicalRouter.Nat,
+			Mutator: ovsdb.MutateOperationInsert,
+			Value:   natUUIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		operations = append(operations, updateOps...)
 	}
 
-	_, err = ovsdb.CheckOperationResults(resp, operations)
+	return operations, nil
+}
+
+// updateLogicalSwitchPortARPProxy applies a change to the list of entries in the arp_proxy option of a switch port,
+// along with the operations returned by extraOps if set.
+// Updates from this server are serialized and the change is only committed if the port's options still hold the
+// entries it was computed from, otherwise it's computed again from the current entries.
+func (o *NB) updateLogicalSwitchPortARPProxy(ctx context.Context, portName OVNSwitchPort, change func(entries []string) []string, extraOps func() ([]ovsdb.Operation, error)) error {
+	unlock, err := locking.Lock(ctx, fmt.Sprintf("network.ovn.arp_proxy.%s", portName))
 	if err != nil {
 		return err
 	}
 
-	return nil
+	defer unlock()
+
+	for attempt := 1; ; attempt++ {
+		err = o.applyLogicalSwitchPortARPProxy(ctx, portName, change, extraOps)
+		if !errors.Is(err, ErrConcurrentChange) || attempt >= arpProxyUpdateAttempts {
+			return err
+		}
+
+		// Give the concurrent change time to reach the cache before trying again.
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * 100 * time.Millisecond):
+		}
+	}
 }
 
-// UpdateLogicalSwitchPortLinkRouter links a logical switch port to a logical router port.
-func (o *NB) UpdateLogicalSwitchPortLinkRouter(ctx context.Context, switchPortName OVNSwitchPort, routerPortName OVNRouterPort) error {
+// applyLogicalSwitchPortARPProxy runs a single attempt of updateLogicalSwitchPortARPProxy.
+func (o *NB) applyLogicalSwitchPortARPProxy(ctx context.Context, portName OVNSwitchPort, change func(entries []string) []string, extraOps func() ([]ovsdb.Operation, error)) error {
 	// Get the logical switch port.
 	lsp := ovnNB.LogicalSwitchPort{
-		Name: string(switchPortName),
+		Name: string(portName),
 	}
 
 	err := o.get(ctx, &lsp)
@@ -2413,22 +3910,47 @@ func (o *NB) UpdateLogicalSwitchPortLinkRouter(ctx context.Context, switchPortNa
 		return err
 	}
 
-	// Update the fields.
-	lsp.Type = "router"
-	lsp.Addresses = []string{"router"}
 	if lsp.Options == nil {
 		lsp.Options = map[string]string{}
 	}
 
-	lsp.Options["nat-addresses"] = "router"
-	lsp.Options["router-port"] = string(routerPortName)
+	// Make sure the options haven't changed since they were read.
+	previous := ovnNB.LogicalSwitchPort{
+		UUID:    lsp.UUID,
+		Options: maps.Clone(lsp.Options),
+	}
+
+	timeout := 0
+	operations, err := o.client.Where(&previous).Wait(ovsdb.WaitConditionEqual, &timeout, &previous, &previous.Options)
+	if err != nil {
+		return err
+	}
+
+	// Apply the change.
+	entries := change(strings.Fields(lsp.Options["arp_proxy"]))
+	if len(entries) > 0 {
+		lsp.Options["arp_proxy"] = strings.Join(entries, " ")
+	} else {
+		delete(lsp.Options, "arp_proxy")
+	}
 
 	// Update the record.
-	operations, err := o.client.Where(&lsp).Update(&lsp)
+	updateOps, err := o.client.Where(&lsp).Update(&lsp, &lsp.Options)
 	if err != nil {
 		return err
 	}
 
+	operations = append(operations, updateOps...)
+
+	if extraOps != nil {
+		ops, err := extraOps()
+		if err != nil {
+			return err
+		}
+
+		operations = append(operations, ops...)
+	}
+
 	// Apply the changes.
 	resp, err := o.client.Transact(ctx, operations...)
 	if err != nil {
@@ -2668,6 +4190,31 @@ func (o *NB) SetChassisGroupPriority(ctx context.Context, haChassisGroupName OVN
 	return nil
 }
 
+// GetChassisGroupPriorities returns the priority of each chassis in the HA chassis group, keyed by chassis ID.
+func (o *NB) GetChassisGroupPriorities(ctx context.Context, haChassisGroupName OVNChassisGroup) (map[string]int, error) {
+	haGroup := ovnNB.HAChassisGroup{
+		Name: string(haChassisGroupName),
+	}
+
+	err := o.get(ctx, &haGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := make(map[string]int, len(haGroup.HaChassis))
+	for _, entry := range haGroup.HaChassis {
+		chassis := ovnNB.HAChassis{UUID: entry}
+		err = o.get(ctx, &chassis)
+		if err != nil {
+			return nil, err
+		}
+
+		priorities[chassis.ChassisName] = chassis.Priority
+	}
+
+	return priorities, nil
+}
+
 // GetPortGroupInfo returns the port group UUID or empty string if port doesn't exist, and whether the port group has
 // any ACL rules defined on it.
 func (o *NB) GetPortGroupInfo(ctx context.Context, portGroupName OVNPortGroup) (OVNPortGroupUUID, bool, error) {
@@ -3133,8 +4680,10 @@ func (o *NB) ClearPortGroupPortACLRules(ctx context.Context, portGroupName OVNPo
 }
 
 // CreateLoadBalancer creates a new load balancer (if doesn't exist) on the specified router and switch.
+// If switchAttach is false, the load balancer is only applied on the router, so the VIPs aren't handled for
+// traffic originating from the switch itself.
 // Providing an empty set of vips will delete the load balancer.
-func (o *NB) CreateLoadBalancer(ctx context.Context, loadBalancerName OVNLoadBalancer, routerName OVNRouter, switchName OVNSwitch, vips ...OVNLoadBalancerVIP) error {
+func (o *NB) CreateLoadBalancer(ctx context.Context, loadBalancerName OVNLoadBalancer, routerName OVNRouter, switchName OVNSwitch, switchAttach bool, vips ...OVNLoadBalancerVIP) error {
 	lbTCPName := fmt.Sprintf("%s-tcp", loadBalancerName)
 	lbUDPName := fmt.Sprintf("%s-udp", loadBalancerName)
 	operations := []ovsdb.Operation{}
@@ -3407,6 +4956,10 @@ func (o *NB) CreateLoadBalancer(ctx context.Context, loadBalancerName OVNLoadBal
 
 		operations = append(operations, updateOps...)
 
+		if !switchAttach {
+			continue
+		}
+
 		// Add to the switch.
 		updateOps, err = o.client.Where(ls).Mutate(ls, ovsModel.Mutation{
 			Field:   &ls.LoadBalancer,
@@ -3499,6 +5052,95 @@ func (o *NB) DeleteLoadBalancer(ctx context.Context, loadBalancerNames ...OVNLoa
 	return nil
 }
 
+// UpdateLoadBalancerSharedSwitches applies the load balancer on the listed logical switches on top of the switch
+// it was created on, so that traffic originating from them to its VIPs is handled directly. The load balancer is
+// removed from any other switch it was previously shared with.
+func (o *NB) UpdateLoadBalancerSharedSwitches(ctx context.Context, loadBalancerName OVNLoadBalancer, switchName OVNSwitch, sharedSwitchNames ...OVNSwitch) error {
+	operations := []ovsdb.Operation{}
+
+	for _, name := range []string{fmt.Sprintf("%s-tcp", loadBalancerName), fmt.Sprintf("%s-udp", loadBalancerName)} {
+		lb := ovnNB.LoadBalancer{
+			Name: name,
+		}
+
+		err := o.get(ctx, &lb)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+
+			return err
+		}
+
+		// Remove the load balancer from the switches it's no longer shared with.
+		switches := []ovnNB.LogicalSwitch{}
+		err = o.client.WhereCache(func(ls *ovnNB.LogicalSwitch) bool {
+			return slices.Contains(ls.LoadBalancer, lb.UUID)
+		}).List(ctx, &switches)
+		if err != nil {
+			return err
+		}
+
+		for _, ls := range switches {
+			if ls.Name == string(switchName) || slices.Contains(sharedSwitchNames, OVNSwitch(ls.Name)) {
+				continue
+			}
+
+			updateOps, err := o.client.Where(&ls).Mutate(&ls, ovsModel.Mutation{
+				Field:   &ls.LoadBalancer,
+				Mutator: ovsdb.MutateOperationDelete,
+				Value:   []string{lb.UUID},
+			})
+			if err != nil {
+				return err
+			}
+
+			operations = append(operations, updateOps...)
+		}
+
+		// Add it to the shared switches.
+		for _, sharedSwitchName := range sharedSwitchNames {
+			ls, err := o.GetLogicalSwitch(ctx, sharedSwitchName)
+			if err != nil {
+				return err
+			}
+
+			if slices.Contains(ls.LoadBalancer, lb.UUID) {
+				continue
+			}
+
+			updateOps, err := o.client.Where(ls).Mutate(ls, ovsModel.Mutation{
+				Field:   &ls.LoadBalancer,
+				Mutator: ovsdb.MutateOperationInsert,
+				Value:   []string{lb.UUID},
+			})
+			if err != nil {
+				return err
+			}
+
+			operations = append(operations, updateOps...)
+		}
+	}
+
+	// Check if anything to change.
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// Apply the changes.
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetLoadBalancer gets the OVN database record for the load balancer.
 func (o *NB) GetLoadBalancer(ctx context.Context, lbName OVNLoadBalancer) (*ovnNB.LoadBalancer, error) {
 	lb := &ovnNB.LoadBalancer{
@@ -4362,3 +6004,157 @@ func (o *NB) GetName(ctx context.Context) (string, error) {
 
 	return nbGlobal[0].Name, nil
 }
+
+// GetSequenceNumbers returns the current NB, SB and hypervisor configuration sequence numbers.
+func (o *NB) GetSequenceNumbers(ctx context.Context) (*OVNSequenceNumbers, error) {
+	// Get the global configuration.
+	nbGlobal := []ovnNB.NBGlobal{}
+	err := o.client.List(ctx, &nbGlobal)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that we got a result.
+	if len(nbGlobal) != 1 {
+		return nil, ovsClient.ErrNotFound
+	}
+
+	return &OVNSequenceNumbers{
+		NbCfg:          nbGlobal[0].NbCfg,
+		NbCfgTimestamp: time.UnixMilli(int64(nbGlobal[0].NbCfgTimestamp)),
+		SbCfg:          nbGlobal[0].SbCfg,
+		SbCfgTimestamp: time.UnixMilli(int64(nbGlobal[0].SbCfgTimestamp)),
+		HvCfg:          nbGlobal[0].HvCfg,
+		HvCfgTimestamp: time.UnixMilli(int64(nbGlobal[0].HvCfgTimestamp)),
+	}, nil
+}
+
+// GetNetworkObjects returns the northbound database records of a network's logical router and switches, along with
+// the DHCP options and port groups tied to its internal switch and the load balancers and address sets whose name
+// starts with the provided prefixes. A missing logical router or switch is skipped rather than treated as an error.
+func (o *NB) GetNetworkObjects(ctx context.Context, routerName OVNRouter, switchNames []OVNSwitch, intSwitchName OVNSwitch, lbPrefix string, addressSetPrefix string) (*OVNNetworkObjects, error) {
+	objects := &OVNNetworkObjects{
+		SwitchPorts: map[OVNSwitch][]ovnNB.LogicalSwitchPort{},
+	}
+
+	// Get the logical router and its ports, NAT rules, static routes and policies.
+	lr, err := o.GetLogicalRouter(ctx, routerName)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if lr != nil {
+		objects.Router = lr
+
+		for _, portUUID := range lr.Ports {
+			lrp := ovnNB.LogicalRouterPort{
+				UUID: portUUID,
+			}
+
+			err = o.get(ctx, &lrp)
+			if err != nil {
+				return nil, err
+			}
+
+			objects.RouterPorts = append(objects.RouterPorts, lrp)
+		}
+
+		for _, natUUID := range lr.Nat {
+			nat := ovnNB.NAT{
+				UUID: natUUID,
+			}
+
+			err = o.get(ctx, &nat)
+			if err != nil {
+				return nil, err
+			}
+
+			objects.RouterNAT = append(objects.RouterNAT, nat)
+		}
+
+		for _, routeUUID := range lr.StaticRoutes {
+			route := ovnNB.LogicalRouterStaticRoute{
+				UUID: routeUUID,
+			}
+
+			err = o.get(ctx, &route)
+			if err != nil {
+				return nil, err
+			}
+
+			objects.RouterRoutes = append(objects.RouterRoutes, route)
+		}
+
+		for _, policyUUID := range lr.Policies {
+			policy := ovnNB.LogicalRouterPolicy{
+				UUID: policyUUID,
+			}
+
+			err = o.get(ctx, &policy)
+			if err != nil {
+				return nil, err
+			}
+
+			objects.RouterPolicies = append(objects.RouterPolicies, policy)
+		}
+	}
+
+	// Get the logical switches and their ports.
+	for _, switchName := range switchNames {
+		ls, err := o.GetLogicalSwitch(ctx, switchName)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		objects.Switches = append(objects.Switches, *ls)
+
+		for _, portUUID := range ls.Ports {
+			lsp := ovnNB.LogicalSwitchPort{
+				UUID: portUUID,
+			}
+
+			err = o.get(ctx, &lsp)
+			if err != nil {
+				return nil, err
+			}
+
+			objects.SwitchPorts[switchName] = append(objects.SwitchPorts[switchName], lsp)
+		}
+	}
+
+	// Get the DHCP options and port groups tied to the internal switch.
+	err = o.client.WhereCache(func(do *ovnNB.DHCPOptions) bool {
+		return do.ExternalIDs != nil && do.ExternalIDs[ovnExtIDIncusSwitch] == string(intSwitchName)
+	}).List(ctx, &objects.DHCPOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.client.WhereCache(func(pg *ovnNB.PortGroup) bool {
+		return pg.ExternalIDs != nil && pg.ExternalIDs[ovnExtIDIncusSwitch] == string(intSwitchName)
+	}).List(ctx, &objects.PortGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the load balancers and address sets by name.
+	err = o.client.WhereCache(func(lb *ovnNB.LoadBalancer) bool {
+		return strings.HasPrefix(lb.Name, lbPrefix)
+	}).List(ctx, &objects.LoadBalancers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.client.WhereCache(func(as *ovnNB.AddressSet) bool {
+		return strings.HasPrefix(as.Name, addressSetPrefix)
+	}).List(ctx, &objects.AddressSets)
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
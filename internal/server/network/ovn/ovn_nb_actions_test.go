@@ -0,0 +1,160 @@
+package ovn
+
+import (
+	"context"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
+)
+
+// Changes to the ARP/NDP proxy of a port made elsewhere while an update is prepared aren't overwritten.
+func TestNB_LogicalSwitchPortARPProxyConcurrentChange(t *testing.T) {
+	fake, err := NewFake()
+	require.NoError(t, err)
+	t.Cleanup(fake.Close)
+
+	ctx := context.Background()
+
+	err = fake.NB.CreateLogicalSwitch(ctx, "ls", false)
+	require.NoError(t, err)
+
+	err = fake.NB.CreateLogicalSwitchPort(ctx, "ls", "ls-port", &OVNSwitchPortOpts{}, false)
+	require.NoError(t, err)
+
+	// Router type ports always have options. The in-memory server doesn't compare empty values when waiting.
+	err = fake.NB.UpdateLogicalSwitchPortOptions(ctx, "ls-port", map[string]string{"router-port": "lr-port"})
+	require.NoError(t, err)
+
+	calls := 0
+	err = fake.NB.updateLogicalSwitchPortARPProxy(ctx, "ls-port", func(entries []string) []string {
+		calls++
+
+		// Simulate another server adding an entry after the port was read.
+		if calls == 1 {
+			err := fake.NB.UpdateLogicalSwitchPortOptions(ctx, "ls-port", map[string]string{"arp_proxy": "203.0.113.0/24"})
+			require.NoError(t, err)
+		}
+
+		if !slices.Contains(entries, "198.51.100.0/24") {
+			entries = append(entries, "198.51.100.0/24")
+		}
+
+		return entries
+	}, nil)
+	require.NoError(t, err)
+	assert.Greater(t, calls, 1)
+
+	lsp, err := fake.NB.GetLogicalSwitchPort(ctx, "ls-port")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"203.0.113.0/24", "198.51.100.0/24"}, strings.Fields(lsp.Options["arp_proxy"]))
+
+	// The retries are bounded when the port keeps changing.
+	_, prefix, _ := net.ParseCIDR("192.0.2.0/24")
+	err = fake.NB.updateLogicalSwitchPortARPProxy(ctx, "ls-port", func(entries []string) []string {
+		err := fake.NB.UpdateLogicalSwitchPortOptions(ctx, "ls-port", map[string]string{"arp_proxy": strings.Join(append(entries, "203.0.113.0/25"), " ")})
+		require.NoError(t, err)
+
+		return append(entries, prefix.String())
+	}, nil)
+	assert.ErrorIs(t, err, ErrConcurrentChange)
+}
+
+// The ports of a switch are read from the client cache and follow changes right away.
+func TestNB_GetLogicalSwitchPorts(t *testing.T) {
+	fake, err := NewFake()
+	require.NoError(t, err)
+	t.Cleanup(fake.Close)
+
+	ctx := context.Background()
+
+	err = fake.NB.CreateLogicalSwitch(ctx, "ls", false)
+	require.NoError(t, err)
+
+	ports, err := fake.NB.GetLogicalSwitchPorts(ctx, "ls")
+	require.NoError(t, err)
+	assert.Empty(t, ports)
+
+	for _, portName := range []OVNSwitchPort{"ls-port1", "ls-port2"} {
+		err = fake.NB.CreateLogicalSwitchPort(ctx, "ls", portName, &OVNSwitchPortOpts{}, false)
+		require.NoError(t, err)
+	}
+
+	ports, err = fake.NB.GetLogicalSwitchPorts(ctx, "ls")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []OVNSwitchPort{"ls-port1", "ls-port2"}, slices.Collect(maps.Keys(ports)))
+
+	err = fake.NB.DeleteLogicalSwitchPort(ctx, "ls", "ls-port1")
+	require.NoError(t, err)
+
+	ports, err = fake.NB.GetLogicalSwitchPorts(ctx, "ls")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []OVNSwitchPort{"ls-port2"}, slices.Collect(maps.Keys(ports)))
+
+	_, err = fake.NB.GetLogicalSwitchPorts(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// Waiting for dynamic addresses returns once they are set and otherwise stops with the context.
+func TestNB_WaitLogicalSwitchPortDynamicIPs(t *testing.T) {
+	fake, err := NewFake()
+	require.NoError(t, err)
+	t.Cleanup(fake.Close)
+
+	ctx := context.Background()
+
+	err = fake.NB.CreateLogicalSwitch(ctx, "ls", false)
+	require.NoError(t, err)
+
+	err = fake.NB.CreateLogicalSwitchPort(ctx, "ls", "ls-port", &OVNSwitchPortOpts{}, false)
+	require.NoError(t, err)
+
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	_, err = fake.NB.WaitLogicalSwitchPortDynamicIPs(waitCtx, "ls-port")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Assign the addresses the way northd does once the waiter is running.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		lsp := ovnNB.LogicalSwitchPort{Name: "ls-port"}
+		err := fake.NB.get(ctx, &lsp)
+		if err != nil {
+			return
+		}
+
+		dynamicAddresses := "00:16:3e:00:00:01 10.0.0.2"
+		lsp.DynamicAddresses = &dynamicAddresses
+
+		ops, err := fake.NB.client.Where(&lsp).Update(&lsp, &lsp.DynamicAddresses)
+		if err != nil {
+			return
+		}
+
+		_, _ = fake.NB.client.Transact(ctx, ops...)
+	}()
+
+	waitCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	dynamicIPs, err := fake.NB.WaitLogicalSwitchPortDynamicIPs(waitCtx, "ls-port")
+	require.NoError(t, err)
+	assert.Equal(t, []net.IP{net.ParseIP("10.0.0.2")}, dynamicIPs)
+
+	// The waiters unregister themselves.
+	nbEventHandlersMu.Lock()
+	defer nbEventHandlersMu.Unlock()
+
+	for name := range nbEventHandlers {
+		assert.NotContains(t, name, "ls-port")
+	}
+}
@@ -0,0 +1,38 @@
+package ovn
+
+import (
+	"sync"
+)
+
+var (
+	nbEventHandlers   map[string]EventHandler
+	nbEventHandlersMu sync.Mutex
+)
+
+// AddOVNNBHandler registers a new event handler with the OVN Northbound database.
+func AddOVNNBHandler(name string, handler EventHandler) error {
+	nbEventHandlersMu.Lock()
+	defer nbEventHandlersMu.Unlock()
+
+	if nbEventHandlers == nil {
+		nbEventHandlers = map[string]EventHandler{}
+	}
+
+	nbEventHandlers[name] = handler
+
+	return nil
+}
+
+// RemoveOVNNBHandler removes a currently registered event handler.
+func RemoveOVNNBHandler(name string) error {
+	nbEventHandlersMu.Lock()
+	defer nbEventHandlersMu.Unlock()
+
+	if nbEventHandlers == nil {
+		return nil
+	}
+
+	delete(nbEventHandlers, name)
+
+	return nil
+}
@@ -9,10 +9,7 @@ import (
 	"runtime"
 	"slices"
 	"strings"
-	"time"
 
-	"github.com/cenkalti/backoff/v4"
-	"github.com/go-logr/logr"
 	ovsdbCache "github.com/ovn-org/libovsdb/cache"
 	ovsdbClient "github.com/ovn-org/libovsdb/client"
 	ovsdbModel "github.com/ovn-org/libovsdb/model"
@@ -28,18 +25,7 @@ type SB struct {
 
 // NewSB initializes new OVN client for Southbound operations.
 func NewSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey string) (*SB, error) {
-	// Prepare the OVSDB client.
-	dbSchema, err := ovnSB.FullDatabaseModel()
-	if err != nil {
-		return nil, err
-	}
-
-	discard := logr.Discard()
-
-	options := []ovsdbClient.Option{ovsdbClient.WithLogger(&discard), ovsdbClient.WithReconnect(5*time.Second, &backoff.ZeroBackOff{})}
-	for _, entry := range strings.Split(dbAddr, ",") {
-		options = append(options, ovsdbClient.WithEndpoint(entry))
-	}
+	options := ovsdbConnectionOptions(dbAddr)
 
 	// Handle SSL.
 	if strings.Contains(dbAddr, "ssl:") {
@@ -115,6 +101,17 @@ func NewSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 		options = append(options, ovsdbClient.WithTLSConfig(tlsConfig))
 	}
 
+	return connectSB(options...)
+}
+
+// connectSB connects a new SB client to the database using the supplied client options.
+func connectSB(options ...ovsdbClient.Option) (*SB, error) {
+	// Prepare the OVSDB client.
+	dbSchema, err := ovnSB.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to OVSDB.
 	ovn, err := ovsdbClient.NewOVSDBClient(dbSchema, options...)
 	if err != nil {
@@ -134,6 +131,9 @@ func NewSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 	// Set up monitor for the tables we use.
 	monitorCookie, err := ovn.Monitor(context.TODO(), ovn.NewMonitor(
 		ovsdbClient.WithTable(&ovnSB.Chassis{}),
+		ovsdbClient.WithTable(&ovnSB.ChassisPrivate{}),
+		ovsdbClient.WithTable(&ovnSB.DatapathBinding{}),
+		ovsdbClient.WithTable(&ovnSB.Encap{}),
 		ovsdbClient.WithTable(&ovnSB.PortBinding{}),
 		ovsdbClient.WithTable(&ovnSB.ServiceMonitor{})))
 	if err != nil {
@@ -203,3 +203,8 @@ func NewSB(dbAddr string, sslCACert string, sslClientCert string, sslClientKey s
 
 	return client, nil
 }
+
+// Endpoint returns the southbound database endpoint the client is currently connected to (empty if disconnected).
+func (o *SB) Endpoint() string {
+	return o.client.CurrentEndpoint()
+}
@@ -5,13 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	ovnNB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-nb"
 	ovnSB "github.com/lxc/incus/v6/internal/server/network/ovn/schema/ovn-sb"
 )
 
+// OVNChassisStatus represents the configuration state reported by a chassis' ovn-controller.
+type OVNChassisStatus struct {
+	Name           string
+	Hostname       string
+	NbCfg          int
+	NbCfgTimestamp time.Time
+}
+
+// OVNSwitchPortBinding represents the southbound binding state of a logical switch port.
+type OVNSwitchPortBinding struct {
+	Up           bool
+	Chassis      string
+	Hostname     string
+	PortSecurity []string
+}
+
 // GetLogicalRouterPortActiveChassisHostname gets the hostname of the chassis managing the logical router port.
 func (o *SB) GetLogicalRouterPortActiveChassisHostname(ctx context.Context, ovnRouterPort OVNRouterPort) (string, error) {
 	// Look for the port binding.
@@ -25,7 +43,7 @@ func (o *SB) GetLogicalRouterPortActiveChassisHostname(ctx context.Context, ovnR
 	}
 
 	if pb.Chassis == nil {
-		return "", errors.New("No chassis found")
+		return "", ErrNotBound
 	}
 
 	// Get the associated chassis.
@@ -41,6 +59,99 @@ func (o *SB) GetLogicalRouterPortActiveChassisHostname(ctx context.Context, ovnR
 	return chassis.Hostname, nil
 }
 
+// GetLogicalSwitchTunnelKey returns the tunnel key (datapath ID) assigned to the logical switch by ovn-northd.
+// This is the VNI carried by the Geneve packets of the switch on the underlay network.
+func (o *SB) GetLogicalSwitchTunnelKey(ctx context.Context, switchName OVNSwitch) (int, error) {
+	datapaths := []ovnSB.DatapathBinding{}
+
+	err := o.client.WhereCache(func(dp *ovnSB.DatapathBinding) bool {
+		return dp.ExternalIDs != nil && dp.ExternalIDs["name"] == string(switchName) && dp.ExternalIDs["logical-switch"] != ""
+	}).List(ctx, &datapaths)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(datapaths) == 0 {
+		return 0, ErrNotFound
+	}
+
+	return datapaths[0].TunnelKey, nil
+}
+
+// GetBoundLogicalSwitchPorts returns which of the provided switch ports are currently up on a chassis.
+func (o *SB) GetBoundLogicalSwitchPorts(ctx context.Context, portNames ...OVNSwitchPort) (map[OVNSwitchPort]bool, error) {
+	wantedPorts := make(map[string]bool, len(portNames))
+	for _, portName := range portNames {
+		wantedPorts[string(portName)] = true
+	}
+
+	portBindings := []ovnSB.PortBinding{}
+
+	err := o.client.WhereCache(func(pb *ovnSB.PortBinding) bool {
+		return pb.Chassis != nil && pb.Up != nil && *pb.Up && wantedPorts[pb.LogicalPort]
+	}).List(ctx, &portBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	boundPorts := make(map[OVNSwitchPort]bool, len(portBindings))
+	for _, pb := range portBindings {
+		boundPorts[OVNSwitchPort(pb.LogicalPort)] = true
+	}
+
+	return boundPorts, nil
+}
+
+// GetLogicalSwitchPortBinding returns whether the logical switch port is up, the chassis it is bound to and the
+// port security addresses applied to it.
+func (o *SB) GetLogicalSwitchPortBinding(ctx context.Context, portName OVNSwitchPort) (*OVNSwitchPortBinding, error) {
+	pb := &ovnSB.PortBinding{
+		LogicalPort: string(portName),
+	}
+
+	err := o.client.Get(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+
+	binding := &OVNSwitchPortBinding{
+		Up:           pb.Up != nil && *pb.Up,
+		PortSecurity: pb.PortSecurity,
+	}
+
+	if pb.Chassis != nil {
+		chassis := &ovnSB.Chassis{
+			UUID: *pb.Chassis,
+		}
+
+		err = o.client.Get(ctx, chassis)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+
+		binding.Chassis = chassis.Name
+		binding.Hostname = chassis.Hostname
+	}
+
+	return binding, nil
+}
+
+// GetMACBindings returns the MAC addresses learned by the logical routers for any of the provided IP addresses.
+func (o *SB) GetMACBindings(ctx context.Context, ips ...net.IP) ([]ovnSB.MACBinding, error) {
+	macBindings := []ovnSB.MACBinding{}
+
+	err := o.client.WhereCache(func(mb *ovnSB.MACBinding) bool {
+		ip := net.ParseIP(mb.IP)
+
+		return ip != nil && slices.ContainsFunc(ips, ip.Equal)
+	}).List(ctx, &macBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	return macBindings, nil
+}
+
 // GetServiceHealth returns the current health record for a particular server and port.
 func (o *SB) GetServiceHealth(ctx context.Context, address string, protocol string, port int) (string, error) {
 	services := []ovnSB.ServiceMonitor{}
@@ -96,3 +207,84 @@ func (o *SB) CheckLoadBalancerOnline(ctx context.Context, lb ovnNB.LoadBalancer)
 
 	return false, nil
 }
+
+// GetChassisBridgeMappings returns the provider networks which the chassis with the given hostname maps to one of
+// its local bridges.
+func (o *SB) GetChassisBridgeMappings(ctx context.Context, hostname string) ([]string, error) {
+	chassis := []ovnSB.Chassis{}
+
+	err := o.client.WhereCache(func(c *ovnSB.Chassis) bool {
+		return c.Hostname == hostname
+	}).List(ctx, &chassis)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chassis) == 0 {
+		return nil, ErrNotFound
+	}
+
+	// Older versions of ovn-controller report the mappings in external_ids.
+	mappings := chassis[0].OtherConfig["ovn-bridge-mappings"]
+	if mappings == "" {
+		mappings = chassis[0].ExternalIDs["ovn-bridge-mappings"]
+	}
+
+	providerNetworks := []string{}
+	for _, mapping := range strings.Split(mappings, ",") {
+		providerNetwork, _, found := strings.Cut(strings.TrimSpace(mapping), ":")
+		if found {
+			providerNetworks = append(providerNetworks, providerNetwork)
+		}
+	}
+
+	return providerNetworks, nil
+}
+
+// GetChassisEncaps returns the tunnel encapsulations registered by all chassis.
+func (o *SB) GetChassisEncaps(ctx context.Context) ([]ovnSB.Encap, error) {
+	encaps := []ovnSB.Encap{}
+
+	err := o.client.List(ctx, &encaps)
+	if err != nil {
+		return nil, err
+	}
+
+	return encaps, nil
+}
+
+// GetChassisStatus returns the last configuration sequence number processed by each chassis.
+func (o *SB) GetChassisStatus(ctx context.Context) ([]OVNChassisStatus, error) {
+	chassisPrivate := []ovnSB.ChassisPrivate{}
+	err := o.client.List(ctx, &chassisPrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]OVNChassisStatus, 0, len(chassisPrivate))
+	for _, entry := range chassisPrivate {
+		chassisStatus := OVNChassisStatus{
+			Name:           entry.Name,
+			NbCfg:          entry.NbCfg,
+			NbCfgTimestamp: time.UnixMilli(int64(entry.NbCfgTimestamp)),
+		}
+
+		// Resolve the hostname from the public chassis record.
+		if entry.Chassis != nil {
+			chassis := &ovnSB.Chassis{
+				UUID: *entry.Chassis,
+			}
+
+			err = o.client.Get(ctx, chassis)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return nil, err
+			}
+
+			chassisStatus.Hostname = chassis.Hostname
+		}
+
+		status = append(status, chassisStatus)
+	}
+
+	return status, nil
+}
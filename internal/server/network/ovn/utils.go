@@ -3,8 +3,36 @@ package ovn
 import (
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-logr/logr"
+	ovsdbClient "github.com/ovn-org/libovsdb/client"
 )
 
+// ovsdbInactivityTimeout is how long a database endpoint may stay silent before it's probed, and then how long it
+// has to answer the probe before the client fails over to the next endpoint.
+const ovsdbInactivityTimeout = 15 * time.Second
+
+// ovsdbConnectionOptions returns the client options to connect to a comma-separated list of database endpoints.
+// The endpoints are tried in order, and the client moves on to the next reachable one whenever the current one
+// disconnects or stops answering inactivity probes.
+func ovsdbConnectionOptions(dbAddr string) []ovsdbClient.Option {
+	discard := logr.Discard()
+
+	options := []ovsdbClient.Option{ovsdbClient.WithLogger(&discard), ovsdbClient.WithInactivityCheck(ovsdbInactivityTimeout, 5*time.Second, &backoff.ZeroBackOff{})}
+	for _, entry := range strings.Split(dbAddr, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		options = append(options, ovsdbClient.WithEndpoint(entry))
+	}
+
+	return options
+}
+
 // unquote passes s through strconv.Unquote if the first character is a ", otherwise returns s unmodified.
 // This is useful as openvswitch's tools can sometimes return values double quoted if they start with a number.
 func unquote(s string) (string, error) {
@@ -494,6 +494,113 @@ func (o *VSwitch) GetInterfaceAssociatedOVNSwitchPort(ctx context.Context, inter
 	return ovsInterface.ExternalIDs["iface-id"], nil
 }
 
+// InterfaceStatistics represents the traffic counters of an OVS interface (from the switch's point of view).
+type InterfaceStatistics struct {
+	RxBytes   int64
+	RxPackets int64
+	RxErrors  int64
+	RxDropped int64
+	TxBytes   int64
+	TxPackets int64
+	TxErrors  int64
+	TxDropped int64
+}
+
+// GetInterfaceStatistics returns the traffic counters of the interface.
+func (o *VSwitch) GetInterfaceStatistics(ctx context.Context, interfaceName string) (*InterfaceStatistics, error) {
+	// Get the OVS interface.
+	ovsInterface := ovsSwitch.Interface{
+		Name: interfaceName,
+	}
+
+	err := o.client.Get(ctx, &ovsInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	return interfaceStatistics(ovsInterface.Statistics), nil
+}
+
+// GetOVNSwitchPortStatistics returns the traffic counters of all interfaces associated to an OVN switch port,
+// keyed by the OVN switch port name.
+func (o *VSwitch) GetOVNSwitchPortStatistics(ctx context.Context) (map[string]InterfaceStatistics, error) {
+	// Get the interfaces.
+	interfaceList := []ovsSwitch.Interface{}
+
+	err := o.client.WhereCache(func(iface *ovsSwitch.Interface) bool {
+		return iface.ExternalIDs["iface-id"] != ""
+	}).List(ctx, &interfaceList)
+	if err != nil {
+		return nil, err
+	}
+
+	portStats := make(map[string]InterfaceStatistics, len(interfaceList))
+	for _, iface := range interfaceList {
+		portStats[iface.ExternalIDs["iface-id"]] = *interfaceStatistics(iface.Statistics)
+	}
+
+	return portStats, nil
+}
+
+// interfaceStatistics converts the OVS interface statistics column into InterfaceStatistics.
+func interfaceStatistics(stats map[string]int) *InterfaceStatistics {
+	// Counters not supported by the interface type are omitted and so left as zero.
+	return &InterfaceStatistics{
+		RxBytes:   int64(stats["rx_bytes"]),
+		RxPackets: int64(stats["rx_packets"]),
+		RxErrors:  int64(stats["rx_errors"]),
+		RxDropped: int64(stats["rx_dropped"]),
+		TxBytes:   int64(stats["tx_bytes"]),
+		TxPackets: int64(stats["tx_packets"]),
+		TxErrors:  int64(stats["tx_errors"]),
+		TxDropped: int64(stats["tx_dropped"]),
+	}
+}
+
+// DisassociateInterfaceOVNSwitchPort removes the OVN switch port association from the interface (if already
+// disassociated does nothing).
+func (o *VSwitch) DisassociateInterfaceOVNSwitchPort(ctx context.Context, interfaceName string) error {
+	// Get the OVS interface.
+	ovsInterface := &ovsSwitch.Interface{
+		Name: interfaceName,
+	}
+
+	err := o.client.Get(ctx, ovsInterface)
+	if err != nil {
+		// Interface is already gone.
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	_, ok := ovsInterface.ExternalIDs["iface-id"]
+	if !ok {
+		return nil
+	}
+
+	// Update the record.
+	delete(ovsInterface.ExternalIDs, "iface-id")
+
+	operations, err := o.client.Where(ovsInterface).Update(ovsInterface)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.client.Transact(ctx, operations...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ovsdb.CheckOperationResults(resp, operations)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetChassisID returns the local chassis ID.
 func (o *VSwitch) GetChassisID(ctx context.Context) (string, error) {
 	// Get the root switch.
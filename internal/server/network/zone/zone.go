@@ -442,15 +442,21 @@ func (d *zone) Content() (*strings.Builder, error) {
 			isReverse6 := strings.HasSuffix(d.info.Name, ip6Arpa)
 			isReverse := isReverse4 || isReverse6
 
-			genRecord := func(name string, ip net.IP) map[string]string {
+			// Load the host names of the network forwards and load balancers.
+			listenHostnames, err := d.listenHostnames(n.ID())
+			if err != nil {
+				return nil, err
+			}
+
+			genRecord := func(name string, ip net.IP, nat bool) map[string]string {
 				isV4 := ip.To4() != nil
 
 				// Skip disabled families.
-				if isV4 && !includeV4 {
+				if nat && isV4 && !includeV4 {
 					return nil
 				}
 
-				if !isV4 && !includeV6 {
+				if nat && !isV4 && !includeV6 {
 					return nil
 				}
 
@@ -477,7 +483,7 @@ func (d *zone) Content() (*strings.Builder, error) {
 
 					// Get the ARPA record.
 					reverseAddr := reverse(ip)
-					if reverseAddr == "" {
+					if reverseAddr == "" || !strings.HasSuffix(reverseAddr, "."+d.info.Name+".") {
 						return nil
 					}
 
@@ -499,7 +505,7 @@ func (d *zone) Content() (*strings.Builder, error) {
 					}
 
 					// Load the leases for the forward zone project.
-					leases, err := n.Leases(forwardZoneProjectName, request.ClientTypeNormal)
+					leases, err := n.Leases(d.state.ShutdownCtx, forwardZoneProjectName, request.ClientTypeNormal)
 					if err != nil {
 						return nil, err
 					}
@@ -509,7 +515,17 @@ func (d *zone) Content() (*strings.Builder, error) {
 						ip := net.ParseIP(lease.Address)
 
 						// Get the record.
-						record := genRecord(fmt.Sprintf("%s.%s", lease.Hostname, forwardZoneName), ip)
+						record := genRecord(fmt.Sprintf("%s.%s", lease.Hostname, forwardZoneName), ip, true)
+						if record == nil {
+							continue
+						}
+
+						records = append(records, record)
+					}
+
+					// Convert the forward and load balancer listen addresses to PTR records.
+					for listenAddress, hostname := range listenHostnames {
+						record := genRecord(fmt.Sprintf("%s.%s", hostname, forwardZoneName), net.ParseIP(listenAddress), false)
 						if record == nil {
 							continue
 						}
@@ -519,7 +535,7 @@ func (d *zone) Content() (*strings.Builder, error) {
 				}
 			} else {
 				// Load the leases in the forward zone's project.
-				leases, err := n.Leases(d.projectName, request.ClientTypeNormal)
+				leases, err := n.Leases(d.state.ShutdownCtx, d.projectName, request.ClientTypeNormal)
 				if err != nil {
 					return nil, err
 				}
@@ -529,7 +545,17 @@ func (d *zone) Content() (*strings.Builder, error) {
 					ip := net.ParseIP(lease.Address)
 
 					// Get the record.
-					record := genRecord(lease.Hostname, ip)
+					record := genRecord(lease.Hostname, ip, true)
+					if record == nil {
+						continue
+					}
+
+					records = append(records, record)
+				}
+
+				// Convert the forward and load balancer listen addresses to usable records.
+				for listenAddress, hostname := range listenHostnames {
+					record := genRecord(hostname, net.ParseIP(listenAddress), false)
 					if record == nil {
 						continue
 					}
@@ -595,6 +621,53 @@ func (d *zone) Content() (*strings.Builder, error) {
 	return sb, nil
 }
 
+// listenHostnames returns the host names of the network forwards and load balancers of a network, keyed by
+// listen address.
+func (d *zone) listenHostnames(networkID int64) (map[string]string, error) {
+	hostnames := map[string]string{}
+
+	err := d.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbForwards, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network forwards: %w", err)
+		}
+
+		for _, dbForward := range dbForwards {
+			config, err := dbCluster.GetNetworkForwardConfig(ctx, tx.Tx(), int(dbForward.ID))
+			if err != nil {
+				return fmt.Errorf("Failed loading network forward config: %w", err)
+			}
+
+			if config["hostname"] != "" {
+				hostnames[dbForward.ListenAddress] = config["hostname"]
+			}
+		}
+
+		dbLoadBalancers, err := dbCluster.GetNetworkLoadBalancers(ctx, tx.Tx(), dbCluster.NetworkLoadBalancerFilter{NetworkID: &networkID})
+		if err != nil {
+			return fmt.Errorf("Failed loading network load balancers: %w", err)
+		}
+
+		for _, dbLoadBalancer := range dbLoadBalancers {
+			config, err := dbCluster.GetNetworkLoadBalancerConfig(ctx, tx.Tx(), int(dbLoadBalancer.ID))
+			if err != nil {
+				return fmt.Errorf("Failed loading network load balancer config: %w", err)
+			}
+
+			if config["hostname"] != "" {
+				hostnames[dbLoadBalancer.ListenAddress] = config["hostname"]
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hostnames, nil
+}
+
 // SOA returns just the DNS zone SOA record.
 func (d *zone) SOA() (*strings.Builder, error) {
 	// Get the nameservers.
@@ -483,6 +483,7 @@ func checkRestrictions(project api.Project, instances []api.Instance, profiles [
 
 	allowContainerLowLevel := false
 	allowVMLowLevel := false
+	allowExclusiveACLs := false
 	var allowedIDMapHostUIDs, allowedIDMapHostGIDs []idmap.Entry
 
 	for i := range allRestrictions {
@@ -640,9 +641,19 @@ func checkRestrictions(project api.Project, instances []api.Instance, profiles [
 					}
 				}
 
+				// Check if the NIC may ignore the ACLs of its network.
+				if util.IsTrue(device["security.acls.exclusive"]) && !allowExclusiveACLs {
+					return errors.New("Ignoring network ACLs is forbidden")
+				}
+
 				return nil
 			}
 
+		case "restricted.networks.acls.exclusive":
+			if restrictionValue == "allow" {
+				allowExclusiveACLs = true
+			}
+
 		case "restricted.devices.disk":
 			devicesChecks["disk"] = func(device map[string]string) error {
 				// The root device is always allowed.
@@ -858,6 +869,7 @@ var allRestrictions = map[string]string{
 	"restricted.idmap.uid":                 "",
 	"restricted.idmap.gid":                 "",
 	"restricted.networks.access":           "",
+	"restricted.networks.acls.exclusive":   "block",
 	"restricted.snapshots":                 "block",
 }
 
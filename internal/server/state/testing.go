@@ -6,9 +6,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	clusterConfig "github.com/lxc/incus/v6/internal/server/cluster/config"
 	"github.com/lxc/incus/v6/internal/server/db"
 	"github.com/lxc/incus/v6/internal/server/firewall"
+	"github.com/lxc/incus/v6/internal/server/network/ovn"
 	"github.com/lxc/incus/v6/internal/server/sys"
 )
 
@@ -22,10 +25,14 @@ func NewTestState(t *testing.T) (*State, func()) {
 	cluster, clusterCleanup := db.NewTestCluster(t)
 	os, osCleanup := sys.NewTestOS(t)
 
+	ovnFake, err := ovn.NewFake()
+	require.NoError(t, err)
+
 	cleanup := func() {
 		nodeCleanup()
 		clusterCleanup()
 		osCleanup()
+		ovnFake.Close()
 	}
 
 	state := &State{
@@ -35,6 +42,9 @@ func NewTestState(t *testing.T) (*State, func()) {
 		Firewall:               firewall.New(),
 		UpdateCertificateCache: func() {},
 		GlobalConfig:           &clusterConfig.Config{},
+		OVN: func() (*ovn.NB, *ovn.SB, error) {
+			return ovnFake.NB, ovnFake.SB, nil
+		},
 	}
 
 	return state, cleanup
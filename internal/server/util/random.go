@@ -1,13 +1,18 @@
 package util
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"hash/fnv"
 	"io"
 	"math/rand"
+	"strings"
 )
 
+// DefaultHwaddrPrefix is the prefix of generated MAC addresses when no other is configured.
+const DefaultHwaddrPrefix = "10:66:6a"
+
 // GetStableRandomGenerator returns a stable random generator. Uses the FNV-1a hash algorithm to convert the seed
 // string into an int64 for use as seed to the non-cryptographic random number generator.
 func GetStableRandomGenerator(seed string) (*rand.Rand, error) {
@@ -53,3 +58,49 @@ func GenerateSequenceInt64(begin, end, step int) ([]int64, error) {
 
 	return sequence, nil
 }
+
+// RandomHwaddr generates a MAC address made of the prefix (one to five octets) followed by random hex digits, each
+// returned by randomDigit.
+func RandomHwaddr(prefix string, randomDigit func() (int64, error)) (string, error) {
+	if prefix == "" {
+		prefix = DefaultHwaddrPrefix
+	}
+
+	template := prefix + strings.Repeat(":xx", 6-len(strings.Split(prefix, ":")))
+
+	ret := bytes.Buffer{}
+	for _, c := range strings.ToLower(template) {
+		if c == 'x' {
+			digit, err := randomDigit()
+			if err != nil {
+				return "", err
+			}
+
+			ret.WriteString(fmt.Sprintf("%x", digit))
+		} else {
+			ret.WriteString(string(c))
+		}
+	}
+
+	return ret.String(), nil
+}
+
+// IsHwaddrPrefix validates a MAC address prefix of one to five octets, which must leave the multicast bit unset.
+func IsHwaddrPrefix(value string) error {
+	octets := strings.Split(value, ":")
+	if len(octets) < 1 || len(octets) > 5 {
+		return errors.New("MAC address prefix must have between one and five octets")
+	}
+
+	for _, octet := range octets {
+		if len(octet) != 2 || strings.Trim(strings.ToLower(octet), "0123456789abcdef") != "" {
+			return fmt.Errorf("Invalid MAC address prefix octet %q", octet)
+		}
+	}
+
+	if strings.IndexByte("13579bdf", strings.ToLower(octets[0])[1]) >= 0 {
+		return fmt.Errorf("MAC address prefix %q is a multicast prefix", value)
+	}
+
+	return nil
+}
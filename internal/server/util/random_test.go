@@ -0,0 +1,39 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lxc/incus/v6/internal/server/util"
+)
+
+// Generated MAC addresses keep the prefix and fill the remaining octets with random digits.
+func TestRandomHwaddr(t *testing.T) {
+	digit := int64(0)
+	randomDigit := func() (int64, error) {
+		digit = (digit + 1) % 16
+		return digit, nil
+	}
+
+	hwaddr, err := util.RandomHwaddr(util.DefaultHwaddrPrefix, randomDigit)
+	require.NoError(t, err)
+	assert.Equal(t, "10:66:6a:12:34:56", hwaddr)
+
+	hwaddr, err = util.RandomHwaddr("02:AB:cd:00", randomDigit)
+	require.NoError(t, err)
+	assert.Equal(t, "02:ab:cd:00:78:9a", hwaddr)
+}
+
+// MAC address prefixes must be one to five unicast octets.
+func TestIsHwaddrPrefix(t *testing.T) {
+	assert.NoError(t, util.IsHwaddrPrefix("10:66:6a"))
+	assert.NoError(t, util.IsHwaddrPrefix("02"))
+	assert.NoError(t, util.IsHwaddrPrefix("02:00:00:00:0A"))
+	assert.Error(t, util.IsHwaddrPrefix("02:00:00:00:00:00"))
+	assert.Error(t, util.IsHwaddrPrefix("03:00"))
+	assert.Error(t, util.IsHwaddrPrefix("0g"))
+	assert.Error(t, util.IsHwaddrPrefix("2:00"))
+	assert.Error(t, util.IsHwaddrPrefix(""))
+}
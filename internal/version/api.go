@@ -493,6 +493,83 @@ var APIExtensions = []string{
 	"limits_memory_hotplug",
 	"disk_wwn",
 	"server_logging_webhook",
+	"network_ovn_state_chassis_health",
+	"network_leases_state",
+	"projects_network_ovn_integration_bridge",
+	"network_ovn_volatile_router_hwaddr",
+	"network_ovn_ntp_servers",
+	"network_forward_internal_scope",
+	"network_ovn_services_subnet",
+	"network_ovn_nat_hairpin",
+	"network_ovn_l2proxy_aggregate",
+	"network_ovn_status_errors",
+	"projects_networks_default_security_acls",
+	"projects_restricted_networks_domains",
+	"network_ovn_ipv6_ranges_required",
+	"network_health",
+	"network_external_ports",
+	"instance_nic_ovn_neighbors",
+	"network_ovn_replication",
+	"network_restore_points",
+	"network_traffic_accounting",
+	"network_ovn_stateless",
+	"network_load_balancer_udp_probes",
+	"network_ovn_transaction_retry",
+	"network_ovn_dynamic_addresses",
+	"instance_state_network_ovn",
+	"network_ovn_chassis_priority",
+	"network_update_remap_targets",
+	"network_listen_address_move",
+	"projects_restricted_networks_external_ips",
+	"auth_network_entitlements",
+	"network_ovn_objects",
+	"network_ovn_stale_records_pruning",
+	"network_forward_target_address_family",
+	"network_target_groups",
+	"network_ovn_config_templates",
+	"network_dns_nameservers_refresh",
+	"network_ovn_unnumbered_uplink",
+	"network_ovn_readiness",
+	"network_acl_include",
+	"network_acl_exclusive",
+	"network_peer_approval",
+	"network_peer_bandwidth",
+	"network_integrations_transit_gc",
+	"network_integrations_gateway_selection",
+	"network_integrations_state",
+	"network_peer_integration_failover",
+	"network_peer_route_filters",
+	"metadata_configuration_network_schema",
+	"network_forward_ports_patch",
+	"network_forward_load_balancer_used_by",
+	"nic_ovn_routed_mode",
+	"network_detach_uplink",
+	"network_state_ovn_tunnel_key",
+	"network_ovn_failover_addresses",
+	"network_router_routes",
+	"network_ovn_nat_policies",
+	"network_ovn_nat_exclusions",
+	"network_ovn_dns_forward_networks",
+	"instance_nic_ovn_network_move",
+	"network_hwaddr_prefix",
+	"network_ovn_ipv6_l3only_small_subnets",
+	"instance_oci_publish_ports",
+	"network_physical_ovn_cluster_groups",
+	"network_ovn_external_interfaces_state",
+	"network_forward_bgp_advertise",
+	"network_load_balancer_bgp_delay",
+	"network_ovn_database_endpoints",
+	"instance_nic_ovn_precreate",
+	"network_leases_all_projects",
+	"network_ovn_dns_conflicts",
+	"instance_state_network_ovn_binding",
+	"network_load_balancer_networks",
+	"network_forward_port_protocol_any",
+	"recover_ovn_networks",
+	"instance_interface_ovn",
+	"network_forward_hostname",
+	"network_address_conflicts",
+	"instance_nic_ovn_ovs_counters",
 }
 
 // APIExtensionsCount returns the number of available API extensions.
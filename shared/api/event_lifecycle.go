@@ -78,18 +78,32 @@ const (
 	EventLifecycleNetworkDeleted                    = "network-deleted"
 	EventLifecycleNetworkForwardCreated             = "network-forward-created"
 	EventLifecycleNetworkForwardDeleted             = "network-forward-deleted"
+	EventLifecycleNetworkForwardRenamed             = "network-forward-renamed"
 	EventLifecycleNetworkForwardUpdated             = "network-forward-updated"
+	EventLifecycleNetworkExternalPortCreated        = "network-external-port-created"
+	EventLifecycleNetworkExternalPortDeleted        = "network-external-port-deleted"
+	EventLifecycleNetworkExternalPortUpdated        = "network-external-port-updated"
 	EventLifecycleNetworkIntegrationCreated         = "network-integration-created"
 	EventLifecycleNetworkIntegrationDeleted         = "network-integration-deleted"
 	EventLifecycleNetworkIntegrationRenamed         = "network-integration-renamed"
 	EventLifecycleNetworkIntegrationUpdated         = "network-integration-updated"
 	EventLifecycleNetworkLoadBalancerCreated        = "network-load-balancer-created"
 	EventLifecycleNetworkLoadBalancerDeleted        = "network-load-balancer-deleted"
+	EventLifecycleNetworkLoadBalancerRenamed        = "network-load-balancer-renamed"
 	EventLifecycleNetworkLoadBalancerUpdated        = "network-load-balancer-updated"
+	EventLifecycleNetworkPeerApproved               = "network-peer-approved"
 	EventLifecycleNetworkPeerCreated                = "network-peer-created"
 	EventLifecycleNetworkPeerDeleted                = "network-peer-deleted"
+	EventLifecycleNetworkPeerRejected               = "network-peer-rejected"
 	EventLifecycleNetworkPeerUpdated                = "network-peer-updated"
 	EventLifecycleNetworkRenamed                    = "network-renamed"
+	EventLifecycleNetworkRestorePointCreated        = "network-restore-point-created"
+	EventLifecycleNetworkRestorePointDeleted        = "network-restore-point-deleted"
+	EventLifecycleNetworkRestorePointRestored       = "network-restore-point-restored"
+	EventLifecycleNetworkTargetGroupCreated         = "network-target-group-created"
+	EventLifecycleNetworkTargetGroupDeleted         = "network-target-group-deleted"
+	EventLifecycleNetworkTargetGroupRenamed         = "network-target-group-renamed"
+	EventLifecycleNetworkTargetGroupUpdated         = "network-target-group-updated"
 	EventLifecycleNetworkUpdated                    = "network-updated"
 	EventLifecycleNetworkZoneCreated                = "network-zone-created"
 	EventLifecycleNetworkZoneDeleted                = "network-zone-deleted"
@@ -166,6 +166,11 @@ type InstanceStateNetwork struct {
 	// Type of interface (broadcast, loopback, point-to-point, ...)
 	// Example: broadcast
 	Type string `json:"type" yaml:"type"`
+
+	// Effective DHCP and router advertisement settings (OVN NICs only)
+	//
+	// API extension: instance_state_network_ovn.
+	OVN *InstanceStateNetworkOVN `json:"ovn,omitempty" yaml:"ovn,omitempty"`
 }
 
 // InstanceStateNetworkAddress represents a network address as part of the network section of an
@@ -258,3 +263,105 @@ type InstanceStateOSInfo struct {
 	// Example: myhost.mydomain.local
 	FQDN string `json:"fqdn" yaml:"fqdn"`
 }
+
+// InstanceStateNetworkOVN represents the effective DHCP and router advertisement settings offered by OVN
+// to an instance network interface along with the binding state of its port.
+//
+// swagger:model
+//
+// API extension: instance_state_network_ovn.
+type InstanceStateNetworkOVN struct {
+	// UUID of the DHCPv4 option set applied to the port
+	// Example: 5e9b8f2c-0d1a-4c3e-9a8b-6f2e1d4c3b2a
+	DHCPv4OptionsUUID string `json:"dhcpv4_options_uuid" yaml:"dhcpv4_options_uuid"`
+
+	// UUID of the DHCPv6 option set applied to the port
+	// Example: 8c1d2e3f-4a5b-6c7d-8e9f-0a1b2c3d4e5f
+	DHCPv6OptionsUUID string `json:"dhcpv6_options_uuid" yaml:"dhcpv6_options_uuid"`
+
+	// DHCPv4 lease time in seconds
+	// Example: 3600
+	LeaseTime int64 `json:"lease_time" yaml:"lease_time"`
+
+	// MTU advertised through DHCPv4 or router advertisements
+	// Example: 1442
+	MTU int64 `json:"mtu" yaml:"mtu"`
+
+	// DNS servers advertised to the instance
+	// Example: ["10.0.0.1", "fd42:4c81:5770:1eaf::1"]
+	DNSServers []string `json:"dns_servers" yaml:"dns_servers"`
+
+	// DNS search domains advertised to the instance
+	// Example: ["incus"]
+	DNSSearch []string `json:"dns_search" yaml:"dns_search"`
+
+	// Whether the logical switch port is up
+	// Example: true
+	//
+	// API extension: instance_state_network_ovn_binding
+	PortUp bool `json:"port_up" yaml:"port_up"`
+
+	// Name of the chassis the port is bound to (empty if unbound)
+	// Example: 0e2a2c17-3f6a-4c4f-9c5e-7d5b0a4a8e1f
+	//
+	// API extension: instance_state_network_ovn_binding
+	Chassis string `json:"chassis" yaml:"chassis"`
+
+	// Hostname of the chassis the port is bound to (empty if unbound)
+	// Example: server01
+	//
+	// API extension: instance_state_network_ovn_binding
+	ChassisHostname string `json:"chassis_hostname" yaml:"chassis_hostname"`
+
+	// Port security addresses applied to the port
+	// Example: ["10:66:6a:0c:ee:dd 10.0.0.2 fd42:4c81:5770:1eaf:1266:6aff:fe0c:eedd"]
+	//
+	// API extension: instance_state_network_ovn_binding
+	PortSecurity []string `json:"port_security" yaml:"port_security"`
+}
+
+// InstanceInterfaceOVN represents the comparison between the expected OVN configuration of an instance NIC and its
+// actual records in the OVN databases
+//
+// swagger:model
+//
+// API extension: instance_interface_ovn.
+type InstanceInterfaceOVN struct {
+	// Name of the logical switch port of the NIC
+	// Example: incus-net3-instance-fc933d65-0900-46b0-b5f2-4d323342e755-eth0
+	LogicalSwitchPort string `json:"logical_switch_port" yaml:"logical_switch_port"`
+
+	// Whether the OVN records match the expected configuration
+	// Example: true
+	Consistent bool `json:"consistent" yaml:"consistent"`
+
+	// List of individual comparisons
+	Checks []InstanceInterfaceOVNCheck `json:"checks" yaml:"checks"`
+}
+
+// InstanceInterfaceOVNCheck represents the comparison of one aspect of an instance NIC's OVN configuration
+//
+// swagger:model
+//
+// API extension: instance_interface_ovn.
+type InstanceInterfaceOVNCheck struct {
+	// Name of the check
+	// Example: dhcp_options
+	Name string `json:"name" yaml:"name"`
+
+	// Status of the check (ok, mismatch or skipped)
+	// Example: mismatch
+	Status string `json:"status" yaml:"status"`
+
+	// Values expected from the configuration
+	// Example: ["3f0a1c6e-8f5b-4d2b-9c1e-2f7d0b7a9e51"]
+	Expected []string `json:"expected" yaml:"expected"`
+
+	// Values found in the OVN databases
+	// Example: []
+	Actual []string `json:"actual" yaml:"actual"`
+
+	// Details on the check result
+	// Example: Logical switch port doesn't use the DHCPv4 options of the network
+	Message string `json:"message" yaml:"message"`
+}
@@ -106,3 +106,64 @@ type MetadataConfigKey struct {
 	// Example: "Specify the kernel modules as a comma-separated list."
 	LongDescription string `json:"longdesc" yaml:"longdesc"`
 }
+
+// MetadataNetworkConfiguration represents the configuration schema of a network type
+//
+// swagger:model
+//
+// API extension: metadata_configuration_network_schema.
+type MetadataNetworkConfiguration struct {
+	// Network type
+	// Example: ovn
+	Type string `json:"type" yaml:"type"`
+
+	// Configuration keys accepted by the network type
+	Keys []MetadataNetworkConfigKey `json:"keys" yaml:"keys"`
+}
+
+// MetadataNetworkConfigKey describes a configuration key of a network type
+//
+// swagger:model
+//
+// API extension: metadata_configuration_network_schema.
+type MetadataNetworkConfigKey struct {
+	// Name of the key, may contain a placeholder (`*` or an upper case word) for dynamic parts
+	// Example: ipv4.address
+	Name string `json:"name" yaml:"name"`
+
+	// Group the key belongs to
+	// Example: common
+	Group string `json:"group" yaml:"group"`
+
+	// Type of the value
+	// Example: string
+	Type string `json:"type" yaml:"type"`
+
+	// Default value, when it is a literal
+	// Example: 1442
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+
+	// Description of the default value
+	// Example: initial value on creation
+	DefaultDescription string `json:"default_description,omitempty" yaml:"default_description,omitempty"`
+
+	// Condition that must be met for the key to be taken into account
+	// Example: IPv4 address
+	Condition string `json:"condition,omitempty" yaml:"condition,omitempty"`
+
+	// Whether the key is required
+	// Example: false
+	Required bool `json:"required" yaml:"required"`
+
+	// Whether the key is stored per cluster member or cluster wide
+	// Example: global
+	Scope string `json:"scope,omitempty" yaml:"scope,omitempty"`
+
+	// Short description of the key
+	// Example: IPv4 address for the bridge
+	Description string `json:"description" yaml:"description"`
+
+	// Long description of the key
+	// Example: Use CIDR notation.
+	LongDescription string `json:"long_description,omitempty" yaml:"long_description,omitempty"`
+}
@@ -1,5 +1,9 @@
 package api
 
+import (
+	"time"
+)
+
 // NetworksPost represents the fields of a new network
 //
 // swagger:model
@@ -141,6 +145,24 @@ type NetworkLease struct {
 	//
 	// API extension: network_leases_location
 	Location string `json:"location" yaml:"location"`
+
+	// Whether the address is actively in use (bound) or only configured
+	// Example: bound
+	//
+	// API extension: network_leases_state
+	State string `json:"state" yaml:"state"`
+
+	// When the lease expires (if applicable)
+	// Example: 2025-01-01T00:00:00Z
+	//
+	// API extension: network_leases_state
+	ExpiresAt *time.Time `json:"expires_at" yaml:"expires_at"`
+
+	// Project the record belongs to (only set when listing leases from all projects)
+	// Example: default
+	//
+	// API extension: network_leases_all_projects
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
 }
 
 // NetworkState represents the network state
@@ -335,6 +357,12 @@ type NetworkStateOVN struct {
 	// API extension: network_state_ovn_ls
 	LogicalSwitch string `json:"logical_switch" yaml:"logical_switch"`
 
+	// Tunnel key (datapath ID) of the OVN logical switch, as carried in the Geneve header (0 if not assigned yet)
+	// Example: 5
+	//
+	// API extension: network_state_ovn_tunnel_key
+	LogicalSwitchTunnelKey int `json:"logical_switch_tunnel_key" yaml:"logical_switch_tunnel_key"`
+
 	// OVN network uplink ipv4 address
 	// Example: 10.0.0.1
 	//
@@ -346,4 +374,583 @@ type NetworkStateOVN struct {
 	//
 	// API extension: network_ovn_state_addresses
 	UplinkIPv6 string `json:"uplink_ipv6" yaml:"uplink_ipv6"`
+
+	// OVN northbound configuration sequence number
+	// Example: 42
+	//
+	// API extension: network_ovn_state_chassis_health
+	NbCfg int `json:"nb_cfg" yaml:"nb_cfg"`
+
+	// OVN southbound configuration sequence number (as processed by ovn-northd)
+	// Example: 42
+	//
+	// API extension: network_ovn_state_chassis_health
+	SbCfg int `json:"sb_cfg" yaml:"sb_cfg"`
+
+	// OVN hypervisor configuration sequence number (as processed by all chassis)
+	// Example: 41
+	//
+	// API extension: network_ovn_state_chassis_health
+	HvCfg int `json:"hv_cfg" yaml:"hv_cfg"`
+
+	// Per-chassis configuration state
+	//
+	// API extension: network_ovn_state_chassis_health
+	ChassisHealth []NetworkStateOVNChassis `json:"chassis_health" yaml:"chassis_health"`
+
+	// Priorities of the chassis in the network's HA chassis group (highest first)
+	//
+	// API extension: network_ovn_chassis_priority
+	ChassisPriorities []NetworkStateOVNChassisPriority `json:"chassis_priorities" yaml:"chassis_priorities"`
+
+	// Failover addresses of the network and the instances currently holding them
+	//
+	// API extension: network_ovn_failover_addresses
+	FailoverAddresses []NetworkStateOVNFailoverAddress `json:"failover_addresses" yaml:"failover_addresses"`
+
+	// External interfaces bridged into the network by the member
+	//
+	// API extension: network_ovn_external_interfaces_state
+	ExternalInterfaces []NetworkStateOVNExternalInterface `json:"external_interfaces" yaml:"external_interfaces"`
+
+	// OVN northbound database endpoint the member is connected to (empty if disconnected)
+	// Example: ssl:10.0.0.2:6641
+	//
+	// API extension: network_ovn_database_endpoints
+	NorthboundEndpoint string `json:"northbound_endpoint" yaml:"northbound_endpoint"`
+
+	// OVN southbound database endpoint the member is connected to (empty if disconnected)
+	// Example: ssl:10.0.0.2:6642
+	//
+	// API extension: network_ovn_database_endpoints
+	SouthboundEndpoint string `json:"southbound_endpoint" yaml:"southbound_endpoint"`
+
+	// DNS names registered by more than one port of the network
+	//
+	// API extension: network_ovn_dns_conflicts
+	DNSConflicts []NetworkStateOVNDNSConflict `json:"dns_conflicts" yaml:"dns_conflicts"`
+}
+
+// NetworkStateOVNDNSConflict represents a DNS name registered by several ports of an OVN network
+//
+// swagger:model
+//
+// API extension: network_ovn_dns_conflicts.
+type NetworkStateOVNDNSConflict struct {
+	// DNS name
+	// Example: c1.incus
+	Name string `json:"name" yaml:"name"`
+
+	// Instances (or ports not belonging to an instance) registering the name
+	// Example: ["/1.0/instances/c1", "/1.0/instances/c1?project=foo"]
+	UsedBy []string `json:"used_by" yaml:"used_by"`
+}
+
+// NetworkStateOVNExternalInterface represents an external interface bridged into an OVN network
+//
+// swagger:model
+//
+// API extension: network_ovn_external_interfaces_state.
+type NetworkStateOVNExternalInterface struct {
+	// Interface name
+	// Example: eth1
+	Name string `json:"name" yaml:"name"`
+
+	// Whether the interface is attached to the network
+	// Example: true
+	Attached bool `json:"attached" yaml:"attached"`
+
+	// Error preventing the interface from being attached (empty if attached)
+	// Example: Only unconfigured network interfaces can be bridged
+	Error string `json:"error" yaml:"error"`
+}
+
+// NetworkStateOVNFailoverAddress represents a failover address of an OVN network
+//
+// swagger:model
+//
+// API extension: network_ovn_failover_addresses.
+type NetworkStateOVNFailoverAddress struct {
+	// Failover address name
+	// Example: web
+	Name string `json:"name" yaml:"name"`
+
+	// Failover address
+	// Example: 10.0.0.100
+	Address string `json:"address" yaml:"address"`
+
+	// Instance currently holding the address (empty if none of its instances is up)
+	// Example: web01
+	Instance string `json:"instance" yaml:"instance"`
+}
+
+// NetworkStateOVNChassisPriority represents the priority of a chassis in the HA chassis group of an OVN network
+//
+// swagger:model
+//
+// API extension: network_ovn_chassis_priority.
+type NetworkStateOVNChassisPriority struct {
+	// Chassis name
+	// Example: 0e2a2c17-3f6a-4c4f-9c5e-7d5b0a4a8e1f
+	Name string `json:"name" yaml:"name"`
+
+	// Chassis hostname
+	// Example: server01
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// Priority of the chassis (the highest priority chassis hosts the network's router)
+	// Example: 32767
+	Priority int `json:"priority" yaml:"priority"`
+}
+
+// NetworkStateOVNChassis represents the configuration state of an OVN chassis
+//
+// swagger:model
+//
+// API extension: network_ovn_state_chassis_health.
+type NetworkStateOVNChassis struct {
+	// Chassis name
+	// Example: 0e2a2c17-3f6a-4c4f-9c5e-7d5b0a4a8e1f
+	Name string `json:"name" yaml:"name"`
+
+	// Chassis hostname
+	// Example: server01
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// Last configuration sequence number processed by the chassis
+	// Example: 41
+	NbCfg int `json:"nb_cfg" yaml:"nb_cfg"`
+
+	// Number of configuration sequence numbers the chassis is behind
+	// Example: 1
+	Lag int `json:"lag" yaml:"lag"`
+
+	// Time since the chassis last processed a configuration change (in seconds)
+	// Example: 12
+	HeartbeatAge int64 `json:"heartbeat_age" yaml:"heartbeat_age"`
+}
+
+// NetworkHealth represents the result of the health checks of a network
+//
+// swagger:model
+//
+// API extension: network_health.
+type NetworkHealth struct {
+	// Whether all the checks passed
+	// Example: true
+	Healthy bool `json:"healthy" yaml:"healthy"`
+
+	// List of individual checks
+	Checks []NetworkHealthCheck `json:"checks" yaml:"checks"`
+}
+
+// NetworkHealthCheck represents the result of a single network health check
+//
+// swagger:model
+//
+// API extension: network_health.
+type NetworkHealthCheck struct {
+	// Name of the check
+	// Example: logical_router
+	Name string `json:"name" yaml:"name"`
+
+	// Status of the check (ok, failed or skipped)
+	// Example: ok
+	Status string `json:"status" yaml:"status"`
+
+	// Details on the check result
+	// Example: Logical router "incus-net1-lr" is missing
+	Message string `json:"message" yaml:"message"`
+}
+
+// NetworkOVN represents the OVN northbound database objects backing a network
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVN struct {
+	// Logical router (if any)
+	LogicalRouter *NetworkOVNLogicalRouter `json:"logical_router" yaml:"logical_router"`
+
+	// Logical switches
+	LogicalSwitches []NetworkOVNLogicalSwitch `json:"logical_switches" yaml:"logical_switches"`
+
+	// DHCP options of the internal switch
+	DHCPOptions []NetworkOVNDHCPOptions `json:"dhcp_options" yaml:"dhcp_options"`
+
+	// Load balancers used by forwards and load balancers
+	LoadBalancers []NetworkOVNLoadBalancer `json:"load_balancers" yaml:"load_balancers"`
+
+	// Port groups of the internal switch
+	PortGroups []NetworkOVNPortGroup `json:"port_groups" yaml:"port_groups"`
+
+	// Address sets
+	AddressSets []NetworkOVNAddressSet `json:"address_sets" yaml:"address_sets"`
+}
+
+// NetworkOVNLogicalRouter represents an OVN logical router
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNLogicalRouter struct {
+	// Record UUID
+	// Example: 8b7a0b4e-0c1b-4bf0-a2a5-2f3c8f5e9d10
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Router name
+	// Example: incus-net1-lr
+	Name string `json:"name" yaml:"name"`
+
+	// Router options
+	// Example: {"chassis": "server01"}
+	Options map[string]string `json:"options" yaml:"options"`
+
+	// Router ports
+	Ports []NetworkOVNLogicalRouterPort `json:"ports" yaml:"ports"`
+
+	// NAT rules
+	NAT []NetworkOVNNAT `json:"nat" yaml:"nat"`
+
+	// Static routes
+	StaticRoutes []NetworkOVNStaticRoute `json:"static_routes" yaml:"static_routes"`
+
+	// Routing policies
+	Policies []NetworkOVNRouterPolicy `json:"policies" yaml:"policies"`
+}
+
+// NetworkOVNLogicalRouterPort represents an OVN logical router port
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNLogicalRouterPort struct {
+	// Record UUID
+	// Example: 5e0c2a61-7d1b-4f1e-9c2a-1f0d3b4a5c6d
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Port name
+	// Example: incus-net1-lr-lrp-int
+	Name string `json:"name" yaml:"name"`
+
+	// MAC address
+	// Example: 00:16:3e:3c:7b:2a
+	MAC string `json:"mac" yaml:"mac"`
+
+	// Addresses and subnets
+	// Example: ["10.0.0.1/24"]
+	Networks []string `json:"networks" yaml:"networks"`
+
+	// Peer router port (if any)
+	// Example: incus-net2-lr-lrp-peer-net1
+	Peer string `json:"peer" yaml:"peer"`
+}
+
+// NetworkOVNNAT represents an OVN logical router NAT rule
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNNAT struct {
+	// Record UUID
+	// Example: c3a5f1d2-6b7e-4c8d-9e0f-1a2b3c4d5e6f
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// NAT type (snat, dnat or dnat_and_snat)
+	// Example: snat
+	Type string `json:"type" yaml:"type"`
+
+	// External address
+	// Example: 192.0.2.10
+	ExternalIP string `json:"external_ip" yaml:"external_ip"`
+
+	// Internal address or subnet
+	// Example: 10.0.0.0/24
+	LogicalIP string `json:"logical_ip" yaml:"logical_ip"`
+
+	// Logical port (if any)
+	// Example: incus-net1-instance-1b3f0b5c-eth0
+	LogicalPort string `json:"logical_port" yaml:"logical_port"`
+}
+
+// NetworkOVNStaticRoute represents an OVN logical router static route
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNStaticRoute struct {
+	// Record UUID
+	// Example: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Route prefix
+	// Example: 0.0.0.0/0
+	Prefix string `json:"prefix" yaml:"prefix"`
+
+	// Next hop address
+	// Example: 192.0.2.1
+	NextHop string `json:"nexthop" yaml:"nexthop"`
+
+	// Output port (if any)
+	// Example: incus-net1-lr-lrp-ext
+	OutputPort string `json:"output_port" yaml:"output_port"`
+
+	// Route table (empty for the main table)
+	RouteTable string `json:"route_table" yaml:"route_table"`
+}
+
+// NetworkOVNRouterPolicy represents an OVN logical router policy
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNRouterPolicy struct {
+	// Record UUID
+	// Example: 0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Policy priority
+	// Example: 600
+	Priority int `json:"priority" yaml:"priority"`
+
+	// Match expression
+	// Example: inport == "incus-net1-lr-lrp-peer-net2"
+	Match string `json:"match" yaml:"match"`
+
+	// Policy action (allow, drop or reroute)
+	// Example: allow
+	Action string `json:"action" yaml:"action"`
+
+	// Next hops for reroute policies
+	// Example: ["10.0.0.2"]
+	NextHops []string `json:"nexthops" yaml:"nexthops"`
+}
+
+// NetworkOVNLogicalSwitch represents an OVN logical switch
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNLogicalSwitch struct {
+	// Record UUID
+	// Example: 9a8b7c6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Switch name
+	// Example: incus-net1-ls-int
+	Name string `json:"name" yaml:"name"`
+
+	// Switch configuration
+	// Example: {"subnet": "10.0.0.0/24"}
+	OtherConfig map[string]string `json:"other_config" yaml:"other_config"`
+
+	// Switch ports
+	Ports []NetworkOVNLogicalSwitchPort `json:"ports" yaml:"ports"`
+}
+
+// NetworkOVNLogicalSwitchPort represents an OVN logical switch port
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNLogicalSwitchPort struct {
+	// Record UUID
+	// Example: 6d5c4b3a-2f1e-4d0c-8b9a-7f6e5d4c3b2a
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Port name
+	// Example: incus-net1-instance-1b3f0b5c-eth0
+	Name string `json:"name" yaml:"name"`
+
+	// Port type (empty for regular ports)
+	// Example: router
+	Type string `json:"type" yaml:"type"`
+
+	// Port addresses
+	// Example: ["00:16:3e:3c:7b:2a 10.0.0.2"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+
+	// Dynamically allocated addresses
+	// Example: 00:16:3e:3c:7b:2a 10.0.0.2
+	DynamicAddresses string `json:"dynamic_addresses" yaml:"dynamic_addresses"`
+
+	// Whether the port is up
+	// Example: true
+	Up bool `json:"up" yaml:"up"`
+}
+
+// NetworkOVNDHCPOptions represents an OVN DHCP options set
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNDHCPOptions struct {
+	// Record UUID
+	// Example: 3b2a1f0e-9d8c-4b7a-8f6e-5d4c3b2a1f0e
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Subnet
+	// Example: 10.0.0.0/24
+	CIDR string `json:"cidr" yaml:"cidr"`
+
+	// DHCP options
+	// Example: {"router": "10.0.0.1"}
+	Options map[string]string `json:"options" yaml:"options"`
+}
+
+// NetworkOVNLoadBalancer represents an OVN load balancer
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNLoadBalancer struct {
+	// Record UUID
+	// Example: 7e6d5c4b-3a2f-4e1d-9c0b-8a7f6e5d4c3b
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Load balancer name
+	// Example: incus-net1-lb-192.0.2.10-tcp
+	Name string `json:"name" yaml:"name"`
+
+	// Protocol
+	// Example: tcp
+	Protocol string `json:"protocol" yaml:"protocol"`
+
+	// Virtual IPs and their backends
+	// Example: {"192.0.2.10:80": "10.0.0.2:80"}
+	VIPs map[string]string `json:"vips" yaml:"vips"`
+}
+
+// NetworkOVNPortGroup represents an OVN port group
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNPortGroup struct {
+	// Record UUID
+	// Example: 2a1f0e9d-8c7b-4a6f-9e5d-4c3b2a1f0e9d
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Port group name
+	// Example: incus_net1
+	Name string `json:"name" yaml:"name"`
+
+	// Names of the member ports
+	// Example: ["incus-net1-instance-1b3f0b5c-eth0"]
+	Ports []string `json:"ports" yaml:"ports"`
+
+	// Number of ACL rules
+	// Example: 4
+	ACLs int `json:"acls" yaml:"acls"`
+}
+
+// NetworkOVNAddressSet represents an OVN address set
+//
+// swagger:model
+//
+// API extension: network_ovn_objects.
+type NetworkOVNAddressSet struct {
+	// Record UUID
+	// Example: 4c3b2a1f-0e9d-4c8b-9a7f-6e5d4c3b2a1f
+	UUID string `json:"uuid" yaml:"uuid"`
+
+	// Address set name
+	// Example: incus_net1_routes_ip4
+	Name string `json:"name" yaml:"name"`
+
+	// Addresses
+	// Example: ["10.0.0.0/24"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+}
+
+// NetworkRouteSourceConfig is the source of routes and policies derived from the network's configuration.
+const NetworkRouteSourceConfig = "config"
+
+// NetworkRouteSourceNIC is the source of routes to instance NICs and the subnets they route.
+const NetworkRouteSourceNIC = "nic"
+
+// NetworkRouteSourceForward is the source of routes to network forward listen addresses.
+const NetworkRouteSourceForward = "forward"
+
+// NetworkRouteSourceLoadBalancer is the source of routes to network load balancer listen addresses.
+const NetworkRouteSourceLoadBalancer = "load-balancer"
+
+// NetworkRouteSourcePeer is the source of routes and policies set up by network peerings.
+const NetworkRouteSourcePeer = "peer"
+
+// NetworkRouteSourceUnknown is the source of routes and policies that can't be attributed.
+const NetworkRouteSourceUnknown = "unknown"
+
+// NetworkRoutes represents the static routes and routing policies of a network's router along with their source
+//
+// swagger:model
+//
+// API extension: network_router_routes.
+type NetworkRoutes struct {
+	// Static routes
+	Routes []NetworkRoute `json:"routes" yaml:"routes"`
+
+	// Routing policies
+	Policies []NetworkRoutePolicy `json:"policies" yaml:"policies"`
+}
+
+// NetworkRoute represents a static route of a network's router
+//
+// swagger:model
+//
+// API extension: network_router_routes.
+type NetworkRoute struct {
+	// Route prefix
+	// Example: 0.0.0.0/0
+	Prefix string `json:"prefix" yaml:"prefix"`
+
+	// Next hop address (or "discard")
+	// Example: 192.0.2.1
+	NextHop string `json:"nexthop" yaml:"nexthop"`
+
+	// Output port (if any)
+	// Example: incus-net1-lr-lrp-ext
+	OutputPort string `json:"output_port" yaml:"output_port"`
+
+	// Route table (empty for the main table)
+	RouteTable string `json:"route_table" yaml:"route_table"`
+
+	// Source of the route (config, nic, forward, load-balancer, peer or unknown)
+	// Example: config
+	Source string `json:"source" yaml:"source"`
+
+	// URL of the entity the route comes from (if any)
+	// Example: /1.0/networks/ovn0
+	SourceURL string `json:"source_url" yaml:"source_url"`
+}
+
+// NetworkRoutePolicy represents a routing policy of a network's router
+//
+// swagger:model
+//
+// API extension: network_router_routes.
+type NetworkRoutePolicy struct {
+	// Policy priority
+	// Example: 600
+	Priority int `json:"priority" yaml:"priority"`
+
+	// Match expression
+	// Example: inport == "incus-net1-lr-lrp-int" && ip4 && ip4.src == $incus_net1_routes_ip4
+	Match string `json:"match" yaml:"match"`
+
+	// Policy action (allow, drop or reroute)
+	// Example: allow
+	Action string `json:"action" yaml:"action"`
+
+	// Next hops for reroute policies
+	// Example: ["10.0.0.2"]
+	NextHops []string `json:"nexthops" yaml:"nexthops"`
+
+	// Source of the policy (config, peer or unknown)
+	// Example: config
+	Source string `json:"source" yaml:"source"`
+
+	// URL of the entity the policy comes from (if any)
+	// Example: /1.0/networks/ovn0
+	SourceURL string `json:"source_url" yaml:"source_url"`
 }
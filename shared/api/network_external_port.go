@@ -0,0 +1,77 @@
+package api
+
+import (
+	"strings"
+)
+
+// NetworkExternalPortsPost represents the fields of a new network external port
+//
+// swagger:model
+//
+// API extension: network_external_ports.
+type NetworkExternalPortsPost struct {
+	NetworkExternalPortPut `yaml:",inline"`
+
+	// Name of the external port
+	// Example: dpdk0
+	Name string `json:"name" yaml:"name"`
+}
+
+// Normalise normalises the fields in the external port so that they are comparable with ones stored.
+func (p *NetworkExternalPortsPost) Normalise() {
+	p.Name = strings.TrimSpace(p.Name)
+	p.NetworkExternalPortPut.Normalise()
+}
+
+// NetworkExternalPortPut represents the modifiable fields of a network external port
+//
+// swagger:model
+//
+// API extension: network_external_ports.
+type NetworkExternalPortPut struct {
+	// Description of the external port
+	// Example: DPDK vhost-user port for vrouter01
+	Description string `json:"description" yaml:"description"`
+
+	// External port configuration map (refer to doc/network-external-ports.md)
+	// Example: {"interface": "vhu0", "hwaddr": "10:66:6a:05:2b:1f", "ipv4.address": "10.0.0.10"}
+	Config map[string]string `json:"config" yaml:"config"`
+}
+
+// Normalise normalises the fields in the external port so that they are comparable with ones stored.
+func (p *NetworkExternalPortPut) Normalise() {
+	p.Description = strings.TrimSpace(p.Description)
+
+	if p.Config == nil {
+		p.Config = map[string]string{}
+	}
+}
+
+// NetworkExternalPort used for displaying a network external port.
+//
+// swagger:model
+//
+// API extension: network_external_ports.
+type NetworkExternalPort struct {
+	NetworkExternalPortPut `yaml:",inline"`
+
+	// Name of the external port
+	// Read only: true
+	// Example: dpdk0
+	Name string `json:"name" yaml:"name"`
+
+	// What cluster member this external port is attached on
+	// Read only: true
+	// Example: server01
+	Location string `json:"location" yaml:"location"`
+}
+
+// Etag returns the values used for etag generation.
+func (p *NetworkExternalPort) Etag() []any {
+	return []any{p.Name, p.Description, p.Config}
+}
+
+// Writable converts a full NetworkExternalPort struct into a NetworkExternalPortPut struct (filters read-only fields).
+func (p *NetworkExternalPort) Writable() NetworkExternalPortPut {
+	return p.NetworkExternalPortPut
+}
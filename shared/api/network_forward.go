@@ -15,7 +15,7 @@ type NetworkForwardPort struct {
 	// Example: My web server forward
 	Description string `json:"description" yaml:"description"`
 
-	// Protocol for port forward (either tcp or udp)
+	// Protocol for port forward (tcp, udp or any for both)
 	// Example: tcp
 	Protocol string `json:"protocol" yaml:"protocol"`
 
@@ -31,6 +31,12 @@ type NetworkForwardPort struct {
 	// Example: 198.51.100.2
 	TargetAddress string `json:"target_address" yaml:"target_address"`
 
+	// TargetGroup to forward ListenPorts to (instead of TargetAddress)
+	// Example: web
+	//
+	// API extension: network_target_groups
+	TargetGroup string `json:"target_group,omitempty" yaml:"target_group,omitempty"`
+
 	// SNAT controls whether to apply a matching SNAT rule to new outgoing traffic from the target
 	// Example: false
 	//
@@ -43,6 +49,7 @@ func (p *NetworkForwardPort) Normalise() {
 	p.Description = strings.TrimSpace(p.Description)
 	p.Protocol = strings.TrimSpace(p.Protocol)
 	p.TargetAddress = strings.TrimSpace(p.TargetAddress)
+	p.TargetGroup = strings.TrimSpace(p.TargetGroup)
 
 	ip := net.ParseIP(p.TargetAddress)
 	if ip != nil {
@@ -89,6 +96,25 @@ func (f *NetworkForwardsPost) Normalise() {
 	f.NetworkForwardPut.Normalise()
 }
 
+// NetworkForwardPost represents the fields required to move a network address forward to another listen address
+//
+// swagger:model
+//
+// API extension: network_listen_address_move.
+type NetworkForwardPost struct {
+	// The new listen address of the forward
+	// Example: 192.0.2.2
+	ListenAddress string `json:"listen_address" yaml:"listen_address"`
+}
+
+// Normalise normalises the fields so that they are comparable with ones stored.
+func (f *NetworkForwardPost) Normalise() {
+	ip := net.ParseIP(f.ListenAddress)
+	if ip != nil {
+		f.ListenAddress = ip.String() // Replace with canonical form if specified.
+	}
+}
+
 // NetworkForwardPut represents the modifiable fields of a network address forward
 //
 // swagger:model
@@ -111,9 +137,11 @@ type NetworkForwardPut struct {
 func (f *NetworkForwardPut) Normalise() {
 	f.Description = strings.TrimSpace(f.Description)
 
-	ip := net.ParseIP(f.Config["target_address"])
-	if ip != nil {
-		f.Config["target_address"] = ip.String() // Replace with canonical form if specified.
+	for _, key := range []string{"target_address", "target_address.ipv4", "target_address.ipv6"} {
+		ip := net.ParseIP(f.Config[key])
+		if ip != nil {
+			f.Config[key] = ip.String() // Replace with canonical form if specified.
+		}
 	}
 
 	for i := range f.Ports {
@@ -121,6 +149,56 @@ func (f *NetworkForwardPut) Normalise() {
 	}
 }
 
+// NetworkForwardPatch represents a partial update of a network address forward
+//
+// Ports are matched on their listen port and protocol. Adding a port which already exists with the same
+// settings and removing a port which doesn't exist are no-ops, so the same request can safely be replayed.
+//
+// swagger:model
+//
+// API extension: network_forward_ports_patch.
+type NetworkForwardPatch struct {
+	// Description of the forward listen IP (left unchanged if not set)
+	// Example: My public IP forward
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Forward configuration keys to set (other keys are left unchanged)
+	// Example: {"user.mykey": "foo"}
+	Config map[string]string `json:"config,omitempty" yaml:"config,omitempty"`
+
+	// Full replacement of the port forwards (can't be combined with the port operations)
+	Ports []NetworkForwardPort `json:"ports,omitempty" yaml:"ports,omitempty"`
+
+	// Port forwards to add
+	PortsAdd []NetworkForwardPort `json:"ports_add,omitempty" yaml:"ports_add,omitempty"`
+
+	// Port forwards to modify
+	PortsModify []NetworkForwardPort `json:"ports_modify,omitempty" yaml:"ports_modify,omitempty"`
+
+	// Port forwards to remove (only the listen port and protocol are considered)
+	PortsRemove []NetworkForwardPort `json:"ports_remove,omitempty" yaml:"ports_remove,omitempty"`
+}
+
+// Normalise normalises the fields in the patch so that they are comparable with ones stored.
+func (f *NetworkForwardPatch) Normalise() {
+	if f.Description != nil {
+		description := strings.TrimSpace(*f.Description)
+		f.Description = &description
+	}
+
+	for _, ports := range [][]NetworkForwardPort{f.Ports, f.PortsAdd, f.PortsModify, f.PortsRemove} {
+		for i := range ports {
+			ports[i].Normalise()
+		}
+	}
+}
+
+// NetworkListenAddressStatusActive forward or load balancer is applied on the network.
+const NetworkListenAddressStatusActive = "Active"
+
+// NetworkListenAddressStatusDisabled forward or load balancer isn't applied as the network has no uplink.
+const NetworkListenAddressStatusDisabled = "Disabled"
+
 // NetworkForward used for displaying an network address forward.
 //
 // swagger:model
@@ -136,6 +214,27 @@ type NetworkForward struct {
 	// What cluster member this record was found on
 	// Example: server01
 	Location string `json:"location" yaml:"location"`
+
+	// List of URLs of the instances serving the forward, resolved from its target addresses
+	// Read only: true
+	// Example: ["/1.0/instances/web01", "/1.0/instances/web02"]
+	//
+	// API extension: network_forward_load_balancer_used_by
+	UsedBy []string `json:"used_by,omitempty" yaml:"used_by,omitempty"`
+
+	// List of target addresses not matching any active instance port
+	// Read only: true
+	// Example: ["198.51.100.3"]
+	//
+	// API extension: network_forward_load_balancer_used_by
+	UnmatchedTargetAddresses []string `json:"unmatched_target_addresses,omitempty" yaml:"unmatched_target_addresses,omitempty"`
+
+	// Whether the forward is applied on the network (Active) or not because the network has no uplink (Disabled)
+	// Read only: true
+	// Example: Active
+	//
+	// API extension: network_detach_uplink
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
 // Etag returns the values used for etag generation.
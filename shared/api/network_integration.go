@@ -69,3 +69,95 @@ type NetworkIntegrationPost struct {
 	// Example: region2
 	Name string `json:"name" yaml:"name"`
 }
+
+// NetworkIntegrationState represents the state of a network integration
+//
+// swagger:model
+//
+// API extension: network_integrations_state.
+type NetworkIntegrationState struct {
+	// State of the connection to the northbound database
+	Northbound NetworkIntegrationStateDatabase `json:"northbound" yaml:"northbound"`
+
+	// State of the connection to the southbound database
+	Southbound NetworkIntegrationStateDatabase `json:"southbound" yaml:"southbound"`
+
+	// Name of the local availability zone
+	// Example: az1
+	AvailabilityZone string `json:"availability_zone" yaml:"availability_zone"`
+
+	// List of interconnection gateways of the local availability zone
+	// Example: ["server01", "server02"]
+	Gateways []string `json:"gateways" yaml:"gateways"`
+
+	// List of transit switches managed by Incus
+	TransitSwitches []NetworkIntegrationStateTransitSwitch `json:"transit_switches" yaml:"transit_switches"`
+
+	// List of network peers using the integration
+	Peers []NetworkIntegrationStatePeer `json:"peers" yaml:"peers"`
+}
+
+// NetworkIntegrationStateDatabase represents the state of the connection to an interconnection database
+//
+// swagger:model
+//
+// API extension: network_integrations_state.
+type NetworkIntegrationStateDatabase struct {
+	// Whether the database could be reached
+	// Example: true
+	Reachable bool `json:"reachable" yaml:"reachable"`
+
+	// Error encountered when connecting to the database
+	// Example: dial tcp 192.0.2.12:6645: connect: connection refused
+	Error string `json:"error" yaml:"error"`
+}
+
+// NetworkIntegrationStateTransitSwitch represents a transit switch of a network integration
+//
+// swagger:model
+//
+// API extension: network_integrations_state.
+type NetworkIntegrationStateTransitSwitch struct {
+	// Name of the transit switch
+	// Example: ts-incus-region-default-ovn0
+	Name string `json:"name" yaml:"name"`
+
+	// List of availability zones holding an allocation on the switch
+	// Example: ["az1", "az2"]
+	AvailabilityZones []string `json:"availability_zones" yaml:"availability_zones"`
+}
+
+// NetworkIntegrationStatePeer represents the state of a network peer using a network integration
+//
+// swagger:model
+//
+// API extension: network_integrations_state.
+type NetworkIntegrationStatePeer struct {
+	// Project of the peered network
+	// Example: default
+	Project string `json:"project" yaml:"project"`
+
+	// Name of the peered network
+	// Example: ovn0
+	Network string `json:"network" yaml:"network"`
+
+	// Name of the network peer
+	// Example: region
+	Name string `json:"name" yaml:"name"`
+
+	// Name of the transit switch used by the peer
+	// Example: ts-incus-region-default-ovn0
+	TransitSwitch string `json:"transit_switch" yaml:"transit_switch"`
+
+	// Whether the local port on the transit switch is bound to an interconnection gateway
+	// Example: true
+	Bound bool `json:"bound" yaml:"bound"`
+
+	// Interconnection gateway the local port is bound to
+	// Example: server01
+	Gateway string `json:"gateway" yaml:"gateway"`
+
+	// List of the other availability zones with a port bound on the transit switch
+	// Example: ["az2"]
+	RemoteAvailabilityZones []string `json:"remote_availability_zones" yaml:"remote_availability_zones"`
+}
@@ -26,12 +26,19 @@ type NetworkLoadBalancerBackend struct {
 	// TargetAddress to forward ListenPorts to
 	// Example: 198.51.100.2
 	TargetAddress string `json:"target_address" yaml:"target_address"`
+
+	// TargetGroup to forward ListenPorts to (instead of TargetAddress)
+	// Example: web
+	//
+	// API extension: network_target_groups
+	TargetGroup string `json:"target_group,omitempty" yaml:"target_group,omitempty"`
 }
 
 // Normalise normalises the fields in the load balancer backend so that they are comparable with ones stored.
 func (p *NetworkLoadBalancerBackend) Normalise() {
 	p.Description = strings.TrimSpace(p.Description)
 	p.TargetAddress = strings.TrimSpace(p.TargetAddress)
+	p.TargetGroup = strings.TrimSpace(p.TargetGroup)
 
 	ip := net.ParseIP(p.TargetAddress)
 	if ip != nil {
@@ -97,6 +104,25 @@ type NetworkLoadBalancersPost struct {
 	ListenAddress string `json:"listen_address" yaml:"listen_address"`
 }
 
+// NetworkLoadBalancerPost represents the fields required to move a network load balancer to another listen address
+//
+// swagger:model
+//
+// API extension: network_listen_address_move.
+type NetworkLoadBalancerPost struct {
+	// The new listen address of the load balancer
+	// Example: 192.0.2.2
+	ListenAddress string `json:"listen_address" yaml:"listen_address"`
+}
+
+// Normalise normalises the fields so that they are comparable with ones stored.
+func (f *NetworkLoadBalancerPost) Normalise() {
+	ip := net.ParseIP(f.ListenAddress)
+	if ip != nil {
+		f.ListenAddress = ip.String() // Replace with canonical form if specified.
+	}
+}
+
 // NetworkLoadBalancerPut represents the modifiable fields of a network load balancer
 //
 // swagger:model
@@ -146,6 +172,27 @@ type NetworkLoadBalancer struct {
 	// What cluster member this record was found on
 	// Example: server01
 	Location string `json:"location" yaml:"location"`
+
+	// List of URLs of the instances serving the load balancer, resolved from its target addresses
+	// Read only: true
+	// Example: ["/1.0/instances/web01", "/1.0/instances/web02"]
+	//
+	// API extension: network_forward_load_balancer_used_by
+	UsedBy []string `json:"used_by,omitempty" yaml:"used_by,omitempty"`
+
+	// List of target addresses not matching any active instance port
+	// Read only: true
+	// Example: ["198.51.100.3"]
+	//
+	// API extension: network_forward_load_balancer_used_by
+	UnmatchedTargetAddresses []string `json:"unmatched_target_addresses,omitempty" yaml:"unmatched_target_addresses,omitempty"`
+
+	// Whether the load balancer is applied on the network (Active) or not because the network has no uplink (Disabled)
+	// Read only: true
+	// Example: Active
+	//
+	// API extension: network_detach_uplink
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
 // Etag returns the values used for etag generation.
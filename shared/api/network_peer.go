@@ -1,5 +1,15 @@
 package api
 
+// NetworkPeerStatusAwaitingApproval peering between projects is linked but waits for the target side to approve it.
+//
+// API extension: network_peer_approval.
+const NetworkPeerStatusAwaitingApproval = "Awaiting approval"
+
+// NetworkPeerStatusRejected peering between projects was rejected by the target side.
+//
+// API extension: network_peer_approval.
+const NetworkPeerStatusRejected = "Rejected"
+
 // NetworkPeersPost represents the fields of a new network peering
 //
 // swagger:model
@@ -92,6 +102,13 @@ type NetworkPeer struct {
 	//
 	// API extension: network_integrations.
 	TargetIntegration string `json:"target_integration,omitempty" yaml:"target_integration,omitempty"`
+
+	// Name of the integration currently carrying the peering (differs from the target one after a failover)
+	// Read only: true
+	// Example: ovn-ic2
+	//
+	// API extension: network_peer_integration_failover.
+	ActiveIntegration string `json:"active_integration,omitempty" yaml:"active_integration,omitempty"`
 }
 
 // Etag returns the values used for etag generation.
@@ -103,3 +120,29 @@ func (p *NetworkPeer) Etag() []any {
 func (p *NetworkPeer) Writable() NetworkPeerPut {
 	return p.NetworkPeerPut
 }
+
+// NetworkPeerStatePost represents an action on the state of a network peering.
+//
+// swagger:model
+//
+// API extension: network_peer_approval.
+type NetworkPeerStatePost struct {
+	// The action to perform (approve or reject)
+	// Example: approve
+	Action string `json:"action" yaml:"action"`
+}
+
+// NetworkPeerRoutes lists the routes a remote network peer advertises to its integration
+//
+// swagger:model
+//
+// API extension: network_peer_route_filters.
+type NetworkPeerRoutes struct {
+	// Routes advertised to the integration
+	// Example: ["10.0.0.0/24", "fd42:1234::/64"]
+	Exported []string `json:"exported" yaml:"exported"`
+
+	// Routes held back by the export filter
+	// Example: ["192.0.2.0/28"]
+	Filtered []string `json:"filtered" yaml:"filtered"`
+}
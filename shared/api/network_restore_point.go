@@ -0,0 +1,90 @@
+package api
+
+import (
+	"time"
+)
+
+// NetworkRestorePointsPost represents the fields of a new network restore point
+//
+// swagger:model
+//
+// API extension: network_restore_points.
+type NetworkRestorePointsPost struct {
+	// Name of the restore point
+	// Example: before-acl-change
+	Name string `json:"name" yaml:"name"`
+
+	// When the restore point expires (gets auto-deleted)
+	// Example: 2021-03-23T17:38:37.753398689-04:00
+	ExpiresAt time.Time `json:"expires_at" yaml:"expires_at"`
+}
+
+// NetworkRestorePoint represents a saved definition of a network
+//
+// swagger:model
+//
+// API extension: network_restore_points.
+type NetworkRestorePoint struct {
+	// Name of the restore point
+	// Example: auto-20210323-173837
+	Name string `json:"name" yaml:"name"`
+
+	// When the restore point was created
+	// Example: 2021-03-23T16:38:37.753398689-04:00
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+
+	// When the restore point expires (gets auto-deleted)
+	// Example: 2021-03-23T17:38:37.753398689-04:00
+	ExpiresAt time.Time `json:"expires_at" yaml:"expires_at"`
+
+	// Saved definition of the network
+	Definition NetworkRestorePointDefinition `json:"definition" yaml:"definition"`
+}
+
+// NetworkRestorePointDefinition represents the parts of a network saved in a restore point
+//
+// swagger:model
+//
+// API extension: network_restore_points.
+type NetworkRestorePointDefinition struct {
+	// Description of the network
+	// Example: My new OVN network
+	Description string `json:"description" yaml:"description"`
+
+	// Network configuration map (excluding volatile keys)
+	// Example: {"ipv4.address": "10.0.0.1/24", "security.acls": "web"}
+	Config map[string]string `json:"config" yaml:"config"`
+
+	// Network address forwards
+	Forwards []NetworkForwardsPost `json:"forwards" yaml:"forwards"`
+
+	// Network load balancers
+	LoadBalancers []NetworkLoadBalancersPost `json:"load_balancers" yaml:"load_balancers"`
+}
+
+// NetworkRestorePointChange represents a single difference between a network and one of its restore points
+//
+// swagger:model
+//
+// API extension: network_restore_points.
+type NetworkRestorePointChange struct {
+	// Kind of object the change applies to (network, forward or load-balancer)
+	// Example: network
+	Entity string `json:"entity" yaml:"entity"`
+
+	// Name of the object (listen address for forwards and load balancers)
+	// Example: 192.0.2.1
+	Name string `json:"name" yaml:"name"`
+
+	// Changed field (empty when the whole object was added or removed)
+	// Example: config.security.acls
+	Field string `json:"field" yaml:"field"`
+
+	// Current value
+	// Example: web,ssh
+	Current string `json:"current" yaml:"current"`
+
+	// Value stored in the restore point
+	// Example: web
+	RestorePoint string `json:"restore_point" yaml:"restore_point"`
+}
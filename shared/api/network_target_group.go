@@ -0,0 +1,98 @@
+package api
+
+import (
+	"net"
+	"strings"
+)
+
+// NetworkTargetGroupPost used for renaming a target group.
+//
+// swagger:model
+//
+// API extension: network_target_groups.
+type NetworkTargetGroupPost struct {
+	// The new name of the target group
+	// Example: web
+	Name string `json:"name" yaml:"name"`
+}
+
+// NetworkTargetGroupPut used for updating a target group.
+//
+// swagger:model
+//
+// API extension: network_target_groups.
+type NetworkTargetGroupPut struct {
+	// Description of the target group
+	// Example: Web servers
+	Description string `json:"description" yaml:"description"`
+
+	// List of target addresses in the group
+	// Example: ["198.51.100.2", "2001:db8::2"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+
+	// List of instances in the group
+	// Example: ["web01", "web02"]
+	Instances []string `json:"instances" yaml:"instances"`
+
+	// Target group configuration map (refer to doc/network-target-groups.md)
+	// Example: {"user.mykey": "foo"}
+	Config map[string]string `json:"config" yaml:"config"`
+}
+
+// Normalise normalises the fields in the target group so that they are comparable with ones stored.
+func (p *NetworkTargetGroupPut) Normalise() {
+	p.Description = strings.TrimSpace(p.Description)
+
+	for i, address := range p.Addresses {
+		p.Addresses[i] = strings.TrimSpace(address)
+
+		ip := net.ParseIP(p.Addresses[i])
+		if ip != nil {
+			p.Addresses[i] = ip.String() // Replace with canonical form if specified.
+		}
+	}
+
+	for i, instance := range p.Instances {
+		p.Instances[i] = strings.TrimSpace(instance)
+	}
+}
+
+// NetworkTargetGroupsPost used for creating a new target group.
+//
+// swagger:model
+//
+// API extension: network_target_groups.
+type NetworkTargetGroupsPost struct {
+	NetworkTargetGroupPost `yaml:",inline"`
+	NetworkTargetGroupPut  `yaml:",inline"`
+}
+
+// NetworkTargetGroup represents a named group of targets which network forwards and load balancers can reference.
+// Refer to doc/howto/network_target_groups.md for details.
+//
+// swagger:model
+//
+// API extension: network_target_groups.
+type NetworkTargetGroup struct {
+	NetworkTargetGroupPost `yaml:",inline"`
+	NetworkTargetGroupPut  `yaml:",inline"`
+
+	// List of URLs of forwards and load balancers using this target group
+	// Read only: true
+	// Example: ["/1.0/networks/ovn0/forwards/192.0.2.1", "/1.0/networks/ovn0/load-balancers/192.0.2.2"]
+	UsedBy []string `json:"used_by" yaml:"used_by"`
+
+	// Project name
+	// Example: project1
+	Project string `json:"project" yaml:"project"`
+}
+
+// Etag returns the values used for etag generation.
+func (g *NetworkTargetGroup) Etag() []any {
+	return []any{g.Name, g.Description, g.Addresses, g.Instances, g.Config}
+}
+
+// Writable converts a full NetworkTargetGroup struct into a NetworkTargetGroupPut struct (filters read-only fields).
+func (g *NetworkTargetGroup) Writable() NetworkTargetGroupPut {
+	return g.NetworkTargetGroupPut
+}
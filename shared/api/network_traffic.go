@@ -0,0 +1,47 @@
+package api
+
+import (
+	"time"
+)
+
+// NetworkTraffic represents the accumulated traffic of a network across all cluster members
+//
+// swagger:model
+//
+// API extension: network_traffic_accounting.
+type NetworkTraffic struct {
+	// Traffic of the instances and external ports connected to the network
+	Ports NetworkTrafficCounters `json:"ports" yaml:"ports"`
+
+	// Traffic exchanged with the uplink network (gateway and NAT traffic)
+	Uplink NetworkTrafficCounters `json:"uplink" yaml:"uplink"`
+
+	// When the counters were last updated
+	// Example: 2021-03-23T17:38:37.753398689-04:00
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at"`
+}
+
+// NetworkTrafficCounters represents ingress and egress traffic counters
+//
+// Ingress is traffic headed towards the instances, egress is traffic coming from them.
+//
+// swagger:model
+//
+// API extension: network_traffic_accounting.
+type NetworkTrafficCounters struct {
+	// Number of bytes received
+	// Example: 250542118
+	IngressBytes int64 `json:"ingress_bytes" yaml:"ingress_bytes"`
+
+	// Number of packets received
+	// Example: 1182515
+	IngressPackets int64 `json:"ingress_packets" yaml:"ingress_packets"`
+
+	// Number of bytes sent
+	// Example: 25524371
+	EgressBytes int64 `json:"egress_bytes" yaml:"egress_bytes"`
+
+	// Number of packets sent
+	// Example: 178762
+	EgressPackets int64 `json:"egress_packets" yaml:"egress_packets"`
+}